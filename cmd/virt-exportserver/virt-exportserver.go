@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"flag"
+	"os"
+
+	klog "kubevirt.io/client-go/log"
+
+	virt_exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+)
+
+func main() {
+	klog.InitializeLogging("virt-exportserver")
+
+	server := virt_exportserver.NewExportServer()
+	flag.StringVar(&server.ListenAddr, "listen", server.ListenAddr, "Address to listen on")
+	flag.StringVar(&server.CertFile, "cert-file", server.CertFile, "Serving certificate")
+	flag.StringVar(&server.KeyFile, "key-file", server.KeyFile, "Key of the serving certificate")
+	flag.StringVar(&server.TokenFile, "token-file", server.TokenFile, "File containing the export token")
+	flag.StringVar(&server.VolumesDir, "volumes-dir", server.VolumesDir, "Directory containing the exported volumes")
+	flag.StringVar(&server.ManifestFile, "manifest-file", server.ManifestFile, "File containing the VirtualMachine manifest")
+	flag.Parse()
+
+	if err := server.Run(); err != nil {
+		klog.Log.Reason(err).Error("Export server failed")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/pflag"
+
+	virttail "kubevirt.io/kubevirt/pkg/virt-tail"
+)
+
+func main() {
+	logFile := pflag.String("logfile", "", "The log file to follow")
+	pflag.Parse()
+
+	if *logFile == "" {
+		fmt.Fprintln(os.Stderr, "--logfile is required")
+		os.Exit(1)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	stop := make(chan struct{})
+	go func() {
+		<-signals
+		close(stop)
+	}()
+
+	if err := virttail.NewTailer(*logFile, os.Stdout).Run(stop); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to follow %s: %v\n", *logFile, err)
+		os.Exit(1)
+	}
+}
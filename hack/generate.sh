@@ -11,8 +11,12 @@ rm -rf ${KUBEVIRT_DIR}/staging/src/${CLIENT_GEN_BASE}
 
 # KubeVirt stuff
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go/apis/snapshot/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go/apis/instancetype/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go/apis/pool/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go/apis/clone/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go/apis/export/v1alpha1/types.go
 
-deepcopy-gen --input-dirs kubevirt.io/client-go/apis/snapshot/v1alpha1 \
+deepcopy-gen --input-dirs kubevirt.io/client-go/apis/snapshot/v1alpha1,kubevirt.io/client-go/apis/instancetype/v1alpha1,kubevirt.io/client-go/apis/pool/v1alpha1,kubevirt.io/client-go/apis/clone/v1alpha1,kubevirt.io/client-go/apis/export/v1alpha1 \
     --bounding-dirs kubevirt.io/client-go/apis \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
 
@@ -21,6 +25,30 @@ openapi-gen --input-dirs kubevirt.io/client-go/apis/snapshot/v1alpha1,k8s.io/api
     --output-package kubevirt.io/client-go/apis/snapshot/v1alpha1 \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt >${KUBEVIRT_DIR}/api/api-rule-violations.list
 
+openapi-gen --input-dirs kubevirt.io/client-go/apis/instancetype/v1alpha1,k8s.io/api/core/v1,k8s.io/apimachinery/pkg/apis/meta/v1,kubevirt.io/client-go/api/v1 \
+    --output-base ${KUBEVIRT_DIR}/staging/src \
+    --output-package kubevirt.io/client-go/apis/instancetype/v1alpha1 \
+    --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt |
+    grep "kubevirt.io/client-go/apis/instancetype/" >>${KUBEVIRT_DIR}/api/api-rule-violations.list || true
+
+openapi-gen --input-dirs kubevirt.io/client-go/apis/pool/v1alpha1,k8s.io/api/core/v1,k8s.io/apimachinery/pkg/apis/meta/v1,kubevirt.io/client-go/api/v1 \
+    --output-base ${KUBEVIRT_DIR}/staging/src \
+    --output-package kubevirt.io/client-go/apis/pool/v1alpha1 \
+    --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt |
+    grep "kubevirt.io/client-go/apis/pool/" >>${KUBEVIRT_DIR}/api/api-rule-violations.list || true
+
+openapi-gen --input-dirs kubevirt.io/client-go/apis/clone/v1alpha1,k8s.io/api/core/v1,k8s.io/apimachinery/pkg/apis/meta/v1,kubevirt.io/client-go/api/v1 \
+    --output-base ${KUBEVIRT_DIR}/staging/src \
+    --output-package kubevirt.io/client-go/apis/clone/v1alpha1 \
+    --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt |
+    grep "kubevirt.io/client-go/apis/clone/" >>${KUBEVIRT_DIR}/api/api-rule-violations.list || true
+
+openapi-gen --input-dirs kubevirt.io/client-go/apis/export/v1alpha1,k8s.io/api/core/v1,k8s.io/apimachinery/pkg/apis/meta/v1,kubevirt.io/client-go/api/v1 \
+    --output-base ${KUBEVIRT_DIR}/staging/src \
+    --output-package kubevirt.io/client-go/apis/export/v1alpha1 \
+    --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt |
+    grep "kubevirt.io/client-go/apis/export/" >>${KUBEVIRT_DIR}/api/api-rule-violations.list || true
+
 if cmp ${KUBEVIRT_DIR}/api/api-rule-violations.list ${KUBEVIRT_DIR}/api/api-rule-violations-known.list; then
     echo "openapi generated"
 else
@@ -32,7 +60,7 @@ fi
 
 client-gen --clientset-name versioned \
     --input-base kubevirt.io/client-go/apis \
-    --input snapshot/v1alpha1 \
+    --input snapshot/v1alpha1,instancetype/v1alpha1,pool/v1alpha1,clone/v1alpha1,export/v1alpha1 \
     --output-base ${KUBEVIRT_DIR}/staging/src \
     --output-package ${CLIENT_GEN_BASE}/kubevirt/clientset \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -80,6 +108,14 @@ deepcopy-gen --input-dirs ./pkg/virt-launcher/virtwrap/api \
         GOFLAGS= controller-gen crd:allowDangerousTypes=true paths=./api/v1/
     #include snapshot
     GOFLAGS= controller-gen crd paths=./apis/snapshot/v1alpha1/
+    #include instancetype
+    GOFLAGS= controller-gen crd paths=./apis/instancetype/v1alpha1/
+    #include pool
+    GOFLAGS= controller-gen crd paths=./apis/pool/v1alpha1/
+    #include clone
+    GOFLAGS= controller-gen crd paths=./apis/clone/v1alpha1/
+    #include export
+    GOFLAGS= controller-gen crd paths=./apis/export/v1alpha1/
 
     #remove some weird stuff from controller-gen
     cd config/crd
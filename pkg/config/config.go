@@ -45,6 +45,9 @@ const (
 	// ServiceAccount represents a secret type,
 	// https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
 	ServiceAccount Type = "serviceaccount"
+	// Sysprep represents a Sysprep answer file provided by a ConfigMap or a Secret,
+	// https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview
+	Sysprep Type = "sysprep"
 
 	mountBaseDir = "/var/run/kubevirt-private"
 )
@@ -58,6 +61,8 @@ var (
 	DownwardAPISourceDir = mountBaseDir + "/downwardapi"
 	// ServiceAccountSourceDir represents the location where the ServiceAccount token is attached to the pod
 	ServiceAccountSourceDir = "/var/run/secrets/kubernetes.io/serviceaccount/"
+	// SysprepSourceDir represents a location where the Sysprep ConfigMap or Secret is attached to the pod
+	SysprepSourceDir = mountBaseDir + "/sysprep"
 
 	// ConfigMapDisksDir represents a path to ConfigMap iso images
 	ConfigMapDisksDir = mountBaseDir + "/config-map-disks"
@@ -69,6 +74,8 @@ var (
 	ServiceAccountDiskDir = mountBaseDir + "/service-account-disk"
 	// ServiceAccountDiskName represents the name of the ServiceAccount iso image
 	ServiceAccountDiskName = "service-account.iso"
+	// SysprepDisksDir represents a path to Sysprep iso images
+	SysprepDisksDir = mountBaseDir + "/sysprep-disks"
 
 	createISOImage = defaultCreateIsoImage
 )
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+)
+
+const sysprepVolumeLabel = "sysprep"
+
+// sysprepAnswerFiles are the answer files Windows Setup looks for on removable media
+var sysprepAnswerFiles = []string{"autounattend.xml", "unattend.xml"}
+
+// GetSysprepSourcePath returns a path to the Sysprep ConfigMap or Secret mounted on a pod
+func GetSysprepSourcePath(volumeName string) string {
+	return filepath.Join(SysprepSourceDir, volumeName)
+}
+
+// GetSysprepDiskPath returns a path to Sysprep iso image created based on a volume name
+func GetSysprepDiskPath(volumeName string) string {
+	return filepath.Join(SysprepDisksDir, volumeName+".iso")
+}
+
+func validateSysprepAnswerFile(dirPath string) error {
+	files, err := ioutil.ReadDir(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		for _, answerFile := range sysprepAnswerFiles {
+			if strings.EqualFold(file.Name(), answerFile) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("sysprep volume %s does not contain any of the answer files %s", filepath.Base(dirPath), strings.Join(sysprepAnswerFiles, ", "))
+}
+
+// CreateSysprepDisks creates Sysprep iso disks which are attached to vmis
+func CreateSysprepDisks(vmi *v1.VirtualMachineInstance) error {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Sysprep != nil {
+			sourcePath := GetSysprepSourcePath(volume.Name)
+			if err := validateSysprepAnswerFile(sourcePath); err != nil {
+				return err
+			}
+
+			filesPath, err := getFilesLayout(sourcePath)
+			if err != nil {
+				return err
+			}
+
+			disk := GetSysprepDiskPath(volume.Name)
+			if err := createIsoConfigImage(disk, sysprepVolumeLabel, filesPath); err != nil {
+				return err
+			}
+
+			if err := ephemeraldiskutils.DefaultOwnershipManager.SetFileOwnership(disk); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Sysprep", func() {
+
+	newSysprepVMI := func() *v1.VirtualMachineInstance {
+		vmi := v1.NewMinimalVMI("fake-vmi")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "sysprep-volume",
+			VolumeSource: v1.VolumeSource{
+				Sysprep: &v1.SysprepSource{
+					ConfigMap: &k8sv1.LocalObjectReference{
+						Name: "test-config-map",
+					},
+				},
+			},
+		})
+		return vmi
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		SysprepSourceDir, err = ioutil.TempDir("", "sysprep")
+		Expect(err).NotTo(HaveOccurred())
+		os.MkdirAll(filepath.Join(SysprepSourceDir, "sysprep-volume"), 0755)
+
+		SysprepDisksDir, err = ioutil.TempDir("", "sysprep-disks")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(SysprepSourceDir)
+		os.RemoveAll(SysprepDisksDir)
+	})
+
+	table.DescribeTable("Should create a new sysprep iso disk", func(answerFile string) {
+		os.OpenFile(filepath.Join(SysprepSourceDir, "sysprep-volume", answerFile), os.O_RDONLY|os.O_CREATE, 0666)
+
+		err := CreateSysprepDisks(newSysprepVMI())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = os.Stat(filepath.Join(SysprepDisksDir, "sysprep-volume.iso"))
+		Expect(err).NotTo(HaveOccurred())
+	},
+		table.Entry("with autounattend.xml", "autounattend.xml"),
+		table.Entry("with unattend.xml", "unattend.xml"),
+		table.Entry("with a differently cased answer file", "Autounattend.xml"),
+	)
+
+	It("Should fail without an answer file", func() {
+		os.OpenFile(filepath.Join(SysprepSourceDir, "sysprep-volume", "test-file"), os.O_RDONLY|os.O_CREATE, 0666)
+
+		err := CreateSysprepDisks(newSysprepVMI())
+		Expect(err).To(HaveOccurred())
+		_, err = os.Stat(filepath.Join(SysprepDisksDir, "sysprep-volume.iso"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
@@ -250,3 +250,52 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 
 	return vmiSpec
 }
+
+// ApplyInterfaceRequestOnVMISpec adds or removes the interface and network of the request, hotplugged interfaces use the bridge binding
+func ApplyInterfaceRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineInterfaceRequest) *v1.VirtualMachineInstanceSpec {
+	if request.AddInterfaceOptions != nil {
+		name := request.AddInterfaceOptions.Name
+		for _, network := range vmiSpec.Networks {
+			if network.Name == name {
+				return vmiSpec
+			}
+		}
+
+		vmiSpec.Networks = append(vmiSpec.Networks, v1.Network{
+			Name: name,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: request.AddInterfaceOptions.NetworkAttachmentDefinitionName},
+			},
+		})
+		vmiSpec.Domain.Devices.Interfaces = append(vmiSpec.Domain.Devices.Interfaces, v1.Interface{
+			Name: name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{},
+			},
+		})
+	} else if request.RemoveInterfaceOptions != nil {
+		name := request.RemoveInterfaceOptions.Name
+
+		var newNetworksList []v1.Network
+		for _, network := range vmiSpec.Networks {
+			if network.Name != name {
+				newNetworksList = append(newNetworksList, network)
+			}
+		}
+		if len(newNetworksList) != len(vmiSpec.Networks) {
+			vmiSpec.Networks = newNetworksList
+		}
+
+		var newInterfacesList []v1.Interface
+		for _, iface := range vmiSpec.Domain.Devices.Interfaces {
+			if iface.Name != name {
+				newInterfacesList = append(newInterfacesList, iface)
+			}
+		}
+		if len(newInterfacesList) != len(vmiSpec.Domain.Devices.Interfaces) {
+			vmiSpec.Domain.Devices.Interfaces = newInterfacesList
+		}
+	}
+
+	return vmiSpec
+}
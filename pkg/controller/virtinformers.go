@@ -34,6 +34,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -50,6 +51,9 @@ import (
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 
 	kubev1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -100,6 +104,18 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachinePool objects
+	VirtualMachinePool() cache.SharedIndexInformer
+
+	// Watches VirtualMachineClone objects
+	VirtualMachineClone() cache.SharedIndexInformer
+
+	// Watches VirtualMachineExport objects
+	VirtualMachineExport() cache.SharedIndexInformer
+
 	// Watches for k8s extensions api configmap
 	ApiAuthConfigMap() cache.SharedIndexInformer
 
@@ -208,6 +224,9 @@ type KubeInformerFactory interface {
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
+	// Ingress returns an informer for ALL Ingresses in the system
+	Ingress() cache.SharedIndexInformer
+
 	K8SInformerFactory() informers.SharedInformerFactory
 }
 
@@ -347,7 +366,7 @@ func (f *kubeInformerFactory) KubeVirtNode() cache.SharedIndexInformer {
 func (f *kubeInformerFactory) VirtualMachine() cache.SharedIndexInformer {
 	return f.getInformer("vmInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachines", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachine{}, f.defaultResync, cache.Indexers{})
+		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachine{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 	})
 }
 
@@ -401,6 +420,20 @@ func (f *kubeInformerFactory) VirtualMachineSnapshotContent() cache.SharedIndexI
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachinePool() cache.SharedIndexInformer {
+	return f.getInformer("vmPoolInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().PoolV1alpha1().RESTClient(), "virtualmachinepools", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &poolv1.VirtualMachinePool{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer {
 	return f.getInformer("vmRestoreInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinerestores", k8sv1.NamespaceAll, fields.Everything())
@@ -430,6 +463,81 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineClone() cache.SharedIndexInformer {
+	return f.getInformer("vmCloneInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().CloneV1alpha1().RESTClient(), "virtualmachineclones", k8sv1.NamespaceAll, fields.Everything())
+		refIndexFunc := func(ref func(*clonev1.VirtualMachineClone) *k8sv1.TypedLocalObjectReference, group, kind string) cache.IndexFunc {
+			return func(obj interface{}) ([]string, error) {
+				vmClone, ok := obj.(*clonev1.VirtualMachineClone)
+				if !ok {
+					return nil, unexpectedObjectError
+				}
+
+				r := ref(vmClone)
+				if r != nil && r.APIGroup != nil && *r.APIGroup == group && r.Kind == kind && r.Name != "" {
+					return []string{fmt.Sprintf("%s/%s", vmClone.Namespace, r.Name)}, nil
+				}
+
+				return nil, nil
+			}
+		}
+
+		return cache.NewSharedIndexInformer(lw, &clonev1.VirtualMachineClone{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			"vmSource": refIndexFunc(func(c *clonev1.VirtualMachineClone) *k8sv1.TypedLocalObjectReference {
+				return c.Spec.Source
+			}, kubev1.GroupName, "VirtualMachine"),
+			"snapshotSource": refIndexFunc(func(c *clonev1.VirtualMachineClone) *k8sv1.TypedLocalObjectReference {
+				return c.Spec.Source
+			}, snapshotv1.SchemeGroupVersion.Group, "VirtualMachineSnapshot"),
+			"vmTarget": func(obj interface{}) ([]string, error) {
+				vmClone, ok := obj.(*clonev1.VirtualMachineClone)
+				if !ok {
+					return nil, unexpectedObjectError
+				}
+
+				if vmClone.Status.TargetName != nil {
+					return []string{fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName)}, nil
+				}
+
+				return nil, nil
+			},
+		})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineExport() cache.SharedIndexInformer {
+	return f.getInformer("vmExportInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().ExportV1alpha1().RESTClient(), "virtualmachineexports", k8sv1.NamespaceAll, fields.Everything())
+		sourceIndexFunc := func(group, kind string) cache.IndexFunc {
+			return func(obj interface{}) ([]string, error) {
+				vmExport, ok := obj.(*exportv1.VirtualMachineExport)
+				if !ok {
+					return nil, unexpectedObjectError
+				}
+
+				source := vmExport.Spec.Source
+				sourceGroup := ""
+				if source.APIGroup != nil {
+					sourceGroup = *source.APIGroup
+				}
+				if sourceGroup == group && source.Kind == kind && source.Name != "" {
+					return []string{fmt.Sprintf("%s/%s", vmExport.Namespace, source.Name)}, nil
+				}
+
+				return nil, nil
+			}
+		}
+
+		return cache.NewSharedIndexInformer(lw, &exportv1.VirtualMachineExport{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			"pvcSource":          sourceIndexFunc("", "PersistentVolumeClaim"),
+			"vmSource":           sourceIndexFunc(kubev1.GroupName, "VirtualMachine"),
+			"snapshotSource":     sourceIndexFunc(snapshotv1.SchemeGroupVersion.Group, "VirtualMachineSnapshot"),
+		})
+	})
+}
+
 func (f *kubeInformerFactory) DataVolume() cache.SharedIndexInformer {
 	return f.getInformer("dataVolumeInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1alpha1().RESTClient(), "datavolumes", k8sv1.NamespaceAll, fields.Everything())
@@ -810,6 +918,13 @@ func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) Ingress() cache.SharedIndexInformer {
+	return f.getInformer("ingressInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.NetworkingV1().RESTClient(), "ingresses", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &networkingv1.Ingress{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 // VolumeSnapshotInformer returns an informer for VolumeSnapshots
 func VolumeSnapshotInformer(clientSet kubecli.KubevirtClient, resyncPeriod time.Duration) cache.SharedIndexInformer {
 	restClient := clientSet.KubernetesSnapshotClient().SnapshotV1beta1().RESTClient()
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package downwardmetrics
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd"
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	DownwardMetricDisksDir = util.VirtPrivateDir + "/downwardapi-disks"
+	DownwardMetricDisk     = DownwardMetricDisksDir + "/vhostmd0"
+)
+
+// HasDownwardMetricDisk returns true if the vmi has a DownwardMetrics volume
+func HasDownwardMetricDisk(vmi *v1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.DownwardMetrics != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatDownwardMetricPath returns the path of the metrics disk of the virt-launcher process with the given pid
+func FormatDownwardMetricPath(pid int) string {
+	return filepath.Join("/proc", strconv.Itoa(pid), "root", DownwardMetricDisk)
+}
+
+// CreateDownwardMetricDisk creates the metrics disk which virt-handler updates while the vmi is running
+func CreateDownwardMetricDisk(vmi *v1.VirtualMachineInstance) error {
+	if !HasDownwardMetricDisk(vmi) {
+		return nil
+	}
+
+	if err := os.MkdirAll(DownwardMetricDisksDir, 0755); err != nil {
+		return err
+	}
+	if err := vhostmd.NewMetricsIODisk(DownwardMetricDisk).Create(); err != nil {
+		return err
+	}
+	return ephemeraldiskutils.DefaultOwnershipManager.SetFileOwnership(DownwardMetricDisk)
+}
@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package api
+
+import "encoding/xml"
+
+type MetricType string
+
+const (
+	MetricTypeString MetricType = "string"
+	MetricTypeReal64 MetricType = "real64"
+	MetricTypeUInt32 MetricType = "uint32"
+	MetricTypeUInt64 MetricType = "uint64"
+	MetricTypeInt64  MetricType = "int64"
+)
+
+type MetricContext string
+
+const (
+	MetricContextHost MetricContext = "host"
+	MetricContextVM   MetricContext = "vm"
+)
+
+// Metrics is the XML document vhostmd exposes to the guest
+type Metrics struct {
+	XMLName xml.Name `xml:"metrics"`
+	Metrics []Metric `xml:"metric"`
+}
+
+type Metric struct {
+	Type    MetricType    `xml:"type,attr"`
+	Context MetricContext `xml:"context,attr"`
+	Name    string        `xml:"name"`
+	Value   string        `xml:"value"`
+}
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package vhostmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+)
+
+// The disk layout is the one of the vhostmd metrics disk: a header in network byte
+// order followed by the XML document, see https://github.com/vhostmd/vhostmd
+const (
+	signature = 0x6d766264 // "mvbd"
+
+	// DiskSize is the size of the metrics disk, the default of vhostmd
+	DiskSize = 256 * 1024
+
+	headerSize    = 16
+	maxBodyLength = DiskSize - headerSize
+)
+
+type header struct {
+	Signature uint32
+	// Busy is set while the disk is updated, readers have to retry
+	Busy     uint32
+	Checksum uint32
+	Length   uint32
+}
+
+type MetricsIO interface {
+	Create() error
+	Read() (*api.Metrics, error)
+	Write(metrics *api.Metrics) error
+}
+
+type vhostmd struct {
+	filePath string
+}
+
+func NewMetricsIODisk(filePath string) MetricsIO {
+	return &vhostmd{filePath: filePath}
+}
+
+// Create creates the disk with an empty metrics document
+func (v *vhostmd) Create() error {
+	f, err := os.OpenFile(v.filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create metrics disk %s: %v", v.filePath, err)
+	}
+	defer f.Close()
+
+	if err := f.Truncate(DiskSize); err != nil {
+		return fmt.Errorf("failed to allocate metrics disk %s: %v", v.filePath, err)
+	}
+	return write(f, &api.Metrics{})
+}
+
+// Write replaces the metrics document on an existing disk
+func (v *vhostmd) Write(metrics *api.Metrics) error {
+	f, err := os.OpenFile(v.filePath, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open metrics disk %s: %v", v.filePath, err)
+	}
+	defer f.Close()
+
+	return write(f, metrics)
+}
+
+func (v *vhostmd) Read() (*api.Metrics, error) {
+	f, err := os.Open(v.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open metrics disk %s: %v", v.filePath, err)
+	}
+	defer f.Close()
+
+	h := &header{}
+	if err := binary.Read(f, binary.BigEndian, h); err != nil {
+		return nil, fmt.Errorf("failed to read the header of metrics disk %s: %v", v.filePath, err)
+	}
+	if h.Signature != signature {
+		return nil, fmt.Errorf("metrics disk %s has an invalid signature", v.filePath)
+	}
+	if h.Busy != 0 {
+		return nil, fmt.Errorf("metrics disk %s is busy", v.filePath)
+	}
+	if h.Length > maxBodyLength {
+		return nil, fmt.Errorf("metrics disk %s has an invalid length %d", v.filePath, h.Length)
+	}
+
+	body := make([]byte, h.Length)
+	if _, err := io.ReadFull(f, body); err != nil {
+		return nil, fmt.Errorf("failed to read the metrics of metrics disk %s: %v", v.filePath, err)
+	}
+	if checksum(body) != h.Checksum {
+		return nil, fmt.Errorf("metrics disk %s has an invalid checksum", v.filePath)
+	}
+
+	metrics := &api.Metrics{}
+	if err := xml.Unmarshal(body, metrics); err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics of metrics disk %s: %v", v.filePath, err)
+	}
+	return metrics, nil
+}
+
+func write(f *os.File, metrics *api.Metrics) error {
+	body, err := xml.MarshalIndent(metrics, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal metrics: %v", err)
+	}
+	if len(body) > maxBodyLength {
+		return fmt.Errorf("metrics of %d bytes exceed the metrics disk size", len(body))
+	}
+
+	// mark the disk as busy while the body is updated
+	if err := writeHeader(f, &header{Signature: signature, Busy: 1}); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(body, headerSize); err != nil {
+		return fmt.Errorf("failed to write metrics: %v", err)
+	}
+	if err := writeHeader(f, &header{
+		Signature: signature,
+		Checksum:  checksum(body),
+		Length:    uint32(len(body)),
+	}); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func writeHeader(f *os.File, h *header) error {
+	buf := &bytes.Buffer{}
+	if err := binary.Write(buf, binary.BigEndian, h); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(buf.Bytes(), 0); err != nil {
+		return fmt.Errorf("failed to write the metrics disk header: %v", err)
+	}
+	return f.Sync()
+}
+
+func checksum(body []byte) (sum uint32) {
+	for _, b := range body {
+		sum += uint32(b)
+	}
+	return sum
+}
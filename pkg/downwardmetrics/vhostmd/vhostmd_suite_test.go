@@ -0,0 +1,13 @@
+package vhostmd_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestVhostmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vhostmd Suite")
+}
@@ -0,0 +1,87 @@
+package vhostmd_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+)
+
+var _ = Describe("vhostmd", func() {
+
+	var tmpDir string
+	var diskPath string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "vhostmd")
+		Expect(err).ToNot(HaveOccurred())
+		diskPath = filepath.Join(tmpDir, "vhostmd0")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should create an empty metrics disk of the vhostmd size", func() {
+		disk := vhostmd.NewMetricsIODisk(diskPath)
+		Expect(disk.Create()).To(Succeed())
+
+		info, err := os.Stat(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size()).To(Equal(int64(vhostmd.DiskSize)))
+
+		metrics, err := disk.Read()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metrics.Metrics).To(BeEmpty())
+	})
+
+	It("should write the vhostmd header and read the metrics back", func() {
+		disk := vhostmd.NewMetricsIODisk(diskPath)
+		Expect(disk.Create()).To(Succeed())
+
+		metrics := &api.Metrics{
+			Metrics: []api.Metric{
+				{Type: api.MetricTypeString, Context: api.MetricContextHost, Name: "HostName", Value: "node01"},
+				{Type: api.MetricTypeReal64, Context: api.MetricContextVM, Name: "TotalCPUTime", Value: "1.500000"},
+			},
+		}
+		Expect(disk.Write(metrics)).To(Succeed())
+
+		raw, err := ioutil.ReadFile(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw[0:4])).To(Equal("mvbd"))
+		Expect(binary.BigEndian.Uint32(raw[4:8])).To(BeZero(), "the disk must not be busy")
+		length := binary.BigEndian.Uint32(raw[12:16])
+		Expect(string(raw[16 : 16+length])).To(ContainSubstring(`<metric type="string" context="host">`))
+		Expect(string(raw[16 : 16+length])).To(ContainSubstring(`<name>HostName</name>`))
+
+		read, err := disk.Read()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(read.Metrics).To(Equal(metrics.Metrics))
+	})
+
+	It("should fail to read a busy disk", func() {
+		disk := vhostmd.NewMetricsIODisk(diskPath)
+		Expect(disk.Create()).To(Succeed())
+
+		f, err := os.OpenFile(diskPath, os.O_RDWR, 0)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteAt([]byte{0, 0, 0, 1}, 4)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		_, err = disk.Read()
+		Expect(err).To(MatchError(ContainSubstring("busy")))
+	})
+
+	It("should fail to write to a disk which was not created", func() {
+		Expect(vhostmd.NewMetricsIODisk(diskPath).Write(&api.Metrics{})).ToNot(Succeed())
+	})
+})
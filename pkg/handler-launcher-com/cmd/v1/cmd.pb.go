@@ -20,6 +20,10 @@ It has these top-level messages:
 	GuestInfoResponse
 	GuestUserListResponse
 	GuestFilesystemsResponse
+	FreezeRequest
+	MemoryDumpRequest
+	GuestExecRequest
+	GuestExecResponse
 */
 package v1
 
@@ -334,6 +338,142 @@ func (m *GuestFilesystemsResponse) GetGuestFilesystemsResponse() string {
 	return ""
 }
 
+type FreezeRequest struct {
+	Vmi                    *VMI  `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	UnfreezeTimeoutSeconds int32 `protobuf:"varint,2,opt,name=unfreezeTimeoutSeconds" json:"unfreezeTimeoutSeconds,omitempty"`
+}
+
+func (m *FreezeRequest) Reset()                    { *m = FreezeRequest{} }
+func (m *FreezeRequest) String() string            { return proto.CompactTextString(m) }
+func (*FreezeRequest) ProtoMessage()               {}
+func (*FreezeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *FreezeRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *FreezeRequest) GetUnfreezeTimeoutSeconds() int32 {
+	if m != nil {
+		return m.UnfreezeTimeoutSeconds
+	}
+	return 0
+}
+
+type MemoryDumpRequest struct {
+	Vmi      *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	DumpPath string `protobuf:"bytes,2,opt,name=dumpPath" json:"dumpPath,omitempty"`
+}
+
+func (m *MemoryDumpRequest) Reset()                    { *m = MemoryDumpRequest{} }
+func (m *MemoryDumpRequest) String() string            { return proto.CompactTextString(m) }
+func (*MemoryDumpRequest) ProtoMessage()               {}
+func (*MemoryDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *MemoryDumpRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *MemoryDumpRequest) GetDumpPath() string {
+	if m != nil {
+		return m.DumpPath
+	}
+	return ""
+}
+
+type GuestExecRequest struct {
+	Vmi            *VMI     `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Command        string   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Args           []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	Stdin          string   `protobuf:"bytes,4,opt,name=stdin" json:"stdin,omitempty"`
+	TimeoutSeconds int32    `protobuf:"varint,5,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestExecRequest) Reset()                    { *m = GuestExecRequest{} }
+func (m *GuestExecRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecRequest) ProtoMessage()               {}
+func (*GuestExecRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GuestExecRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *GuestExecRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *GuestExecRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *GuestExecRequest) GetStdin() string {
+	if m != nil {
+		return m.Stdin
+	}
+	return ""
+}
+
+func (m *GuestExecRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type GuestExecResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	ExitCode int32     `protobuf:"varint,2,opt,name=exitCode" json:"exitCode,omitempty"`
+	Stdout   string    `protobuf:"bytes,3,opt,name=stdout" json:"stdout,omitempty"`
+	Stderr   string    `protobuf:"bytes,4,opt,name=stderr" json:"stderr,omitempty"`
+}
+
+func (m *GuestExecResponse) Reset()                    { *m = GuestExecResponse{} }
+func (m *GuestExecResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecResponse) ProtoMessage()               {}
+func (*GuestExecResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *GuestExecResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *GuestExecResponse) GetStdout() string {
+	if m != nil {
+		return m.Stdout
+	}
+	return ""
+}
+
+func (m *GuestExecResponse) GetStderr() string {
+	if m != nil {
+		return m.Stderr
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
 	proto.RegisterType((*SMBios)(nil), "kubevirt.cmd.v1.SMBios")
@@ -347,6 +487,10 @@ func init() {
 	proto.RegisterType((*GuestInfoResponse)(nil), "kubevirt.cmd.v1.GuestInfoResponse")
 	proto.RegisterType((*GuestUserListResponse)(nil), "kubevirt.cmd.v1.GuestUserListResponse")
 	proto.RegisterType((*GuestFilesystemsResponse)(nil), "kubevirt.cmd.v1.GuestFilesystemsResponse")
+	proto.RegisterType((*FreezeRequest)(nil), "kubevirt.cmd.v1.FreezeRequest")
+	proto.RegisterType((*MemoryDumpRequest)(nil), "kubevirt.cmd.v1.MemoryDumpRequest")
+	proto.RegisterType((*GuestExecRequest)(nil), "kubevirt.cmd.v1.GuestExecRequest")
+	proto.RegisterType((*GuestExecResponse)(nil), "kubevirt.cmd.v1.GuestExecResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,18 +507,24 @@ type CmdClient interface {
 	SyncVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	PauseVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	UnpauseVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	FreezeVirtualMachine(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*Response, error)
+	UnfreezeVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error)
 	ShutdownVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	RebootVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	KillVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	DeleteVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	MigrateVirtualMachine(ctx context.Context, in *MigrationRequest, opts ...grpc.CallOption) (*Response, error)
 	SyncMigrationTarget(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	CancelVirtualMachineMigration(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	SetVirtualMachineGuestTime(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	SetInterfaceBandwidth(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 	GetDomain(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainResponse, error)
 	GetDomainStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainStatsResponse, error)
 	GetGuestInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestInfoResponse, error)
 	GetUsers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestUserListResponse, error)
 	GetFilesystems(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestFilesystemsResponse, error)
+	GuestExec(ctx context.Context, in *GuestExecRequest, opts ...grpc.CallOption) (*GuestExecResponse, error)
 	Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
@@ -413,6 +563,33 @@ func (c *cmdClient) UnpauseVirtualMachine(ctx context.Context, in *VMIRequest, o
 	return out, nil
 }
 
+func (c *cmdClient) FreezeVirtualMachine(ctx context.Context, in *FreezeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/FreezeVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) UnfreezeVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/UnfreezeVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/VirtualMachineMemoryDump", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) ShutdownVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/ShutdownVirtualMachine", in, out, c.cc, opts...)
@@ -422,6 +599,15 @@ func (c *cmdClient) ShutdownVirtualMachine(ctx context.Context, in *VMIRequest,
 	return out, nil
 }
 
+func (c *cmdClient) RebootVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/RebootVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) KillVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/KillVirtualMachine", in, out, c.cc, opts...)
@@ -476,6 +662,15 @@ func (c *cmdClient) SetVirtualMachineGuestTime(ctx context.Context, in *VMIReque
 	return out, nil
 }
 
+func (c *cmdClient) SetInterfaceBandwidth(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SetInterfaceBandwidth", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) GetDomain(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
 	out := new(DomainResponse)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetDomain", in, out, c.cc, opts...)
@@ -521,6 +716,15 @@ func (c *cmdClient) GetFilesystems(ctx context.Context, in *EmptyRequest, opts .
 	return out, nil
 }
 
+func (c *cmdClient) GuestExec(ctx context.Context, in *GuestExecRequest, opts ...grpc.CallOption) (*GuestExecResponse, error) {
+	out := new(GuestExecResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/Ping", in, out, c.cc, opts...)
@@ -536,18 +740,24 @@ type CmdServer interface {
 	SyncVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 	PauseVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 	UnpauseVirtualMachine(context.Context, *VMIRequest) (*Response, error)
+	FreezeVirtualMachine(context.Context, *FreezeRequest) (*Response, error)
+	UnfreezeVirtualMachine(context.Context, *VMIRequest) (*Response, error)
+	VirtualMachineMemoryDump(context.Context, *MemoryDumpRequest) (*Response, error)
 	ShutdownVirtualMachine(context.Context, *VMIRequest) (*Response, error)
+	RebootVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 	KillVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 	DeleteVirtualMachine(context.Context, *VMIRequest) (*Response, error)
 	MigrateVirtualMachine(context.Context, *MigrationRequest) (*Response, error)
 	SyncMigrationTarget(context.Context, *VMIRequest) (*Response, error)
 	CancelVirtualMachineMigration(context.Context, *VMIRequest) (*Response, error)
 	SetVirtualMachineGuestTime(context.Context, *VMIRequest) (*Response, error)
+	SetInterfaceBandwidth(context.Context, *VMIRequest) (*Response, error)
 	GetDomain(context.Context, *EmptyRequest) (*DomainResponse, error)
 	GetDomainStats(context.Context, *EmptyRequest) (*DomainStatsResponse, error)
 	GetGuestInfo(context.Context, *EmptyRequest) (*GuestInfoResponse, error)
 	GetUsers(context.Context, *EmptyRequest) (*GuestUserListResponse, error)
 	GetFilesystems(context.Context, *EmptyRequest) (*GuestFilesystemsResponse, error)
+	GuestExec(context.Context, *GuestExecRequest) (*GuestExecResponse, error)
 	Ping(context.Context, *EmptyRequest) (*Response, error)
 }
 
@@ -609,6 +819,60 @@ func _Cmd_UnpauseVirtualMachine_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_FreezeVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).FreezeVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/FreezeVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).FreezeVirtualMachine(ctx, req.(*FreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_UnfreezeVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).UnfreezeVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/UnfreezeVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).UnfreezeVirtualMachine(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_VirtualMachineMemoryDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).VirtualMachineMemoryDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/VirtualMachineMemoryDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).VirtualMachineMemoryDump(ctx, req.(*MemoryDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_ShutdownVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMIRequest)
 	if err := dec(in); err != nil {
@@ -627,6 +891,24 @@ func _Cmd_ShutdownVirtualMachine_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_RebootVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).RebootVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/RebootVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).RebootVirtualMachine(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_KillVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMIRequest)
 	if err := dec(in); err != nil {
@@ -735,6 +1017,24 @@ func _Cmd_SetVirtualMachineGuestTime_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_SetInterfaceBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SetInterfaceBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SetInterfaceBandwidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SetInterfaceBandwidth(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_GetDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -825,6 +1125,24 @@ func _Cmd_GetFilesystems_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExec(ctx, req.(*GuestExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -859,10 +1177,26 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseVirtualMachine",
 			Handler:    _Cmd_UnpauseVirtualMachine_Handler,
 		},
+		{
+			MethodName: "FreezeVirtualMachine",
+			Handler:    _Cmd_FreezeVirtualMachine_Handler,
+		},
+		{
+			MethodName: "UnfreezeVirtualMachine",
+			Handler:    _Cmd_UnfreezeVirtualMachine_Handler,
+		},
+		{
+			MethodName: "VirtualMachineMemoryDump",
+			Handler:    _Cmd_VirtualMachineMemoryDump_Handler,
+		},
 		{
 			MethodName: "ShutdownVirtualMachine",
 			Handler:    _Cmd_ShutdownVirtualMachine_Handler,
 		},
+		{
+			MethodName: "RebootVirtualMachine",
+			Handler:    _Cmd_RebootVirtualMachine_Handler,
+		},
 		{
 			MethodName: "KillVirtualMachine",
 			Handler:    _Cmd_KillVirtualMachine_Handler,
@@ -887,6 +1221,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetVirtualMachineGuestTime",
 			Handler:    _Cmd_SetVirtualMachineGuestTime_Handler,
 		},
+		{
+			MethodName: "SetInterfaceBandwidth",
+			Handler:    _Cmd_SetInterfaceBandwidth_Handler,
+		},
 		{
 			MethodName: "GetDomain",
 			Handler:    _Cmd_GetDomain_Handler,
@@ -907,6 +1245,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilesystems",
 			Handler:    _Cmd_GetFilesystems_Handler,
 		},
+		{
+			MethodName: "GuestExec",
+			Handler:    _Cmd_GuestExec_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _Cmd_Ping_Handler,
@@ -919,52 +1261,67 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x61, 0x4f, 0xd3, 0x40,
-	0x18, 0xc7, 0x37, 0x86, 0x30, 0x1e, 0x26, 0xc2, 0xc1, 0xb0, 0x62, 0x08, 0x78, 0x31, 0x44, 0x12,
-	0x19, 0x01, 0xf1, 0x8d, 0x2f, 0x8c, 0x19, 0xe8, 0x82, 0x38, 0x98, 0x1d, 0xcc, 0x68, 0x4c, 0xcc,
-	0xd1, 0x1e, 0xdd, 0x85, 0xf6, 0x6e, 0xf6, 0xae, 0xd3, 0xbd, 0xf7, 0x95, 0x89, 0x5f, 0xc0, 0x4f,
-	0xe3, 0x47, 0x33, 0xbd, 0x76, 0x83, 0xad, 0x9d, 0x8b, 0xd9, 0x5e, 0xd1, 0xe7, 0x9e, 0xe7, 0x7e,
-	0xff, 0xe7, 0x9e, 0x6b, 0xff, 0x0c, 0xb6, 0x5b, 0xd7, 0xce, 0x6e, 0x93, 0x70, 0xdb, 0xa5, 0xfe,
-	0x8e, 0x4b, 0x02, 0x6e, 0x35, 0xa9, 0xbf, 0x63, 0x09, 0x6f, 0xd7, 0xf2, 0xec, 0xdd, 0xf6, 0x5e,
-	0xf8, 0xa7, 0xd4, 0xf2, 0x85, 0x12, 0xe8, 0xde, 0x75, 0x70, 0x49, 0xdb, 0xcc, 0x57, 0xa5, 0x70,
-	0xad, 0xbd, 0x87, 0x37, 0x20, 0xd7, 0xa8, 0x1e, 0x23, 0x03, 0x66, 0xdb, 0x1e, 0x7b, 0x2b, 0x05,
-	0x37, 0xb2, 0x9b, 0xd9, 0x27, 0x05, 0xb3, 0x1b, 0xe2, 0x9f, 0x59, 0x98, 0xa9, 0x57, 0xcb, 0x4c,
-	0x48, 0x84, 0xa1, 0xe0, 0x11, 0x1e, 0x5c, 0x11, 0x4b, 0x05, 0x3e, 0xf5, 0x75, 0xe5, 0x9c, 0xd9,
-	0xb7, 0x16, 0x82, 0x5a, 0xbe, 0xb0, 0x03, 0x4b, 0x19, 0x53, 0x3a, 0xdd, 0x0d, 0xb5, 0x04, 0xf5,
-	0x25, 0x13, 0xdc, 0xc8, 0x45, 0x99, 0x38, 0x44, 0x8b, 0x90, 0x93, 0xd7, 0x81, 0x31, 0xad, 0x57,
-	0xc3, 0x47, 0xb4, 0x0a, 0x33, 0x57, 0xc4, 0x63, 0x6e, 0xc7, 0xb8, 0xa3, 0x17, 0xe3, 0x08, 0xff,
-	0xce, 0x42, 0xb1, 0xc1, 0x7c, 0x15, 0x10, 0xb7, 0x4a, 0xac, 0x26, 0xe3, 0xf4, 0xac, 0xa5, 0x98,
-	0xe0, 0x12, 0x9d, 0xc0, 0x4a, 0x7f, 0x22, 0xea, 0x59, 0xf7, 0x38, 0xbf, 0x7f, 0xbf, 0x34, 0x70,
-	0xee, 0x52, 0x94, 0x36, 0x53, 0x37, 0xa1, 0x03, 0x28, 0x56, 0xa9, 0x57, 0x26, 0xae, 0x2b, 0x04,
-	0xaf, 0x2b, 0xa2, 0x64, 0x8d, 0xfa, 0x4c, 0xd8, 0xfa, 0x48, 0x77, 0xcd, 0xf4, 0x24, 0x6e, 0x03,
-	0x34, 0xaa, 0xc7, 0x26, 0xfd, 0x1a, 0x50, 0xa9, 0xd0, 0x16, 0xe4, 0xda, 0x1e, 0x8b, 0xf5, 0x57,
-	0x12, 0xfa, 0x61, 0x65, 0x58, 0x80, 0x5e, 0xc1, 0xac, 0x88, 0xce, 0xa0, 0xe9, 0xf3, 0xfb, 0x5b,
-	0xc9, 0xda, 0xb4, 0x13, 0x9b, 0xdd, 0x6d, 0xf8, 0x1c, 0x16, 0xab, 0xcc, 0xf1, 0x49, 0x18, 0xfd,
-	0xaf, 0xba, 0xd1, 0xaf, 0x5e, 0xb8, 0xa1, 0x2e, 0x40, 0xe1, 0xb5, 0xd7, 0x52, 0x9d, 0x98, 0x88,
-	0x5f, 0x42, 0xde, 0xa4, 0xb2, 0x25, 0xb8, 0xa4, 0xe1, 0x2e, 0x19, 0x58, 0x16, 0x95, 0xd1, 0x7c,
-	0xf3, 0x66, 0x37, 0x0c, 0x33, 0x1e, 0x95, 0x92, 0x38, 0xb4, 0x7b, 0xfd, 0x71, 0x88, 0xbf, 0xc0,
-	0xc2, 0x91, 0xf0, 0x08, 0xe3, 0x3d, 0xca, 0x73, 0xc8, 0xfb, 0xf1, 0x73, 0xdc, 0xe8, 0x83, 0x44,
-	0xa3, 0xdd, 0x62, 0xb3, 0x57, 0x1a, 0xbe, 0x1b, 0xb6, 0x06, 0xc5, 0x0a, 0x71, 0x84, 0x39, 0x2c,
-	0x47, 0x02, 0xfa, 0x4e, 0xc6, 0x55, 0xd9, 0x84, 0x79, 0xfb, 0x86, 0x16, 0x4b, 0xdd, 0x5e, 0xc2,
-	0xdf, 0x61, 0xa9, 0x12, 0x4e, 0xe6, 0x98, 0x5f, 0x89, 0x71, 0xd5, 0x9e, 0xc2, 0x92, 0x33, 0xc8,
-	0x8a, 0x35, 0x93, 0x09, 0xfc, 0x23, 0x0b, 0x45, 0x2d, 0x7d, 0x21, 0xa9, 0xff, 0x8e, 0x49, 0x35,
-	0xae, 0xfc, 0x01, 0x14, 0x9d, 0x34, 0x5e, 0xdc, 0x42, 0x7a, 0x12, 0xff, 0xca, 0x82, 0xa1, 0xdb,
-	0x78, 0xc3, 0x5c, 0x2a, 0x3b, 0x52, 0x51, 0x6f, 0xec, 0xb1, 0xbf, 0x00, 0xc3, 0x19, 0x82, 0x8c,
-	0x9b, 0x19, 0x9a, 0xdf, 0xff, 0x03, 0x90, 0x3b, 0xf4, 0x6c, 0x74, 0x0a, 0xa8, 0xde, 0xe1, 0x56,
-	0xff, 0x57, 0x83, 0x1e, 0xa6, 0x7e, 0x04, 0xd1, 0xcb, 0xbd, 0x36, 0xbc, 0x37, 0x9c, 0x41, 0x67,
-	0xb0, 0x5c, 0x23, 0x81, 0xa4, 0x13, 0x03, 0xbe, 0x87, 0xe2, 0x05, 0x6f, 0x4d, 0x14, 0x69, 0xc2,
-	0x6a, 0xbd, 0x19, 0x28, 0x5b, 0x7c, 0xe3, 0x13, 0x63, 0x9e, 0x02, 0x3a, 0x61, 0xae, 0x3b, 0x31,
-	0x5e, 0x0d, 0x56, 0x8e, 0xa8, 0x4b, 0xd5, 0xe4, 0x4e, 0xfd, 0x01, 0x8a, 0x91, 0xf3, 0x0d, 0x22,
-	0x1f, 0x25, 0x76, 0x0d, 0x3a, 0xe4, 0xc8, 0x2b, 0x0f, 0x5f, 0xa1, 0xde, 0xa6, 0x73, 0xe2, 0x3b,
-	0x54, 0x8d, 0xd1, 0xe9, 0x47, 0x58, 0x3f, 0x24, 0xdc, 0xa2, 0x03, 0xd3, 0xec, 0x09, 0x8c, 0x81,
-	0x6e, 0xc0, 0x5a, 0x9d, 0xaa, 0x7e, 0xae, 0xfe, 0x2c, 0xcf, 0x99, 0x37, 0xce, 0x70, 0xab, 0x30,
-	0x57, 0xa1, 0x2a, 0xb2, 0x54, 0xb4, 0x9e, 0xa8, 0xbc, 0xfd, 0xcf, 0x61, 0x6d, 0x23, 0x91, 0xee,
-	0xf7, 0x7a, 0x7d, 0x57, 0x0b, 0x3d, 0x9c, 0x36, 0xd0, 0x51, 0xcc, 0xc7, 0x43, 0x98, 0x7d, 0xf6,
-	0x8e, 0x33, 0xa8, 0x0e, 0x85, 0x0a, 0x55, 0x3d, 0x2b, 0x1e, 0x85, 0xc5, 0x89, 0x74, 0xc2, 0xc5,
-	0x35, 0x34, 0x5f, 0xa1, 0xda, 0xf2, 0x46, 0xf6, 0xb9, 0x95, 0x0e, 0x4c, 0xd8, 0x65, 0x06, 0x7d,
-	0xd6, 0x23, 0xb8, 0x65, 0x5d, 0xa3, 0xd0, 0xdb, 0xe9, 0xe8, 0x14, 0xf3, 0xc3, 0x19, 0x54, 0x86,
-	0xe9, 0x1a, 0xe3, 0xce, 0x28, 0xe6, 0xbf, 0xee, 0xbc, 0x3c, 0xfd, 0x69, 0xaa, 0xbd, 0x77, 0x39,
-	0xa3, 0x7f, 0x2b, 0x3e, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xa3, 0x1e, 0x96, 0x58, 0x0a,
-	0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x4f, 0x1b, 0x47,
+	0x10, 0xc7, 0x31, 0x10, 0x33, 0x10, 0x0a, 0x1b, 0x4c, 0xaf, 0xae, 0xd2, 0xd0, 0x53, 0x85, 0x12,
+	0xa9, 0x01, 0x41, 0xd3, 0x3e, 0xf4, 0xa1, 0xaa, 0x80, 0x04, 0xd1, 0xd4, 0x89, 0x7b, 0x06, 0xa2,
+	0xfe, 0x91, 0xaa, 0xe5, 0x6e, 0x6c, 0xaf, 0xb8, 0xdb, 0x75, 0x77, 0xf7, 0x1c, 0xdc, 0xe7, 0x3e,
+	0x55, 0xea, 0x6b, 0x1f, 0xfa, 0x01, 0xfa, 0x3d, 0xfa, 0xcd, 0xaa, 0xdb, 0x5b, 0x1f, 0xd8, 0x77,
+	0xc6, 0x22, 0xf6, 0x93, 0x6f, 0x76, 0x66, 0x7f, 0xbf, 0xdf, 0xce, 0xdc, 0xec, 0x8d, 0xe1, 0x69,
+	0xf7, 0xb2, 0xbd, 0xdb, 0xa1, 0x3c, 0x08, 0x51, 0x3e, 0x0b, 0x69, 0xcc, 0xfd, 0x0e, 0xca, 0x67,
+	0xbe, 0x88, 0x76, 0xfd, 0x28, 0xd8, 0xed, 0xed, 0x25, 0x3f, 0x3b, 0x5d, 0x29, 0xb4, 0x20, 0x1f,
+	0x5c, 0xc6, 0x17, 0xd8, 0x63, 0x52, 0xef, 0x24, 0x6b, 0xbd, 0x3d, 0xf7, 0x31, 0x94, 0xcf, 0xeb,
+	0x27, 0xc4, 0x81, 0xfb, 0xbd, 0x88, 0x7d, 0xa7, 0x04, 0x77, 0x4a, 0x5b, 0xa5, 0x27, 0x2b, 0xde,
+	0xc0, 0x74, 0xff, 0x2c, 0xc1, 0x62, 0xb3, 0x7e, 0xc0, 0x84, 0x22, 0x2e, 0xac, 0x44, 0x94, 0xc7,
+	0x2d, 0xea, 0xeb, 0x58, 0xa2, 0x34, 0x91, 0x4b, 0xde, 0xd0, 0x5a, 0x02, 0xd4, 0x95, 0x22, 0x88,
+	0x7d, 0xed, 0xdc, 0x33, 0xee, 0x81, 0x69, 0x28, 0x50, 0x2a, 0x26, 0xb8, 0x53, 0x4e, 0x3d, 0xd6,
+	0x24, 0x6b, 0x50, 0x56, 0x97, 0xb1, 0x33, 0x6f, 0x56, 0x93, 0x47, 0xb2, 0x09, 0x8b, 0x2d, 0x1a,
+	0xb1, 0xb0, 0xef, 0x2c, 0x98, 0x45, 0x6b, 0xb9, 0xff, 0x94, 0xa0, 0x7a, 0xce, 0xa4, 0x8e, 0x69,
+	0x58, 0xa7, 0x7e, 0x87, 0x71, 0x7c, 0xd3, 0xd5, 0x4c, 0x70, 0x45, 0x5e, 0xc1, 0xc6, 0xb0, 0x23,
+	0xd5, 0x6c, 0x34, 0x2e, 0xef, 0x7f, 0xb8, 0x33, 0x72, 0xee, 0x9d, 0xd4, 0xed, 0x15, 0x6e, 0x22,
+	0xcf, 0xa1, 0x5a, 0xc7, 0xe8, 0x80, 0x86, 0xa1, 0x10, 0xbc, 0xa9, 0xa9, 0x56, 0x0d, 0x94, 0x4c,
+	0x04, 0xe6, 0x48, 0x0f, 0xbc, 0x62, 0xa7, 0xdb, 0x03, 0x38, 0xaf, 0x9f, 0x78, 0xf8, 0x5b, 0x8c,
+	0x4a, 0x93, 0x6d, 0x28, 0xf7, 0x22, 0x66, 0xf9, 0x37, 0x72, 0xfc, 0x49, 0x64, 0x12, 0x40, 0xbe,
+	0x85, 0xfb, 0x22, 0x3d, 0x83, 0x41, 0x5f, 0xde, 0xdf, 0xce, 0xc7, 0x16, 0x9d, 0xd8, 0x1b, 0x6c,
+	0x73, 0x4f, 0x61, 0xad, 0xce, 0xda, 0x92, 0x26, 0xd6, 0x5d, 0xd9, 0x9d, 0x61, 0xf6, 0x95, 0x6b,
+	0xd4, 0x55, 0x58, 0x79, 0x11, 0x75, 0x75, 0xdf, 0x22, 0xba, 0xdf, 0x40, 0xc5, 0x43, 0xd5, 0x15,
+	0x5c, 0x61, 0xb2, 0x4b, 0xc5, 0xbe, 0x8f, 0x2a, 0xcd, 0x6f, 0xc5, 0x1b, 0x98, 0x89, 0x27, 0x42,
+	0xa5, 0x68, 0x1b, 0x07, 0xe5, 0xb7, 0xa6, 0xfb, 0x2b, 0xac, 0x1e, 0x89, 0x88, 0x32, 0x9e, 0xa1,
+	0x7c, 0x09, 0x15, 0x69, 0x9f, 0xad, 0xd0, 0x8f, 0x72, 0x42, 0x07, 0xc1, 0x5e, 0x16, 0x9a, 0xbc,
+	0x1b, 0x81, 0x01, 0xb2, 0x0c, 0xd6, 0x72, 0x39, 0x3c, 0x4c, 0x09, 0x4c, 0x4d, 0xa6, 0x65, 0xd9,
+	0x82, 0xe5, 0xe0, 0x1a, 0xcd, 0x52, 0xdd, 0x5c, 0x72, 0xaf, 0x60, 0xfd, 0x38, 0xc9, 0xcc, 0x09,
+	0x6f, 0x89, 0x69, 0xd9, 0x3e, 0x87, 0xf5, 0xf6, 0x28, 0x96, 0xe5, 0xcc, 0x3b, 0xdc, 0x3f, 0x4a,
+	0x50, 0x35, 0xd4, 0x67, 0x0a, 0xe5, 0xf7, 0x4c, 0xe9, 0x69, 0xe9, 0x9f, 0x43, 0xb5, 0x5d, 0x84,
+	0x67, 0x25, 0x14, 0x3b, 0xdd, 0xbf, 0x4a, 0xe0, 0x18, 0x19, 0x2f, 0x59, 0x88, 0xaa, 0xaf, 0x34,
+	0x46, 0x53, 0xa7, 0xfd, 0x6b, 0x70, 0xda, 0x63, 0x20, 0xad, 0x98, 0xb1, 0x7e, 0x57, 0xc0, 0x83,
+	0x97, 0x12, 0xf1, 0x77, 0xbc, 0x6b, 0x13, 0x7c, 0x05, 0x9b, 0x31, 0x6f, 0x99, 0xad, 0xa7, 0x2c,
+	0x42, 0x11, 0xeb, 0x26, 0xfa, 0x82, 0x07, 0x69, 0xd9, 0x17, 0xbc, 0x31, 0x5e, 0xf7, 0x2d, 0xac,
+	0xd7, 0x31, 0x12, 0xb2, 0x7f, 0x14, 0x47, 0xdd, 0xbb, 0x92, 0xd6, 0xa0, 0x12, 0xc4, 0x51, 0xb7,
+	0x41, 0x75, 0xc7, 0x9e, 0x2c, 0xb3, 0xdd, 0x7f, 0x4b, 0xb0, 0x66, 0x32, 0xfb, 0xe2, 0x0a, 0xfd,
+	0xf7, 0x68, 0x69, 0x5f, 0x44, 0x11, 0xe5, 0xc1, 0xa0, 0x05, 0xad, 0x49, 0x08, 0xcc, 0x53, 0xd9,
+	0x56, 0x4e, 0x79, 0xab, 0xfc, 0x64, 0xc9, 0x33, 0xcf, 0x64, 0x03, 0x16, 0x94, 0x0e, 0x18, 0xb7,
+	0xb7, 0x6f, 0x6a, 0x90, 0x6d, 0x58, 0xd5, 0xc3, 0x99, 0x58, 0x30, 0x99, 0x18, 0x59, 0x75, 0xff,
+	0x2e, 0xc1, 0xfa, 0x0d, 0xa1, 0xd3, 0xd5, 0xbe, 0x06, 0x15, 0xbc, 0x62, 0xfa, 0x50, 0x04, 0x68,
+	0x13, 0x9f, 0xd9, 0x49, 0xd3, 0x2b, 0x1d, 0x88, 0x58, 0xdb, 0x6f, 0x87, 0xb5, 0xec, 0x3a, 0x4a,
+	0x69, 0xf5, 0x5b, 0x6b, 0xff, 0xbf, 0x55, 0x28, 0x1f, 0x46, 0x01, 0x79, 0x0d, 0xa4, 0xd9, 0xe7,
+	0xfe, 0xf0, 0x0d, 0x4a, 0x3e, 0x2e, 0xcc, 0x5e, 0x9a, 0xe7, 0xda, 0x78, 0xad, 0xee, 0x1c, 0x79,
+	0x03, 0x0f, 0x1b, 0x34, 0x56, 0x38, 0x33, 0xc0, 0x1f, 0xa0, 0x7a, 0xc6, 0xbb, 0x33, 0x85, 0x6c,
+	0xc2, 0x46, 0xda, 0x07, 0x23, 0x88, 0x9f, 0xe4, 0x36, 0x0d, 0xb5, 0xcb, 0xed, 0xa0, 0x1e, 0x6c,
+	0x9e, 0xf1, 0x56, 0x11, 0xec, 0xfb, 0x0b, 0xfd, 0x19, 0x9c, 0x61, 0xac, 0xeb, 0x6e, 0x22, 0x6e,
+	0x6e, 0x63, 0xae, 0xd5, 0x26, 0x0a, 0x6e, 0x76, 0x62, 0x1d, 0x88, 0x77, 0x7c, 0x66, 0x82, 0x1b,
+	0xb0, 0xe1, 0xe1, 0x85, 0x10, 0x7a, 0x66, 0x88, 0xaf, 0x81, 0xbc, 0x62, 0x61, 0x38, 0x4b, 0x85,
+	0x47, 0x18, 0xa2, 0x9e, 0x5d, 0x91, 0xde, 0x42, 0x35, 0x9d, 0x2e, 0x46, 0x21, 0x3f, 0xcd, 0x57,
+	0x68, 0x64, 0x0a, 0x99, 0xd8, 0x4a, 0x49, 0x6b, 0x66, 0x9b, 0x4e, 0xa9, 0x6c, 0xa3, 0x9e, 0x42,
+	0xe9, 0x8f, 0xf0, 0xe8, 0x90, 0x72, 0x1f, 0x47, 0xb2, 0x99, 0x11, 0x4c, 0x01, 0x7d, 0x0e, 0xb5,
+	0x26, 0x8e, 0x54, 0xdd, 0xdc, 0x7b, 0xc9, 0x17, 0x61, 0xba, 0xee, 0x6f, 0xa2, 0x3e, 0xe1, 0x1a,
+	0x65, 0x8b, 0xfa, 0x78, 0x40, 0x79, 0xf0, 0x8e, 0x05, 0xba, 0x33, 0x05, 0x64, 0x1d, 0x96, 0x8e,
+	0x51, 0xa7, 0x93, 0x10, 0x79, 0x94, 0x8b, 0xbc, 0x39, 0xd3, 0xd5, 0x1e, 0xe7, 0xdc, 0xc3, 0x23,
+	0x9a, 0x29, 0xff, 0x6a, 0x06, 0x67, 0xe6, 0x9e, 0x49, 0x98, 0x9f, 0x8d, 0xc1, 0x1c, 0x9a, 0xca,
+	0xcc, 0x2d, 0xb5, 0x72, 0x8c, 0x3a, 0x9b, 0xa0, 0x26, 0xc1, 0xe6, 0xef, 0x83, 0xdc, 0xf0, 0x65,
+	0x40, 0x2b, 0xc7, 0x68, 0x26, 0x95, 0x89, 0x3a, 0xb7, 0x8b, 0x01, 0x73, 0x53, 0xce, 0x1c, 0xf9,
+	0xc5, 0xa4, 0xe0, 0xc6, 0xc4, 0x31, 0x09, 0xfa, 0x69, 0x31, 0x74, 0xd1, 0xcc, 0x32, 0x47, 0x4e,
+	0x61, 0x29, 0xfb, 0x82, 0x16, 0xf4, 0xd4, 0xe8, 0x18, 0x50, 0x73, 0x6f, 0x0b, 0xc9, 0x50, 0x0f,
+	0x60, 0xbe, 0xc1, 0x78, 0x7b, 0x92, 0xd2, 0xdb, 0xde, 0xa4, 0x83, 0xf9, 0x9f, 0xee, 0xf5, 0xf6,
+	0x2e, 0x16, 0xcd, 0x1f, 0xc7, 0x2f, 0xfe, 0x1f, 0x00, 0x10, 0x86, 0x2b, 0x36, 0x65, 0x0e, 0x00,
+	0x00,
 }
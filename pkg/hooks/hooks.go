@@ -21,6 +21,7 @@ package hooks
 
 import (
 	"encoding/json"
+	"path/filepath"
 
 	k8sv1 "k8s.io/api/core/v1"
 
@@ -30,6 +31,17 @@ import (
 const HookSidecarListAnnotationName = "hooks.kubevirt.io/hookSidecars"
 const HookSocketsSharedDirectory = "/var/run/kubevirt-hooks"
 
+// NetworkBindingSocketsSharedDirectory is shared between the compute container and the network binding plugin sidecars
+const NetworkBindingSocketsSharedDirectory = "/var/run/kubevirt-network-bindings"
+
+// NetworkBindingSocketEnv is set on the network binding plugin sidecars to the socket they have to serve on
+const NetworkBindingSocketEnv = "KUBEVIRT_NETWORK_BINDING_SOCKET"
+
+// NetworkBindingSocketPath returns the socket the sidecar of a network binding plugin serves on
+func NetworkBindingSocketPath(bindingName string) string {
+	return filepath.Join(NetworkBindingSocketsSharedDirectory, bindingName+".sock")
+}
+
 type HookSidecarList []HookSidecar
 
 type HookSidecar struct {
@@ -69,6 +69,11 @@ func GetFileSystemDiskTargetPathFromHostView(virtlauncherPodUID types.UID, volum
 	return diskFile, err
 }
 
+// GetVolumeMountDir returns the directory a hotplugged file system volume is mounted to, as seen from the target pod (virt-launcher).
+func GetVolumeMountDir(volumeName string) string {
+	return filepath.Join(mountBaseDir, volumeName)
+}
+
 // SetLocalDirectory sets the base directory where disk images will be mounted when hotplugged. File system volumes will be in
 // a directory under this, that contains the volume name. block volumes will be in this directory as a block device.
 func SetLocalDirectory(dir string) error {
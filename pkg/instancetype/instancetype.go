@@ -0,0 +1,500 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+type Methods interface {
+	FindInstancetypeSpec(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error)
+	FindPreferenceSpec(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreferenceSpec, error)
+	StoreControllerRevisions(vm *virtv1.VirtualMachine) error
+	ApplyToVmi(field *k8sfield.Path, instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts
+}
+
+type Conflicts []*k8sfield.Path
+
+func (c Conflicts) String() string {
+	pathStrings := make([]string, 0, len(c))
+	for _, path := range c {
+		pathStrings = append(pathStrings, path.String())
+	}
+	return strings.Join(pathStrings, ", ")
+}
+
+type methods struct {
+	clientset kubecli.KubevirtClient
+}
+
+var _ Methods = &methods{}
+
+func NewMethods(clientset kubecli.KubevirtClient) Methods {
+	return &methods{
+		clientset: clientset,
+	}
+}
+
+// NeedsControllerRevisions returns true if the VirtualMachine references an instancetype or preference
+// that has not yet been captured in a ControllerRevision.
+func NeedsControllerRevisions(vm *virtv1.VirtualMachine) bool {
+	return (vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName == "") ||
+		(vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName == "")
+}
+
+func GetRevisionName(vmName, resourceName string, resourceUID types.UID, resourceGeneration int64) string {
+	return fmt.Sprintf("%s-%s-%s-%d", vmName, resourceName, resourceUID, resourceGeneration)
+}
+
+func (m *methods) StoreControllerRevisions(vm *virtv1.VirtualMachine) error {
+	var patches []string
+
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName == "" {
+		revision, err := m.createInstancetypeRevision(vm)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Instancetype.RevisionName = revision.Name
+		patches = append(patches, fmt.Sprintf(`{ "op": "add", "path": "/spec/instancetype/revisionName", "value": "%s" }`, revision.Name))
+	}
+
+	if vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName == "" {
+		revision, err := m.createPreferenceRevision(vm)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Preference.RevisionName = revision.Name
+		patches = append(patches, fmt.Sprintf(`{ "op": "add", "path": "/spec/preference/revisionName", "value": "%s" }`, revision.Name))
+	}
+
+	if len(patches) == 0 {
+		return nil
+	}
+
+	patch := fmt.Sprintf("[ %s ]", strings.Join(patches, ", "))
+	if _, err := m.clientset.VirtualMachine(vm.Namespace).Patch(vm.Name, types.JSONPatchType, []byte(patch)); err != nil {
+		return fmt.Errorf("failed to update VirtualMachine %s/%s with ControllerRevision names: %v", vm.Namespace, vm.Name, err)
+	}
+
+	return nil
+}
+
+func (m *methods) createInstancetypeRevision(vm *virtv1.VirtualMachine) (*appsv1.ControllerRevision, error) {
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case instancetypev1alpha1.SingularResourceName, instancetypev1alpha1.PluralResourceName:
+		instancetype, err := m.findInstancetype(vm)
+		if err != nil {
+			return nil, err
+		}
+		instancetype.Kind = instancetypev1alpha1.VirtualMachineInstancetypeKind
+		instancetype.APIVersion = instancetypev1alpha1.SchemeGroupVersion.String()
+		return m.createRevision(vm, instancetype, &instancetype.ObjectMeta)
+
+	case instancetypev1alpha1.ClusterSingularResourceName, instancetypev1alpha1.ClusterPluralResourceName, "":
+		clusterInstancetype, err := m.findClusterInstancetype(vm)
+		if err != nil {
+			return nil, err
+		}
+		clusterInstancetype.Kind = instancetypev1alpha1.VirtualMachineClusterInstancetypeKind
+		clusterInstancetype.APIVersion = instancetypev1alpha1.SchemeGroupVersion.String()
+		return m.createRevision(vm, clusterInstancetype, &clusterInstancetype.ObjectMeta)
+
+	default:
+		return nil, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+	}
+}
+
+func (m *methods) createPreferenceRevision(vm *virtv1.VirtualMachine) (*appsv1.ControllerRevision, error) {
+	switch strings.ToLower(vm.Spec.Preference.Kind) {
+	case instancetypev1alpha1.SingularPreferenceResourceName, instancetypev1alpha1.PluralPreferenceResourceName:
+		preference, err := m.findPreference(vm)
+		if err != nil {
+			return nil, err
+		}
+		preference.Kind = instancetypev1alpha1.VirtualMachinePreferenceKind
+		preference.APIVersion = instancetypev1alpha1.SchemeGroupVersion.String()
+		return m.createRevision(vm, preference, &preference.ObjectMeta)
+
+	case instancetypev1alpha1.ClusterSingularPreferenceResourceName, instancetypev1alpha1.ClusterPluralPreferenceResourceName, "":
+		clusterPreference, err := m.findClusterPreference(vm)
+		if err != nil {
+			return nil, err
+		}
+		clusterPreference.Kind = instancetypev1alpha1.VirtualMachineClusterPreferenceKind
+		clusterPreference.APIVersion = instancetypev1alpha1.SchemeGroupVersion.String()
+		return m.createRevision(vm, clusterPreference, &clusterPreference.ObjectMeta)
+
+	default:
+		return nil, fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
+	}
+}
+
+func (m *methods) createRevision(vm *virtv1.VirtualMachine, obj runtime.Object, objMeta *metav1.ObjectMeta) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetRevisionName(vm.Name, objMeta.Name, objMeta.UID, objMeta.Generation),
+			Namespace: vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Data: runtime.RawExtension{Raw: data},
+	}
+
+	created, err := m.clientset.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create ControllerRevision %s: %v", revision.Name, err)
+		}
+		// The revision name is derived from the UID and generation of the captured object,
+		// an existing revision therefore already contains the expected data.
+		return revision, nil
+	}
+
+	return created, nil
+}
+
+func (m *methods) getControllerRevisionData(namespace, name string) ([]byte, error) {
+	revision, err := m.clientset.AppsV1().ControllerRevisions(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(revision.Data.Raw) == 0 {
+		return nil, fmt.Errorf("ControllerRevision %s/%s does not contain any data", namespace, name)
+	}
+	return revision.Data.Raw, nil
+}
+
+func (m *methods) FindInstancetypeSpec(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error) {
+	if vm.Spec.Instancetype == nil {
+		return nil, nil
+	}
+
+	if vm.Spec.Instancetype.RevisionName != "" {
+		data, err := m.getControllerRevisionData(vm.Namespace, vm.Spec.Instancetype.RevisionName)
+		if err != nil {
+			return nil, err
+		}
+		// VirtualMachineInstancetype and VirtualMachineClusterInstancetype share the same spec
+		instancetype := &instancetypev1alpha1.VirtualMachineInstancetype{}
+		if err := json.Unmarshal(data, instancetype); err != nil {
+			return nil, fmt.Errorf("failed to decode instancetype from ControllerRevision %s: %v", vm.Spec.Instancetype.RevisionName, err)
+		}
+		return &instancetype.Spec, nil
+	}
+
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case instancetypev1alpha1.SingularResourceName, instancetypev1alpha1.PluralResourceName:
+		instancetype, err := m.findInstancetype(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &instancetype.Spec, nil
+
+	case instancetypev1alpha1.ClusterSingularResourceName, instancetypev1alpha1.ClusterPluralResourceName, "":
+		clusterInstancetype, err := m.findClusterInstancetype(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &clusterInstancetype.Spec, nil
+
+	default:
+		return nil, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+	}
+}
+
+func (m *methods) findInstancetype(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetype, error) {
+	return m.clientset.VirtualMachineInstancetype(vm.Namespace).Get(context.Background(), vm.Spec.Instancetype.Name, metav1.GetOptions{})
+}
+
+func (m *methods) findClusterInstancetype(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineClusterInstancetype, error) {
+	return m.clientset.VirtualMachineClusterInstancetype().Get(context.Background(), vm.Spec.Instancetype.Name, metav1.GetOptions{})
+}
+
+func (m *methods) FindPreferenceSpec(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreferenceSpec, error) {
+	if vm.Spec.Preference == nil {
+		return nil, nil
+	}
+
+	if vm.Spec.Preference.RevisionName != "" {
+		data, err := m.getControllerRevisionData(vm.Namespace, vm.Spec.Preference.RevisionName)
+		if err != nil {
+			return nil, err
+		}
+		// VirtualMachinePreference and VirtualMachineClusterPreference share the same spec
+		preference := &instancetypev1alpha1.VirtualMachinePreference{}
+		if err := json.Unmarshal(data, preference); err != nil {
+			return nil, fmt.Errorf("failed to decode preference from ControllerRevision %s: %v", vm.Spec.Preference.RevisionName, err)
+		}
+		return &preference.Spec, nil
+	}
+
+	switch strings.ToLower(vm.Spec.Preference.Kind) {
+	case instancetypev1alpha1.SingularPreferenceResourceName, instancetypev1alpha1.PluralPreferenceResourceName:
+		preference, err := m.findPreference(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &preference.Spec, nil
+
+	case instancetypev1alpha1.ClusterSingularPreferenceResourceName, instancetypev1alpha1.ClusterPluralPreferenceResourceName, "":
+		clusterPreference, err := m.findClusterPreference(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &clusterPreference.Spec, nil
+
+	default:
+		return nil, fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
+	}
+}
+
+func (m *methods) findPreference(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreference, error) {
+	return m.clientset.VirtualMachinePreference(vm.Namespace).Get(context.Background(), vm.Spec.Preference.Name, metav1.GetOptions{})
+}
+
+func (m *methods) findClusterPreference(vm *virtv1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineClusterPreference, error) {
+	return m.clientset.VirtualMachineClusterPreference().Get(context.Background(), vm.Spec.Preference.Name, metav1.GetOptions{})
+}
+
+func (m *methods) ApplyToVmi(field *k8sfield.Path, instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	var conflicts Conflicts
+
+	if instancetypeSpec != nil {
+		conflicts = append(conflicts, applyCPU(field, instancetypeSpec, preferenceSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyMemory(field, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyIOThreadPolicy(field, instancetypeSpec, vmiSpec)...)
+	}
+
+	if preferenceSpec != nil {
+		applyDevicePreferences(preferenceSpec, vmiSpec)
+		applyFirmwarePreferences(preferenceSpec, vmiSpec)
+		applyMachinePreferences(preferenceSpec, vmiSpec)
+	}
+
+	return conflicts
+}
+
+func applyCPU(field *k8sfield.Path, instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if vmiSpec.Domain.CPU != nil {
+		return Conflicts{field.Child("domain", "cpu")}
+	}
+
+	if _, hasCPURequests := vmiSpec.Domain.Resources.Requests[k8sv1.ResourceCPU]; hasCPURequests {
+		return Conflicts{field.Child("domain", "resources", "requests", string(k8sv1.ResourceCPU))}
+	}
+
+	if _, hasCPULimits := vmiSpec.Domain.Resources.Limits[k8sv1.ResourceCPU]; hasCPULimits {
+		return Conflicts{field.Child("domain", "resources", "limits", string(k8sv1.ResourceCPU))}
+	}
+
+	vmiSpec.Domain.CPU = &virtv1.CPU{
+		Sockets:               uint32(1),
+		Cores:                 uint32(1),
+		Threads:               uint32(1),
+		Model:                 instancetypeSpec.CPU.Model,
+		DedicatedCPUPlacement: instancetypeSpec.CPU.DedicatedCPUPlacement,
+		IsolateEmulatorThread: instancetypeSpec.CPU.IsolateEmulatorThread,
+	}
+
+	switch getPreferredTopology(preferenceSpec) {
+	case instancetypev1alpha1.PreferCores:
+		vmiSpec.Domain.CPU.Cores = instancetypeSpec.CPU.Guest
+	case instancetypev1alpha1.PreferThreads:
+		vmiSpec.Domain.CPU.Threads = instancetypeSpec.CPU.Guest
+	default:
+		vmiSpec.Domain.CPU.Sockets = instancetypeSpec.CPU.Guest
+	}
+
+	return nil
+}
+
+func getPreferredTopology(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec) instancetypev1alpha1.PreferredCPUTopology {
+	if preferenceSpec == nil || preferenceSpec.CPU == nil || preferenceSpec.CPU.PreferredCPUTopology == "" {
+		return instancetypev1alpha1.PreferSockets
+	}
+	return preferenceSpec.CPU.PreferredCPUTopology
+}
+
+func applyMemory(field *k8sfield.Path, instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if vmiSpec.Domain.Memory != nil {
+		return Conflicts{field.Child("domain", "memory")}
+	}
+
+	if _, hasMemoryRequests := vmiSpec.Domain.Resources.Requests[k8sv1.ResourceMemory]; hasMemoryRequests {
+		return Conflicts{field.Child("domain", "resources", "requests", string(k8sv1.ResourceMemory))}
+	}
+
+	if _, hasMemoryLimits := vmiSpec.Domain.Resources.Limits[k8sv1.ResourceMemory]; hasMemoryLimits {
+		return Conflicts{field.Child("domain", "resources", "limits", string(k8sv1.ResourceMemory))}
+	}
+
+	instancetypeMemoryGuest := instancetypeSpec.Memory.Guest.DeepCopy()
+	vmiSpec.Domain.Memory = &virtv1.Memory{
+		Guest: &instancetypeMemoryGuest,
+	}
+
+	if instancetypeSpec.Memory.Hugepages != nil {
+		vmiSpec.Domain.Memory.Hugepages = instancetypeSpec.Memory.Hugepages.DeepCopy()
+	}
+
+	// Explicitly request the guest memory so that validations depending on
+	// memory requests (dedicated CPUs, hugepages) see the expanded value
+	if vmiSpec.Domain.Resources.Requests == nil {
+		vmiSpec.Domain.Resources.Requests = k8sv1.ResourceList{}
+	}
+	vmiSpec.Domain.Resources.Requests[k8sv1.ResourceMemory] = instancetypeSpec.Memory.Guest.DeepCopy()
+
+	return nil
+}
+
+func applyIOThreadPolicy(field *k8sfield.Path, instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if instancetypeSpec.IOThreadsPolicy == nil {
+		return nil
+	}
+
+	if vmiSpec.Domain.IOThreadsPolicy != nil {
+		return Conflicts{field.Child("domain", "ioThreadsPolicy")}
+	}
+
+	instancetypeIOThreadPolicy := *instancetypeSpec.IOThreadsPolicy
+	vmiSpec.Domain.IOThreadsPolicy = &instancetypeIOThreadPolicy
+
+	return nil
+}
+
+func applyDevicePreferences(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Devices == nil {
+		return
+	}
+
+	if preferenceSpec.Devices.PreferredAutoattachGraphicsDevice != nil && vmiSpec.Domain.Devices.AutoattachGraphicsDevice == nil {
+		vmiSpec.Domain.Devices.AutoattachGraphicsDevice = boolPtr(*preferenceSpec.Devices.PreferredAutoattachGraphicsDevice)
+	}
+
+	if preferenceSpec.Devices.PreferredAutoattachSerialConsole != nil && vmiSpec.Domain.Devices.AutoattachSerialConsole == nil {
+		vmiSpec.Domain.Devices.AutoattachSerialConsole = boolPtr(*preferenceSpec.Devices.PreferredAutoattachSerialConsole)
+	}
+
+	applyDiskPreferences(preferenceSpec, vmiSpec)
+	applyInterfacePreferences(preferenceSpec, vmiSpec)
+}
+
+func applyDiskPreferences(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	for diskIndex := range vmiSpec.Domain.Devices.Disks {
+		vmiDisk := &vmiSpec.Domain.Devices.Disks[diskIndex]
+		// If we don't have a target device defined default to a DiskTarget so we can apply preferences
+		if vmiDisk.DiskDevice.Disk == nil && vmiDisk.DiskDevice.CDRom == nil && vmiDisk.DiskDevice.LUN == nil && vmiDisk.DiskDevice.Floppy == nil {
+			vmiDisk.DiskDevice.Disk = &virtv1.DiskTarget{}
+		}
+
+		if vmiDisk.DiskDevice.Disk != nil && vmiDisk.DiskDevice.Disk.Bus == "" && preferenceSpec.Devices.PreferredDiskBus != "" {
+			vmiDisk.DiskDevice.Disk.Bus = preferenceSpec.Devices.PreferredDiskBus
+		}
+
+		if vmiDisk.DiskDevice.CDRom != nil && vmiDisk.DiskDevice.CDRom.Bus == "" && preferenceSpec.Devices.PreferredCdromBus != "" {
+			vmiDisk.DiskDevice.CDRom.Bus = preferenceSpec.Devices.PreferredCdromBus
+		}
+
+		if vmiDisk.DiskDevice.LUN != nil && vmiDisk.DiskDevice.LUN.Bus == "" && preferenceSpec.Devices.PreferredLunBus != "" {
+			vmiDisk.DiskDevice.LUN.Bus = preferenceSpec.Devices.PreferredLunBus
+		}
+	}
+}
+
+func applyInterfacePreferences(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Devices.PreferredInterfaceModel == "" {
+		return
+	}
+
+	for ifaceIndex := range vmiSpec.Domain.Devices.Interfaces {
+		vmiIface := &vmiSpec.Domain.Devices.Interfaces[ifaceIndex]
+		if vmiIface.Model == "" {
+			vmiIface.Model = preferenceSpec.Devices.PreferredInterfaceModel
+		}
+	}
+}
+
+func applyFirmwarePreferences(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Firmware == nil {
+		return
+	}
+
+	if vmiSpec.Domain.Firmware != nil && vmiSpec.Domain.Firmware.Bootloader != nil {
+		return
+	}
+
+	var bootloader *virtv1.Bootloader
+	if preferenceSpec.Firmware.PreferredUseBios != nil && *preferenceSpec.Firmware.PreferredUseBios {
+		bootloader = &virtv1.Bootloader{
+			BIOS: &virtv1.BIOS{},
+		}
+	} else if preferenceSpec.Firmware.PreferredUseEfi != nil && *preferenceSpec.Firmware.PreferredUseEfi {
+		bootloader = &virtv1.Bootloader{
+			EFI: &virtv1.EFI{},
+		}
+		if preferenceSpec.Firmware.PreferredUseSecureBoot != nil {
+			bootloader.EFI.SecureBoot = boolPtr(*preferenceSpec.Firmware.PreferredUseSecureBoot)
+		}
+	}
+
+	if bootloader == nil {
+		return
+	}
+
+	if vmiSpec.Domain.Firmware == nil {
+		vmiSpec.Domain.Firmware = &virtv1.Firmware{}
+	}
+	vmiSpec.Domain.Firmware.Bootloader = bootloader
+}
+
+func applyMachinePreferences(preferenceSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Machine == nil {
+		return
+	}
+
+	if vmiSpec.Domain.Machine.Type == "" {
+		vmiSpec.Domain.Machine.Type = preferenceSpec.Machine.PreferredMachineType
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,464 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	"kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Instancetype and Preferences", func() {
+
+	var (
+		ctrl                *gomock.Controller
+		virtClient          *kubecli.MockKubevirtClient
+		vmInterface         *kubecli.MockVirtualMachineInterface
+		kubevirtFakeClient  *fake.Clientset
+		k8sClient           *k8sfake.Clientset
+		instancetypeMethods Methods
+		vm                  *v1.VirtualMachine
+		field               = k8sfield.NewPath("spec", "template", "spec")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubevirtFakeClient = fake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+
+		virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstancetype(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineInstancetypes(namespace)
+		}).AnyTimes()
+		virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		virtClient.EXPECT().VirtualMachinePreference(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachinePreferences(namespace)
+		}).AnyTimes()
+		virtClient.EXPECT().VirtualMachineClusterPreference().Return(kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineClusterPreferences()).AnyTimes()
+
+		instancetypeMethods = NewMethods(virtClient)
+
+		vm = &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: k8sv1.NamespaceDefault,
+				UID:       types.UID("testvm-uid"),
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("Find Instancetype Spec", func() {
+
+		It("returns nil when no instancetype is specified", func() {
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec).To(BeNil())
+		})
+
+		It("returns error when invalid Instancetype Kind is specified", func() {
+			vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+				Name: "foo",
+				Kind: "bar",
+			}
+			_, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("got unexpected kind in InstancetypeMatcher"))
+		})
+
+		Context("Using global ClusterInstancetype", func() {
+			var clusterInstancetype *instancetypev1alpha1.VirtualMachineClusterInstancetype
+
+			BeforeEach(func() {
+				clusterInstancetype = &instancetypev1alpha1.VirtualMachineClusterInstancetype{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-cluster-instancetype",
+						UID:        types.UID("cluster-instancetype-uid"),
+						Generation: 1,
+					},
+					Spec: instancetypev1alpha1.VirtualMachineInstancetypeSpec{
+						CPU: instancetypev1alpha1.CPUInstancetype{
+							Guest: uint32(2),
+						},
+						Memory: instancetypev1alpha1.MemoryInstancetype{
+							Guest: resource.MustParse("128M"),
+						},
+					},
+				}
+				_, err := kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes().Create(context.Background(), clusterInstancetype, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					Name: clusterInstancetype.Name,
+				}
+			})
+
+			It("returns expected instancetype when Kind is omitted", func() {
+				spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*spec).To(Equal(clusterInstancetype.Spec))
+			})
+
+			It("returns expected instancetype when Kind is specified", func() {
+				vm.Spec.Instancetype.Kind = instancetypev1alpha1.ClusterSingularResourceName
+				spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*spec).To(Equal(clusterInstancetype.Spec))
+			})
+
+			It("fails when instancetype does not exist", func() {
+				vm.Spec.Instancetype.Name = "non-existing-instancetype"
+				_, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("stores a ControllerRevision and returns its content once stored", func() {
+				revisionName := GetRevisionName(vm.Name, clusterInstancetype.Name, clusterInstancetype.UID, clusterInstancetype.Generation)
+				vmInterface.EXPECT().Patch(vm.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, pt types.PatchType, data []byte, subresources ...string) (*v1.VirtualMachine, error) {
+					var patch []map[string]interface{}
+					Expect(json.Unmarshal(data, &patch)).To(Succeed())
+					Expect(patch).To(HaveLen(1))
+					Expect(patch[0]["path"]).To(Equal("/spec/instancetype/revisionName"))
+					Expect(patch[0]["value"]).To(Equal(revisionName))
+					return vm, nil
+				})
+
+				Expect(NeedsControllerRevisions(vm)).To(BeTrue())
+				Expect(instancetypeMethods.StoreControllerRevisions(vm)).To(Succeed())
+				Expect(vm.Spec.Instancetype.RevisionName).To(Equal(revisionName))
+				Expect(NeedsControllerRevisions(vm)).To(BeFalse())
+
+				revision, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), revisionName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metav1.IsControlledBy(revision, vm)).To(BeTrue())
+
+				// Changes to the original instancetype are not picked up once a revision is stored
+				updated := clusterInstancetype.DeepCopy()
+				updated.Spec.CPU.Guest = uint32(4)
+				_, err = kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes().Update(context.Background(), updated, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*spec).To(Equal(clusterInstancetype.Spec))
+			})
+		})
+
+		Context("Using namespaced Instancetype", func() {
+			var instancetype *instancetypev1alpha1.VirtualMachineInstancetype
+
+			BeforeEach(func() {
+				instancetype = &instancetypev1alpha1.VirtualMachineInstancetype{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-instancetype",
+						Namespace: vm.Namespace,
+					},
+					Spec: instancetypev1alpha1.VirtualMachineInstancetypeSpec{
+						CPU: instancetypev1alpha1.CPUInstancetype{
+							Guest: uint32(2),
+						},
+						Memory: instancetypev1alpha1.MemoryInstancetype{
+							Guest: resource.MustParse("128M"),
+						},
+					},
+				}
+				_, err := kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineInstancetypes(vm.Namespace).Create(context.Background(), instancetype, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					Name: instancetype.Name,
+					Kind: instancetypev1alpha1.SingularResourceName,
+				}
+			})
+
+			It("returns expected instancetype", func() {
+				spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*spec).To(Equal(instancetype.Spec))
+			})
+
+			It("fails when instancetype does not exist", func() {
+				vm.Spec.Instancetype.Name = "non-existing-instancetype"
+				_, err := instancetypeMethods.FindInstancetypeSpec(vm)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Find Preference Spec", func() {
+
+		It("returns nil when no preference is specified", func() {
+			preference, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preference).To(BeNil())
+		})
+
+		It("returns error when invalid Preference Kind is specified", func() {
+			vm.Spec.Preference = &v1.PreferenceMatcher{
+				Name: "foo",
+				Kind: "bar",
+			}
+			_, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("got unexpected kind in PreferenceMatcher"))
+		})
+
+		It("returns expected cluster preference when Kind is omitted", func() {
+			clusterPreference := &instancetypev1alpha1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster-preference",
+				},
+				Spec: instancetypev1alpha1.VirtualMachinePreferenceSpec{
+					CPU: &instancetypev1alpha1.CPUPreferences{
+						PreferredCPUTopology: instancetypev1alpha1.PreferCores,
+					},
+				},
+			}
+			_, err := kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachineClusterPreferences().Create(context.Background(), clusterPreference, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Spec.Preference = &v1.PreferenceMatcher{
+				Name: clusterPreference.Name,
+			}
+			preferenceSpec, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*preferenceSpec).To(Equal(clusterPreference.Spec))
+		})
+
+		It("returns expected namespaced preference", func() {
+			preference := &instancetypev1alpha1.VirtualMachinePreference{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-preference",
+					Namespace: vm.Namespace,
+				},
+				Spec: instancetypev1alpha1.VirtualMachinePreferenceSpec{
+					Machine: &instancetypev1alpha1.MachinePreferences{
+						PreferredMachineType: "q35",
+					},
+				},
+			}
+			_, err := kubevirtFakeClient.InstancetypeV1alpha1().VirtualMachinePreferences(vm.Namespace).Create(context.Background(), preference, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Spec.Preference = &v1.PreferenceMatcher{
+				Name: preference.Name,
+				Kind: instancetypev1alpha1.SingularPreferenceResourceName,
+			}
+			preferenceSpec, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*preferenceSpec).To(Equal(preference.Spec))
+		})
+	})
+
+	Context("Apply", func() {
+
+		var (
+			instancetypeSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec
+			preferenceSpec   *instancetypev1alpha1.VirtualMachinePreferenceSpec
+		)
+
+		BeforeEach(func() {
+			instancetypeSpec = &instancetypev1alpha1.VirtualMachineInstancetypeSpec{
+				CPU: instancetypev1alpha1.CPUInstancetype{
+					Guest:                 uint32(2),
+					Model:                 "host-passthrough",
+					DedicatedCPUPlacement: true,
+					IsolateEmulatorThread: true,
+				},
+				Memory: instancetypev1alpha1.MemoryInstancetype{
+					Guest: resource.MustParse("512M"),
+					Hugepages: &v1.Hugepages{
+						PageSize: "1Gi",
+					},
+				},
+			}
+			preferenceSpec = nil
+		})
+
+		It("should apply nothing when instancetype and preference are nil", func() {
+			expected := vm.Spec.Template.Spec.DeepCopy()
+			conflicts := instancetypeMethods.ApplyToVmi(field, nil, nil, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec).To(Equal(*expected))
+		})
+
+		It("should apply CPU and Memory of the instancetype", func() {
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(BeEmpty())
+
+			cpu := vm.Spec.Template.Spec.Domain.CPU
+			Expect(cpu.Sockets).To(Equal(instancetypeSpec.CPU.Guest))
+			Expect(cpu.Cores).To(Equal(uint32(1)))
+			Expect(cpu.Threads).To(Equal(uint32(1)))
+			Expect(cpu.Model).To(Equal(instancetypeSpec.CPU.Model))
+			Expect(cpu.DedicatedCPUPlacement).To(BeTrue())
+			Expect(cpu.IsolateEmulatorThread).To(BeTrue())
+
+			memory := vm.Spec.Template.Spec.Domain.Memory
+			Expect(*memory.Guest).To(Equal(instancetypeSpec.Memory.Guest))
+			Expect(*memory.Hugepages).To(Equal(*instancetypeSpec.Memory.Hugepages))
+			Expect(vm.Spec.Template.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]).To(Equal(instancetypeSpec.Memory.Guest))
+		})
+
+		table.DescribeTable("should apply the preferred CPU topology", func(topology instancetypev1alpha1.PreferredCPUTopology, sockets, cores, threads uint32) {
+			preferenceSpec = &instancetypev1alpha1.VirtualMachinePreferenceSpec{
+				CPU: &instancetypev1alpha1.CPUPreferences{
+					PreferredCPUTopology: topology,
+				},
+			}
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(BeEmpty())
+
+			cpu := vm.Spec.Template.Spec.Domain.CPU
+			Expect(cpu.Sockets).To(Equal(sockets))
+			Expect(cpu.Cores).To(Equal(cores))
+			Expect(cpu.Threads).To(Equal(threads))
+		},
+			table.Entry("with sockets", instancetypev1alpha1.PreferSockets, uint32(2), uint32(1), uint32(1)),
+			table.Entry("with cores", instancetypev1alpha1.PreferCores, uint32(1), uint32(2), uint32(1)),
+			table.Entry("with threads", instancetypev1alpha1.PreferThreads, uint32(1), uint32(1), uint32(2)),
+		)
+
+		It("should detect CPU conflict", func() {
+			vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+				Sockets: 2,
+			}
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(HaveLen(1))
+			Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.cpu"))
+		})
+
+		It("should detect Memory conflict", func() {
+			vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize: "2Mi",
+				},
+			}
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(HaveLen(1))
+			Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.memory"))
+		})
+
+		It("should detect resource requests conflicts", func() {
+			vm.Spec.Template.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("1"),
+				k8sv1.ResourceMemory: resource.MustParse("128M"),
+			}
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+			Expect(conflicts).To(HaveLen(2))
+			Expect(conflicts.String()).To(Equal("spec.template.spec.domain.resources.requests.cpu, spec.template.spec.domain.resources.requests.memory"))
+		})
+
+		Context("Preferences", func() {
+
+			BeforeEach(func() {
+				preferenceSpec = &instancetypev1alpha1.VirtualMachinePreferenceSpec{
+					Devices: &instancetypev1alpha1.DevicePreferences{
+						PreferredAutoattachGraphicsDevice: boolPtr(false),
+						PreferredDiskBus:                  "virtio",
+						PreferredCdromBus:                 "sata",
+						PreferredLunBus:                   "scsi",
+						PreferredInterfaceModel:           "e1000",
+					},
+					Firmware: &instancetypev1alpha1.FirmwarePreferences{
+						PreferredUseEfi:        boolPtr(true),
+						PreferredUseSecureBoot: boolPtr(false),
+					},
+					Machine: &instancetypev1alpha1.MachinePreferences{
+						PreferredMachineType: "q35",
+					},
+				}
+			})
+
+			It("should apply preferences only to unset fields", func() {
+				vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+					{Name: "default"},
+					{Name: "disk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}},
+					{Name: "user-disk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: "sata"}}},
+					{Name: "cdrom", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+					{Name: "lun", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{}}},
+				}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					{Name: "default"},
+					{Name: "user", Model: "virtio"},
+				}
+				vm.Spec.Template.Spec.Domain.Machine.Type = "pc"
+
+				conflicts := instancetypeMethods.ApplyToVmi(field, nil, preferenceSpec, &vm.Spec.Template.Spec)
+				Expect(conflicts).To(BeEmpty())
+
+				devices := vm.Spec.Template.Spec.Domain.Devices
+				Expect(*devices.AutoattachGraphicsDevice).To(BeFalse())
+				Expect(devices.AutoattachSerialConsole).To(BeNil())
+				Expect(devices.Disks[0].DiskDevice.Disk.Bus).To(Equal("virtio"))
+				Expect(devices.Disks[1].DiskDevice.Disk.Bus).To(Equal("virtio"))
+				Expect(devices.Disks[2].DiskDevice.Disk.Bus).To(Equal("sata"))
+				Expect(devices.Disks[3].DiskDevice.CDRom.Bus).To(Equal("sata"))
+				Expect(devices.Disks[4].DiskDevice.LUN.Bus).To(Equal("scsi"))
+				Expect(devices.Interfaces[0].Model).To(Equal("e1000"))
+				Expect(devices.Interfaces[1].Model).To(Equal("virtio"))
+
+				Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.BIOS).To(BeNil())
+				Expect(*vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot).To(BeFalse())
+				Expect(vm.Spec.Template.Spec.Domain.Machine.Type).To(Equal("pc"))
+			})
+
+			It("should not override a user provided bootloader", func() {
+				vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+					Bootloader: &v1.Bootloader{
+						BIOS: &v1.BIOS{},
+					},
+				}
+				conflicts := instancetypeMethods.ApplyToVmi(field, nil, preferenceSpec, &vm.Spec.Template.Spec)
+				Expect(conflicts).To(BeEmpty())
+				Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI).To(BeNil())
+				Expect(vm.Spec.Template.Spec.Domain.Machine.Type).To(Equal("q35"))
+			})
+		})
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package downwardmetrics
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	k6tv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd"
+	promvm "kubevirt.io/kubevirt/pkg/monitoring/vms/prometheus"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+const (
+	refreshInterval   = 5 * time.Second
+	collectionTimeout = refreshInterval
+	// stats which took longer than this to collect are stale
+	statsMaxAge = collectionTimeout + 2*time.Second
+)
+
+// RunDownwardMetricsCollector refreshes the metrics disk of all VMIs of the informer which have a DownwardMetrics volume
+func RunDownwardMetricsCollector(stop <-chan struct{}, nodeName string, vmiInformer cache.SharedIndexInformer, isolationDetector isolation.PodIsolationDetector) {
+	log.Log.Infof("Starting downward metrics collector: node name=%v", nodeName)
+	scraper := &scraper{
+		isolation: isolationDetector,
+		reporter:  newReporter(nodeName),
+	}
+	// one scrape per VMI at a time, a hanging launcher must not pile up scrapers
+	collector := promvm.NewConcurrentCollector(1)
+
+	wait.Until(func() {
+		socketToVMIs := map[string]*k6tv1.VirtualMachineInstance{}
+		for _, obj := range vmiInformer.GetStore().List() {
+			vmi := obj.(*k6tv1.VirtualMachineInstance)
+			if !vmi.IsRunning() || !downwardmetrics.HasDownwardMetricDisk(vmi) {
+				continue
+			}
+			socketPath, err := cmdclient.FindSocketOnHost(vmi)
+			if err != nil {
+				// the launcher is not reachable yet or anymore
+				continue
+			}
+			socketToVMIs[socketPath] = vmi
+		}
+		if len(socketToVMIs) > 0 {
+			collector.Collect(socketToVMIs, scraper, collectionTimeout)
+		}
+	}, refreshInterval, stop)
+}
+
+type scraper struct {
+	isolation isolation.PodIsolationDetector
+	reporter  *reporter
+}
+
+func (s *scraper) Scrape(socketFile string, vmi *k6tv1.VirtualMachineInstance) {
+	ts := time.Now()
+	cli, err := cmdclient.NewClient(socketFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to connect to cmd client socket")
+		return
+	}
+	defer cli.Close()
+
+	vmStats, exists, err := cli.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("failed to update stats from socket %s", socketFile)
+		return
+	}
+	if !exists || vmStats.Name == "" {
+		log.Log.Object(vmi).V(2).Infof("disappearing VM on %s, ignored", socketFile)
+		return
+	}
+
+	if elapsed := time.Now().Sub(ts); elapsed > statsMaxAge {
+		log.Log.Object(vmi).Infof("took too long (%v) to collect stats from %s: ignored", elapsed, socketFile)
+		return
+	}
+
+	metrics, err := s.reporter.Report(vmi, vmStats)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to collect the downward metrics")
+		return
+	}
+
+	res, err := s.isolation.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to detect the virt-launcher process")
+		return
+	}
+
+	if err := vhostmd.NewMetricsIODisk(downwardmetrics.FormatDownwardMetricPath(res.Pid())).Write(metrics); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to write the downward metrics")
+	}
+}
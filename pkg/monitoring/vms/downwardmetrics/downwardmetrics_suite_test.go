@@ -0,0 +1,13 @@
+package downwardmetrics
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDownwardMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DownwardMetrics Suite")
+}
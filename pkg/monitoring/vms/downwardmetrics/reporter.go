@@ -0,0 +1,236 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package downwardmetrics
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/version"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// userHZ is the unit of the cpu times in /proc/stat
+const userHZ = 100
+
+type staticHostMetrics struct {
+	hostName             string
+	hostSystemInfo       string
+	virtualizationVendor string
+	virtProductInfo      string
+}
+
+// reporter turns the stats of a domain and of the host into the metrics exposed to the guest
+type reporter struct {
+	staticHostInfo *staticHostMetrics
+	procDir        string
+}
+
+func newReporter(nodeName string) *reporter {
+	return &reporter{
+		staticHostInfo: &staticHostMetrics{
+			hostName:             nodeName,
+			hostSystemInfo:       "linux",
+			virtualizationVendor: "kubevirt.io",
+			virtProductInfo:      fmt.Sprintf("KubeVirt %s", version.Get().GitVersion),
+		},
+		procDir: "/proc",
+	}
+}
+
+func (r *reporter) Report(vmi *k6tv1.VirtualMachineInstance, vmStats *stats.DomainStats) (*api.Metrics, error) {
+	metrics := &api.Metrics{
+		Metrics: []api.Metric{
+			stringMetric("HostName", r.staticHostInfo.hostName),
+			stringMetric("HostSystemInfo", r.staticHostInfo.hostSystemInfo),
+			stringMetric("VirtualizationVendor", r.staticHostInfo.virtualizationVendor),
+			stringMetric("VirtProductInfo", r.staticHostInfo.virtProductInfo),
+			uint64Metric(api.MetricContextHost, "Time", uint64(time.Now().Unix())),
+		},
+	}
+
+	hostCPUMetrics, err := r.hostCPUMetrics()
+	if err != nil {
+		return nil, err
+	}
+	metrics.Metrics = append(metrics.Metrics, hostCPUMetrics...)
+
+	hostMemoryMetrics, err := r.hostMemoryMetrics()
+	if err != nil {
+		return nil, err
+	}
+	metrics.Metrics = append(metrics.Metrics, hostMemoryMetrics...)
+
+	metrics.Metrics = append(metrics.Metrics, guestCPUMetrics(vmStats)...)
+	metrics.Metrics = append(metrics.Metrics, guestMemoryMetrics(vmi, vmStats)...)
+	return metrics, nil
+}
+
+func (r *reporter) hostCPUMetrics() ([]api.Metric, error) {
+	lines, err := readProcFile(filepath.Join(r.procDir, "stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	cpus := 0
+	var busy uint64
+	for _, fields := range lines {
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "cpu" {
+			// user, nice, system, idle, iowait, irq, softirq, steal
+			for i, field := range fields[1:] {
+				if i == 3 || i == 4 || i > 7 {
+					continue
+				}
+				value, err := strconv.ParseUint(field, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse the cpu times of the host: %v", err)
+				}
+				busy += value
+			}
+		} else if strings.HasPrefix(fields[0], "cpu") {
+			cpus++
+		}
+	}
+
+	return []api.Metric{
+		uint32Metric(api.MetricContextHost, "NumberOfPhysicalCPUs", uint32(cpus)),
+		real64Metric(api.MetricContextHost, "TotalCPUTime", float64(busy)/userHZ),
+	}, nil
+}
+
+func (r *reporter) hostMemoryMetrics() ([]api.Metric, error) {
+	meminfo, err := readProcValues(filepath.Join(r.procDir, "meminfo"))
+	if err != nil {
+		return nil, err
+	}
+	vmstat, err := readProcValues(filepath.Join(r.procDir, "vmstat"))
+	if err != nil {
+		return nil, err
+	}
+
+	// meminfo is in KiB, the paging counters of vmstat as well
+	usedPhysicalMemory := meminfo["MemTotal"] - meminfo["MemFree"]
+	usedSwap := meminfo["SwapTotal"] - meminfo["SwapFree"]
+	return []api.Metric{
+		uint64Metric(api.MetricContextHost, "FreePhysicalMemory", meminfo["MemFree"]),
+		uint64Metric(api.MetricContextHost, "FreeVirtualMemory", meminfo["MemFree"]+meminfo["SwapFree"]),
+		uint64Metric(api.MetricContextHost, "MemoryAllocatedToVirtualServers", usedPhysicalMemory),
+		uint64Metric(api.MetricContextHost, "UsedVirtualMemory", usedPhysicalMemory+usedSwap),
+		uint64Metric(api.MetricContextHost, "PagedInMemory", vmstat["pgpgin"]),
+		uint64Metric(api.MetricContextHost, "PagedOutMemory", vmstat["pgpgout"]),
+	}, nil
+}
+
+func guestCPUMetrics(vmStats *stats.DomainStats) []api.Metric {
+	var cpuTime uint64
+	if vmStats.Cpu != nil && vmStats.Cpu.TimeSet {
+		cpuTime = vmStats.Cpu.Time
+	}
+
+	return []api.Metric{
+		// the domain cpu time is in nanoseconds
+		real64Metric(api.MetricContextVM, "TotalCPUTime", float64(cpuTime)/float64(time.Second)),
+		uint32Metric(api.MetricContextVM, "ResourceProcessorLimit", uint32(len(vmStats.Vcpu))),
+	}
+}
+
+func guestMemoryMetrics(vmi *k6tv1.VirtualMachineInstance, vmStats *stats.DomainStats) []api.Metric {
+	var allocated uint64
+	if vmStats.Memory != nil && vmStats.Memory.ActualBalloonSet {
+		allocated = vmStats.Memory.ActualBalloon
+	}
+
+	var limit uint64
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		limit = uint64(vmi.Spec.Domain.Memory.Guest.Value()) / 1024
+	} else if memory, exists := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		limit = uint64(memory.Value()) / 1024
+	}
+
+	return []api.Metric{
+		// the balloon size is in KiB
+		uint64Metric(api.MetricContextVM, "PhysicalMemoryAllocatedToVirtualSystem", allocated),
+		uint64Metric(api.MetricContextVM, "ResourceMemoryLimit", limit),
+	}
+}
+
+func stringMetric(name, value string) api.Metric {
+	return api.Metric{Type: api.MetricTypeString, Context: api.MetricContextHost, Name: name, Value: value}
+}
+
+func uint32Metric(context api.MetricContext, name string, value uint32) api.Metric {
+	return api.Metric{Type: api.MetricTypeUInt32, Context: context, Name: name, Value: strconv.FormatUint(uint64(value), 10)}
+}
+
+func uint64Metric(context api.MetricContext, name string, value uint64) api.Metric {
+	return api.Metric{Type: api.MetricTypeUInt64, Context: context, Name: name, Value: strconv.FormatUint(value, 10)}
+}
+
+func real64Metric(context api.MetricContext, name string, value float64) api.Metric {
+	return api.Metric{Type: api.MetricTypeReal64, Context: context, Name: name, Value: strconv.FormatFloat(value, 'f', 6, 64)}
+}
+
+func readProcFile(path string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, strings.Fields(scanner.Text()))
+	}
+	return lines, scanner.Err()
+}
+
+// readProcValues reads files with a "key value" or "key: value unit" per line
+func readProcValues(path string) (map[string]uint64, error) {
+	lines, err := readProcFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+	for _, fields := range lines {
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		values[strings.TrimSuffix(fields[0], ":")] = value
+	}
+	return values, nil
+}
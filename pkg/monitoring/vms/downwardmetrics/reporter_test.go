@@ -0,0 +1,96 @@
+package downwardmetrics
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	k6tv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("DownwardMetrics reporter", func() {
+
+	var procDir string
+	var r *reporter
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "stat"), []byte(
+			"cpu  100 20 80 5000 50 10 10 30 0 0\n"+
+				"cpu0 50 10 40 2500 25 5 5 15 0 0\n"+
+				"cpu1 50 10 40 2500 25 5 5 15 0 0\n"+
+				"intr 12345\n"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "meminfo"), []byte(
+			"MemTotal:       16000 kB\n"+
+				"MemFree:         4000 kB\n"+
+				"SwapTotal:       2000 kB\n"+
+				"SwapFree:        1500 kB\n"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(procDir, "vmstat"), []byte(
+			"pgpgin 300\n"+
+				"pgpgout 400\n"), 0644)).To(Succeed())
+
+		r = newReporter("node01")
+		r.procDir = procDir
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(procDir)
+	})
+
+	metricValue := func(metrics *api.Metrics, context api.MetricContext, name string) string {
+		for _, metric := range metrics.Metrics {
+			if metric.Context == context && metric.Name == name {
+				return metric.Value
+			}
+		}
+		Fail("metric " + name + " not found")
+		return ""
+	}
+
+	It("should report host and guest metrics", func() {
+		vmi := k6tv1.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("64Mi")}
+		vmStats := &stats.DomainStats{
+			Name:   "default_testvmi",
+			Cpu:    &stats.DomainStatsCPU{TimeSet: true, Time: 2500000000},
+			Memory: &stats.DomainStatsMemory{ActualBalloonSet: true, ActualBalloon: 65536},
+			Vcpu:   []stats.DomainStatsVcpu{{}, {}},
+		}
+
+		metrics, err := r.Report(vmi, vmStats)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(metricValue(metrics, api.MetricContextHost, "HostName")).To(Equal("node01"))
+		Expect(metricValue(metrics, api.MetricContextHost, "VirtualizationVendor")).To(Equal("kubevirt.io"))
+		Expect(metricValue(metrics, api.MetricContextHost, "NumberOfPhysicalCPUs")).To(Equal("2"))
+		// user + nice + system + irq + softirq + steal
+		Expect(metricValue(metrics, api.MetricContextHost, "TotalCPUTime")).To(Equal("2.500000"))
+		Expect(metricValue(metrics, api.MetricContextHost, "FreePhysicalMemory")).To(Equal("4000"))
+		Expect(metricValue(metrics, api.MetricContextHost, "FreeVirtualMemory")).To(Equal("5500"))
+		Expect(metricValue(metrics, api.MetricContextHost, "MemoryAllocatedToVirtualServers")).To(Equal("12000"))
+		Expect(metricValue(metrics, api.MetricContextHost, "UsedVirtualMemory")).To(Equal("12500"))
+		Expect(metricValue(metrics, api.MetricContextHost, "PagedInMemory")).To(Equal("300"))
+		Expect(metricValue(metrics, api.MetricContextHost, "PagedOutMemory")).To(Equal("400"))
+
+		Expect(metricValue(metrics, api.MetricContextVM, "TotalCPUTime")).To(Equal("2.500000"))
+		Expect(metricValue(metrics, api.MetricContextVM, "ResourceProcessorLimit")).To(Equal("2"))
+		Expect(metricValue(metrics, api.MetricContextVM, "PhysicalMemoryAllocatedToVirtualSystem")).To(Equal("65536"))
+		Expect(metricValue(metrics, api.MetricContextVM, "ResourceMemoryLimit")).To(Equal("65536"))
+	})
+
+	It("should fail if the host stats can not be read", func() {
+		Expect(os.Remove(filepath.Join(procDir, "meminfo"))).To(Succeed())
+		_, err := r.Report(k6tv1.NewMinimalVMI("testvmi"), &stats.DomainStats{})
+		Expect(err).To(HaveOccurred())
+	})
+})
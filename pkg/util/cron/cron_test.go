@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package cron
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+
+	parseTime := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	table.DescribeTable("should calculate the next activation", func(spec, from, expected string) {
+		schedule, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(parseTime(from))).To(Equal(parseTime(expected)))
+	},
+		table.Entry("every minute", "* * * * *", "2022-03-01T10:15:30Z", "2022-03-01T10:16:00Z"),
+		table.Entry("nightly", "0 2 * * *", "2022-03-01T10:15:00Z", "2022-03-02T02:00:00Z"),
+		table.Entry("nightly exactly at activation", "0 2 * * *", "2022-03-02T02:00:00Z", "2022-03-03T02:00:00Z"),
+		table.Entry("step", "*/15 * * * *", "2022-03-01T10:15:00Z", "2022-03-01T10:30:00Z"),
+		table.Entry("range with step", "10-40/10 * * * *", "2022-03-01T10:41:00Z", "2022-03-01T11:10:00Z"),
+		table.Entry("start with step", "50/5 * * * *", "2022-03-01T10:51:00Z", "2022-03-01T10:55:00Z"),
+		table.Entry("list", "0 6,18 * * *", "2022-03-01T07:00:00Z", "2022-03-01T18:00:00Z"),
+		table.Entry("month names", "0 0 1 jan,jul *", "2022-03-01T00:00:00Z", "2022-07-01T00:00:00Z"),
+		table.Entry("weekday names", "0 0 * * sat", "2022-03-01T00:00:00Z", "2022-03-05T00:00:00Z"),
+		table.Entry("sunday as 7", "0 0 * * 7", "2022-03-01T00:00:00Z", "2022-03-06T00:00:00Z"),
+		table.Entry("day of month or day of week", "0 0 15 * mon", "2022-03-01T00:00:00Z", "2022-03-07T00:00:00Z"),
+		table.Entry("leap day", "0 0 29 2 *", "2022-03-01T00:00:00Z", "2024-02-29T00:00:00Z"),
+		table.Entry("end of year", "0 0 1 1 *", "2022-12-31T23:59:00Z", "2023-01-01T00:00:00Z"),
+		table.Entry("@daily", "@daily", "2022-03-01T10:15:00Z", "2022-03-02T00:00:00Z"),
+		table.Entry("@hourly", "@hourly", "2022-03-01T10:15:00Z", "2022-03-01T11:00:00Z"),
+		table.Entry("@weekly", "@weekly", "2022-03-01T10:15:00Z", "2022-03-06T00:00:00Z"),
+	)
+
+	It("should return zero time for a schedule that never fires", func() {
+		schedule, err := Parse("0 0 30 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(parseTime("2022-03-01T00:00:00Z")).IsZero()).To(BeTrue())
+	})
+
+	table.DescribeTable("should reject invalid expressions", func(spec string) {
+		_, err := Parse(spec)
+		Expect(err).To(HaveOccurred())
+	},
+		table.Entry("empty", ""),
+		table.Entry("too few fields", "* * * *"),
+		table.Entry("too many fields", "* * * * * *"),
+		table.Entry("minute out of range", "60 * * * *"),
+		table.Entry("day of month out of range", "* * 0 * *"),
+		table.Entry("reversed range", "* 10-5 * * *"),
+		table.Entry("zero step", "*/0 * * * *"),
+		table.Entry("garbage", "a b c d e"),
+		table.Entry("unknown descriptor", "@every-night"),
+	)
+})
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package multus
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+const (
+	NetworksAnnotation      = "k8s.v1.cni.cncf.io/networks"
+	NetworkStatusAnnotation = "k8s.v1.cni.cncf.io/network-status"
+)
+
+// NetworkStatus is an entry of the network status annotation which Multus sets on the pod
+type NetworkStatus struct {
+	Name      string   `json:"name"`
+	Interface string   `json:"interface,omitempty"`
+	IPs       []string `json:"ips,omitempty"`
+	Mac       string   `json:"mac,omitempty"`
+	Default   bool     `json:"default,omitempty"`
+}
+
+// IsSecondaryNetwork returns true for multus networks which get an additional pod interface
+func IsSecondaryNetwork(network v1.Network) bool {
+	return network.Multus != nil && !network.Multus.Default
+}
+
+// PodInterfaceNames returns the name of the pod interface of every secondary multus network of the vmi.
+// The names recorded in the status of the vmi win. As long as no name is recorded, the spec of the vmi was
+// not changed since the pod was created, and the interfaces are named by their ordinal, like the pod
+// template does. Interfaces which are hotplugged later are named by a hash of the network name, so that
+// the names don't depend on the order of the networks, which changes when networks are unplugged.
+func PodInterfaceNames(vmi *v1.VirtualMachineInstance) map[string]string {
+	recorded := map[string]string{}
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.PodInterfaceName != "" {
+			recorded[iface.Name] = iface.PodInterfaceName
+		}
+	}
+
+	names := map[string]string{}
+	for _, network := range vmi.Spec.Networks {
+		if !IsSecondaryNetwork(network) {
+			continue
+		}
+		if len(recorded) == 0 {
+			// multus pod interfaces start from 1
+			names[network.Name] = fmt.Sprintf("net%d", len(names)+1)
+		} else if name, exists := recorded[network.Name]; exists {
+			names[network.Name] = name
+		} else {
+			names[network.Name] = HashedPodInterfaceName(network.Name)
+		}
+	}
+	return names
+}
+
+// HashedPodInterfaceName returns the name of the pod interface of a hotplugged network
+func HashedPodInterfaceName(networkName string) string {
+	hash := sha256.Sum256([]byte(networkName))
+	return fmt.Sprintf("pod%x", hash)[:10]
+}
+
+// IsPodInterfaceAttached returns true if the pod interface of the network is expected to exist in the pod.
+// The pod is created with all networks of the vmi, while networks hotplugged to a running vmi are
+// attached once Multus reports their interface in the network status of the pod. As long as no name is
+// recorded, the networks of the vmi are the ones the pod was created with.
+func IsPodInterfaceAttached(vmi *v1.VirtualMachineInstance, network v1.Network) bool {
+	if !IsSecondaryNetwork(network) || !vmi.IsRunning() {
+		return true
+	}
+	recorded := false
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.PodInterfaceName == "" {
+			continue
+		}
+		if iface.Name == network.Name {
+			return true
+		}
+		recorded = true
+	}
+	return !recorded
+}
+
+// ParseNetworkStatus returns the network status of the pod, it is empty if Multus did not report it yet
+func ParseNetworkStatus(annotations map[string]string) ([]NetworkStatus, error) {
+	annotation, exists := annotations[NetworkStatusAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	var status []NetworkStatus
+	if err := json.Unmarshal([]byte(annotation), &status); err != nil {
+		return nil, fmt.Errorf("failed to parse the network status of the pod: %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package multus
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Multus", func() {
+	multusNetwork := func(name string) v1.Network {
+		return v1.Network{
+			Name:          name,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name}},
+		}
+	}
+
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = v1.NewMinimalVMI("testvmi")
+		vmi.Spec.Networks = []v1.Network{
+			multusNetwork("red"),
+			*v1.DefaultPodNetwork(),
+			multusNetwork("blue"),
+		}
+	})
+
+	Context("PodInterfaceNames", func() {
+		It("should name the interfaces by their ordinal as long as no name is recorded", func() {
+			Expect(PodInterfaceNames(vmi)).To(Equal(map[string]string{"red": "net1", "blue": "net2"}))
+		})
+
+		It("should keep recorded names and hash the names of new networks", func() {
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue"), multusNetwork("green")}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: "default"},
+				{Name: "red", PodInterfaceName: "net1"},
+				{Name: "blue", PodInterfaceName: "net2"},
+			}
+			Expect(PodInterfaceNames(vmi)).To(Equal(map[string]string{
+				"blue":  "net2",
+				"green": HashedPodInterfaceName("green"),
+			}))
+		})
+
+		It("should generate valid and stable interface names", func() {
+			name := HashedPodInterfaceName("green")
+			Expect(name).To(HavePrefix("pod"))
+			Expect(len(name)).To(BeNumerically("<=", 15))
+			Expect(HashedPodInterfaceName("green")).To(Equal(name))
+			Expect(HashedPodInterfaceName("red")).ToNot(Equal(name))
+		})
+	})
+
+	Context("IsPodInterfaceAttached", func() {
+		It("should consider all interfaces attached before the vmi runs", func() {
+			Expect(IsPodInterfaceAttached(vmi, vmi.Spec.Networks[0])).To(BeTrue())
+		})
+
+		It("should only consider recorded secondary interfaces of a running vmi attached", func() {
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "red", PodInterfaceName: "net1"}}
+			Expect(IsPodInterfaceAttached(vmi, vmi.Spec.Networks[0])).To(BeTrue())
+			Expect(IsPodInterfaceAttached(vmi, vmi.Spec.Networks[1])).To(BeTrue())
+			Expect(IsPodInterfaceAttached(vmi, vmi.Spec.Networks[2])).To(BeFalse())
+		})
+
+		It("should consider all interfaces of a running vmi attached as long as no name is recorded", func() {
+			vmi.Status.Phase = v1.Running
+			Expect(IsPodInterfaceAttached(vmi, vmi.Spec.Networks[2])).To(BeTrue())
+		})
+	})
+
+	Context("ParseNetworkStatus", func() {
+		It("should parse the network status of the pod", func() {
+			status, err := ParseNetworkStatus(map[string]string{
+				NetworkStatusAnnotation: `[{"name":"kindnet","interface":"eth0","ips":["10.244.0.5"],"default":true},{"name":"default/red","interface":"net1","mac":"8a:37:d9:e7:0f:18"}]`,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal([]NetworkStatus{
+				{Name: "kindnet", Interface: "eth0", IPs: []string{"10.244.0.5"}, Default: true},
+				{Name: "default/red", Interface: "net1", Mac: "8a:37:d9:e7:0f:18"},
+			}))
+		})
+
+		It("should return no status without the annotation", func() {
+			status, err := ParseNetworkStatus(map[string]string{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(BeEmpty())
+		})
+
+		It("should fail on an invalid annotation", func() {
+			_, err := ParseNetworkStatus(map[string]string{NetworkStatusAnnotation: "{"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
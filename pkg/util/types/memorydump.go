@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package types
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+)
+
+// memoryDumpOverhead is the space needed on top of the guest memory for the headers of the dump file
+var memoryDumpOverhead = resource.MustParse("100Mi")
+
+// GetMemoryDumpSize returns the minimal size of a volume which can hold a memory dump of the VMI
+func GetMemoryDumpSize(vmi *virtv1.VirtualMachineInstance) resource.Quantity {
+	size := resource.Quantity{}
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		size = vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	} else if memory, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		size = memory.DeepCopy()
+	}
+	size.Add(memoryDumpOverhead)
+	return size
+}
+
+// GetPVCCapacity returns the capacity of a bound PVC or the requested storage of an unbound one
+func GetPVCCapacity(pvc *k8sv1.PersistentVolumeClaim) (resource.Quantity, bool) {
+	if capacity, ok := pvc.Status.Capacity[k8sv1.ResourceStorage]; ok {
+		return capacity, true
+	}
+	capacity, ok := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	return capacity, ok
+}
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package types
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Memory dump utils test", func() {
+
+	It("should add the overhead to the requested memory", func() {
+		vmi := virtv1.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Resources.Requests = kubev1.ResourceList{
+			kubev1.ResourceMemory: resource.MustParse("1Gi"),
+		}
+
+		size := GetMemoryDumpSize(vmi)
+		Expect(size.Cmp(resource.MustParse("1124Mi"))).To(Equal(0))
+	})
+
+	It("should prefer the guest memory over the requested memory", func() {
+		guest := resource.MustParse("2Gi")
+		vmi := virtv1.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Memory = &virtv1.Memory{Guest: &guest}
+		vmi.Spec.Domain.Resources.Requests = kubev1.ResourceList{
+			kubev1.ResourceMemory: resource.MustParse("1Gi"),
+		}
+
+		size := GetMemoryDumpSize(vmi)
+		Expect(size.Cmp(resource.MustParse("2148Mi"))).To(Equal(0))
+	})
+
+	It("should prefer the capacity of a bound PVC", func() {
+		pvc := &kubev1.PersistentVolumeClaim{}
+		pvc.Spec.Resources.Requests = kubev1.ResourceList{kubev1.ResourceStorage: resource.MustParse("1Gi")}
+		pvc.Status.Capacity = kubev1.ResourceList{kubev1.ResourceStorage: resource.MustParse("2Gi")}
+
+		capacity, ok := GetPVCCapacity(pvc)
+		Expect(ok).To(BeTrue())
+		Expect(capacity.Cmp(resource.MustParse("2Gi"))).To(Equal(0))
+	})
+})
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package types
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+)
+
+const (
+	// PersistentStateVolumeName is the name of the launcher pod volume holding the persistent state of a VM
+	PersistentStateVolumeName = "persistent-state"
+	// PersistentStateNVRAMSubPath is the directory of the persistent state volume holding the EFI NVRAM
+	PersistentStateNVRAMSubPath = "nvram"
+	// PersistentStateNVRAMPath is where the EFI NVRAM directory is mounted in the compute container
+	PersistentStateNVRAMPath = "/var/lib/libvirt/qemu/nvram"
+	// PersistentStateTPMSubPath is the directory of the persistent state volume holding the TPM state
+	PersistentStateTPMSubPath = "swtpm"
+	// PersistentStateTPMPath is where the TPM state directory is mounted in the compute container
+	PersistentStateTPMPath = "/var/lib/libvirt/swtpm"
+)
+
+// persistentStateSize is large enough for the EFI vars and the TPM state of the VM
+var persistentStateSize = resource.MustParse("10Mi")
+
+// PersistentStatePVCName returns the name of the PVC holding the persistent state of the VM
+func PersistentStatePVCName(vmName string) string {
+	return "persistent-state-for-" + vmName
+}
+
+// HasPersistentEFI returns true if the EFI NVRAM of the VMI has to be persisted
+func HasPersistentEFI(spec *virtv1.VirtualMachineInstanceSpec) bool {
+	firmware := spec.Domain.Firmware
+	return firmware != nil && firmware.Bootloader != nil && firmware.Bootloader.EFI != nil &&
+		firmware.Bootloader.EFI.Persistent != nil && *firmware.Bootloader.EFI.Persistent
+}
+
+// HasPersistentTPM returns true if the state of the TPM device of the VMI has to be persisted
+func HasPersistentTPM(spec *virtv1.VirtualMachineInstanceSpec) bool {
+	tpm := spec.Domain.Devices.TPM
+	return tpm != nil && tpm.Persistent != nil && *tpm.Persistent
+}
+
+// HasPersistentState returns true if the VMI needs the persistent state PVC of its VM
+func HasPersistentState(spec *virtv1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentEFI(spec) || HasPersistentTPM(spec)
+}
+
+// NewPersistentStatePVC returns the PVC holding the persistent state of the VM, owned by the VM
+func NewPersistentStatePVC(vm *virtv1.VirtualMachine, storageClass string) *k8sv1.PersistentVolumeClaim {
+	pvc := &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PersistentStatePVCName(vm.Name),
+			Namespace: vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			// RWX keeps the VM live migratable, the source and target pod mount the PVC at the same time
+			AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+			Resources: k8sv1.ResourceRequirements{
+				Requests: k8sv1.ResourceList{
+					k8sv1.ResourceStorage: persistentStateSize,
+				},
+			},
+		},
+	}
+	volumeMode := k8sv1.PersistentVolumeFilesystem
+	pvc.Spec.VolumeMode = &volumeMode
+	if storageClass != "" {
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	return pvc
+}
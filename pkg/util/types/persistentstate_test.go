@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package types
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Persistent state utils test", func() {
+
+	boolPtr := func(b bool) *bool { return &b }
+
+	table.DescribeTable("should detect persistent EFI", func(firmware *virtv1.Firmware, expected bool) {
+		vmi := virtv1.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Firmware = firmware
+		Expect(HasPersistentEFI(&vmi.Spec)).To(Equal(expected))
+	},
+		table.Entry("without firmware", nil, false),
+		table.Entry("with BIOS", &virtv1.Firmware{Bootloader: &virtv1.Bootloader{BIOS: &virtv1.BIOS{}}}, false),
+		table.Entry("with EFI", &virtv1.Firmware{Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{}}}, false),
+		table.Entry("with non persistent EFI", &virtv1.Firmware{Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{Persistent: boolPtr(false)}}}, false),
+		table.Entry("with persistent EFI", &virtv1.Firmware{Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{Persistent: boolPtr(true)}}}, true),
+	)
+
+	table.DescribeTable("should detect persistent state", func(tpm *virtv1.TPMDevice, efiPersistent *bool, expectedTPM, expected bool) {
+		vmi := virtv1.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.TPM = tpm
+		vmi.Spec.Domain.Firmware = &virtv1.Firmware{Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{Persistent: efiPersistent}}}
+		Expect(HasPersistentTPM(&vmi.Spec)).To(Equal(expectedTPM))
+		Expect(HasPersistentState(&vmi.Spec)).To(Equal(expected))
+	},
+		table.Entry("without TPM and persistent EFI", nil, nil, false, false),
+		table.Entry("with non persistent TPM", &virtv1.TPMDevice{}, nil, false, false),
+		table.Entry("with persistent TPM", &virtv1.TPMDevice{Persistent: boolPtr(true)}, nil, true, true),
+		table.Entry("with persistent EFI", nil, boolPtr(true), false, true),
+	)
+
+	It("should create a shared PVC owned by the VM", func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvm", Namespace: "default", UID: "1234"},
+		}
+
+		pvc := NewPersistentStatePVC(vm, "")
+		Expect(pvc.Name).To(Equal("persistent-state-for-testvm"))
+		Expect(pvc.Namespace).To(Equal("default"))
+		Expect(metav1.IsControlledBy(pvc, vm)).To(BeTrue())
+		Expect(pvc.Spec.AccessModes).To(ConsistOf(kubev1.ReadWriteMany))
+		Expect(*pvc.Spec.VolumeMode).To(Equal(kubev1.PersistentVolumeFilesystem))
+		Expect(pvc.Spec.StorageClassName).To(BeNil())
+	})
+})
@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package types
+
+import (
+	virtv1 "kubevirt.io/client-go/api/v1"
+)
+
+const (
+	// SerialConsoleLogContainerName is the name of the launcher pod container streaming the serial console log
+	SerialConsoleLogContainerName = "guest-console-log"
+	// SerialConsoleLogVolumeName is the name of the launcher pod volume holding the serial console log
+	SerialConsoleLogVolumeName = "serial-console-log"
+	// SerialConsoleLogDir is where the serial console log volume is mounted in the launcher pod containers
+	SerialConsoleLogDir = "/var/run/kubevirt-serial-console-log"
+	// SerialConsoleLogPath is the serial console log file, virtlogd rotates it next to it
+	SerialConsoleLogPath = SerialConsoleLogDir + "/virt-serial0-log"
+)
+
+// HasSerialConsoleLog returns true if the output of the default serial console of the VMI has to be logged
+func HasSerialConsoleLog(spec *virtv1.VirtualMachineInstanceSpec) bool {
+	devices := spec.Domain.Devices
+	if devices.AutoattachSerialConsole != nil && !*devices.AutoattachSerialConsole {
+		return false
+	}
+	return devices.LogSerialConsole != nil && *devices.LogSerialConsole
+}
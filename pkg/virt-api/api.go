@@ -251,6 +251,34 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, "Not Found", "").
 			Returns(http.StatusBadRequest, "Bad Request", ""))
 
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("freeze")).
+			To(subresourceApp.FreezeVMIRequestHandler).
+			Reads(v1.FreezeUnfreezeTimeout{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"Freeze").
+			Doc("Freeze a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, "Not Found", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("unfreeze")).
+			To(subresourceApp.UnfreezeVMIRequestHandler).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"Unfreeze").
+			Doc("Unfreeze a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, "Not Found", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("softreboot")).
+			To(subresourceApp.SoftRebootVMIRequestHandler).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"SoftReboot").
+			Doc("Soft reboot a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, "Not Found", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
 		subws.Route(subws.GET(rest.ResourcePath(subresourcesvmiGVR) + rest.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
 			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
@@ -263,6 +291,20 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version + "VNC").
 			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
 
+		subws.Route(subws.GET(rest.ResourcePath(subresourcesvmiGVR) + rest.SubResourcePath("portforward") + "/{port}/{protocol}").
+			To(subresourceApp.PortForwardRequestHandler).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Param(rest.PortForwardPortParameter(subws)).Param(rest.PortForwardProtocolParameter(subws)).
+			Operation(version.Version + "VMIPortForward").
+			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port."))
+
+		subws.Route(subws.GET(rest.ResourcePath(subresourcesvmGVR) + rest.SubResourcePath("portforward") + "/{port}/{protocol}").
+			To(subresourceApp.PortForwardRequestHandler).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Param(rest.PortForwardPortParameter(subws)).Param(rest.PortForwardProtocolParameter(subws)).
+			Operation(version.Version + "VMPortForward").
+			Doc("Open a websocket connection forwarding traffic to the running VirtualMachineInstance of the specified VirtualMachine and port."))
+
 		// An empty handler function would respond with HTTP OK by default
 		subws.Route(subws.GET(rest.ResourcePath(subresourcesvmiGVR) + rest.SubResourcePath("test")).
 			To(func(request *restful.Request, response *restful.Response) {}).
@@ -320,6 +362,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecVMIRequestHandler).
+			Reads(v1.VirtualMachineInstanceGuestExecRequest{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"GuestExec").
+			Doc("Run a command in the guest via guest agent").
+			Writes(v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusNotFound, "Not Found", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
 		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
@@ -352,6 +407,59 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, "Bad Request", ""))
 
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("addinterface")).
+			To(subresourceApp.VMIAddInterfaceRequestHandler).
+			Reads(v1.AddInterfaceOptions{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"vmi-addinterface").
+			Doc("Add a network interface to a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmiGVR)+rest.SubResourcePath("removeinterface")).
+			To(subresourceApp.VMIRemoveInterfaceRequestHandler).
+			Reads(v1.RemoveInterfaceOptions{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"vmi-removeinterface").
+			Doc("Removes a network interface from a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmGVR)+rest.SubResourcePath("addinterface")).
+			To(subresourceApp.VMAddInterfaceRequestHandler).
+			Reads(v1.AddInterfaceOptions{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"vm-addinterface").
+			Doc("Add a network interface to a Virtual Machine and to its running Virtual Machine Instance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmGVR)+rest.SubResourcePath("removeinterface")).
+			To(subresourceApp.VMRemoveInterfaceRequestHandler).
+			Reads(v1.RemoveInterfaceOptions{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"vm-removeinterface").
+			Doc("Removes a network interface from a Virtual Machine and from its running Virtual Machine Instance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmGVR)+rest.SubResourcePath("memorydump")).
+			To(subresourceApp.MemoryDumpVMRequestHandler).
+			Reads(v1.VirtualMachineMemoryDumpRequest{}).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"MemoryDump").
+			Doc("Dumps a VirtualMachineInstance memory.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
+		subws.Route(subws.PUT(rest.ResourcePath(subresourcesvmGVR)+rest.SubResourcePath("removememorydump")).
+			To(subresourceApp.RemoveMemoryDumpVMRequestHandler).
+			Param(rest.NamespaceParam(subws)).Param(rest.NameParam(subws)).
+			Operation(version.Version+"RemoveMemoryDump").
+			Doc("Remove memory dump association.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, "Bad Request", ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -374,6 +482,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/portforward",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/pause",
 						Namespaced: true,
@@ -382,6 +494,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/unpause",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/freeze",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/unfreeze",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/softreboot",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/start",
 						Namespaced: true,
@@ -414,6 +538,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -422,6 +550,34 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/addinterface",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/removeinterface",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/addinterface",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/removeinterface",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/portforward",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/memorydump",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/removememorydump",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -628,6 +784,12 @@ func (app *virtAPIApp) registerValidatingWebhooks() {
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.StatusValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeStatusValidation(w, r, app.clusterConfig, app.virtCli)
 	})
@@ -31,6 +31,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	clonev1alpha1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1alpha1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	poolv1alpha1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	mime "kubevirt.io/kubevirt/pkg/rest"
 )
@@ -47,6 +51,18 @@ func ComposeAPIDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+
+	instancetypeGVR := instancetypev1alpha1.SchemeGroupVersion.WithResource(instancetypev1alpha1.PluralResourceName)
+	clusterInstancetypeGVR := instancetypev1alpha1.SchemeGroupVersion.WithResource(instancetypev1alpha1.ClusterPluralResourceName)
+	preferenceGVR := instancetypev1alpha1.SchemeGroupVersion.WithResource(instancetypev1alpha1.PluralPreferenceResourceName)
+	clusterPreferenceGVR := instancetypev1alpha1.SchemeGroupVersion.WithResource(instancetypev1alpha1.ClusterPluralPreferenceResourceName)
+
+	poolGVR := poolv1alpha1.SchemeGroupVersion.WithResource(poolv1alpha1.PluralResourceName)
+
+	cloneGVR := clonev1alpha1.SchemeGroupVersion.WithResource(clonev1alpha1.PluralResourceName)
+
+	exportGVR := exportv1alpha1.SchemeGroupVersion.WithResource(exportv1alpha1.PluralResourceName)
 
 	ws, err := GroupVersionProxyBase(v1.GroupVersion)
 	if err != nil {
@@ -107,12 +123,92 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws2, err = GenericResourceProxy(ws2, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws3, err := ResourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
 	}
 
-	return []*restful.WebService{ws, ws1, ws2, ws3}
+	ws4, err := GroupVersionProxyBase(instancetypev1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws4, err = GenericResourceProxy(ws4, instancetypeGVR, &instancetypev1alpha1.VirtualMachineInstancetype{}, instancetypev1alpha1.VirtualMachineInstancetypeKind, &instancetypev1alpha1.VirtualMachineInstancetypeList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws4, err = GenericClusterResourceProxy(ws4, clusterInstancetypeGVR, &instancetypev1alpha1.VirtualMachineClusterInstancetype{}, instancetypev1alpha1.VirtualMachineClusterInstancetypeKind, &instancetypev1alpha1.VirtualMachineClusterInstancetypeList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws4, err = GenericResourceProxy(ws4, preferenceGVR, &instancetypev1alpha1.VirtualMachinePreference{}, instancetypev1alpha1.VirtualMachinePreferenceKind, &instancetypev1alpha1.VirtualMachinePreferenceList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws4, err = GenericClusterResourceProxy(ws4, clusterPreferenceGVR, &instancetypev1alpha1.VirtualMachineClusterPreference{}, instancetypev1alpha1.VirtualMachineClusterPreferenceKind, &instancetypev1alpha1.VirtualMachineClusterPreferenceList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws5, err := ResourceProxyAutodiscovery(instancetypeGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	ws6, err := GroupVersionProxyBase(poolv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws6, err = GenericResourceProxy(ws6, poolGVR, &poolv1alpha1.VirtualMachinePool{}, poolv1alpha1.VirtualMachinePoolKind, &poolv1alpha1.VirtualMachinePoolList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws7, err := ResourceProxyAutodiscovery(poolGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	ws8, err := GroupVersionProxyBase(clonev1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws8, err = GenericResourceProxy(ws8, cloneGVR, &clonev1alpha1.VirtualMachineClone{}, clonev1alpha1.VirtualMachineCloneKind, &clonev1alpha1.VirtualMachineCloneList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws9, err := ResourceProxyAutodiscovery(cloneGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	ws10, err := GroupVersionProxyBase(exportv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws10, err = GenericResourceProxy(ws10, exportGVR, &exportv1alpha1.VirtualMachineExport{}, exportv1alpha1.VirtualMachineExportKind, &exportv1alpha1.VirtualMachineExportList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws11, err := ResourceProxyAutodiscovery(exportGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws1, ws2, ws3, ws4, ws5, ws6, ws7, ws8, ws9, ws10, ws11}
 }
 
 func GroupVersionProxyBase(gv schema.GroupVersion) (*restful.WebService, error) {
@@ -251,6 +347,104 @@ func GenericResourceProxy(ws *restful.WebService, gvr schema.GroupVersionResourc
 	return ws, nil
 }
 
+func GenericClusterResourceProxy(ws *restful.WebService, gvr schema.GroupVersionResource, objPointer runtime.Object, objKind string, objListPointer runtime.Object) (*restful.WebService, error) {
+
+	objExample := reflect.ValueOf(objPointer).Elem().Interface()
+	listExample := reflect.ValueOf(objListPointer).Elem().Interface()
+
+	ws.Route(
+		ws.POST(ClusterResourceBasePath(gvr)).
+			Produces(mime.MIME_JSON, mime.MIME_YAML).
+			Consumes(mime.MIME_JSON, mime.MIME_YAML).
+			Operation("create"+objKind).
+			To(Noop).Reads(objExample).Writes(objExample).
+			Doc("Create a "+objKind+" object.").
+			Returns(http.StatusOK, "OK", objExample).
+			Returns(http.StatusCreated, "Created", objExample).
+			Returns(http.StatusAccepted, "Accepted", objExample).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""),
+	)
+
+	ws.Route(addClusterPutParams(
+		ws.PUT(ClusterResourcePath(gvr)).
+			Produces(mime.MIME_JSON, mime.MIME_YAML).
+			Consumes(mime.MIME_JSON, mime.MIME_YAML).
+			Operation("replace"+objKind).
+			To(Noop).Reads(objExample).Writes(objExample).
+			Doc("Update a "+objKind+" object.").
+			Returns(http.StatusOK, "OK", objExample).
+			Returns(http.StatusCreated, "Create", objExample).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	ws.Route(addClusterDeleteParams(
+		ws.DELETE(ClusterResourcePath(gvr)).
+			Produces(mime.MIME_JSON, mime.MIME_YAML).
+			Consumes(mime.MIME_JSON, mime.MIME_YAML).
+			Operation("delete"+objKind).
+			To(Noop).
+			Reads(metav1.DeleteOptions{}).Writes(metav1.Status{}).
+			Doc("Delete a "+objKind+" object.").
+			Returns(http.StatusOK, "OK", metav1.Status{}).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	ws.Route(addClusterGetParams(
+		ws.GET(ClusterResourcePath(gvr)).
+			Produces(mime.MIME_JSON, mime.MIME_YAML, mime.MIME_JSON_STREAM).
+			Operation("read"+objKind).
+			To(Noop).Writes(objExample).
+			Doc("Get a "+objKind+" object.").
+			Returns(http.StatusOK, "OK", objExample).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	ws.Route(addCollectionParams(
+		ws.GET(ClusterResourceBasePath(gvr)).
+			Produces(mime.MIME_JSON, mime.MIME_YAML, mime.MIME_JSON_STREAM).
+			Operation("list"+objKind).
+			To(Noop).Writes(listExample).
+			Doc("Get a list of "+objKind+" objects.").
+			Returns(http.StatusOK, "OK", listExample).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	ws.Route(addClusterPatchParams(
+		ws.PATCH(ClusterResourcePath(gvr)).
+			Consumes(mime.MIME_JSON_PATCH, mime.MIME_MERGE_PATCH).
+			Produces(mime.MIME_JSON).
+			Operation("patch"+objKind).
+			To(Noop).
+			Writes(objExample).Reads(metav1.Patch{}).
+			Doc("Patch a "+objKind+" object.").
+			Returns(http.StatusOK, "OK", objExample).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	// TODO, implement watch. For now it is here to provide swagger doc only
+	ws.Route(addWatchGetListParams(
+		ws.GET("/watch"+ClusterResourceBasePath(gvr)).
+			Produces(mime.MIME_JSON).
+			Operation("watch"+objKind+"List").
+			To(Noop).Writes(metav1.WatchEvent{}).
+			Doc("Watch a "+objKind+"List object.").
+			Returns(http.StatusOK, "OK", metav1.WatchEvent{}).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	ws.Route(addDeleteListParams(
+		ws.DELETE(ClusterResourceBasePath(gvr)).
+			Operation("deleteCollection"+objKind).
+			Produces(mime.MIME_JSON, mime.MIME_YAML).
+			To(Noop).Writes(metav1.Status{}).
+			Doc("Delete a collection of "+objKind+" objects.").
+			Returns(http.StatusOK, "OK", metav1.Status{}).
+			Returns(http.StatusUnauthorized, "Unauthorized", ""), ws,
+	))
+
+	return ws, nil
+}
+
 func ResourceProxyAutodiscovery(gvr schema.GroupVersionResource) (*restful.WebService, error) {
 	ws := new(restful.WebService)
 	ws.Path(GroupBasePath(gvr.GroupVersion()))
@@ -321,6 +515,27 @@ func addPatchParams(builder *restful.RouteBuilder, ws *restful.WebService) *rest
 	return builder.Param(NamespaceParam(ws)).Param(NameParam(ws))
 }
 
+func addClusterGetParams(builder *restful.RouteBuilder, ws *restful.WebService) *restful.RouteBuilder {
+	return builder.Param(NameParam(ws)).
+		Param(exactParam(ws)).
+		Param(exportParam(ws))
+}
+
+func addClusterPutParams(builder *restful.RouteBuilder, ws *restful.WebService) *restful.RouteBuilder {
+	return builder.Param(NameParam(ws))
+}
+
+func addClusterDeleteParams(builder *restful.RouteBuilder, ws *restful.WebService) *restful.RouteBuilder {
+	return builder.Param(NameParam(ws)).
+		Param(gracePeriodSecondsParam(ws)).
+		Param(orphanDependentsParam(ws)).
+		Param(propagationPolicyParam(ws))
+}
+
+func addClusterPatchParams(builder *restful.RouteBuilder, ws *restful.WebService) *restful.RouteBuilder {
+	return builder.Param(NameParam(ws))
+}
+
 func NameParam(ws *restful.WebService) *restful.Parameter {
 	return ws.PathParameter("name", "Name of the resource").Required(true)
 }
@@ -329,6 +544,14 @@ func NamespaceParam(ws *restful.WebService) *restful.Parameter {
 	return ws.PathParameter("namespace", "Object name and auth scope, such as for teams and projects").Required(true)
 }
 
+func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter("port", "The target port for portforward on the VirtualMachineInstance.").Required(true)
+}
+
+func PortForwardProtocolParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter("protocol", "The protocol for portforward on the VirtualMachineInstance, tcp or udp.").Required(true)
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
@@ -397,6 +620,14 @@ func ResourcePath(gvr schema.GroupVersionResource) string {
 	return fmt.Sprintf("/namespaces/{namespace:[a-z0-9][a-z0-9\\-]*}/%s/{name:[a-z0-9][a-z0-9\\-]*}", gvr.Resource)
 }
 
+func ClusterResourceBasePath(gvr schema.GroupVersionResource) string {
+	return fmt.Sprintf("/%s", gvr.Resource)
+}
+
+func ClusterResourcePath(gvr schema.GroupVersionResource) string {
+	return fmt.Sprintf("/%s/{name:[a-z0-9][a-z0-9\\-]*}", gvr.Resource)
+}
+
 func SubResourcePath(subResource string) string {
 	if !strings.HasPrefix(subResource, "/") {
 		return "/" + subResource
@@ -20,16 +20,20 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 
 	"crypto/tls"
 	goerror "errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	v12 "k8s.io/api/core/v1"
@@ -47,6 +51,8 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -150,7 +156,7 @@ func (app *SubresourceAPIApp) streamRequestHandler(request *restful.Request, res
 	}
 }
 
-func (app *SubresourceAPIApp) putRequestHandler(request *restful.Request, response *restful.Response, validate validation, getVirtHandlerURL URLResolver) {
+func (app *SubresourceAPIApp) putRequestHandler(request *restful.Request, response *restful.Response, validate validation, getVirtHandlerURL URLResolver, body io.ReadCloser) {
 
 	_, url, conn, statusErr := app.prepareConnection(request, validate, getVirtHandlerURL)
 	if statusErr != nil {
@@ -158,7 +164,7 @@ func (app *SubresourceAPIApp) putRequestHandler(request *restful.Request, respon
 		return
 	}
 
-	err := conn.Put(url, app.handlerTLSConfiguration)
+	err := conn.Put(url, app.handlerTLSConfiguration, body)
 	if err != nil {
 		writeError(errors.NewInternalError(err), response)
 		return
@@ -185,6 +191,34 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 	app.streamRequestHandler(request, response, validate, getConsoleURL)
 }
 
+// PortForwardRequestHandler forwards a websocket connection to a port of the VirtualMachineInstance.
+// It serves both the VirtualMachineInstance and the VirtualMachine subresource, a VirtualMachine
+// forwards to the port of its VirtualMachineInstance.
+func (app *SubresourceAPIApp) PortForwardRequestHandler(request *restful.Request, response *restful.Response) {
+	port, err := strconv.Atoi(request.PathParameter("port"))
+	if err != nil || port < 1 || port > 65535 {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid port %q", request.PathParameter("port"))), response)
+		return
+	}
+	protocol := request.PathParameter("protocol")
+	if protocol != "tcp" && protocol != "udp" {
+		writeError(errors.NewBadRequest(fmt.Sprintf("unsupported protocol %q, only tcp and udp are supported", protocol)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+		}
+		return nil
+	}
+	getPortForwardURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.PortForwardURI(vmi, port, protocol)
+	}
+	app.streamRequestHandler(request, response, validate, getPortForwardURL)
+}
+
 func (app *SubresourceAPIApp) getVirtHandlerConnForVMI(vmi *v1.VirtualMachineInstance) (kubecli.VirtHandlerConn, error) {
 	if !vmi.IsRunning() {
 		return nil, goerror.New(fmt.Sprintf("Unable to connect to VirtualMachineInstance because phase is %s instead of %s", vmi.Status.Phase, v1.Running))
@@ -731,7 +765,7 @@ func (app *SubresourceAPIApp) PauseVMIRequestHandler(request *restful.Request, r
 		return conn.PauseURI(vmi)
 	}
 
-	app.putRequestHandler(request, response, validate, getURL)
+	app.putRequestHandler(request, response, validate, getURL, nil)
 }
 
 func (app *SubresourceAPIApp) UnpauseVMIRequestHandler(request *restful.Request, response *restful.Response) {
@@ -749,8 +783,103 @@ func (app *SubresourceAPIApp) UnpauseVMIRequestHandler(request *restful.Request,
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.UnpauseURI(vmi)
 	}
-	app.putRequestHandler(request, response, validate, getURL)
+	app.putRequestHandler(request, response, validate, getURL, nil)
+
+}
+
+func (app *SubresourceAPIApp) FreezeVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have guest agent connected"))
+		}
+		return nil
+	}
+
+	unfreezeTimeout := &v1.FreezeUnfreezeTimeout{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(unfreezeTimeout)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, an unfreeze timeout is expected as the request body"), response)
+		return
+	}
+
+	if unfreezeTimeout.UnfreezeTimeout == nil {
+		writeError(errors.NewBadRequest("Unfreeze timeout must be specified"), response)
+		return
+	} else if unfreezeTimeout.UnfreezeTimeout.Duration < 0 {
+		writeError(errors.NewBadRequest("Unfreeze timeout must not be negative"), response)
+		return
+	}
+
+	bodyBytes, err := json.Marshal(unfreezeTimeout)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.FreezeURI(vmi)
+	}
 
+	app.putRequestHandler(request, response, validate, getURL, ioutil.NopCloser(bytes.NewReader(bodyBytes)))
+}
+
+func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.UnfreezeURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, nil)
+}
+
+// SoftRebootVMIRequestHandler asks the guest of a running VMI to reboot, unlike a restart of the VM the VMI and its pod are kept.
+func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+		}
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) && !acpiEnabled(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI neither has the guest agent connected nor ACPI enabled"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.SoftRebootURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, nil)
+}
+
+func acpiEnabled(vmi *v1.VirtualMachineInstance) bool {
+	features := vmi.Spec.Domain.Features
+	return features == nil || features.ACPI.Enabled == nil || *features.ACPI.Enabled
 }
 
 func (app *SubresourceAPIApp) fetchVirtualMachine(name string, namespace string) (*v1.VirtualMachine, *errors.StatusError) {
@@ -907,6 +1036,87 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	response.WriteEntity(filesystemList)
 }
 
+// GuestExecVMIRequestHandler runs a command in the guest through the guest agent and returns its exit code and output
+func (app *SubresourceAPIApp) GuestExecVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+		}
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have guest agent connected"))
+		}
+		return nil
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a guest exec request is expected as the request body"), response)
+		return
+	}
+
+	execRequest := &v1.VirtualMachineInstanceGuestExecRequest{}
+	defer request.Request.Body.Close()
+	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(execRequest)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+		return
+	}
+
+	if execRequest.Command == "" {
+		writeError(errors.NewBadRequest("Command must be specified"), response)
+		return
+	}
+	if execRequest.TimeoutSeconds == nil {
+		timeout := v1.DefaultGuestExecTimeoutSeconds
+		execRequest.TimeoutSeconds = &timeout
+	} else if *execRequest.TimeoutSeconds <= 0 || *execRequest.TimeoutSeconds > v1.MaxGuestExecTimeoutSeconds {
+		writeError(errors.NewBadRequest(fmt.Sprintf("Timeout must be between 1 and %d seconds", v1.MaxGuestExecTimeoutSeconds)), response)
+		return
+	}
+
+	bodyBytes, err := json.Marshal(execRequest)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestExecURI(vmi)
+	}
+
+	_, url, conn, statusErr := app.prepareConnection(request, validate, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	// virt-handler only answers once the command exited or the timeout expired
+	timeout := time.Duration(*execRequest.TimeoutSeconds)*time.Second + 10*time.Second
+	resp, err := conn.PutWithResponse(url, app.handlerTLSConfiguration, ioutil.NopCloser(bytes.NewReader(bodyBytes)), timeout)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to run guest command")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := v1.VirtualMachineInstanceGuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), &result); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling guest exec response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	if err := response.WriteHeaderAndJson(http.StatusOK, result, restful.MIME_JSON); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) (string, error) {
 	verb := "add"
 	if len(vm.Status.VolumeRequests) > 0 {
@@ -1214,3 +1424,457 @@ func (app *SubresourceAPIApp) VMIAddVolumeRequestHandler(request *restful.Reques
 func (app *SubresourceAPIApp) VMIRemoveVolumeRequestHandler(request *restful.Request, response *restful.Response) {
 	app.removeVolumeRequestHandler(request, response, true)
 }
+
+func generateVMInterfaceRequestPatch(vm *v1.VirtualMachine, interfaceRequest *v1.VirtualMachineInterfaceRequest) (string, error) {
+	verb := "add"
+	if len(vm.Status.InterfaceRequests) > 0 {
+		verb = "replace"
+	}
+
+	vmCopy := vm.DeepCopy()
+
+	if interfaceRequest.AddInterfaceOptions != nil {
+		name := interfaceRequest.AddInterfaceOptions.Name
+		for _, request := range vm.Status.InterfaceRequests {
+			if request.AddInterfaceOptions != nil && request.AddInterfaceOptions.Name == name {
+				return "", fmt.Errorf("Add interface request for interface [%s] already exists", name)
+			} else if request.RemoveInterfaceOptions != nil && request.RemoveInterfaceOptions.Name == name {
+				return "", fmt.Errorf("Unable to add interface. A remove interface request for interface [%s] already exists and is still being processed.", name)
+			}
+		}
+		vmCopy.Status.InterfaceRequests = append(vm.Status.InterfaceRequests, *interfaceRequest)
+	} else if interfaceRequest.RemoveInterfaceOptions != nil {
+		name := interfaceRequest.RemoveInterfaceOptions.Name
+		interfaceRequestsList := []v1.VirtualMachineInterfaceRequest{}
+		for _, request := range vm.Status.InterfaceRequests {
+			if request.AddInterfaceOptions != nil && request.AddInterfaceOptions.Name == name {
+				// Filter matching AddInterface requests from the new list.
+				continue
+			} else if request.RemoveInterfaceOptions != nil && request.RemoveInterfaceOptions.Name == name {
+				return "", fmt.Errorf("A remove interface request for interface [%s] already exists and is still being processed.", name)
+			}
+
+			interfaceRequestsList = append(interfaceRequestsList, request)
+		}
+		interfaceRequestsList = append(interfaceRequestsList, *interfaceRequest)
+		vmCopy.Status.InterfaceRequests = interfaceRequestsList
+	}
+
+	oldJson, err := json.Marshal(vm.Status.InterfaceRequests)
+	if err != nil {
+		return "", err
+	}
+
+	newJson, err := json.Marshal(vmCopy.Status.InterfaceRequests)
+	if err != nil {
+		return "", err
+	}
+
+	test := fmt.Sprintf(`{ "op": "test", "path": "/status/interfaceRequests", "value": %s}`, string(oldJson))
+	update := fmt.Sprintf(`{ "op": "%s", "path": "/status/interfaceRequests", "value": %s}`, verb, string(newJson))
+	patch := fmt.Sprintf("[%s, %s]", test, update)
+
+	return patch, nil
+}
+
+func generateVMIInterfaceRequestPatch(vmi *v1.VirtualMachineInstance, interfaceRequest *v1.VirtualMachineInterfaceRequest) (string, error) {
+	networkVerb := "add"
+	interfaceVerb := "add"
+
+	if len(vmi.Spec.Networks) > 0 {
+		networkVerb = "replace"
+	}
+
+	if len(vmi.Spec.Domain.Devices.Interfaces) > 0 {
+		interfaceVerb = "replace"
+	}
+
+	if interfaceRequest.AddInterfaceOptions != nil {
+		name := interfaceRequest.AddInterfaceOptions.Name
+		for _, network := range vmi.Spec.Networks {
+			if network.Name == name {
+				return "", fmt.Errorf("Unable to add interface [%s] because a network with the same name already exists", name)
+			}
+		}
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			if iface.Name == name {
+				return "", fmt.Errorf("Unable to add interface [%s] because it already exists", name)
+			}
+		}
+	} else if interfaceRequest.RemoveInterfaceOptions != nil {
+		name := interfaceRequest.RemoveInterfaceOptions.Name
+		var network *v1.Network
+		for i := range vmi.Spec.Networks {
+			if vmi.Spec.Networks[i].Name == name {
+				network = &vmi.Spec.Networks[i]
+			}
+		}
+		var iface *v1.Interface
+		for i := range vmi.Spec.Domain.Devices.Interfaces {
+			if vmi.Spec.Domain.Devices.Interfaces[i].Name == name {
+				iface = &vmi.Spec.Domain.Devices.Interfaces[i]
+			}
+		}
+		if network == nil || iface == nil {
+			return "", fmt.Errorf("Unable to remove interface [%s] because it does not exist", name)
+		}
+		if !multus.IsSecondaryNetwork(*network) || iface.Bridge == nil {
+			return "", fmt.Errorf("Unable to remove interface [%s], only interfaces of secondary multus networks with a bridge binding can be removed", name)
+		}
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Spec = *controller.ApplyInterfaceRequestOnVMISpec(&vmiCopy.Spec, interfaceRequest)
+
+	oldNetworksJson, err := json.Marshal(vmi.Spec.Networks)
+	if err != nil {
+		return "", err
+	}
+
+	newNetworksJson, err := json.Marshal(vmiCopy.Spec.Networks)
+	if err != nil {
+		return "", err
+	}
+
+	oldInterfacesJson, err := json.Marshal(vmi.Spec.Domain.Devices.Interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	newInterfacesJson, err := json.Marshal(vmiCopy.Spec.Domain.Devices.Interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	testNetworks := fmt.Sprintf(`{ "op": "test", "path": "/spec/networks", "value": %s}`, string(oldNetworksJson))
+	updateNetworks := fmt.Sprintf(`{ "op": "%s", "path": "/spec/networks", "value": %s}`, networkVerb, string(newNetworksJson))
+
+	testInterfaces := fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": %s}`, string(oldInterfacesJson))
+	updateInterfaces := fmt.Sprintf(`{ "op": "%s", "path": "/spec/domain/devices/interfaces", "value": %s}`, interfaceVerb, string(newInterfacesJson))
+
+	patch := fmt.Sprintf("[%s, %s, %s, %s]", testNetworks, testInterfaces, updateNetworks, updateInterfaces)
+
+	return patch, nil
+}
+
+func (app *SubresourceAPIApp) interfaceRequestHandler(request *restful.Request, response *restful.Response, interfaceRequest *v1.VirtualMachineInterfaceRequest, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	// inject into VMI if ephemeral, else set as a request on the VM to both make permanent and hotplug.
+	if ephemeral {
+		vmi, statErr := app.fetchVirtualMachineInstance(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		if !vmi.IsRunning() {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("VMI is not running")), response)
+			return
+		}
+
+		patch, err := generateVMIInterfaceRequestPatch(vmi, interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+			return
+		}
+
+		log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", patch)
+		_, err = app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi during interface hotplug: %v", err)), response)
+			return
+		}
+	} else {
+		vm, statErr := app.fetchVirtualMachine(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		patch, err := generateVMInterfaceRequestPatch(vm, interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+			return
+		}
+
+		err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during interface hotplug: %v", err)), response)
+			return
+		}
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) addInterfaceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	if !app.clusterConfig.HotplugNICsEnabled() {
+		writeError(errors.NewBadRequest("Unable to Add Interface because HotplugNICs feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.AddInterfaceOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("AddInterfaceOptions requires name to be set"), response)
+		return
+	} else if opts.NetworkAttachmentDefinitionName == "" {
+		writeError(errors.NewBadRequest("AddInterfaceOptions requires networkAttachmentDefinitionName to be set"), response)
+		return
+	}
+
+	app.interfaceRequestHandler(request, response, &v1.VirtualMachineInterfaceRequest{AddInterfaceOptions: opts}, ephemeral)
+}
+
+func (app *SubresourceAPIApp) removeInterfaceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	if !app.clusterConfig.HotplugNICsEnabled() {
+		writeError(errors.NewBadRequest("Unable to Remove Interface because HotplugNICs feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.RemoveInterfaceOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("RemoveInterfaceOptions requires name to be set"), response)
+		return
+	}
+
+	app.interfaceRequestHandler(request, response, &v1.VirtualMachineInterfaceRequest{RemoveInterfaceOptions: opts}, ephemeral)
+}
+
+// VMAddInterfaceRequestHandler handles the subresource for hot plugging a network interface.
+func (app *SubresourceAPIApp) VMAddInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addInterfaceRequestHandler(request, response, false)
+}
+
+// VMRemoveInterfaceRequestHandler handles the subresource for hot unplugging a network interface.
+func (app *SubresourceAPIApp) VMRemoveInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeInterfaceRequestHandler(request, response, false)
+}
+
+// VMIAddInterfaceRequestHandler handles the subresource for hot plugging a network interface.
+func (app *SubresourceAPIApp) VMIAddInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addInterfaceRequestHandler(request, response, true)
+}
+
+// VMIRemoveInterfaceRequestHandler handles the subresource for hot unplugging a network interface.
+func (app *SubresourceAPIApp) VMIRemoveInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeInterfaceRequestHandler(request, response, true)
+}
+
+func generateVMMemoryDumpRequestPatch(vm *v1.VirtualMachine, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) (string, error) {
+	verb := "add"
+	if vm.Status.MemoryDumpRequest != nil {
+		verb = "replace"
+	}
+
+	oldJson, err := json.Marshal(vm.Status.MemoryDumpRequest)
+	if err != nil {
+		return "", err
+	}
+
+	newJson, err := json.Marshal(memoryDumpRequest)
+	if err != nil {
+		return "", err
+	}
+
+	test := fmt.Sprintf(`{ "op": "test", "path": "/status/memoryDumpRequest", "value": %s}`, string(oldJson))
+	update := fmt.Sprintf(`{ "op": "%s", "path": "/status/memoryDumpRequest", "value": %s}`, verb, string(newJson))
+	patch := fmt.Sprintf("[%s, %s]", test, update)
+
+	return patch, nil
+}
+
+func memoryDumpInProgress(memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) bool {
+	if memoryDumpRequest == nil {
+		return false
+	}
+	switch memoryDumpRequest.Phase {
+	case v1.MemoryDumpAssociating, v1.MemoryDumpInProgress, v1.MemoryDumpUnmounting, v1.MemoryDumpDissociating:
+		return true
+	}
+	return false
+}
+
+func (app *SubresourceAPIApp) validateMemoryDumpClaim(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, claimName string) *errors.StatusError {
+	volumes := vmi.Spec.Volumes
+	if vm.Spec.Template != nil {
+		volumes = append(volumes[:len(volumes):len(volumes)], vm.Spec.Template.Spec.Volumes...)
+	}
+	for _, volume := range volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName ||
+			volume.DataVolume != nil && volume.DataVolume.Name == claimName {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf("PVC %s is already used by the VM as volume %s", claimName, volume.Name))
+		}
+	}
+
+	pvc, exists, isBlock, err := kubevirttypes.IsPVCBlockFromClient(app.virtCli, vm.Namespace, claimName)
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("unable to get PVC %s: %v", claimName, err))
+	}
+	if !exists {
+		return errors.NewNotFound(v12.Resource("persistentvolumeclaim"), claimName)
+	}
+	if isBlock {
+		return errors.NewBadRequest(fmt.Sprintf("PVC %s is a block device, memory dump requires a filesystem volume", claimName))
+	}
+
+	expectedSize := kubevirttypes.GetMemoryDumpSize(vmi)
+	capacity, ok := kubevirttypes.GetPVCCapacity(pvc)
+	if !ok || capacity.Cmp(expectedSize) < 0 {
+		return errors.NewBadRequest(fmt.Sprintf("PVC %s is too small for the memory dump, at least %s are required", claimName, expectedSize.String()))
+	}
+
+	return nil
+}
+
+// MemoryDumpVMRequestHandler handles the subresource for dumping the memory of a running VM into a PVC.
+func (app *SubresourceAPIApp) MemoryDumpVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to dump memory because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(memoryDumpRequest)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a claim name is expected as the request body"), response)
+		return
+	}
+
+	if memoryDumpRequest.ClaimName == "" {
+		writeError(errors.NewBadRequest("Memory dump requires claim name to be set"), response)
+		return
+	}
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if memoryDumpInProgress(vm.Status.MemoryDumpRequest) {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("memory dump to PVC %s is still being processed", vm.Status.MemoryDumpRequest.ClaimName)), response)
+		return
+	}
+
+	vmi, statErr := app.fetchVirtualMachineInstance(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("VMI is not running")), response)
+		return
+	}
+
+	if statErr := app.validateMemoryDumpClaim(vm, vmi, memoryDumpRequest.ClaimName); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	patch, err := generateVMMemoryDumpRequestPatch(vm, &v1.VirtualMachineMemoryDumpRequest{
+		ClaimName: memoryDumpRequest.ClaimName,
+		Phase:     v1.MemoryDumpAssociating,
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during memory dump: %v", err)), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// RemoveMemoryDumpVMRequestHandler handles the subresource for dissociating the memory dump PVC from the VM.
+func (app *SubresourceAPIApp) RemoveMemoryDumpVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to remove memory dump because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if vm.Status.MemoryDumpRequest == nil {
+		writeError(errors.NewBadRequest("Unable to remove memory dump because there is no memory dump request"), response)
+		return
+	}
+
+	if vm.Status.MemoryDumpRequest.Phase == v1.MemoryDumpDissociating {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("memory dump PVC %s is already being removed", vm.Status.MemoryDumpRequest.ClaimName)), response)
+		return
+	}
+
+	memoryDumpRequest := vm.Status.MemoryDumpRequest.DeepCopy()
+	memoryDumpRequest.Phase = v1.MemoryDumpDissociating
+	memoryDumpRequest.Remove = true
+
+	patch, err := generateVMMemoryDumpRequestPatch(vm, memoryDumpRequest)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during memory dump removal: %v", err)), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -43,8 +44,10 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -147,7 +150,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	}
 
-	expectVMI := func(running, paused bool) {
+	expectVMIWithConditions := func(running bool, conditions ...v1.VirtualMachineInstanceConditionType) {
 		request.PathParameters()["name"] = "testvmi"
 		request.PathParameters()["namespace"] = "default"
 
@@ -166,13 +169,11 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			},
 		}
 
-		if paused {
-			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
-				{
-					Type:   v1.VirtualMachineInstancePaused,
-					Status: k8sv1.ConditionTrue,
-				},
-			}
+		for _, condition := range conditions {
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   condition,
+				Status: k8sv1.ConditionTrue,
+			})
 		}
 
 		server.AppendHandlers(
@@ -185,6 +186,14 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		expectHandlerPod()
 	}
 
+	expectVMI := func(running, paused bool) {
+		if paused {
+			expectVMIWithConditions(running, v1.VirtualMachineInstancePaused)
+		} else {
+			expectVMIWithConditions(running)
+		}
+	}
+
 	getVMPath := func(version, namespace, vmName string) string {
 		return fmt.Sprintf(vmPathFormat, version, namespace, vmName)
 	}
@@ -357,6 +366,44 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			close(done)
 		}, 5)
 
+		table.DescribeTable("should reject invalid port-forward parameters", func(port, protocol string) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+			request.PathParameters()["port"] = port
+			request.PathParameters()["protocol"] = protocol
+
+			app.PortForwardRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		},
+			table.Entry("with a non numeric port", "ssh", "tcp"),
+			table.Entry("with port 0", "0", "tcp"),
+			table.Entry("with a too large port", "65536", "tcp"),
+			table.Entry("with an unsupported protocol", "22", "sctp"),
+		)
+
+		It("should fail to port-forward if the VMI is paused", func(done Done) {
+			request.PathParameters()["port"] = "22"
+			request.PathParameters()["protocol"] = "tcp"
+
+			expectVMI(true, true)
+
+			app.PortForwardRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			close(done)
+		}, 5)
+
+		It("should fail to port-forward if the VMI is not running", func(done Done) {
+			request.PathParameters()["port"] = "22"
+			request.PathParameters()["protocol"] = "tcp"
+
+			expectVMI(false, false)
+
+			app.PortForwardRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			close(done)
+		}, 5)
+
 		It("should fail if VirtualMachine not exists", func(done Done) {
 			request.PathParameters()["name"] = "testvm"
 			request.PathParameters()["namespace"] = "default"
@@ -1245,6 +1292,198 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Add/Remove Interface Subresource api", func() {
+
+		newAddInterfaceBody := func(opts *v1.AddInterfaceOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+		newRemoveInterfaceBody := func(opts *v1.RemoveInterfaceOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		newVMIWithSecondaryInterface := func(name string) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI(name)
+			vmi.Namespace = "default"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Networks = []v1.Network{
+				*v1.DefaultPodNetwork(),
+				{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				*v1.DefaultBridgeNetworkInterface(),
+				{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			return vmi
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = "testvm"
+			request.PathParameters()["namespace"] = "default"
+		})
+
+		table.DescribeTable("Should handle interface requests", func(addOpts *v1.AddInterfaceOptions, removeOpts *v1.RemoveInterfaceOptions, isVM bool, code int, enableGate bool) {
+			if enableGate {
+				enableFeatureGate(virtconfig.HotplugNICsGate)
+			}
+			if addOpts != nil {
+				request.Request.Body = newAddInterfaceBody(addOpts)
+			} else {
+				request.Request.Body = newRemoveInterfaceBody(removeOpts)
+			}
+
+			if isVM {
+				vm := newMinimalVM(request.PathParameter("name"))
+				vm.Namespace = "default"
+
+				patchedVM := vm.DeepCopy()
+				patchedVM.Status.InterfaceRequests = append(patchedVM.Status.InterfaceRequests, v1.VirtualMachineInterfaceRequest{AddInterfaceOptions: addOpts, RemoveInterfaceOptions: removeOpts})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vm),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm/status"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, patchedVM),
+					),
+				)
+				if addOpts != nil {
+					app.VMAddInterfaceRequestHandler(request, response)
+				} else {
+					app.VMRemoveInterfaceRequestHandler(request, response)
+				}
+			} else {
+				vmi := newVMIWithSecondaryInterface(request.PathParameter("name"))
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+				)
+
+				if addOpts != nil {
+					app.VMIAddInterfaceRequestHandler(request, response)
+				} else {
+					app.VMIRemoveInterfaceRequestHandler(request, response)
+				}
+			}
+
+			Expect(response.StatusCode()).To(Equal(code))
+		},
+			table.Entry("VM with a valid add interface request", &v1.AddInterfaceOptions{
+				Name:                            "blue",
+				NetworkAttachmentDefinitionName: "blue-net",
+			}, nil, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid add interface request", &v1.AddInterfaceOptions{
+				Name:                            "blue",
+				NetworkAttachmentDefinitionName: "blue-net",
+			}, nil, false, http.StatusAccepted, true),
+			table.Entry("VMI with an add interface request that's missing a name", &v1.AddInterfaceOptions{
+				NetworkAttachmentDefinitionName: "blue-net",
+			}, nil, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an add interface request that's missing a network attachment definition", &v1.AddInterfaceOptions{
+				Name: "blue",
+			}, nil, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an add interface request for an existing interface", &v1.AddInterfaceOptions{
+				Name:                            "red",
+				NetworkAttachmentDefinitionName: "red-net",
+			}, nil, false, http.StatusConflict, true),
+			table.Entry("VM with a valid remove interface request", nil, &v1.RemoveInterfaceOptions{
+				Name: "red",
+			}, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid remove interface request", nil, &v1.RemoveInterfaceOptions{
+				Name: "red",
+			}, false, http.StatusAccepted, true),
+			table.Entry("VMI with a remove interface request for the pod network", nil, &v1.RemoveInterfaceOptions{
+				Name: "default",
+			}, false, http.StatusConflict, true),
+			table.Entry("VMI with a remove interface request for a missing interface", nil, &v1.RemoveInterfaceOptions{
+				Name: "blue",
+			}, false, http.StatusConflict, true),
+			table.Entry("VMI with a remove interface request missing a name", nil, &v1.RemoveInterfaceOptions{}, false, http.StatusBadRequest, true),
+			table.Entry("VM with a valid add interface request but no feature gate", &v1.AddInterfaceOptions{
+				Name:                            "blue",
+				NetworkAttachmentDefinitionName: "blue-net",
+			}, nil, true, http.StatusBadRequest, false),
+		)
+
+		table.DescribeTable("Should generate expected vmi patch", func(interfaceRequest *v1.VirtualMachineInterfaceRequest, expectedPatch string) {
+			vmi := newVMIWithSecondaryInterface(request.PathParameter("name"))
+
+			patch, err := generateVMIInterfaceRequestPatch(vmi, interfaceRequest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("add interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "blue",
+						NetworkAttachmentDefinitionName: "blue-net",
+					},
+				},
+				`[{ "op": "test", "path": "/spec/networks", "value": [{"name":"default","pod":{}},{"name":"red","multus":{"networkName":"red-net"}}]}, `+
+					`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","bridge":{}},{"name":"red","bridge":{}}]}, `+
+					`{ "op": "replace", "path": "/spec/networks", "value": [{"name":"default","pod":{}},{"name":"red","multus":{"networkName":"red-net"}},{"name":"blue","multus":{"networkName":"blue-net"}}]}, `+
+					`{ "op": "replace", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","bridge":{}},{"name":"red","bridge":{}},{"name":"blue","bridge":{}}]}]`),
+			table.Entry("remove interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "red",
+					},
+				},
+				`[{ "op": "test", "path": "/spec/networks", "value": [{"name":"default","pod":{}},{"name":"red","multus":{"networkName":"red-net"}}]}, `+
+					`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","bridge":{}},{"name":"red","bridge":{}}]}, `+
+					`{ "op": "replace", "path": "/spec/networks", "value": [{"name":"default","pod":{}}]}, `+
+					`{ "op": "replace", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","bridge":{}}]}]`),
+		)
+
+		table.DescribeTable("Should generate expected vm patch", func(interfaceRequest *v1.VirtualMachineInterfaceRequest, existingInterfaceRequests []v1.VirtualMachineInterfaceRequest, expectedPatch string, expectError bool) {
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = "default"
+			vm.Status.InterfaceRequests = existingInterfaceRequests
+
+			patch, err := generateVMInterfaceRequestPatch(vm, interfaceRequest)
+			if expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("add interface request with no existing requests",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{Name: "blue", NetworkAttachmentDefinitionName: "blue-net"},
+				},
+				nil,
+				`[{ "op": "test", "path": "/status/interfaceRequests", "value": null}, { "op": "add", "path": "/status/interfaceRequests", "value": [{"addInterfaceOptions":{"networkAttachmentDefinitionName":"blue-net","name":"blue"}}]}]`,
+				false),
+			table.Entry("add interface request that already exists should fail",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{Name: "blue", NetworkAttachmentDefinitionName: "blue-net"},
+				},
+				[]v1.VirtualMachineInterfaceRequest{
+					{AddInterfaceOptions: &v1.AddInterfaceOptions{Name: "blue", NetworkAttachmentDefinitionName: "blue-net"}},
+				},
+				"",
+				true),
+			table.Entry("remove interface request should replace add interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{Name: "blue"},
+				},
+				[]v1.VirtualMachineInterfaceRequest{
+					{AddInterfaceOptions: &v1.AddInterfaceOptions{Name: "blue", NetworkAttachmentDefinitionName: "blue-net"}},
+				},
+				`[{ "op": "test", "path": "/status/interfaceRequests", "value": [{"addInterfaceOptions":{"networkAttachmentDefinitionName":"blue-net","name":"blue"}}]}, { "op": "replace", "path": "/status/interfaceRequests", "value": [{"removeInterfaceOptions":{"name":"blue"}}]}]`,
+				false),
+		)
+	})
+
 	Context("Subresource api - error handling for StartVMRequestHandler", func() {
 		BeforeEach(func() {
 			request.PathParameters()["name"] = "testvm"
@@ -1849,6 +2088,373 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Freezing", func() {
+		newFreezeBody := func(unfreezeTimeout *k8smetav1.Duration) io.ReadCloser {
+			opts := &v1.FreezeUnfreezeTimeout{UnfreezeTimeout: unfreezeTimeout}
+			optsJson, _ := json.Marshal(opts)
+			return ioutil.NopCloser(bytes.NewReader(optsJson))
+		}
+
+		It("Should freeze a running VMI with a connected guest agent", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/freeze"),
+					ghttp.VerifyBody([]byte(`{"unfreezeTimeout":"1m0s"}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithConditions(true, v1.VirtualMachineInstanceAgentConnected)
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+
+			app.FreezeVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail freezing a not running VMI", func() {
+			expectVMIWithConditions(false, v1.VirtualMachineInstanceAgentConnected)
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail freezing a VMI without a connected guest agent", func() {
+			expectVMIWithConditions(true)
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		table.DescribeTable("Should fail freezing with an invalid unfreeze timeout", func(unfreezeTimeout *k8smetav1.Duration) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+			request.Request.Body = newFreezeBody(unfreezeTimeout)
+
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("when it is missing", nil),
+			table.Entry("when it is negative", &k8smetav1.Duration{Duration: -time.Minute}),
+		)
+
+		It("Should unfreeze a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/unfreeze"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithConditions(true, v1.VirtualMachineInstanceAgentConnected)
+
+			app.UnfreezeVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail unfreezing a not running VMI", func() {
+			expectVMIWithConditions(false)
+
+			app.UnfreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("Soft reboot", func() {
+		It("Should soft reboot a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/softreboot"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithConditions(true, v1.VirtualMachineInstanceAgentConnected)
+
+			app.SoftRebootVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		table.DescribeTable("Should fail soft rebooting", func(running bool, conditions ...v1.VirtualMachineInstanceConditionType) {
+			expectVMIWithConditions(running, conditions...)
+
+			app.SoftRebootVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			table.Entry("a not running VMI", false),
+			table.Entry("a paused VMI", true, v1.VirtualMachineInstancePaused),
+		)
+	})
+
+	Context("Guest exec", func() {
+		newGuestExecBody := func(req *v1.VirtualMachineInstanceGuestExecRequest) io.ReadCloser {
+			reqJson, _ := json.Marshal(req)
+			return ioutil.NopCloser(bytes.NewReader(reqJson))
+		}
+
+		It("Should run a command in a running VMI with a connected guest agent", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+					ghttp.VerifyBody([]byte(`{"command":"cat","args":["/etc/hostname"],"timeoutSeconds":30}`)),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.VirtualMachineInstanceGuestExecResult{
+						ExitCode: 0,
+						Stdout:   "testvmi",
+					}),
+				),
+			)
+			expectVMIWithConditions(true, v1.VirtualMachineInstanceAgentConnected)
+			request.Request.Body = newGuestExecBody(&v1.VirtualMachineInstanceGuestExecRequest{
+				Command: "cat",
+				Args:    []string{"/etc/hostname"},
+			})
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := v1.VirtualMachineInstanceGuestExecResult{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &result)).To(Succeed())
+			Expect(result.Stdout).To(Equal("testvmi"))
+		})
+
+		table.DescribeTable("Should fail running a command", func(running bool, conditions ...v1.VirtualMachineInstanceConditionType) {
+			expectVMIWithConditions(running, conditions...)
+			request.Request.Body = newGuestExecBody(&v1.VirtualMachineInstanceGuestExecRequest{Command: "ls"})
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			table.Entry("in a not running VMI", false, v1.VirtualMachineInstanceAgentConnected),
+			table.Entry("in a paused VMI", true, v1.VirtualMachineInstanceAgentConnected, v1.VirtualMachineInstancePaused),
+			table.Entry("in a VMI without a connected guest agent", true),
+		)
+
+		table.DescribeTable("Should reject an invalid guest exec request", func(execRequest *v1.VirtualMachineInstanceGuestExecRequest) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+			request.Request.Body = newGuestExecBody(execRequest)
+
+			app.GuestExecVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("without a command", &v1.VirtualMachineInstanceGuestExecRequest{}),
+			table.Entry("with a negative timeout", &v1.VirtualMachineInstanceGuestExecRequest{Command: "ls", TimeoutSeconds: pointer.Int32Ptr(-1)}),
+			table.Entry("with a too long timeout", &v1.VirtualMachineInstanceGuestExecRequest{Command: "ls", TimeoutSeconds: pointer.Int32Ptr(v1.MaxGuestExecTimeoutSeconds + 1)}),
+		)
+	})
+
+	Context("Memory dump", func() {
+		const claimName = "testclaim"
+
+		newMemoryDumpBody := func(req *v1.VirtualMachineMemoryDumpRequest) io.ReadCloser {
+			reqJson, _ := json.Marshal(req)
+			return &readCloserWrapper{bytes.NewReader(reqJson)}
+		}
+
+		newMemoryDumpVM := func(memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachine {
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = "default"
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			vm.Status.MemoryDumpRequest = memoryDumpRequest
+			return vm
+		}
+
+		newMemoryDumpVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI(request.PathParameter("name"))
+			vmi.Namespace = "default"
+			vmi.Status.Phase = phase
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+			}
+			return vmi
+		}
+
+		newMemoryDumpPVC := func(size string, volumeMode k8sv1.PersistentVolumeMode) *k8sv1.PersistentVolumeClaim {
+			return &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: claimName, Namespace: "default"},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					VolumeMode: &volumeMode,
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+				},
+			}
+		}
+
+		expectGetVM := func(vm *v1.VirtualMachine) {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vm),
+				),
+			)
+		}
+
+		expectGetVMI := func(vmi *v1.VirtualMachineInstance) {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+				),
+			)
+		}
+
+		expectGetPVC := func(pvc *k8sv1.PersistentVolumeClaim) {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/namespaces/default/persistentvolumeclaims/"+claimName),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, pvc),
+				),
+			)
+		}
+
+		expectPatchVMStatus := func(vm *v1.VirtualMachine, expectedPhase v1.MemoryDumpPhase) {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm/status"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(body)).To(ContainSubstring(fmt.Sprintf(`"phase":"%s"`, expectedPhase)))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vm),
+				),
+			)
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = "testvm"
+			request.PathParameters()["namespace"] = "default"
+		})
+
+		It("should request a memory dump of a running VM", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			vm := newMemoryDumpVM(nil)
+			expectGetVM(vm)
+			expectGetVMI(newMemoryDumpVMI(v1.Running))
+			expectGetPVC(newMemoryDumpPVC("2Gi", k8sv1.PersistentVolumeFilesystem))
+			expectPatchVMStatus(vm, v1.MemoryDumpAssociating)
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should allow a new memory dump once the previous one completed", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			vm := newMemoryDumpVM(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpCompleted})
+			expectGetVM(vm)
+			expectGetVMI(newMemoryDumpVMI(v1.Running))
+			expectGetPVC(newMemoryDumpPVC("2Gi", k8sv1.PersistentVolumeFilesystem))
+			expectPatchVMStatus(vm, v1.MemoryDumpAssociating)
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail without the HotplugVolumes feature gate", func() {
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail without a claim name", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{})
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail while a memory dump is in progress", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			expectGetVM(newMemoryDumpVM(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpInProgress}))
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the VMI is not running", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			expectGetVM(newMemoryDumpVM(nil))
+			expectGetVMI(newMemoryDumpVMI(v1.Scheduled))
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the PVC is already used by the VM", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			vmi := newMemoryDumpVMI(v1.Running)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "disk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			})
+			expectGetVM(newMemoryDumpVM(nil))
+			expectGetVMI(vmi)
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		table.DescribeTable("should fail with an unsuitable PVC", func(pvc *k8sv1.PersistentVolumeClaim) {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			request.Request.Body = newMemoryDumpBody(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName})
+			expectGetVM(newMemoryDumpVM(nil))
+			expectGetVMI(newMemoryDumpVMI(v1.Running))
+			expectGetPVC(pvc)
+
+			app.MemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("that is too small", newMemoryDumpPVC("1Gi", k8sv1.PersistentVolumeFilesystem)),
+			table.Entry("that is a block device", newMemoryDumpPVC("2Gi", k8sv1.PersistentVolumeBlock)),
+		)
+
+		It("should request the removal of the memory dump", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			vm := newMemoryDumpVM(&v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpCompleted})
+			expectGetVM(vm)
+			expectPatchVMStatus(vm, v1.MemoryDumpDissociating)
+
+			app.RemoveMemoryDumpVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail removing the memory dump without a memory dump request", func() {
+			enableFeatureGate(virtconfig.HotplugVolumesGate)
+			expectGetVM(newMemoryDumpVM(nil))
+
+			app.RemoveMemoryDumpVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+	})
+
 	AfterEach(func() {
 		server.Close()
 		backend.Close()
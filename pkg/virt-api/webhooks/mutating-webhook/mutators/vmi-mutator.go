@@ -84,6 +84,7 @@ func (mutator *VMIsMutator) Mutate(ar *v1beta1.AdmissionReview) *v1beta1.Admissi
 		mutator.setDefaultResourceRequests(newVMI)
 		mutator.setDefaultGuestCPUTopology(newVMI)
 		mutator.setDefaultPullPoliciesOnContainerDisks(newVMI)
+		mutator.setDefaultLogSerialConsole(newVMI)
 		err = mutator.setDefaultNetworkInterface(newVMI)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
@@ -224,6 +225,18 @@ func (mutator *VMIsMutator) setDefaultMachineType(vmi *v1.VirtualMachineInstance
 	}
 }
 
+func (mutator *VMIsMutator) setDefaultLogSerialConsole(vmi *v1.VirtualMachineInstance) {
+	devices := &vmi.Spec.Domain.Devices
+	if devices.LogSerialConsole != nil {
+		return
+	}
+	if devices.AutoattachSerialConsole != nil && !*devices.AutoattachSerialConsole {
+		return
+	}
+	logSerialConsole := mutator.ClusterConfig.IsSerialConsoleLogEnabled()
+	devices.LogSerialConsole = &logSerialConsole
+}
+
 func (mutator *VMIsMutator) setDefaultPullPoliciesOnContainerDisks(vmi *v1.VirtualMachineInstance) {
 	for _, volume := range vmi.Spec.Volumes {
 		if volume.ContainerDisk != nil && volume.ContainerDisk.ImagePullPolicy == "" {
@@ -35,6 +35,7 @@ import (
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -238,6 +239,33 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(vmiSpec.Domain.Resources.Requests.Cpu().String()).To(Equal(cpuRequestFromConfig))
 	})
 
+	table.DescribeTable("should default logSerialConsole", func(clusterDefault, autoattach, logSerialConsole, expected *bool) {
+		mutator.ClusterConfig, _, _, _ = testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					LogSerialConsole: clusterDefault,
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+		vmi.Spec.Domain.Devices.AutoattachSerialConsole = autoattach
+		vmi.Spec.Domain.Devices.LogSerialConsole = logSerialConsole
+
+		vmiSpec, _ := getVMISpecMetaFromResponse()
+		Expect(vmiSpec.Domain.Devices.LogSerialConsole).To(Equal(expected))
+	},
+		table.Entry("to false by default", nil, nil, nil, pointer.BoolPtr(false)),
+		table.Entry("to the cluster default", pointer.BoolPtr(true), nil, nil, pointer.BoolPtr(true)),
+		table.Entry("not when set on the VMI", pointer.BoolPtr(true), nil, pointer.BoolPtr(false), pointer.BoolPtr(false)),
+		table.Entry("not when the serial console is disabled", pointer.BoolPtr(true), pointer.BoolPtr(false), nil, nil),
+	)
+
 	table.DescribeTable("it should", func(given []v1.Volume, expected []v1.Volume) {
 		vmi.Spec.Volumes = given
 		vmiSpec, _ := getVMISpecMetaFromResponse()
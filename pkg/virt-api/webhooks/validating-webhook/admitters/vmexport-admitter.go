@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMExportAdmitter validates VirtualMachineExports
+type VMExportAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMExportAdmitter creates a VMExportAdmitter
+func NewVMExportAdmitter(config *virtconfig.ClusterConfig) *VMExportAdmitter {
+	return &VMExportAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMExportAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != exportv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != exportv1.PluralResourceName {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == v1beta1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	vmExport := &exportv1.VirtualMachineExport{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, vmExport)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case v1beta1.Create:
+		causes = validateVMExportSpec(k8sfield.NewPath("spec"), &vmExport.Spec)
+
+	case v1beta1.Update:
+		prevObj := &exportv1.VirtualMachineExport{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !reflect.DeepEqual(prevObj.Spec, vmExport.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateVMExportSpec(field *k8sfield.Path, spec *exportv1.VirtualMachineExportSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	sourceField := field.Child("source")
+
+	apiGroup := ""
+	if spec.Source.APIGroup != nil {
+		apiGroup = *spec.Source.APIGroup
+	}
+
+	switch {
+	case apiGroup == "" && spec.Source.Kind == "PersistentVolumeClaim":
+	case apiGroup == v1.GroupName && spec.Source.Kind == "VirtualMachine":
+	case apiGroup == snapshotv1.SchemeGroupVersion.Group && spec.Source.Kind == "VirtualMachineSnapshot":
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("unsupported source %s of apiGroup %q", spec.Source.Kind, apiGroup),
+			Field:   sourceField.Child("kind").String(),
+		})
+	}
+
+	if spec.Source.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing source name",
+			Field:   sourceField.Child("name").String(),
+		})
+	}
+
+	if spec.TokenSecretRef != nil && *spec.TokenSecretRef == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "tokenSecretRef must not be empty",
+			Field:   field.Child("tokenSecretRef").String(),
+		})
+	}
+
+	if spec.TTLDuration != nil && spec.TTLDuration.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "ttlDuration must be greater than zero",
+			Field:   field.Child("ttlDuration").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineExport Admitter", func() {
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	newExport := func(apiGroup *string, kind string) *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: apiGroup,
+					Kind:     kind,
+					Name:     "source",
+				},
+			},
+		}
+	}
+
+	strPtr := func(s string) *string {
+		return &s
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createExportAdmissionReview(newExport(nil, "PersistentVolumeClaim"), nil, v1beta1.Create)
+			resp := NewVMExportAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: "Snapshot"},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := createExportAdmissionReview(newExport(nil, "PersistentVolumeClaim"), nil, v1beta1.Create)
+			ar.Request.Resource.Resource = "virtualmachinesnapshots"
+
+			resp := NewVMExportAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		table.DescribeTable("should accept a supported source", func(apiGroup *string, kind string) {
+			vmExport := newExport(apiGroup, kind)
+			vmExport.Spec.TTLDuration = &metav1.Duration{Duration: time.Hour}
+
+			resp := NewVMExportAdmitter(config).Admit(createExportAdmissionReview(vmExport, nil, v1beta1.Create))
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			table.Entry("PersistentVolumeClaim", nil, "PersistentVolumeClaim"),
+			table.Entry("PersistentVolumeClaim with empty apiGroup", strPtr(""), "PersistentVolumeClaim"),
+			table.Entry("VirtualMachine", strPtr("kubevirt.io"), "VirtualMachine"),
+			table.Entry("VirtualMachineSnapshot", strPtr("snapshot.kubevirt.io"), "VirtualMachineSnapshot"),
+		)
+
+		table.DescribeTable("should reject an invalid export", func(mutate func(*exportv1.VirtualMachineExport), field string) {
+			vmExport := newExport(strPtr("kubevirt.io"), "VirtualMachine")
+			mutate(vmExport)
+
+			resp := NewVMExportAdmitter(config).Admit(createExportAdmissionReview(vmExport, nil, v1beta1.Create))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("with an unsupported kind", func(e *exportv1.VirtualMachineExport) {
+				e.Spec.Source.Kind = "VirtualMachineInstance"
+			}, "spec.source.kind"),
+			table.Entry("with a mismatched apiGroup", func(e *exportv1.VirtualMachineExport) {
+				e.Spec.Source.APIGroup = strPtr("snapshot.kubevirt.io")
+			}, "spec.source.kind"),
+			table.Entry("without a source name", func(e *exportv1.VirtualMachineExport) {
+				e.Spec.Source.Name = ""
+			}, "spec.source.name"),
+			table.Entry("with an empty tokenSecretRef", func(e *exportv1.VirtualMachineExport) {
+				e.Spec.TokenSecretRef = strPtr("")
+			}, "spec.tokenSecretRef"),
+			table.Entry("with a non positive ttlDuration", func(e *exportv1.VirtualMachineExport) {
+				e.Spec.TTLDuration = &metav1.Duration{}
+			}, "spec.ttlDuration"),
+		)
+
+		It("should allow a metadata update", func() {
+			oldExport := newExport(nil, "PersistentVolumeClaim")
+			vmExport := oldExport.DeepCopy()
+			vmExport.Labels = map[string]string{"foo": "bar"}
+
+			resp := NewVMExportAdmitter(config).Admit(createExportAdmissionReview(vmExport, oldExport, v1beta1.Update))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a spec update", func() {
+			oldExport := newExport(nil, "PersistentVolumeClaim")
+			vmExport := oldExport.DeepCopy()
+			vmExport.Spec.Source.Name = "other"
+
+			resp := NewVMExportAdmitter(config).Admit(createExportAdmissionReview(vmExport, oldExport, v1beta1.Update))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createExportAdmissionReview(vmExport, oldExport *exportv1.VirtualMachineExport, operation v1beta1.Operation) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(vmExport)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "export.kubevirt.io",
+				Resource: "virtualmachineexports",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	if oldExport != nil {
+		oldBytes, _ := json.Marshal(oldExport)
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
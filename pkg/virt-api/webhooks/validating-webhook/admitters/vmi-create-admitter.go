@@ -36,6 +36,7 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -58,6 +59,10 @@ const (
 	maxDNSSearchListChars = 256
 )
 
+// passtBinaryShipped stays false until the virt-launcher image contains the passt binary,
+// virt-launcher can't start an interface with the passt binding without it
+var passtBinaryShipped = false
+
 var validInterfaceModels = map[string]*struct{}{"e1000": nil, "e1000e": nil, "ne2k_pci": nil, "pcnet": nil, "rtl8139": nil, "virtio": nil}
 var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.IOThreadsPolicyAuto}
 var validCPUFeaturePolicies = map[string]*struct{}{"": nil, "force": nil, "require": nil, "optional": nil, "disable": nil, "forbid": nil}
@@ -95,6 +100,7 @@ func (admitter *VMICreateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 	causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)
 	causes = append(causes, ValidateVirtualMachineInstanceMandatoryFields(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, accountName)...)
+	causes = append(causes, validatePersistentStateOwner(k8sfield.NewPath("metadata"), vmi)...)
 	// In a future, yet undecided, release either libvirt or QEMU are going to check the hyperv dependencies, so we can get rid of this code.
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHypervFeatureDependencies(k8sfield.NewPath("spec"), &vmi.Spec)...)
 
@@ -128,6 +134,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMemoryLimitsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateHugepagesMemoryRequests(field, spec)...)
 	causes = append(causes, validateGuestMemoryLimit(field, spec)...)
+	causes = append(causes, validateMaxGuestMemory(field, spec)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareSerial(field, spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
@@ -135,6 +142,9 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
 	causes = append(causes, validateCpuPinning(field, spec)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
+	causes = append(causes, validateCPUMaxSockets(field, spec)...)
+	causes = append(causes, validatePersistentState(field, spec, config)...)
+	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
 
 	maxNumberOfInterfacesExceeded := len(spec.Domain.Devices.Interfaces) > arrayLenMax
@@ -228,6 +238,7 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field, iface, idx)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -260,6 +271,40 @@ func validateInterfaceNetworkBasics(field *k8sfield.Path, networkExists bool, id
 		causes = appendStatusCauseForMacvtapFeatureGateNotEnabled(field, causes, idx)
 	} else if iface.InterfaceBindingMethod.Macvtap != nil && networkData.NetworkSource.Multus == nil {
 		causes = appendStatusCauseForMacvtapOnlyAllowedWithMultus(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.Passt != nil && !config.PasstEnabled() {
+		causes = appendStatusCauseForPasstFeatureGateNotEnabled(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.Passt != nil && networkData.NetworkSource.Pod == nil {
+		causes = appendStatusCauseForPasstWithoutPodNetwork(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.Passt != nil && iface.Model != "" && iface.Model != "virtio" {
+		causes = appendStatusCauseForPasstWithoutVirtioModel(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.Passt != nil && !passtBinaryShipped {
+		causes = appendStatusCauseForPasstNotShipped(field, causes, idx)
+	} else if iface.Binding != nil {
+		causes = append(causes, validateInterfaceBindingPlugin(field, iface, idx, config)...)
+	}
+	return causes
+}
+
+func validateInterfaceBindingPlugin(field *k8sfield.Path, iface v1.Interface, idx int, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	bindingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("binding")
+	if !config.NetworkBindingPluginsEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "NetworkBindingPlugins feature gate is not enabled",
+			Field:   bindingField.String(),
+		})
+	} else if iface.InterfaceBindingMethod != (v1.InterfaceBindingMethod{}) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "binding plugin can't be combined with another binding method",
+			Field:   bindingField.String(),
+		})
+	} else if _, exists := config.GetNetworkBindings()[iface.Binding.Name]; !exists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("binding plugin %s is not registered", iface.Binding.Name),
+			Field:   bindingField.Child("name").String(),
+		})
 	}
 	return causes
 }
@@ -388,6 +433,57 @@ func validateInterfaceModel(field *k8sfield.Path, iface v1.Interface, idx int) (
 	return causes
 }
 
+func validateInterfaceBandwidth(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Bandwidth == nil {
+		return nil
+	}
+	bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+	if iface.Bridge == nil && iface.Masquerade == nil && iface.Macvtap == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "bandwidth is only supported with the bridge, masquerade and macvtap bindings",
+			Field:   bandwidthField.String(),
+		})
+	}
+
+	minimum := resource.MustParse("1Ki")
+	limits := []struct {
+		name  string
+		limit *v1.BandwidthLimit
+	}{
+		{"inbound", iface.Bandwidth.Inbound},
+		{"outbound", iface.Bandwidth.Outbound},
+	}
+	for _, l := range limits {
+		if l.limit == nil {
+			continue
+		}
+		limitField := bandwidthField.Child(l.name)
+		if l.limit.Average.Cmp(minimum) < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be at least %s", limitField.Child("average").String(), minimum.String()),
+				Field:   limitField.Child("average").String(),
+			})
+		}
+		if l.limit.Peak != nil && l.limit.Peak.Cmp(l.limit.Average) < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be lower than the average rate", limitField.Child("peak").String()),
+				Field:   limitField.Child("peak").String(),
+			})
+		}
+		if l.limit.Burst != nil && l.limit.Burst.Cmp(minimum) < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be at least %s", limitField.Child("burst").String(), minimum.String()),
+				Field:   limitField.Child("burst").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validatePortConfiguration(field *k8sfield.Path, networkExists bool, networkData *v1.Network, iface v1.Interface, idx int, portForwardMap map[string]struct{}) (causes []metav1.StatusCause) {
 
 	// Check only ports configured on interfaces connected to a pod network
@@ -480,6 +576,42 @@ func appendStatusCauseForMacvtapFeatureGateNotEnabled(field *k8sfield.Path, caus
 	return causes
 }
 
+func appendStatusCauseForPasstFeatureGateNotEnabled(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	causes = append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "Passt feature gate is not enabled",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+	})
+	return causes
+}
+
+func appendStatusCauseForPasstWithoutPodNetwork(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	causes = append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "Passt interface only implemented with pod network",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+	})
+	return causes
+}
+
+func appendStatusCauseForPasstWithoutVirtioModel(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	causes = append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "Passt interface only implemented with virtio model",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+	})
+	return causes
+}
+
+func appendStatusCauseForPasstNotShipped(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	causes = append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: "Passt interface is not supported yet, the virt-launcher image does not provide passt",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+	})
+	return causes
+}
+
 func appendStatusCauseForBridgeNotEnabled(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
 	causes = append(causes, metav1.StatusCause{
 		Type:    metav1.CauseTypeFieldValueInvalid,
@@ -885,6 +1017,24 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 			})
 		}
 
+		// Verify Sysprep volumes are only mapped to CD-ROMs, Windows Setup only looks for answer files on removable media.
+		if disk.CDRom == nil && volumeExists && matchingVolume.Sysprep != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a cdrom to be mapped to a Sysprep volume.", field.Child("domain", "devices", "disks").Index(idx).String()),
+				Field:   field.Child("domain", "devices", "disks").Index(idx).String(),
+			})
+		}
+
+		// Verify DownwardMetrics volumes are only mapped to disks, the guest reads the metrics from a block device.
+		if (disk.CDRom != nil || disk.LUN != nil || disk.Floppy != nil) && volumeExists && matchingVolume.DownwardMetrics != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a disk to be mapped to a DownwardMetrics volume.", field.Child("domain", "devices", "disks").Index(idx).String()),
+				Field:   field.Child("domain", "devices", "disks").Index(idx).String(),
+			})
+		}
+
 		// verify that there are no duplicate boot orders
 		if disk.BootOrder != nil {
 			order := *disk.BootOrder
@@ -951,6 +1101,78 @@ func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInst
 	return causes
 }
 
+func validateCPUMaxSockets(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	cpu := spec.Domain.CPU
+	if cpu == nil || cpu.MaxSockets == 0 {
+		return causes
+	}
+	if cpu.MaxSockets < cpu.Sockets {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater or equal to %s", field.Child("domain", "cpu", "maxSockets").String(), field.Child("domain", "cpu", "sockets").String()),
+			Field:   field.Child("domain", "cpu", "maxSockets").String(),
+		})
+	}
+	if cpu.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can't be set in combination with DedicatedCPUPlacement", field.Child("domain", "cpu", "maxSockets").String()),
+			Field:   field.Child("domain", "cpu", "maxSockets").String(),
+		})
+	}
+	return causes
+}
+
+func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if config.VMPersistentStateEnabled() {
+		return causes
+	}
+	if kubevirttypes.HasPersistentEFI(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "VMPersistentState feature gate is not enabled",
+			Field:   field.Child("domain", "firmware", "bootloader", "efi", "persistent").String(),
+		})
+	}
+	if kubevirttypes.HasPersistentTPM(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "VMPersistentState feature gate is not enabled",
+			Field:   field.Child("domain", "devices", "tpm", "persistent").String(),
+		})
+	}
+	return causes
+}
+
+func validateVSOCK(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	autoattachVSOCK := spec.Domain.Devices.AutoattachVSOCK
+	if autoattachVSOCK != nil && *autoattachVSOCK && !config.VSOCKEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "VSOCK feature gate is not enabled",
+			Field:   field.Child("domain", "devices", "autoattachVSOCK").String(),
+		})
+	}
+	return causes
+}
+
+// validatePersistentStateOwner ensures that a VMI with persistent state is owned by a VM,
+// which owns the PVC holding the state
+func validatePersistentStateOwner(field *k8sfield.Path, vmi *v1.VirtualMachineInstance) (causes []metav1.StatusCause) {
+	if !kubevirttypes.HasPersistentState(&vmi.Spec) {
+		return causes
+	}
+	owner := metav1.GetControllerOf(vmi)
+	if owner == nil || owner.Kind != v1.VirtualMachineGroupVersionKind.Kind {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "persistent state is only supported for VirtualMachineInstances owned by a VirtualMachine",
+			Field:   field.Child("ownerReferences").String(),
+		})
+	}
+	return causes
+}
+
 func validateCpuPinning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.CPU != nil && spec.Domain.CPU.DedicatedCPUPlacement {
 		causes = append(causes, validateMemoryLimitAndRequestProvided(field, spec)...)
@@ -1169,6 +1391,71 @@ func validateGuestMemoryLimit(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 	return causes
 }
 
+func validateMaxGuestMemory(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.Memory == nil || spec.Domain.Memory.MaxGuest == nil {
+		return causes
+	}
+	maxGuestField := field.Child("domain", "memory", "maxGuest")
+	maxGuest := spec.Domain.Memory.MaxGuest
+	if spec.Domain.Memory.Guest == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s requires %s to be set", maxGuestField.String(), field.Child("domain", "memory", "guest").String()),
+			Field:   maxGuestField.String(),
+		})
+	}
+	if maxGuest.Cmp(*spec.Domain.Memory.Guest) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be equal to or larger than %s '%s'",
+				maxGuestField.String(),
+				maxGuest,
+				field.Child("domain", "memory", "guest").String(),
+				spec.Domain.Memory.Guest,
+			),
+			Field: maxGuestField.String(),
+		})
+	}
+	if limits, ok := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok && maxGuest.Cmp(limits) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be equal to or less than the memory limit %s '%s'",
+				maxGuestField.String(),
+				maxGuest,
+				field.Child("domain", "resources", "limits", "memory").String(),
+				limits.String(),
+			),
+			Field: maxGuestField.String(),
+		})
+	}
+
+	// Memory is plugged into the virtio-mem device in blocks of the page size backing the guest
+	blockSize := resource.MustParse("2Mi")
+	if spec.Domain.Memory.Hugepages != nil {
+		pageSize, err := resource.ParseQuantity(spec.Domain.Memory.Hugepages.PageSize)
+		if err != nil {
+			return causes
+		}
+		blockSize = pageSize
+	}
+	for _, memory := range []struct {
+		field    *k8sfield.Path
+		quantity *resource.Quantity
+	}{
+		{field.Child("domain", "memory", "guest"), spec.Domain.Memory.Guest},
+		{maxGuestField, maxGuest},
+	} {
+		if memory.quantity.Value()%blockSize.Value() != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' must be a multiple of %s to be hotpluggable", memory.field.String(), memory.quantity, blockSize.String()),
+				Field:   memory.field.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateHugepagesMemoryRequests(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.Memory != nil && spec.Domain.Memory.Hugepages != nil {
 		hugepagesSize, err := resource.ParseQuantity(spec.Domain.Memory.Hugepages.PageSize)
@@ -1647,8 +1934,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		return causes
 	}
 
-	// check that we have max 1 serviceAccount volume
+	// check that we have max 1 serviceAccount and downwardMetrics volume
 	serviceAccountVolumeCount := 0
+	downwardMetricsVolumeCount := 0
 
 	for idx, volume := range volumes {
 		// verify name is unique
@@ -1717,6 +2005,16 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			volumeSourceSetCount++
 			serviceAccountVolumeCount++
 		}
+		if volume.MemoryDump != nil {
+			volumeSourceSetCount++
+		}
+		if volume.Sysprep != nil {
+			volumeSourceSetCount++
+		}
+		if volume.DownwardMetrics != nil {
+			volumeSourceSetCount++
+			downwardMetricsVolumeCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -1834,6 +2132,14 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			}
 		}
 
+		if volume.DownwardMetrics != nil && !config.DownwardMetricsEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DownwardMetrics feature gate is not enabled",
+				Field:   field.Index(idx).String(),
+			})
+		}
+
 		// validate HostDisk data
 		if hostDisk := volume.HostDisk; hostDisk != nil {
 			if !config.HostDiskEnabled() {
@@ -1889,6 +2195,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			}
 		}
 
+		if volume.Sysprep != nil {
+			causes = append(causes, validateSysprepVolume(field.Index(idx).Child("sysprep"), volume.Sysprep)...)
+		}
+
 		if volume.ServiceAccount != nil {
 			if volume.ServiceAccount.ServiceAccountName == "" {
 				causes = append(causes, metav1.StatusCause{
@@ -1908,6 +2218,40 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		})
 	}
 
+	if downwardMetricsVolumeCount > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have max one downwardMetrics volume set", field.String()),
+			Field:   field.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateSysprepVolume(field *k8sfield.Path, sysprep *v1.SysprepSource) (causes []metav1.StatusCause) {
+	if (sysprep.ConfigMap == nil) == (sysprep.Secret == nil) {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one of configMap or secret set", field.String()),
+			Field:   field.String(),
+		})
+	}
+
+	if sysprep.ConfigMap != nil && sysprep.ConfigMap.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is a required field", field.Child("configMap", "name").String()),
+			Field:   field.Child("configMap", "name").String(),
+		})
+	}
+	if sysprep.Secret != nil && sysprep.Secret.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is a required field", field.Child("secret", "name").String()),
+			Field:   field.Child("secret", "name").String(),
+		})
+	}
 	return causes
 }
 
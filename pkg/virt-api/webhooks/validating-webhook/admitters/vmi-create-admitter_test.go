@@ -848,6 +848,40 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(BeEmpty())
 		})
+		table.DescribeTable("should validate the maximum guest memory", func(guest, maxGuest *resource.Quantity, expectedField string) {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+			}
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: guest, MaxGuest: maxGuest}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			table.Entry("should allow max guest memory larger than guest memory", resource.NewQuantity(128*1024*1024, resource.BinarySI), resource.NewQuantity(1024*1024*1024, resource.BinarySI), ""),
+			table.Entry("should reject max guest memory without guest memory", nil, resource.NewQuantity(1024*1024*1024, resource.BinarySI), "fake.domain.memory.maxGuest"),
+			table.Entry("should reject max guest memory smaller than guest memory", resource.NewQuantity(128*1024*1024, resource.BinarySI), resource.NewQuantity(64*1024*1024, resource.BinarySI), "fake.domain.memory.maxGuest"),
+			table.Entry("should reject max guest memory which is not a multiple of the block size", resource.NewQuantity(128*1024*1024, resource.BinarySI), resource.NewQuantity(1024*1024*1024+1024, resource.BinarySI), "fake.domain.memory.maxGuest"),
+		)
+		It("should reject max guest memory bigger than the memory limit", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			guestMemory := resource.MustParse("64Mi")
+			maxGuestMemory := resource.MustParse("256Mi")
+
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("128Mi"),
+			}
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestMemory}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.memory.maxGuest"))
+		})
 		It("should reject not divisable by hugepages.size requests.memory", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 
@@ -935,6 +969,56 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					},
 				}, 0),
 		)
+		table.DescribeTable("should verify Sysprep volume is mapped to a CD-ROM",
+			func(diskDevice v1.DiskDevice, expectedErrors int) {
+				vmi := v1.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:       "sysprep",
+					DiskDevice: diskDevice,
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "sysprep",
+					VolumeSource: v1.VolumeSource{
+						Sysprep: &v1.SysprepSource{
+							ConfigMap: &k8sv1.LocalObjectReference{Name: "sysprep-config"},
+						},
+					},
+				})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(expectedErrors))
+				if expectedErrors > 0 {
+					Expect(causes[len(causes)-1].Field).To(Equal("fake.domain.devices.disks[0]"))
+				}
+			},
+			table.Entry("and accept a CD-ROM", v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, 0),
+			table.Entry("and reject a disk", v1.DiskDevice{Disk: &v1.DiskTarget{}}, 1),
+			table.Entry("and reject a LUN", v1.DiskDevice{LUN: &v1.LunTarget{}}, 2),
+		)
+		table.DescribeTable("should verify DownwardMetrics volume is mapped to a disk",
+			func(diskDevice v1.DiskDevice, expectedErrors int) {
+				enableFeatureGate(virtconfig.DownwardMetricsGate)
+				vmi := v1.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:       "metrics",
+					DiskDevice: diskDevice,
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "metrics",
+					VolumeSource: v1.VolumeSource{
+						DownwardMetrics: &v1.DownwardMetricsVolumeSource{},
+					},
+				})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(expectedErrors))
+				if expectedErrors > 0 {
+					Expect(causes[len(causes)-1].Field).To(Equal("fake.domain.devices.disks[0]"))
+				}
+			},
+			table.Entry("and accept a disk", v1.DiskDevice{Disk: &v1.DiskTarget{}}, 0),
+			table.Entry("and reject a CD-ROM", v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, 1),
+		)
 		It("should accept a single interface and network", func() {
 			vm := v1.NewMinimalVMI("testvm")
 			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -1589,6 +1673,126 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
 			Expect(causes).To(HaveLen(0))
 		})
+		table.DescribeTable("should validate the binding plugin of an interface", func(enableGate bool, bindingMethod v1.InterfaceBindingMethod, bindingName string, expectedField, expectedMessage string) {
+			kvConfig := kv.DeepCopy()
+			if enableGate {
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.NetworkBindingPlugins}
+			}
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					"vhostuser": {SidecarImage: "vhostuser-binding:v1"},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: bindingMethod,
+				Binding:                &v1.PluginBinding{Name: bindingName},
+			}}
+			vm.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			}
+		},
+			table.Entry("accept a registered binding plugin", true, v1.InterfaceBindingMethod{}, "vhostuser", "", ""),
+			table.Entry("reject a binding plugin when the feature gate is disabled", false, v1.InterfaceBindingMethod{}, "vhostuser",
+				"fake.domain.devices.interfaces[0].binding", "NetworkBindingPlugins feature gate is not enabled"),
+			table.Entry("reject a binding plugin combined with another binding method", true, v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}, "vhostuser",
+				"fake.domain.devices.interfaces[0].binding", "binding plugin can't be combined with another binding method"),
+			table.Entry("reject a binding plugin which is not registered", true, v1.InterfaceBindingMethod{}, "passt",
+				"fake.domain.devices.interfaces[0].binding.name", "binding plugin passt is not registered"),
+		)
+		table.DescribeTable("should validate a passt interface", func(enableGate bool, model string, network v1.Network, expectedField, expectedMessage string) {
+			if enableGate {
+				enableFeatureGate(virtconfig.PasstGate)
+			}
+			passtBinaryShipped = true
+			defer func() { passtBinaryShipped = false }()
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				Model:                  model,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}},
+			}}
+			vm.Spec.Networks = []v1.Network{network}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			}
+		},
+			table.Entry("accept a passt interface on the pod network", true, "", *v1.DefaultPodNetwork(), "", ""),
+			table.Entry("accept a passt interface with the virtio model", true, "virtio", *v1.DefaultPodNetwork(), "", ""),
+			table.Entry("reject a passt interface when the feature gate is disabled", false, "", *v1.DefaultPodNetwork(),
+				"fake.domain.devices.interfaces[0].name", "Passt feature gate is not enabled"),
+			table.Entry("reject a passt interface on a multus network", true, "", v1.Network{Name: "default", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}}},
+				"fake.domain.devices.interfaces[0].name", "Passt interface only implemented with pod network"),
+			table.Entry("reject a passt interface with another model than virtio", true, "e1000", *v1.DefaultPodNetwork(),
+				"fake.domain.devices.interfaces[0].model", "Passt interface only implemented with virtio model"),
+		)
+		It("should reject a passt interface as long as the virt-launcher image does not provide passt", func() {
+			enableFeatureGate(virtconfig.PasstGate)
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}},
+			}}
+			vm.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].name"))
+		})
+		table.DescribeTable("should validate the bandwidth of an interface", func(bindingMethod v1.InterfaceBindingMethod, bandwidth *v1.InterfaceBandwidth, expectedField, expectedMessage string) {
+			enableSlirpInterface()
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: bindingMethod,
+				Bandwidth:              bandwidth,
+			}}
+			vm.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			}
+		},
+			table.Entry("accept limits on a masquerade interface", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{
+					Inbound:  &v1.BandwidthLimit{Average: resource.MustParse("1Mi"), Peak: resourcePtr("2Mi"), Burst: resourcePtr("1Mi")},
+					Outbound: &v1.BandwidthLimit{Average: resource.MustParse("1Ki")},
+				}, "", ""),
+			table.Entry("reject limits on a slirp interface", v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")}},
+				"fake.domain.devices.interfaces[0].bandwidth", "bandwidth is only supported with the bridge, masquerade and macvtap bindings"),
+			table.Entry("reject an average rate below 1Ki", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: resource.MustParse("1000")}},
+				"fake.domain.devices.interfaces[0].bandwidth.outbound.average", "fake.domain.devices.interfaces[0].bandwidth.outbound.average must be at least 1Ki"),
+			table.Entry("reject a peak rate below the average rate", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: resource.MustParse("2Mi"), Peak: resourcePtr("1Mi")}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.peak", "fake.domain.devices.interfaces[0].bandwidth.inbound.peak must not be lower than the average rate"),
+			table.Entry("reject a burst below 1Ki", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi"), Burst: resourcePtr("100")}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.burst", "fake.domain.devices.interfaces[0].bandwidth.inbound.burst must be at least 1Ki"),
+		)
 		It("should reject port out of range", func() {
 			enableSlirpInterface()
 			vm := v1.NewMinimalVMI("testvm")
@@ -2133,6 +2337,98 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				[]string{fmt.Sprintf("must provide `dnsConfig` when `dnsPolicy` is %s", k8sv1.DNSNone)}),
 		)
 	})
+	Context("with vsock", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.AutoattachVSOCK = pointer.BoolPtr(true)
+		})
+
+		It("should reject specs if the feature gate is disabled", func() {
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.autoattachVSOCK"))
+		})
+
+		It("should accept specs if the feature gate is enabled", func() {
+			enableFeatureGate(virtconfig.VSOCKGate)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+	})
+
+	Context("with persistent state", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: pointer.BoolPtr(false),
+						Persistent: pointer.BoolPtr(true),
+					},
+				},
+			}
+		})
+
+		admit := func() *v1beta1.AdmissionResponse {
+			vmiBytes, _ := json.Marshal(&vmi)
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: vmiBytes,
+					},
+				},
+			}
+			return vmiCreateAdmitter.Admit(ar)
+		}
+
+		It("should reject persistent EFI if the feature gate is disabled", func() {
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.firmware.bootloader.efi.persistent"))
+			Expect(causes[0].Message).To(Equal("VMPersistentState feature gate is not enabled"))
+		})
+
+		It("should reject persistent TPM if the feature gate is disabled", func() {
+			vmi.Spec.Domain.Firmware = nil
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: pointer.BoolPtr(true)}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.tpm.persistent"))
+		})
+
+		It("should accept non persistent TPM if the feature gate is disabled", func() {
+			vmi.Spec.Domain.Firmware = nil
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should accept specs if the feature gate is enabled", func() {
+			enableFeatureGate(virtconfig.VMPersistentState)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject VMIs which are not owned by a VirtualMachine", func() {
+			enableFeatureGate(virtconfig.VMPersistentState)
+			resp := admit()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.ownerReferences"))
+		})
+
+		It("should accept VMIs owned by a VirtualMachine", func() {
+			enableFeatureGate(virtconfig.VMPersistentState)
+			vm := &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", UID: "1234"}}
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+			resp := admit()
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+
 	Context("with cpu pinning", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -2154,6 +2450,26 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(len(causes)).To(Equal(1))
 			Expect(causes[0].Field).To(Equal("fake.domain.cpu.isolateEmulatorThread"))
 		})
+		It("should reject specs with MaxSockets and DedicatedCPUPlacement set", func() {
+			vmi.Spec.Domain.CPU.MaxSockets = 4
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("1"),
+				k8sv1.ResourceMemory: resource.MustParse("64M"),
+			}
+			vmi.Spec.Domain.Resources.Requests = vmi.Spec.Domain.Resources.Limits
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.maxSockets"))
+		})
+		It("should reject specs with MaxSockets lower than Sockets", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Sockets:    4,
+				MaxSockets: 2,
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.maxSockets"))
+		})
 		It("should reject specs without inconsistent cpu reqirements", func() {
 			vmi.Spec.Domain.CPU.Cores = 4
 			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
@@ -2889,6 +3205,71 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		It("should reject DownwardMetrics volumes if the feature gate is not enabled", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "metrics",
+				VolumeSource: v1.VolumeSource{
+					DownwardMetrics: &v1.DownwardMetricsVolumeSource{},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("DownwardMetrics feature gate is not enabled"))
+		})
+
+		It("should reject more than one DownwardMetrics volume", func() {
+			enableFeatureGate(virtconfig.DownwardMetricsGate)
+			vmi := v1.NewMinimalVMI("testvmi")
+			for _, name := range []string{"metrics1", "metrics2"} {
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: name,
+					VolumeSource: v1.VolumeSource{
+						DownwardMetrics: &v1.DownwardMetricsVolumeSource{},
+					},
+				})
+			}
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("fake must have max one downwardMetrics volume set"))
+		})
+
+		table.DescribeTable("should validate Sysprep volumes", func(sysprep *v1.SysprepSource, expectedField string) {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "sysprep",
+				VolumeSource: v1.VolumeSource{
+					Sysprep: sysprep,
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			table.Entry("and accept a ConfigMap",
+				&v1.SysprepSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "sysprep-config"}}, ""),
+			table.Entry("and accept a Secret",
+				&v1.SysprepSource{Secret: &k8sv1.LocalObjectReference{Name: "sysprep-secret"}}, ""),
+			table.Entry("and reject no source",
+				&v1.SysprepSource{}, "fake[0].sysprep"),
+			table.Entry("and reject both a ConfigMap and a Secret",
+				&v1.SysprepSource{
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "sysprep-config"},
+					Secret:    &k8sv1.LocalObjectReference{Name: "sysprep-secret"},
+				}, "fake[0].sysprep"),
+			table.Entry("and reject a ConfigMap without name",
+				&v1.SysprepSource{ConfigMap: &k8sv1.LocalObjectReference{}}, "fake[0].sysprep.configMap.name"),
+			table.Entry("and reject a Secret without name",
+				&v1.SysprepSource{Secret: &k8sv1.LocalObjectReference{}}, "fake[0].sysprep.secret.name"),
+		)
+
 		It("should reject CloudInitNoCloud volume if either userData or networkData is missing", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -3371,3 +3752,8 @@ var _ = Describe("Function getNumberOfPodInterfaces()", func() {
 	})
 
 })
+
+func resourcePtr(value string) *resource.Quantity {
+	quantity := resource.MustParse(value)
+	return &quantity
+}
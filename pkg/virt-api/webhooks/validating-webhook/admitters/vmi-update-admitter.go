@@ -24,6 +24,7 @@ import (
 	"reflect"
 
 	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -31,6 +32,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -53,6 +55,18 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 	if !reflect.DeepEqual(newVMI.Spec, oldVMI.Spec) {
 		// Only allow the KubeVirt SA to modify the VMI spec, since that means it went through the sub resource.
 		if webhooks.IsKubeVirtServiceAccount(ar.Request.UserInfo.Username) {
+			if cpuResponse := admitCPUHotplug(newVMI, oldVMI); cpuResponse != nil {
+				return cpuResponse
+			}
+			if memoryResponse := admitMemoryHotplug(newVMI, oldVMI); memoryResponse != nil {
+				return memoryResponse
+			}
+			if bandwidthResponse := admitInterfaceBandwidthUpdate(newVMI, oldVMI); bandwidthResponse != nil {
+				return bandwidthResponse
+			}
+			if interfaceResponse := admitInterfaceHotplug(newVMI, oldVMI, admitter.ClusterConfig); interfaceResponse != nil {
+				return interfaceResponse
+			}
 			hotplugResponse := admitHotplug(newVMI.Spec.Volumes, oldVMI.Spec.Volumes, newVMI.Spec.Domain.Devices.Disks, oldVMI.Spec.Domain.Devices.Disks, oldVMI.Status.VolumeStatus, newVMI, admitter.ClusterConfig)
 			if hotplugResponse != nil {
 				return hotplugResponse
@@ -76,9 +90,211 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 	return &reviewResponse
 }
 
+// admitCPUHotplug ensures that only the sockets of the VMI changed, within the maximum number of sockets.
+func admitCPUHotplug(newVMI, oldVMI *v1.VirtualMachineInstance) *v1beta1.AdmissionResponse {
+	newCPU, oldCPU := newVMI.Spec.Domain.CPU, oldVMI.Spec.Domain.CPU
+	if reflect.DeepEqual(newCPU, oldCPU) {
+		return nil
+	}
+	if newCPU == nil || oldCPU == nil || oldCPU.MaxSockets == 0 {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "CPU hotplug requires maxSockets to be set",
+				Field:   k8sfield.NewPath("spec", "domain", "cpu").String(),
+			},
+		})
+	}
+	oldCPUWithNewSockets := oldCPU.DeepCopy()
+	oldCPUWithNewSockets.Sockets = newCPU.Sockets
+	if !reflect.DeepEqual(newCPU, oldCPUWithNewSockets) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "only the CPU sockets of a running VMI can be changed",
+				Field:   k8sfield.NewPath("spec", "domain", "cpu").String(),
+			},
+		})
+	}
+	if newCPU.Sockets == 0 || newCPU.Sockets > oldCPU.MaxSockets {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU sockets must be between 1 and maxSockets %d", oldCPU.MaxSockets),
+				Field:   k8sfield.NewPath("spec", "domain", "cpu", "sockets").String(),
+			},
+		})
+	}
+	return nil
+}
+
+// admitMemoryHotplug ensures that only the guest memory of the VMI changed, within the maximum guest memory.
+func admitMemoryHotplug(newVMI, oldVMI *v1.VirtualMachineInstance) *v1beta1.AdmissionResponse {
+	newMemory, oldMemory := newVMI.Spec.Domain.Memory, oldVMI.Spec.Domain.Memory
+	if equality.Semantic.DeepEqual(newMemory, oldMemory) {
+		return nil
+	}
+	if newMemory == nil || oldMemory == nil || oldMemory.MaxGuest == nil || newMemory.Guest == nil {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "memory hotplug requires maxGuest to be set",
+				Field:   k8sfield.NewPath("spec", "domain", "memory").String(),
+			},
+		})
+	}
+	oldMemoryWithNewGuest := oldMemory.DeepCopy()
+	oldMemoryWithNewGuest.Guest = newMemory.Guest
+	if !equality.Semantic.DeepEqual(newMemory, oldMemoryWithNewGuest) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "only the guest memory of a running VMI can be changed",
+				Field:   k8sfield.NewPath("spec", "domain", "memory").String(),
+			},
+		})
+	}
+	if newMemory.Guest.Cmp(*oldMemory.MaxGuest) > 0 {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("guest memory must not exceed maxGuest %s", oldMemory.MaxGuest.String()),
+				Field:   k8sfield.NewPath("spec", "domain", "memory", "guest").String(),
+			},
+		})
+	}
+	return nil
+}
+
+// admitInterfaceBandwidthUpdate validates the bandwidth of the interfaces whose bandwidth changed,
+// virt-handler applies it to the running VMI.
+func admitInterfaceBandwidthUpdate(newVMI, oldVMI *v1.VirtualMachineInstance) *v1beta1.AdmissionResponse {
+	oldBandwidths := map[string]*v1.InterfaceBandwidth{}
+	for _, iface := range oldVMI.Spec.Domain.Devices.Interfaces {
+		oldBandwidths[iface.Name] = iface.Bandwidth
+	}
+	var causes []metav1.StatusCause
+	for idx, iface := range newVMI.Spec.Domain.Devices.Interfaces {
+		if !equality.Semantic.DeepEqual(iface.Bandwidth, oldBandwidths[iface.Name]) {
+			causes = append(causes, validateInterfaceBandwidth(k8sfield.NewPath("spec"), iface, idx)...)
+		}
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+	return nil
+}
+
+// withoutBandwidth returns copies of the interfaces without their bandwidth, which can be changed on a running VMI
+func withoutBandwidth(interfaces []v1.Interface) []v1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+	stripped := make([]v1.Interface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		iface.Bandwidth = nil
+		stripped = append(stripped, iface)
+	}
+	return stripped
+}
+
 // admitHotplug compares the old and new volumes and disks, and ensures that they match and are valid.
+// admitInterfaceHotplug ensures that only interfaces of secondary multus networks with a bridge binding
+// are added or removed, together with their network, while all other interfaces and networks stay the same.
+func admitInterfaceHotplug(newVMI, oldVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *v1beta1.AdmissionResponse {
+	newInterfaces, oldInterfaces := withoutBandwidth(newVMI.Spec.Domain.Devices.Interfaces), withoutBandwidth(oldVMI.Spec.Domain.Devices.Interfaces)
+	newNetworks, oldNetworks := newVMI.Spec.Networks, oldVMI.Spec.Networks
+	if equality.Semantic.DeepEqual(newInterfaces, oldInterfaces) && equality.Semantic.DeepEqual(newNetworks, oldNetworks) {
+		return nil
+	}
+	if !config.HotplugNICsEnabled() {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s feature gate is not enabled", virtconfig.HotplugNICsGate),
+				Field:   k8sfield.NewPath("spec", "domain", "devices", "interfaces").String(),
+			},
+		})
+	}
+
+	newInterfaceMap, oldInterfaceMap := map[string]v1.Interface{}, map[string]v1.Interface{}
+	for _, iface := range newInterfaces {
+		newInterfaceMap[iface.Name] = iface
+	}
+	for _, iface := range oldInterfaces {
+		oldInterfaceMap[iface.Name] = iface
+	}
+	newNetworkMap, oldNetworkMap := map[string]v1.Network{}, map[string]v1.Network{}
+	for _, network := range newNetworks {
+		newNetworkMap[network.Name] = network
+	}
+	for _, network := range oldNetworks {
+		oldNetworkMap[network.Name] = network
+	}
+
+	// interfaces are paired with their network by name, so checking the names of both covers added and removed pairs
+	names := map[string]bool{}
+	for _, interfaceMap := range []map[string]v1.Interface{newInterfaceMap, oldInterfaceMap} {
+		for name := range interfaceMap {
+			names[name] = true
+		}
+	}
+	for _, networkMap := range []map[string]v1.Network{newNetworkMap, oldNetworkMap} {
+		for name := range networkMap {
+			names[name] = true
+		}
+	}
+
+	for name := range names {
+		newIface, newIfaceExists := newInterfaceMap[name]
+		oldIface, oldIfaceExists := oldInterfaceMap[name]
+		newNetwork, newNetworkExists := newNetworkMap[name]
+		oldNetwork, oldNetworkExists := oldNetworkMap[name]
+
+		if newIfaceExists && oldIfaceExists && newNetworkExists && oldNetworkExists {
+			if !equality.Semantic.DeepEqual(newIface, oldIface) || !equality.Semantic.DeepEqual(newNetwork, oldNetwork) {
+				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+					{
+						Type:    metav1.CauseTypeFieldValueNotSupported,
+						Message: fmt.Sprintf("interface %s of a running VMI can't be changed", name),
+						Field:   k8sfield.NewPath("spec", "domain", "devices", "interfaces").String(),
+					},
+				})
+			}
+			continue
+		}
+
+		// the interface and its network have to be added or removed together
+		var iface v1.Interface
+		var network v1.Network
+		if newIfaceExists && newNetworkExists && !oldIfaceExists && !oldNetworkExists {
+			iface, network = newIface, newNetwork
+		} else if oldIfaceExists && oldNetworkExists && !newIfaceExists && !newNetworkExists {
+			iface, network = oldIface, oldNetwork
+		} else {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %s has to be hotplugged together with its network", name),
+					Field:   k8sfield.NewPath("spec", "networks").String(),
+				},
+			})
+		}
+		if !multus.IsSecondaryNetwork(network) || iface.Bridge == nil {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("interface %s can't be hotplugged, only interfaces of secondary multus networks with a bridge binding can", name),
+					Field:   k8sfield.NewPath("spec", "domain", "devices", "interfaces").String(),
+				},
+			})
+		}
+	}
+	return nil
+}
+
 func admitHotplug(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *v1beta1.AdmissionResponse {
-	if len(newVolumes) != len(newDisks) {
+	if countDiskVolumes(newVolumes) != len(newDisks) {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -124,6 +340,10 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
+			if v.MemoryDump != nil {
+				// memory dump volumes are not backing a disk
+				continue
+			}
 			if _, ok := newDisks[k]; !ok {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
@@ -141,15 +361,18 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 				})
 			}
 		} else {
-			// This is a new volume, ensure that the volume is either DV or PVC
-			if v.DataVolume == nil && v.PersistentVolumeClaim == nil {
+			// This is a new volume, ensure that the volume is either DV, PVC or memory dump
+			if v.DataVolume == nil && v.PersistentVolumeClaim == nil && v.MemoryDump == nil {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
-						Message: fmt.Sprintf("volume %s is not a PVC or DataVolume", k),
+						Message: fmt.Sprintf("volume %s is not a PVC, DataVolume or MemoryDump", k),
 					},
 				})
 			}
+			if v.MemoryDump != nil {
+				continue
+			}
 			// Also ensure the matching new disk exists and is of type scsi
 			if _, ok := newDisks[k]; !ok {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -216,6 +439,17 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// countDiskVolumes counts the volumes which are expected to have a matching disk
+func countDiskVolumes(volumes []v1.Volume) int {
+	count := 0
+	for _, volume := range volumes {
+		if volume.MemoryDump == nil {
+			count++
+		}
+	}
+	return count
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
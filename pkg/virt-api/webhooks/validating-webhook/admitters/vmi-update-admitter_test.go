@@ -30,6 +30,8 @@ import (
 	"github.com/onsi/gomega/types"
 	"k8s.io/api/admission/v1beta1"
 	authv1 "k8s.io/api/authentication/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -37,6 +39,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/rbac"
 )
 
@@ -310,6 +313,40 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeVolumesWithMemoryDumpVol := func(total int, indexes ...int) []v1.Volume {
+		res := make([]v1.Volume, 0)
+		for i := 0; i < total; i++ {
+			memoryDump := false
+			for _, index := range indexes {
+				if i == index {
+					memoryDump = true
+				}
+			}
+			if memoryDump {
+				res = append(res, v1.Volume{
+					Name: fmt.Sprintf("volume-name-%d", i),
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: fmt.Sprintf("volume-name-%d", i),
+							},
+						},
+					},
+				})
+				continue
+			}
+			res = append(res, v1.Volume{
+				Name: fmt.Sprintf("volume-name-%d", i),
+				VolumeSource: v1.VolumeSource{
+					DataVolume: &v1.DataVolumeSource{
+						Name: fmt.Sprintf("dv-name-%d", i),
+					},
+				},
+			})
+		}
+		return res
+	}
+
 	makeDisks := func(indexes ...int) []v1.Disk {
 		res := make([]v1.Disk, 0)
 		for _, index := range indexes {
@@ -462,7 +499,7 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeDisks(0, 1),
 			makeDisks(0),
 			makeStatus(1, 0),
-			makeExpected("volume volume-name-1 is not a PVC or DataVolume", "")),
+			makeExpected("volume volume-name-1 is not a PVC, DataVolume or MemoryDump", "")),
 		table.Entry("Should accept if we add volumes and disk properly",
 			makeVolumes(0, 1),
 			makeVolumes(0, 1),
@@ -470,6 +507,20 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeDisks(0, 1),
 			makeStatus(2, 1),
 			nil),
+		table.Entry("Should accept if we add a memory dump volume without a disk",
+			makeVolumesWithMemoryDumpVol(2, 1),
+			makeVolumes(0),
+			makeDisks(0),
+			makeDisks(0),
+			makeStatus(1, 0),
+			nil),
+		table.Entry("Should accept if a memory dump volume without a disk is kept",
+			makeVolumesWithMemoryDumpVol(2, 1),
+			makeVolumesWithMemoryDumpVol(2, 1),
+			makeDisks(0),
+			makeDisks(0),
+			makeStatus(2, 1),
+			nil),
 		table.Entry("Should reject if we add disk with invalid bus",
 			makeVolumes(0, 1),
 			makeVolumes(0),
@@ -486,6 +537,138 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeExpected("spec.domain.devices.disks[1] must have a boot order > 0, if supplied", "spec.domain.devices.disks[1].bootOrder")),
 	)
 
+	table.DescribeTable("Should admit or reject CPU changes", func(oldCPU, newCPU *v1.CPU, allowed bool) {
+		oldVMI := v1.NewMinimalVMI("testvmi")
+		oldVMI.Spec.Domain.CPU = oldCPU
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.CPU = newCPU
+
+		result := admitCPUHotplug(newVMI, oldVMI)
+		if allowed {
+			Expect(result).To(BeNil())
+		} else {
+			Expect(result).ToNot(BeNil())
+			Expect(result.Allowed).To(BeFalse())
+		}
+	},
+		table.Entry("Should accept unchanged CPUs",
+			&v1.CPU{Sockets: 1, Cores: 2}, &v1.CPU{Sockets: 1, Cores: 2}, true),
+		table.Entry("Should accept sockets within maxSockets",
+			&v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2}, &v1.CPU{Sockets: 4, MaxSockets: 4, Cores: 2}, true),
+		table.Entry("Should reject sockets without maxSockets",
+			&v1.CPU{Sockets: 1, Cores: 2}, &v1.CPU{Sockets: 2, Cores: 2}, false),
+		table.Entry("Should reject sockets above maxSockets",
+			&v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2}, &v1.CPU{Sockets: 5, MaxSockets: 4, Cores: 2}, false),
+		table.Entry("Should reject changed cores",
+			&v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2}, &v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 4}, false),
+	)
+
+	table.DescribeTable("Should admit or reject memory changes", func(oldGuest, newGuest string, maxGuest *resource.Quantity, allowed bool) {
+		oldVMI := v1.NewMinimalVMI("testvmi")
+		oldGuestMemory := resource.MustParse(oldGuest)
+		oldVMI.Spec.Domain.Memory = &v1.Memory{Guest: &oldGuestMemory, MaxGuest: maxGuest}
+		newVMI := oldVMI.DeepCopy()
+		newGuestMemory := resource.MustParse(newGuest)
+		newVMI.Spec.Domain.Memory.Guest = &newGuestMemory
+
+		result := admitMemoryHotplug(newVMI, oldVMI)
+		if allowed {
+			Expect(result).To(BeNil())
+		} else {
+			Expect(result).ToNot(BeNil())
+			Expect(result.Allowed).To(BeFalse())
+		}
+	},
+		table.Entry("Should accept unchanged guest memory", "1Gi", "1Gi", nil, true),
+		table.Entry("Should accept guest memory within maxGuest", "1Gi", "3Gi", resource.NewQuantity(4*1024*1024*1024, resource.BinarySI), true),
+		table.Entry("Should reject guest memory without maxGuest", "1Gi", "2Gi", nil, false),
+		table.Entry("Should reject guest memory above maxGuest", "1Gi", "8Gi", resource.NewQuantity(4*1024*1024*1024, resource.BinarySI), false),
+	)
+
+	table.DescribeTable("Should admit or reject interface changes", func(mutate func(vmi *v1.VirtualMachineInstance), enableGate bool, allowed bool) {
+		kvConfig := kv.DeepCopy()
+		if enableGate {
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.HotplugNICsGate}
+		}
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfigUsingKV(kvConfig)
+		oldVMI := v1.NewMinimalVMI("testvmi")
+		oldVMI.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+		}
+		oldVMI.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			*v1.DefaultBridgeNetworkInterface(),
+			{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+		newVMI := oldVMI.DeepCopy()
+		mutate(newVMI)
+
+		result := admitInterfaceHotplug(newVMI, oldVMI, clusterConfig)
+		if allowed {
+			Expect(result).To(BeNil())
+		} else {
+			Expect(result).ToNot(BeNil())
+			Expect(result.Allowed).To(BeFalse())
+		}
+	},
+		table.Entry("Should accept unchanged interfaces", func(vmi *v1.VirtualMachineInstance) {}, false, true),
+		table.Entry("Should accept an added bridged multus interface", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}})
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+		}, true, true),
+		table.Entry("Should accept a removed bridged multus interface", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Networks = vmi.Spec.Networks[:1]
+			vmi.Spec.Domain.Devices.Interfaces = vmi.Spec.Domain.Devices.Interfaces[:1]
+		}, true, true),
+		table.Entry("Should reject an added interface without the feature gate", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}})
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+		}, false, false),
+		table.Entry("Should reject an added interface without a network", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+		}, true, false),
+		table.Entry("Should reject an added masquerade interface", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}})
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}})
+		}, true, false),
+		table.Entry("Should reject a removed pod network interface", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Networks = vmi.Spec.Networks[1:]
+			vmi.Spec.Domain.Devices.Interfaces = vmi.Spec.Domain.Devices.Interfaces[1:]
+		}, true, false),
+		table.Entry("Should reject a changed interface", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.Interfaces[1].MacAddress = "de:ad:00:00:be:af"
+		}, true, false),
+		table.Entry("Should accept a changed bandwidth without the feature gate", func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.Interfaces[1].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")},
+			}
+		}, false, true),
+	)
+
+	table.DescribeTable("Should admit or reject bandwidth changes", func(bandwidth *v1.InterfaceBandwidth, allowed bool) {
+		oldVMI := v1.NewMinimalVMI("testvmi")
+		oldVMI.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		oldVMI.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Spec.Domain.Devices.Interfaces[0].Bandwidth = bandwidth
+
+		result := admitInterfaceBandwidthUpdate(newVMI, oldVMI)
+		if allowed {
+			Expect(result).To(BeNil())
+		} else {
+			Expect(result).ToNot(BeNil())
+			Expect(result.Allowed).To(BeFalse())
+		}
+	},
+		table.Entry("Should accept unchanged bandwidth", nil, true),
+		table.Entry("Should accept a valid bandwidth", &v1.InterfaceBandwidth{
+			Outbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi"), Peak: resourcePtr("2Mi")},
+		}, true),
+		table.Entry("Should reject an invalid bandwidth", &v1.InterfaceBandwidth{
+			Outbound: &v1.BandwidthLimit{Average: resource.MustParse("2Mi"), Peak: resourcePtr("1Mi")},
+		}, false),
+	)
+
 	table.DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := v1.NewMinimalVMI("testvmi")
 		vmi.Spec.Volumes = makeVolumes(1)
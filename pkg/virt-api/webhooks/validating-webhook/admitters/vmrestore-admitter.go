@@ -180,13 +180,8 @@ func (admitter *VMRestoreAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, namespace, name string) ([]metav1.StatusCause, *types.UID, error) {
 	vm, err := admitter.Client.VirtualMachine(namespace).Get(name, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
-		return []metav1.StatusCause{
-			{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: fmt.Sprintf("VirtualMachine %q does not exist", name),
-				Field:   field.String(),
-			},
-		}, nil, nil
+		// the restore creates a new VirtualMachine
+		return nil, nil, nil
 	}
 
 	if err != nil {
@@ -242,7 +237,7 @@ func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namesp
 	if targetUID != nil && snapshot.Status != nil && snapshot.Status.SourceUID != nil && *targetUID != *snapshot.Status.SourceUID {
 		cause := metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("VirtualMachineSnapshot source UID is %q but target UID is %q", *snapshot.Status.SourceUID, *targetUID),
+			Message: fmt.Sprintf("VirtualMachineSnapshot source UID is %q but target UID is %q, restoring to a new VirtualMachine requires an unused name", *snapshot.Status.SourceUID, *targetUID),
 			Field:   field.String(),
 		}
 		causes = append(causes, cause)
@@ -138,7 +138,7 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.apiGroup"))
 		})
 
-		It("should reject when VM does not exist", func() {
+		It("should accept when VM does not exist", func() {
 			restore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "restore",
@@ -156,12 +156,36 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 
 			ar := createRestoreAdmissionReview(restore)
 			resp := createTestVMRestoreAdmitter(config, nil, snapshot).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject when VM does not exist and restore to it is in progress", func() {
+			restore := &snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore",
+					Namespace: "default",
+				},
+				Spec: snapshotv1.VirtualMachineRestoreSpec{
+					Target: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     vmName,
+					},
+					VirtualMachineSnapshotName: vmSnapshotName,
+				},
+			}
+
+			restoreInProcess := restore.DeepCopy()
+			restoreInProcess.Name = "restore-in-process"
+
+			ar := createRestoreAdmissionReview(restore)
+			resp := createTestVMRestoreAdmitter(config, nil, snapshot, restoreInProcess).Admit(ar)
 			Expect(resp.Allowed).To(BeFalse())
 			Expect(len(resp.Result.Details.Causes)).To(Equal(1))
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.name"))
 		})
 
-		It("should reject when VM and snapshot do not exist", func() {
+		It("should reject when snapshot does not exist for a new VM", func() {
 			restore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "restore",
@@ -180,9 +204,8 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			ar := createRestoreAdmissionReview(restore)
 			resp := createTestVMRestoreAdmitter(config, nil).Admit(ar)
 			Expect(resp.Allowed).To(BeFalse())
-			Expect(len(resp.Result.Details.Causes)).To(Equal(2))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.name"))
-			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.virtualMachineSnapshotName"))
+			Expect(len(resp.Result.Details.Causes)).To(Equal(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotName"))
 		})
 
 		It("should reject spec update", func() {
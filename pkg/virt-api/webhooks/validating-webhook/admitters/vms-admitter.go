@@ -38,6 +38,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -48,9 +49,10 @@ var validRunStrategies = []v1.VirtualMachineRunStrategy{v1.RunStrategyHalted, v1
 type CloneAuthFunc func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error)
 
 type VMsAdmitter struct {
-	ClusterConfig *virtconfig.ClusterConfig
-	cloneAuthFunc CloneAuthFunc
-	virtClient    kubecli.KubevirtClient
+	ClusterConfig       *virtconfig.ClusterConfig
+	InstancetypeMethods instancetype.Methods
+	cloneAuthFunc       CloneAuthFunc
+	virtClient          kubecli.KubevirtClient
 }
 
 type sarProxy struct {
@@ -65,8 +67,9 @@ func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.Kube
 	proxy := &sarProxy{client: client}
 
 	return &VMsAdmitter{
-		ClusterConfig: clusterConfig,
-		virtClient:    client,
+		ClusterConfig:       clusterConfig,
+		InstancetypeMethods: instancetype.NewMethods(client),
+		virtClient:          client,
 		cloneAuthFunc: func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error) {
 			return cdiclone.CanServiceAccountClonePVC(proxy, pvcNamespace, pvcName, saNamespace, saName)
 		},
@@ -92,7 +95,13 @@ func (admitter *VMsAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.Admissi
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
-	causes := ValidateVirtualMachineSpec(k8sfield.NewPath("spec"), &vm.Spec, admitter.ClusterConfig, accountName)
+	// Validate the VirtualMachine as it will be started, with the referenced instancetype and preference applied
+	causes := admitter.applyInstancetypeToVm(ar.Request, &vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	causes = ValidateVirtualMachineSpec(k8sfield.NewPath("spec"), &vm.Spec, admitter.ClusterConfig, accountName)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -155,6 +164,49 @@ func (admitter *VMsAdmitter) AdmitStatus(ar *v1beta1.AdmissionReview) *v1beta1.A
 	return &reviewResponse
 }
 
+func (admitter *VMsAdmitter) applyInstancetypeToVm(ar *v1beta1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
+	if vm.Spec.Template == nil || (vm.Spec.Instancetype == nil && vm.Spec.Preference == nil) {
+		return nil
+	}
+
+	lookupVM := vm
+	if vm.Namespace == "" {
+		lookupVM = vm.DeepCopy()
+		lookupVM.Namespace = ar.Namespace
+	}
+
+	instancetypeSpec, err := admitter.InstancetypeMethods.FindInstancetypeSpec(lookupVM)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("Failure to find instancetype: %v", err),
+			Field:   k8sfield.NewPath("spec", "instancetype").String(),
+		}}
+	}
+
+	preferenceSpec, err := admitter.InstancetypeMethods.FindPreferenceSpec(lookupVM)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("Failure to find preference: %v", err),
+			Field:   k8sfield.NewPath("spec", "preference").String(),
+		}}
+	}
+
+	conflicts := admitter.InstancetypeMethods.ApplyToVmi(k8sfield.NewPath("spec", "template", "spec"), instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+
+	var causes []metav1.StatusCause
+	for _, conflict := range conflicts {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VM field %s conflicts with selected instancetype", conflict.String()),
+			Field:   conflict.String(),
+		})
+	}
+
+	return causes
+}
+
 func (admitter *VMsAdmitter) authorizeVirtualMachineSpec(ar *v1beta1.AdmissionRequest, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
 	var causes []metav1.StatusCause
 
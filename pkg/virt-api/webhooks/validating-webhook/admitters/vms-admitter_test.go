@@ -42,8 +42,10 @@ import (
 	authv1 "k8s.io/api/authentication/v1"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -56,6 +58,7 @@ var _ = Describe("Validating VM Admitter", func() {
 
 	var virtClient *kubecli.MockKubevirtClient
 	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var instancetypeMethods *testutils.MockInstancetypeMethods
 
 	enableFeatureGate := func(featureGate string) {
 		testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{
@@ -72,8 +75,10 @@ var _ = Describe("Validating VM Admitter", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		instancetypeMethods = testutils.NewMockInstancetypeMethods()
 		vmsAdmitter = &VMsAdmitter{
-			ClusterConfig: config,
+			ClusterConfig:       config,
+			InstancetypeMethods: instancetypeMethods,
 			cloneAuthFunc: func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error) {
 				return true, "", nil
 			},
@@ -151,6 +156,98 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with instancetype and preference", func() {
+		var vm *v1.VirtualMachine
+
+		admitVM := func() *v1beta1.AdmissionResponse {
+			vmBytes, _ := json.Marshal(vm)
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Namespace: k8sv1.NamespaceDefault,
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: vmBytes,
+					},
+				},
+			}
+			return vmsAdmitter.Admit(ar)
+		}
+
+		BeforeEach(func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					Running: &notRunning,
+					Instancetype: &v1.InstancetypeMatcher{
+						Name: "instancetype",
+					},
+					Preference: &v1.PreferenceMatcher{
+						Name: "preference",
+					},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+		})
+
+		It("should accept a VM using an existing instancetype and preference", func() {
+			instancetypeMethods.FindInstancetypeSpecFunc = func(vm *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error) {
+				Expect(vm.Namespace).To(Equal(k8sv1.NamespaceDefault))
+				return &instancetypev1alpha1.VirtualMachineInstancetypeSpec{}, nil
+			}
+			instancetypeMethods.FindPreferenceSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreferenceSpec, error) {
+				return &instancetypev1alpha1.VirtualMachinePreferenceSpec{}, nil
+			}
+			resp := admitVM()
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a VM referencing a missing instancetype", func() {
+			instancetypeMethods.FindInstancetypeSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error) {
+				return nil, fmt.Errorf("instancetype not found")
+			}
+			resp := admitVM()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotFound))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.instancetype"))
+		})
+
+		It("should reject a VM referencing a missing preference", func() {
+			instancetypeMethods.FindPreferenceSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreferenceSpec, error) {
+				return nil, fmt.Errorf("preference not found")
+			}
+			resp := admitVM()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.preference"))
+		})
+
+		It("should reject a VM conflicting with the instancetype", func() {
+			instancetypeMethods.ApplyToVmiFunc = func(field *k8sfield.Path, _ *instancetypev1alpha1.VirtualMachineInstancetypeSpec, _ *instancetypev1alpha1.VirtualMachinePreferenceSpec, _ *v1.VirtualMachineInstanceSpec) instancetype.Conflicts {
+				return instancetype.Conflicts{field.Child("domain", "cpu"), field.Child("domain", "memory")}
+			}
+			resp := admitVM()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(2))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.cpu"))
+			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.template.spec.domain.memory"))
+		})
+
+		It("should validate the VM with the instancetype applied", func() {
+			instancetypeMethods.ApplyToVmiFunc = func(_ *k8sfield.Path, _ *instancetypev1alpha1.VirtualMachineInstancetypeSpec, _ *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) instancetype.Conflicts {
+				vmiSpec.Domain.CPU = &v1.CPU{
+					DedicatedCPUPlacement: true,
+				}
+				return nil
+			}
+			resp := admitVM()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.cpu.dedicatedCpuPlacement"))
+		})
+	})
+
 	table.DescribeTable("should validate VolumeRequest on running vm", func(requests []v1.VirtualMachineVolumeRequest, isValid bool) {
 		vmi := v1.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule(), v1beta1.Create)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: "Snapshot"},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule(), v1beta1.Create)
+			ar.Request.Resource.Resource = "virtualmachinesnapshots"
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		table.DescribeTable("should accept a valid schedule", func(operation v1beta1.Operation) {
+			maxCount := int32(14)
+			policy := snapshotv1.VirtualMachineSnapshotContentRetain
+			schedule := newSchedule()
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				MaxAge:   &metav1.Duration{Duration: 14 * 24 * time.Hour},
+				MaxCount: &maxCount,
+			}
+			schedule.Spec.DeletionPolicy = &policy
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(schedule, operation))
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			table.Entry("on create", v1beta1.Create),
+			table.Entry("on update", v1beta1.Update),
+		)
+
+		table.DescribeTable("should reject an invalid schedule", func(mutate func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			mutate(schedule)
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(schedule, v1beta1.Create))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("with a malformed cron expression", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every night"
+			}, "spec.schedule"),
+			table.Entry("with an out of range cron value", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "0 24 * * *"
+			}, "spec.schedule"),
+			table.Entry("with an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "backup", Operator: "Maybe"}}
+			}, "spec.selector"),
+			table.Entry("with a non positive maxAge", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{MaxAge: &metav1.Duration{}}
+			}, "spec.retention.maxAge"),
+			table.Entry("with a zero maxCount", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				maxCount := int32(0)
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{MaxCount: &maxCount}
+			}, "spec.retention.maxCount"),
+			table.Entry("with an unknown deletionPolicy", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				policy := snapshotv1.DeletionPolicy("Archive")
+				s.Spec.DeletionPolicy = &policy
+			}, "spec.deletionPolicy"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule, operation v1beta1.Operation) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
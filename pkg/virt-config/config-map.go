@@ -220,6 +220,7 @@ func defaultClusterConfig() *v1.KubeVirtConfiguration {
 		SupportedGuestAgentVersions: supportedQEMUGuestAgentVersions,
 		OVMFPath:                    DefaultOVMFPath,
 		MemBalloonStatsPeriod:       &defaultMemBalloonStatsPeriod,
+		LogSerialConsole:            pointer.BoolPtr(DefaultLogSerialConsole),
 	}
 }
 
@@ -256,6 +257,7 @@ type migrationConfiguration struct {
 	ProgressTimeout                   *int64             `json:"progressTimeout,string,omitempty"`
 	UnsafeMigrationOverride           *bool              `json:"unsafeMigrationOverride,string,omitempty"`
 	AllowPostCopy                     *bool              `json:"allowPostCopy,string,omitempty"`
+	Network                           *string            `json:"network,omitempty"`
 }
 
 // setConfigFromConfigMap parses the provided config map and updates the provided config.
@@ -37,6 +37,12 @@ const (
 	HostDiskGate          = "HostDisk"
 	VirtIOFSGate          = "ExperimentalVirtiofsSupport"
 	MacvtapGate           = "Macvtap"
+	VMPersistentState     = "VMPersistentState"
+	VSOCKGate             = "VSOCK"
+	DownwardMetricsGate   = "DownwardMetrics"
+	HotplugNICsGate       = "HotplugNICs"
+	NetworkBindingPlugins = "NetworkBindingPlugins"
+	PasstGate             = "Passt"
 )
 
 func (c *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -99,3 +105,27 @@ func (config *ClusterConfig) MacvtapEnabled() bool {
 func (config *ClusterConfig) HostDevicesPassthroughEnabled() bool {
 	return config.isFeatureGateEnabled(HostDevicesGate)
 }
+
+func (config *ClusterConfig) VMPersistentStateEnabled() bool {
+	return config.isFeatureGateEnabled(VMPersistentState)
+}
+
+func (config *ClusterConfig) VSOCKEnabled() bool {
+	return config.isFeatureGateEnabled(VSOCKGate)
+}
+
+func (config *ClusterConfig) DownwardMetricsEnabled() bool {
+	return config.isFeatureGateEnabled(DownwardMetricsGate)
+}
+
+func (config *ClusterConfig) HotplugNICsEnabled() bool {
+	return config.isFeatureGateEnabled(HotplugNICsGate)
+}
+
+func (config *ClusterConfig) NetworkBindingPluginsEnabled() bool {
+	return config.isFeatureGateEnabled(NetworkBindingPlugins)
+}
+
+func (config *ClusterConfig) PasstEnabled() bool {
+	return config.isFeatureGateEnabled(PasstGate)
+}
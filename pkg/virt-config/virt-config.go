@@ -61,6 +61,7 @@ const (
 	SupportedGuestAgentVersions                     = "2.*,3.*,4.*"
 	DefaultOVMFPath                                 = "/usr/share/OVMF"
 	DefaultMemBalloonStatsPeriod             uint32 = 10
+	DefaultLogSerialConsole                         = false
 	DefaultCPUAllocationRatio                       = 10
 	DefaultVirtAPILogVerbosity                      = 2
 	DefaultVirtControllerLogVerbosity               = 2
@@ -83,6 +84,10 @@ func (c *ClusterConfig) GetMemBalloonStatsPeriod() uint32 {
 	return *c.GetConfig().MemBalloonStatsPeriod
 }
 
+func (c *ClusterConfig) IsSerialConsoleLogEnabled() bool {
+	return *c.GetConfig().LogSerialConsole
+}
+
 func (c *ClusterConfig) IsUseEmulation() bool {
 	return c.GetConfig().DeveloperConfiguration.UseEmulation
 }
@@ -145,6 +150,10 @@ func (c *ClusterConfig) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return *c.GetConfig().NetworkConfiguration.PermitBridgeInterfaceOnPodNetwork
 }
 
+func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return c.GetConfig().NetworkConfiguration.Binding
+}
+
 func (c *ClusterConfig) GetDefaultClusterConfig() *v1.KubeVirtConfiguration {
 	return c.defaultConfig
 }
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -48,6 +49,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	"kubevirt.io/kubevirt/pkg/util/types"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -56,12 +58,13 @@ const configMapName = "kubevirt-config"
 const KvmDevice = "devices.kubevirt.io/kvm"
 const TunDevice = "devices.kubevirt.io/tun"
 const VhostNetDevice = "devices.kubevirt.io/vhost-net"
+const VhostVsockDevice = "devices.kubevirt.io/vhost-vsock"
 
 const debugLogs = "debugLogs"
 const logVerbosity = "logVerbosity"
 const virtiofsDebugLogs = "virtiofsdDebugLogs"
 
-const MultusNetworksAnnotation = "k8s.v1.cni.cncf.io/networks"
+const MultusNetworksAnnotation = multus.NetworksAnnotation
 
 const CAP_NET_ADMIN = "NET_ADMIN"
 const CAP_NET_RAW = "NET_RAW"
@@ -266,6 +269,79 @@ func SetNodeAffinityForForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, po
 	}
 }
 
+type networkBindingPlugin struct {
+	name string
+	v1.InterfaceBindingPlugin
+}
+
+// getNetworkBindingPlugins returns the registered binding plugins the interfaces of the vmi use, ordered by name
+func getNetworkBindingPlugins(vmi *v1.VirtualMachineInstance, registered map[string]v1.InterfaceBindingPlugin) ([]networkBindingPlugin, error) {
+	var names []string
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil {
+			continue
+		}
+		if _, exists := registered[iface.Binding.Name]; !exists {
+			return nil, fmt.Errorf("network binding plugin %s of interface %s is not registered", iface.Binding.Name, iface.Name)
+		}
+		names = append(names, iface.Binding.Name)
+	}
+	sort.Strings(names)
+
+	var bindings []networkBindingPlugin
+	for i, name := range names {
+		if i > 0 && names[i-1] == name {
+			continue
+		}
+		bindings = append(bindings, networkBindingPlugin{name: name, InterfaceBindingPlugin: registered[name]})
+	}
+	return bindings, nil
+}
+
+func newNetworkBindingSidecarContainer(vmi *v1.VirtualMachineInstance, binding networkBindingPlugin, imagePullPolicy k8sv1.PullPolicy) k8sv1.Container {
+	resources := k8sv1.ResourceRequirements{}
+	// add default cpu and memory limits to enable cpu pinning if requested
+	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
+		resources.Limits = make(k8sv1.ResourceList)
+		resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("200m")
+		resources.Limits[k8sv1.ResourceMemory] = resource.MustParse("64M")
+	}
+	return k8sv1.Container{
+		Name:            fmt.Sprintf("network-binding-%s", binding.name),
+		Image:           binding.SidecarImage,
+		ImagePullPolicy: imagePullPolicy,
+		Resources:       resources,
+		Env: []k8sv1.EnvVar{
+			{Name: hooks.NetworkBindingSocketEnv, Value: hooks.NetworkBindingSocketPath(binding.name)},
+		},
+		VolumeMounts: []k8sv1.VolumeMount{
+			{
+				Name:      "network-binding-sockets",
+				MountPath: hooks.NetworkBindingSocketsSharedDirectory,
+			},
+		},
+	}
+}
+
+func addResourceOverhead(resources *k8sv1.ResourceRequirements, overhead *k8sv1.ResourceRequirements) {
+	for name, quantity := range overhead.Requests {
+		if resources.Requests == nil {
+			resources.Requests = make(k8sv1.ResourceList)
+		}
+		val := resources.Requests[name]
+		val.Add(quantity)
+		resources.Requests[name] = val
+	}
+	for name, quantity := range overhead.Limits {
+		if resources.Limits == nil {
+			resources.Limits = make(k8sv1.ResourceList)
+		}
+		val := resources.Limits[name]
+		val.Add(quantity)
+		resources.Limits[name] = val
+	}
+}
+
 // Request a resource by name. This function bumps the number of resources,
 // both its limits and requests attributes.
 //
@@ -538,6 +614,29 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 			serviceAccountName = volume.ServiceAccount.ServiceAccountName
 		}
 
+		if volume.Sysprep != nil {
+			// attach the ConfigMap or Secret containing the answer file to the pod
+			var volumeSource k8sv1.VolumeSource
+			if volume.Sysprep.ConfigMap != nil {
+				volumeSource.ConfigMap = &k8sv1.ConfigMapVolumeSource{
+					LocalObjectReference: *volume.Sysprep.ConfigMap,
+				}
+			} else if volume.Sysprep.Secret != nil {
+				volumeSource.Secret = &k8sv1.SecretVolumeSource{
+					SecretName: volume.Sysprep.Secret.Name,
+				}
+			}
+			volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: filepath.Join(config.SysprepSourceDir, volume.Name),
+				ReadOnly:  true,
+			})
+			volumes = append(volumes, k8sv1.Volume{
+				Name:         volume.Name,
+				VolumeSource: volumeSource,
+			})
+		}
+
 		if volume.CloudInitNoCloud != nil {
 			if volume.CloudInitNoCloud.UserDataSecretRef != nil {
 				// attach a secret referenced by the user
@@ -668,6 +767,45 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		})
 	}
 
+	if types.HasPersistentState(&vmi.Spec) {
+		// The PVC is created by the VM controller and named after the VM, which shares the name with the VMI
+		volumes = append(volumes, k8sv1.Volume{
+			Name: types.PersistentStateVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: types.PersistentStatePVCName(vmi.Name),
+				},
+			},
+		})
+		if types.HasPersistentEFI(&vmi.Spec) {
+			volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+				Name:      types.PersistentStateVolumeName,
+				MountPath: types.PersistentStateNVRAMPath,
+				SubPath:   types.PersistentStateNVRAMSubPath,
+			})
+		}
+		if types.HasPersistentTPM(&vmi.Spec) {
+			volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+				Name:      types.PersistentStateVolumeName,
+				MountPath: types.PersistentStateTPMPath,
+				SubPath:   types.PersistentStateTPMSubPath,
+			})
+		}
+	}
+
+	if types.HasSerialConsoleLog(&vmi.Spec) {
+		volumes = append(volumes, k8sv1.Volume{
+			Name: types.SerialConsoleLogVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+			},
+		})
+		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+			Name:      types.SerialConsoleLogVolumeName,
+			MountPath: types.SerialConsoleLogDir,
+		})
+	}
+
 	if t.imagePullSecret != "" {
 		imagePullSecrets = appendUniqueImagePullSecret(imagePullSecrets, k8sv1.LocalObjectReference{
 			Name: t.imagePullSecret,
@@ -682,7 +820,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 	gracePeriodKillAfter := gracePeriodSeconds + int64(15)
 
 	// Get memory overhead
-	memoryOverhead := getMemoryOverhead(vmi)
+	memoryOverhead := GetMemoryOverhead(vmi)
 
 	// Consider CPU and memory requests and limits for pod scheduling
 	resources := k8sv1.ResourceRequirements{}
@@ -727,7 +865,8 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
 			requests := vmi.Spec.Domain.Resources.Requests.Memory().Value()
 			guest := vmi.Spec.Domain.Memory.Guest.Value()
-			if requests > guest {
+			// Hotplugged memory can exceed the requested memory
+			if requests > guest || vmi.Spec.Domain.Memory.MaxGuest != nil {
 				hugepagesMemReq = vmi.Spec.Domain.Memory.Guest
 			}
 		}
@@ -773,6 +912,11 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 			resources.Limits[k8sv1.ResourceMemory] = *limMemDiff
 		}
 	} else {
+		// Account for memory hotplugged beyond the requested memory
+		if isMemoryHotplugEnabled(vmi) && vmi.Spec.Domain.Memory.Guest.Cmp(resources.Requests[k8sv1.ResourceMemory]) > 0 {
+			resources.Requests[k8sv1.ResourceMemory] = *vmi.Spec.Domain.Memory.Guest
+		}
+
 		// Add overhead memory
 		memoryRequest := resources.Requests[k8sv1.ResourceMemory]
 		if !vmi.Spec.Domain.Resources.OvercommitGuestOverhead {
@@ -805,6 +949,24 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		})
 	}
 
+	networkBindings, err := getNetworkBindingPlugins(vmi, t.clusterConfig.GetNetworkBindings())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(networkBindings) != 0 {
+		volumes = append(volumes, k8sv1.Volume{
+			Name: "network-binding-sockets",
+			VolumeSource: k8sv1.VolumeSource{
+				EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+			},
+		})
+		volumeMounts = append(volumeMounts, k8sv1.VolumeMount{
+			Name:      "network-binding-sockets",
+			MountPath: hooks.NetworkBindingSocketsSharedDirectory,
+		})
+	}
+
 	// Handle CPU pinning
 	if vmi.IsCPUDedicated() {
 		// schedule only on nodes with a running cpu manager
@@ -880,6 +1042,10 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		resources.Limits[KvmDevice] = resource.MustParse("1")
 	}
 
+	if util.IsAutoAttachVSOCK(vmi) {
+		resources.Limits[VhostVsockDevice] = resource.MustParse("1")
+	}
+
 	// Add ports from interfaces to the pod manifest
 	ports := getPortsFromVMI(vmi)
 
@@ -898,6 +1064,13 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		}
 	}
 
+	// Register the resources the network binding plugins need
+	for _, binding := range networkBindings {
+		if binding.ComputeResourceOverhead != nil {
+			addResourceOverhead(&resources, binding.ComputeResourceOverhead)
+		}
+	}
+
 	if util.IsGPUVMI(vmi) {
 		for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
 			requestResource(&resources, gpu.DeviceName)
@@ -1066,6 +1239,14 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		containers = append(containers, sidecar)
 	}
 
+	for _, binding := range networkBindings {
+		containers = append(containers, newNetworkBindingSidecarContainer(vmi, binding, imagePullPolicy))
+	}
+
+	if types.HasSerialConsoleLog(&vmi.Spec) {
+		containers = append(containers, t.newSerialConsoleLogContainer(vmi, imagePullPolicy))
+	}
+
 	hostName := dns.SanitizeHostname(vmi)
 
 	annotationsList := map[string]string{
@@ -1231,6 +1412,33 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 	return &pod, nil
 }
 
+// newSerialConsoleLogContainer returns the container streaming the serial console log of the guest to its stdout
+func (t *templateService) newSerialConsoleLogContainer(vmi *v1.VirtualMachineInstance, imagePullPolicy k8sv1.PullPolicy) k8sv1.Container {
+	resources := k8sv1.ResourceRequirements{}
+	// add limits to keep the QOS class of the pod
+	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
+		resources.Limits = k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("15m"),
+			k8sv1.ResourceMemory: resource.MustParse("60M"),
+		}
+	}
+	return k8sv1.Container{
+		Name:            types.SerialConsoleLogContainerName,
+		Image:           t.launcherImage,
+		ImagePullPolicy: imagePullPolicy,
+		Command:         []string{"/usr/bin/virt-tail"},
+		Args:            []string{"--logfile", types.SerialConsoleLogPath},
+		Resources:       resources,
+		VolumeMounts: []k8sv1.VolumeMount{
+			{
+				Name:      types.SerialConsoleLogVolumeName,
+				MountPath: types.SerialConsoleLogDir,
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
 func (t *templateService) RenderHotplugAttachmentPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	pod := &k8sv1.Pod{
@@ -1371,7 +1579,35 @@ func appendUniqueImagePullSecret(secrets []k8sv1.LocalObjectReference, newsecret
 	return append(secrets, newsecret)
 }
 
-// getMemoryOverhead computes the estimation of total
+func isMemoryHotplugEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil && vmi.Spec.Domain.Memory.MaxGuest != nil
+}
+
+// PodAccommodatesGuestMemory returns true if the memory requested by the compute container of the pod
+// covers the guest memory of the vmi, which can grow beyond the memory the pod was created with by hotplugging memory.
+func PodAccommodatesGuestMemory(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) bool {
+	if !isMemoryHotplugEnabled(vmi) {
+		return true
+	}
+	for _, container := range pod.Spec.Containers {
+		if container.Name != "compute" {
+			continue
+		}
+		if vmi.Spec.Domain.Memory.Hugepages != nil {
+			hugepageType := k8sv1.ResourceName(k8sv1.ResourceHugePagesPrefix + vmi.Spec.Domain.Memory.Hugepages.PageSize)
+			hugepagesRequest := container.Resources.Requests[hugepageType]
+			return hugepagesRequest.Cmp(*vmi.Spec.Domain.Memory.Guest) >= 0
+		}
+		required := vmi.Spec.Domain.Memory.Guest.DeepCopy()
+		if !vmi.Spec.Domain.Resources.OvercommitGuestOverhead {
+			required.Add(*GetMemoryOverhead(vmi))
+		}
+		return container.Resources.Requests.Memory().Cmp(required) >= 0
+	}
+	return false
+}
+
+// GetMemoryOverhead computes the estimation of total
 // memory needed for the domain to operate properly.
 // This includes the memory needed for the guest and memory
 // for Qemu and OS overhead.
@@ -1380,7 +1616,7 @@ func appendUniqueImagePullSecret(secrets []k8sv1.LocalObjectReference, newsecret
 //
 // Note: This is the best estimation we were able to come up with
 //       and is still not 100% accurate
-func getMemoryOverhead(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+func GetMemoryOverhead(vmi *v1.VirtualMachineInstance) *resource.Quantity {
 	domain := vmi.Spec.Domain
 	vmiMemoryReq := domain.Resources.Requests.Memory()
 
@@ -1524,10 +1760,24 @@ func getIfaceByName(vmi *v1.VirtualMachineInstance, name string) *v1.Interface {
 }
 
 func getCniAnnotations(vmi *v1.VirtualMachineInstance) (cniAnnotations map[string]string, err error) {
-	ifaceListMap := make([]map[string]string, 0)
 	cniAnnotations = make(map[string]string, 0)
 
-	next_idx := 0
+	networksAnnotation, err := GenerateMultusNetworksAnnotation(vmi)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	if networksAnnotation != "" {
+		cniAnnotations[MultusNetworksAnnotation] = networksAnnotation
+	}
+	return
+}
+
+// GenerateMultusNetworksAnnotation returns the Multus networks annotation of the virt-launcher pod of the vmi,
+// it is empty if the vmi has no secondary multus networks
+func GenerateMultusNetworksAnnotation(vmi *v1.VirtualMachineInstance) (string, error) {
+	ifaceListMap := make([]map[string]string, 0)
+
+	podInterfaceNames := multus.PodInterfaceNames(vmi)
 	for _, network := range vmi.Spec.Networks {
 		// Set the type for the first network. All other networks must have same type.
 		if network.Multus != nil {
@@ -1538,7 +1788,7 @@ func getCniAnnotations(vmi *v1.VirtualMachineInstance) (cniAnnotations map[strin
 			ifaceMap := map[string]string{
 				"name":      networkName,
 				"namespace": namespace,
-				"interface": fmt.Sprintf("net%d", next_idx+1),
+				"interface": podInterfaceNames[network.Name],
 			}
 			iface := getIfaceByName(vmi, network.Name)
 			if iface != nil && iface.MacAddress != "" {
@@ -1549,18 +1799,17 @@ func getCniAnnotations(vmi *v1.VirtualMachineInstance) (cniAnnotations map[strin
 				// we forbid them in API.
 				ifaceMap["mac"] = iface.MacAddress
 			}
-			next_idx = next_idx + 1
 			ifaceListMap = append(ifaceListMap, ifaceMap)
 		}
 	}
-	if len(ifaceListMap) > 0 {
-		ifaceJsonString, err := json.Marshal(ifaceListMap)
-		if err != nil {
-			return map[string]string{}, fmt.Errorf("Failed to create JSON list from CNI interface map %s", ifaceListMap)
-		}
-		cniAnnotations[MultusNetworksAnnotation] = fmt.Sprintf("%s", ifaceJsonString)
+	if len(ifaceListMap) == 0 {
+		return "", nil
 	}
-	return
+	ifaceJsonString, err := json.Marshal(ifaceListMap)
+	if err != nil {
+		return "", fmt.Errorf("Failed to create JSON list from CNI interface map %s", ifaceListMap)
+	}
+	return string(ifaceJsonString), nil
 }
 
 func NewTemplateService(launcherImage string,
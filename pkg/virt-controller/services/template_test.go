@@ -21,6 +21,7 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"runtime"
 	"testing"
 
@@ -35,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	fakenetworkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned/fake"
@@ -560,6 +562,255 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with persistent EFI", func() {
+			It("should mount the persistent state PVC of the VM", func() {
+				persistent := true
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Firmware: &v1.Firmware{
+								Bootloader: &v1.Bootloader{
+									EFI: &v1.EFI{Persistent: &persistent},
+								},
+							},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name: "persistent-state",
+					VolumeSource: kubev1.VolumeSource{
+						PersistentVolumeClaim: &kubev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "persistent-state-for-testvmi",
+						},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "persistent-state",
+					MountPath: "/var/lib/libvirt/qemu/nvram",
+					SubPath:   "nvram",
+				}))
+			})
+		})
+
+		Context("with persistent TPM", func() {
+			It("should mount the TPM state directory of the persistent state PVC", func() {
+				persistent := true
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								TPM: &v1.TPMDevice{Persistent: &persistent},
+							},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name: "persistent-state",
+					VolumeSource: kubev1.VolumeSource{
+						PersistentVolumeClaim: &kubev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "persistent-state-for-testvmi",
+						},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "persistent-state",
+					MountPath: "/var/lib/libvirt/swtpm",
+					SubPath:   "swtpm",
+				}))
+				for _, volumeMount := range pod.Spec.Containers[0].VolumeMounts {
+					Expect(volumeMount.SubPath).ToNot(Equal("nvram"))
+				}
+			})
+		})
+
+		Context("with serial console log", func() {
+			newVMI := func(logSerialConsole *bool) *v1.VirtualMachineInstance {
+				return &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								LogSerialConsole: logSerialConsole,
+							},
+						},
+					},
+				}
+			}
+
+			It("should add the guest-console-log container", func() {
+				logSerialConsole := true
+				pod, err := svc.RenderLaunchManifest(newVMI(&logSerialConsole))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name: "serial-console-log",
+					VolumeSource: kubev1.VolumeSource{
+						EmptyDir: &kubev1.EmptyDirVolumeSource{},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "serial-console-log",
+					MountPath: "/var/run/kubevirt-serial-console-log",
+				}))
+
+				var container *kubev1.Container
+				for i := range pod.Spec.Containers {
+					if pod.Spec.Containers[i].Name == "guest-console-log" {
+						container = &pod.Spec.Containers[i]
+					}
+				}
+				Expect(container).ToNot(BeNil())
+				Expect(container.Image).To(Equal(pod.Spec.Containers[0].Image))
+				Expect(container.Command).To(Equal([]string{"/usr/bin/virt-tail"}))
+				Expect(container.Args).To(Equal([]string{"--logfile", "/var/run/kubevirt-serial-console-log/virt-serial0-log"}))
+				Expect(container.VolumeMounts).To(ConsistOf(kubev1.VolumeMount{
+					Name:      "serial-console-log",
+					MountPath: "/var/run/kubevirt-serial-console-log",
+					ReadOnly:  true,
+				}))
+			})
+
+			It("should not add the guest-console-log container if it is disabled", func() {
+				logSerialConsole := false
+				pod, err := svc.RenderLaunchManifest(newVMI(&logSerialConsole))
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, container := range pod.Spec.Containers {
+					Expect(container.Name).ToNot(Equal("guest-console-log"))
+				}
+				for _, volume := range pod.Spec.Volumes {
+					Expect(volume.Name).ToNot(Equal("serial-console-log"))
+				}
+			})
+		})
+
+		Context("with network binding plugins", func() {
+			var bindingSvc TemplateService
+
+			BeforeEach(func() {
+				kv := &v1.KubeVirt{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "kubevirt",
+						Namespace: "kubevirt",
+					},
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{},
+							NetworkConfiguration: &v1.NetworkConfiguration{
+								Binding: map[string]v1.InterfaceBindingPlugin{
+									"vhostuser": {
+										SidecarImage: "vhostuser-binding:v1",
+										ComputeResourceOverhead: &kubev1.ResourceRequirements{
+											Requests: kubev1.ResourceList{
+												"devices.example.com/vhostuser-sockets": resource.MustParse("1"),
+											},
+											Limits: kubev1.ResourceList{
+												"devices.example.com/vhostuser-sockets": resource.MustParse("1"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					Status: v1.KubeVirtStatus{
+						Phase: v1.KubeVirtPhaseDeploying,
+					},
+				}
+				bindingConfig, _, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+				bindingSvc = NewTemplateService("kubevirt/virt-launcher",
+					"/var/run/kubevirt",
+					"/var/lib/kubevirt",
+					"/var/run/kubevirt-ephemeral-disks",
+					"/var/run/kubevirt/container-disks",
+					"/var/run/kubevirt/hotplug-disks",
+					"pull-secret-1",
+					pvcCache,
+					virtClient,
+					bindingConfig,
+					qemuGid,
+				)
+			})
+
+			newVMI := func(bindingNames ...string) *v1.VirtualMachineInstance {
+				vmi := &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+				}
+				for i, name := range bindingNames {
+					ifaceName := fmt.Sprintf("net%d", i)
+					vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: ifaceName, Binding: &v1.PluginBinding{Name: name}})
+					vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: ifaceName, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}})
+				}
+				return vmi
+			}
+
+			It("should add one sidecar per binding plugin and its resources", func() {
+				pod, err := bindingSvc.RenderLaunchManifest(newVMI("vhostuser", "vhostuser"))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name: "network-binding-sockets",
+					VolumeSource: kubev1.VolumeSource{
+						EmptyDir: &kubev1.EmptyDirVolumeSource{},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "network-binding-sockets",
+					MountPath: "/var/run/kubevirt-network-bindings",
+				}))
+
+				var sidecars []kubev1.Container
+				for _, container := range pod.Spec.Containers {
+					if container.Name == "network-binding-vhostuser" {
+						sidecars = append(sidecars, container)
+					}
+				}
+				Expect(sidecars).To(HaveLen(1))
+				Expect(sidecars[0].Image).To(Equal("vhostuser-binding:v1"))
+				Expect(sidecars[0].Env).To(ConsistOf(kubev1.EnvVar{
+					Name:  "KUBEVIRT_NETWORK_BINDING_SOCKET",
+					Value: "/var/run/kubevirt-network-bindings/vhostuser.sock",
+				}))
+
+				compute := pod.Spec.Containers[0].Resources
+				sockets := compute.Requests["devices.example.com/vhostuser-sockets"]
+				Expect(sockets.Value()).To(Equal(int64(1)))
+				sockets = compute.Limits["devices.example.com/vhostuser-sockets"]
+				Expect(sockets.Value()).To(Equal(int64(1)))
+			})
+
+			It("should fail if the binding plugin is not registered", func() {
+				_, err := bindingSvc.RenderLaunchManifest(newVMI("unknown"))
+				Expect(err).To(MatchError(ContainSubstring("network binding plugin unknown")))
+			})
+		})
+
 		Context("with cloud-init user secret", func() {
 			It("should add volume with secret referenced by cloud-init user secret ref", func() {
 				vmi := v1.VirtualMachineInstance{
@@ -2019,10 +2270,45 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 				expectedMemory := resource.NewScaledQuantity(0, resource.Kilo)
-				expectedMemory.Add(*getMemoryOverhead(&vmi))
+				expectedMemory.Add(*GetMemoryOverhead(&vmi))
 				expectedMemory.Add(*domain.Resources.Requests.Memory())
 				Expect(pod.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory.Value()))
 			})
+			It("should request the hotplugged guest memory if it exceeds the requested memory", func() {
+				guest := resource.MustParse("2Gi")
+				maxGuest := resource.MustParse("4Gi")
+				domain := v1.DomainSpec{
+					Devices: v1.Devices{
+						DisableHotplug: true,
+					},
+					Memory: &v1.Memory{
+						Guest:    &guest,
+						MaxGuest: &maxGuest,
+					},
+					Resources: v1.ResourceRequirements{
+						Requests: kubev1.ResourceList{
+							kubev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				}
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: domain},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				expectedMemory := resource.NewScaledQuantity(0, resource.Kilo)
+				expectedMemory.Add(*GetMemoryOverhead(&vmi))
+				expectedMemory.Add(guest)
+				Expect(pod.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory.Value()))
+				Expect(PodAccommodatesGuestMemory(&vmi, pod)).To(BeTrue())
+
+				vmi.Spec.Domain.Memory.Guest = &maxGuest
+				Expect(PodAccommodatesGuestMemory(&vmi, pod)).To(BeFalse())
+			})
 			It("should still add memory overhead for 1 core if cpu topology wasn't provided", func() {
 				domain := v1.DomainSpec{
 					Devices: v1.Devices{
@@ -2065,7 +2351,7 @@ var _ = Describe("Template", func() {
 				pod1, err := svc.RenderLaunchManifest(&vmi1)
 				Expect(err).ToNot(HaveOccurred())
 				expectedMemory := resource.NewScaledQuantity(0, resource.Kilo)
-				expectedMemory.Add(*getMemoryOverhead(&vmi))
+				expectedMemory.Add(*GetMemoryOverhead(&vmi))
 				expectedMemory.Add(*domain.Resources.Requests.Memory())
 				Expect(pod.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory.Value()))
 				Expect(pod1.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory.Value()))
@@ -2234,6 +2520,32 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with vsock", func() {
+			table.DescribeTable("should require the vhost-vsock device", func(autoattachVSOCK *bool, expected bool) {
+				domain := v1.DomainSpec{}
+				domain.Devices.AutoattachVSOCK = autoattachVSOCK
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: domain},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				vsock, ok := pod.Spec.Containers[0].Resources.Limits[VhostVsockDevice]
+				Expect(ok).To(Equal(expected))
+				if expected {
+					Expect(int(vsock.Value())).To(Equal(1))
+				}
+			},
+				table.Entry("if vsock is requested", pointer.BoolPtr(true), true),
+				table.Entry("not if vsock is not requested", nil, false),
+				table.Entry("not if vsock is explicitly rejected", pointer.BoolPtr(false), false),
+			)
+		})
+
 		Context("with a configMap volume source", func() {
 			It("Should add the ConfigMap to template", func() {
 				volumes := []v1.Volume{
@@ -2301,6 +2613,57 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.Volumes[1].Secret.SecretName).To(Equal("test-secret"))
 			})
 		})
+
+		Context("with a sysprep volume source", func() {
+			table.DescribeTable("should add the answer file source to template", func(sysprep *v1.SysprepSource, verify func(volume kubev1.Volume)) {
+				volumes := []v1.Volume{
+					{
+						Name: "sysprep-volume",
+						VolumeSource: v1.VolumeSource{
+							Sysprep: sysprep,
+						},
+					},
+				}
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: volumes, Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							DisableHotplug: true,
+						},
+					}},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(len(pod.Spec.Volumes)).To(Equal(6))
+				Expect(pod.Spec.Volumes[1].Name).To(Equal("sysprep-volume"))
+				verify(pod.Spec.Volumes[1])
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "sysprep-volume",
+					MountPath: "/var/run/kubevirt-private/sysprep/sysprep-volume",
+					ReadOnly:  true,
+				}))
+			},
+				table.Entry("from a ConfigMap",
+					&v1.SysprepSource{ConfigMap: &kubev1.LocalObjectReference{Name: "test-configmap"}},
+					func(volume kubev1.Volume) {
+						Expect(volume.ConfigMap).ToNot(BeNil())
+						Expect(volume.ConfigMap.LocalObjectReference.Name).To(Equal("test-configmap"))
+					},
+				),
+				table.Entry("from a Secret",
+					&v1.SysprepSource{Secret: &kubev1.LocalObjectReference{Name: "test-secret"}},
+					func(volume kubev1.Volume) {
+						Expect(volume.Secret).ToNot(BeNil())
+						Expect(volume.Secret.SecretName).To(Equal("test-secret"))
+					},
+				),
+			)
+		})
+
 		Context("with probes", func() {
 			var vmi *v1.VirtualMachineInstance
 			BeforeEach(func() {
@@ -43,6 +43,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/healthz"
 
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -50,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -133,18 +137,28 @@ type VirtControllerApp struct {
 	vmController *VMController
 	vmInformer   cache.SharedIndexInformer
 
+	poolController *PoolController
+	poolInformer   cache.SharedIndexInformer
+
 	dataVolumeInformer cache.SharedIndexInformer
 
 	migrationController *MigrationController
 	migrationInformer   cache.SharedIndexInformer
 
-	snapshotController        *snapshot.VMSnapshotController
-	restoreController         *snapshot.VMRestoreController
-	vmSnapshotInformer        cache.SharedIndexInformer
-	vmSnapshotContentInformer cache.SharedIndexInformer
-	vmRestoreInformer         cache.SharedIndexInformer
-	storageClassInformer      cache.SharedIndexInformer
-	allPodInformer            cache.SharedIndexInformer
+	snapshotController         *snapshot.VMSnapshotController
+	restoreController          *snapshot.VMRestoreController
+	cloneController            *snapshot.VMCloneController
+	scheduleController         *snapshot.VMSnapshotScheduleController
+	exportController           *snapshot.VMExportController
+	vmSnapshotInformer         cache.SharedIndexInformer
+	vmSnapshotContentInformer  cache.SharedIndexInformer
+	vmRestoreInformer          cache.SharedIndexInformer
+	vmCloneInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer cache.SharedIndexInformer
+	vmExportInformer           cache.SharedIndexInformer
+	ingressInformer            cache.SharedIndexInformer
+	storageClassInformer       cache.SharedIndexInformer
+	allPodInformer             cache.SharedIndexInformer
 
 	crdInformer cache.SharedIndexInformer
 
@@ -174,6 +188,7 @@ type VirtControllerApp struct {
 	nodeControllerThreads             int
 	vmiControllerThreads              int
 	rsControllerThreads               int
+	poolControllerThreads             int
 	vmControllerThreads               int
 	migrationControllerThreads        int
 	evacuationControllerThreads       int
@@ -181,6 +196,9 @@ type VirtControllerApp struct {
 	launcherSubGid                    int64
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	cloneControllerThreads            int
+	scheduleControllerThreads         int
+	exportControllerThreads           int
 	snapshotControllerResyncPeriod    time.Duration
 
 	caConfigMapName  string
@@ -193,6 +211,9 @@ var _ service.Service = &VirtControllerApp{}
 func init() {
 	vsv1beta1.AddToScheme(scheme.Scheme)
 	snapshotv1.AddToScheme(scheme.Scheme)
+	poolv1.AddToScheme(scheme.Scheme)
+	clonev1.AddToScheme(scheme.Scheme)
+	exportv1.AddToScheme(scheme.Scheme)
 
 	prometheus.MustRegister(leaderGauge)
 	prometheus.MustRegister(readyGauge)
@@ -271,11 +292,17 @@ func Execute() {
 
 	app.vmInformer = app.informerFactory.VirtualMachine()
 
+	app.poolInformer = app.informerFactory.VirtualMachinePool()
+
 	app.migrationInformer = app.informerFactory.VirtualMachineInstanceMigration()
 
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
+	app.ingressInformer = app.informerFactory.Ingress()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.allPodInformer = app.informerFactory.Pod()
 
@@ -292,11 +319,15 @@ func Execute() {
 
 	app.initCommon()
 	app.initReplicaSet()
+	app.initPool()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initCloneController()
+	app.initSnapshotScheduleController()
+	app.initExportController()
 	go app.Run()
 
 	select {
@@ -391,8 +422,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		vca.informerFactory.Start(stop)
 
 		golog.Printf("STARTING controllers with following threads : "+
-			"node %d, vmi %d, replicaset %d, vm %d, migration %d, evacuation %d, disruptionBudget %d",
-			vca.nodeControllerThreads, vca.vmiControllerThreads, vca.rsControllerThreads,
+			"node %d, vmi %d, replicaset %d, pool %d, vm %d, migration %d, evacuation %d, disruptionBudget %d",
+			vca.nodeControllerThreads, vca.vmiControllerThreads, vca.rsControllerThreads, vca.poolControllerThreads,
 			vca.vmControllerThreads, vca.migrationControllerThreads, vca.evacuationControllerThreads,
 			vca.disruptionBudgetControllerThreads)
 
@@ -401,10 +432,14 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
+		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go vca.snapshotController.Run(vca.snapshotControllerThreads, stop)
 		go vca.restoreController.Run(vca.restoreControllerThreads, stop)
+		go vca.cloneController.Run(vca.cloneControllerThreads, stop)
+		go vca.scheduleController.Run(vca.scheduleControllerThreads, stop)
+		go vca.exportController.Run(vca.exportControllerThreads, stop)
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced)
 		close(vca.readyChan)
 		leaderGauge.Set(1)
@@ -450,6 +485,11 @@ func (vca *VirtControllerApp) initReplicaSet() {
 	vca.rsController = NewVMIReplicaSet(vca.vmiInformer, vca.rsInformer, recorder, vca.clientSet, controller.BurstReplicas)
 }
 
+func (vca *VirtControllerApp) initPool() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "virtualmachinepool-controller")
+	vca.poolController = NewPoolController(vca.vmInformer, vca.poolInformer, recorder, vca.clientSet, controller.BurstReplicas)
+}
+
 func (vca *VirtControllerApp) initVirtualMachines() {
 	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
 
@@ -458,8 +498,10 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.vmInformer,
 		vca.dataVolumeInformer,
 		vca.persistentVolumeClaimInformer,
+		instancetype.NewMethods(vca.clientSet),
 		recorder,
-		vca.clientSet)
+		vca.clientSet,
+		vca.clusterConfig)
 }
 
 func (vca *VirtControllerApp) initDisruptionBudgetController() {
@@ -521,6 +563,51 @@ func (vca *VirtControllerApp) initRestoreController() {
 	vca.restoreController.Init()
 }
 
+func (vca *VirtControllerApp) initCloneController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "clone-controller")
+	vca.cloneController = &snapshot.VMCloneController{
+		Client:                    vca.clientSet,
+		VMCloneInformer:           vca.vmCloneInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMInformer:                vca.vmInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		StorageClassInformer:      vca.storageClassInformer,
+		Recorder:                  recorder,
+	}
+	vca.cloneController.Init()
+}
+
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.scheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	vca.scheduleController.Init()
+}
+
+func (vca *VirtControllerApp) initExportController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "export-controller")
+	vca.exportController = &snapshot.VMExportController{
+		Client:                    vca.clientSet,
+		ExporterImage:             vca.launcherImage,
+		VMExportInformer:          vca.vmExportInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		PodInformer:               vca.allPodInformer,
+		VMInformer:                vca.vmInformer,
+		VMIInformer:               vca.vmiInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		IngressInformer:           vca.ingressInformer,
+		Recorder:                  recorder,
+	}
+	vca.exportController.Init()
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -578,6 +665,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.rsControllerThreads, "rs-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for replicaset controller")
 
+	flag.IntVar(&vca.poolControllerThreads, "pool-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for pool controller")
+
 	flag.IntVar(&vca.vmControllerThreads, "vm-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for vm controller")
 
@@ -599,6 +689,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.scheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for export controller")
+
 	flag.DurationVar(&vca.snapshotControllerResyncPeriod, "snapshot-controller-resync-period", defaultSnapshotControllerResyncPeriod,
 		"Number of goroutines to run for snapshot controller")
 
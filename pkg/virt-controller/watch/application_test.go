@@ -35,12 +35,16 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	kubev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/api/policy/v1beta1"
 	"k8s.io/client-go/tools/record"
 
 	io_prometheus_client "github.com/prometheus/client_model/go"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
@@ -84,9 +88,14 @@ var _ = Describe("Application", func() {
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		rsInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceReplicaSet{})
+		poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1beta1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmCloneInformer, _ := testutils.NewFakeInformerFor(&clonev1.VirtualMachineClone{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		ingressInformer, _ := testutils.NewFakeInformerFor(&networkingv1.Ingress{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 
 		var qemuGid int64 = 107
@@ -104,7 +113,8 @@ var _ = Describe("Application", func() {
 			dataVolumeInformer,
 		)
 		app.rsController = NewVMIReplicaSet(vmiInformer, rsInformer, recorder, virtClient, uint(10))
-		app.vmController = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, recorder, virtClient)
+		app.poolController = NewPoolController(vmInformer, poolInformer, recorder, virtClient, uint(10))
+		app.vmController = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, testutils.NewMockInstancetypeMethods(), recorder, virtClient, config)
 		app.migrationController = NewMigrationController(services.NewTemplateService("a", "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid),
 			vmiInformer,
 			podInformer,
@@ -141,6 +151,38 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		app.restoreController.Init()
+		app.cloneController = &snapshot.VMCloneController{
+			Client:                    virtClient,
+			VMCloneInformer:           vmCloneInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMInformer:                vmInformer,
+			PVCInformer:               pvcInformer,
+			StorageClassInformer:      storageClassInformer,
+			Recorder:                  recorder,
+		}
+		app.cloneController.Init()
+		app.scheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		app.scheduleController.Init()
+		app.exportController = &snapshot.VMExportController{
+			Client:                    virtClient,
+			VMExportInformer:          vmExportInformer,
+			PVCInformer:               pvcInformer,
+			PodInformer:               podInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			IngressInformer:           ingressInformer,
+			Recorder:                  recorder,
+		}
+		app.exportController.Init()
 		app.persistentVolumeClaimInformer = pvcInformer
 
 		app.readyChan = make(chan bool)
@@ -0,0 +1,730 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// Reasons for pool events
+const (
+	// SuccessfulPausedPoolReason is added in an event when the pool discovered that it
+	// should be paused. The event is triggered after it successfully managed to add the Paused Condition
+	// to itself.
+	SuccessfulPausedPoolReason = "SuccessfulPaused"
+	// SuccessfulResumePoolReason is added in an event when the pool discovered that it
+	// should be resumed. The event is triggered after it successfully managed to remove the Paused Condition
+	// from itself.
+	SuccessfulResumePoolReason = "SuccessfulResumed"
+)
+
+func NewPoolController(vmInformer cache.SharedIndexInformer, poolInformer cache.SharedIndexInformer, recorder record.EventRecorder, clientset kubecli.KubevirtClient, burstReplicas uint) *PoolController {
+
+	c := &PoolController{
+		Queue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		vmInformer:    vmInformer,
+		poolInformer:  poolInformer,
+		recorder:      recorder,
+		clientset:     clientset,
+		expectations:  controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		burstReplicas: burstReplicas,
+	}
+
+	c.poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addPool,
+		DeleteFunc: c.deletePool,
+		UpdateFunc: c.updatePool,
+	})
+
+	c.vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVM,
+		DeleteFunc: c.deleteVM,
+		UpdateFunc: c.updateVM,
+	})
+
+	return c
+}
+
+// PoolController creates and removes uniquely indexed VirtualMachines, and
+// the DataVolumes described by their templates, for VirtualMachinePools.
+type PoolController struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.RateLimitingInterface
+	vmInformer    cache.SharedIndexInformer
+	poolInformer  cache.SharedIndexInformer
+	recorder      record.EventRecorder
+	expectations  *controller.UIDTrackingControllerExpectations
+	burstReplicas uint
+}
+
+func (c *PoolController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting VirtualMachinePool controller.")
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.vmInformer.HasSynced, c.poolInformer.HasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping VirtualMachinePool controller.")
+}
+
+func (c *PoolController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *PoolController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("re-enqueuing VirtualMachinePool %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachinePool %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *PoolController) execute(key string) error {
+
+	obj, exists, err := c.poolInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return nil
+	}
+	if !exists {
+		// nothing we need to do. It should always be possible to re-create this type of controller
+		c.expectations.DeleteExpectations(key)
+		return nil
+	}
+	pool := obj.(*poolv1.VirtualMachinePool)
+
+	logger := log.Log.Object(pool)
+
+	if pool.Spec.VirtualMachineTemplate == nil || pool.Spec.Selector == nil || len(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels) == 0 {
+		logger.Error("Invalid controller spec, will not re-enqueue.")
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pool.Spec.Selector)
+	if err != nil {
+		logger.Reason(err).Error("Invalid selector on pool, will not re-enqueue.")
+		return nil
+	}
+
+	if !selector.Matches(labels.Set(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)) {
+		logger.Error("Selector does not match template labels, will not re-enqueue.")
+		return nil
+	}
+
+	needsSync := c.expectations.SatisfiedExpectations(key)
+
+	// get all VMs owned by this pool from the cache
+	vms, err := c.listVMsOwnedByPool(pool)
+	if err != nil {
+		logger.Reason(err).Error("Failed to fetch vms for namespace from cache.")
+		return err
+	}
+
+	activeVMs := filterActiveVMs(vms)
+
+	var scaleErr error
+
+	// Scale up or down, if all expected creates and deletes were report by the listener
+	if needsSync && !pool.Spec.Paused && pool.ObjectMeta.DeletionTimestamp == nil {
+		scaleErr = c.scale(pool, activeVMs, vms)
+	}
+
+	if scaleErr != nil {
+		logger.Reason(scaleErr).Error("Scaling the pool failed.")
+	}
+
+	err = c.updateStatus(pool.DeepCopy(), activeVMs, scaleErr)
+	if err != nil {
+		logger.Reason(err).Error("Updating the pool status failed.")
+	}
+
+	return scaleErr
+}
+
+// scale creates or removes VirtualMachines until the number of active VirtualMachines matches the
+// desired number of replicas. All VirtualMachines owned by the pool, including terminating ones,
+// are needed to find the indexes which are still free.
+func (c *PoolController) scale(pool *poolv1.VirtualMachinePool, activeVMs []*virtv1.VirtualMachine, vms []*virtv1.VirtualMachine) error {
+	log.Log.V(4).Object(pool).Info("Scale")
+	diff := c.calcDiff(pool, activeVMs)
+
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Error("Failed to extract poolKey from pool.")
+		return nil
+	}
+
+	if diff == 0 {
+		return nil
+	}
+
+	// Make sure that we don't overload the cluster
+	diff = limit(diff, c.burstReplicas)
+
+	// Every request can fail, give the channel enough room, to not block the go routines
+	errChan := make(chan error, abs(diff))
+
+	var wg sync.WaitGroup
+	wg.Add(abs(diff))
+
+	if diff > 0 {
+		log.Log.V(4).Object(pool).Info("Delete excess VM's")
+		deleteCandidates := scaleInCandidates(pool, activeVMs)[0:diff]
+		c.expectations.ExpectDeletions(poolKey, vmKeys(deleteCandidates))
+		for _, vm := range deleteCandidates {
+			go func(vm *virtv1.VirtualMachine) {
+				defer wg.Done()
+				err := c.removeVM(pool, vm)
+				if err != nil {
+					// We can't observe a delete if it was not accepted by the server
+					c.expectations.DeletionObserved(poolKey, vmKey(vm))
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDeleteVirtualMachineReason, "Error deleting virtual machine %s: %v", vm.ObjectMeta.Name, err)
+					errChan <- err
+					return
+				}
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Deleted virtual machine %s", vm.ObjectMeta.Name)
+			}(vm)
+		}
+
+	} else if diff < 0 {
+		log.Log.V(4).Object(pool).Info("Add missing VM's")
+		c.expectations.ExpectCreations(poolKey, abs(diff))
+		for _, index := range freeIndexes(pool, vms, abs(diff)) {
+			go func(index int) {
+				defer wg.Done()
+				vm, err := c.clientset.VirtualMachine(pool.Namespace).Create(newVMFromPool(pool, index))
+				if err != nil {
+					c.expectations.CreationObserved(poolKey)
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error creating virtual machine: %v", err)
+					errChan <- err
+					return
+				}
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulCreateVirtualMachineReason, "Created virtual machine %s", vm.ObjectMeta.Name)
+			}(index)
+		}
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred, the others will most likely be equal errors
+		return err
+	default:
+	}
+	return nil
+}
+
+// removeVM deletes a VirtualMachine of the pool. The DataVolumes are owned by the VirtualMachine and
+// are deleted with it in the background. If the volume policy of the pool is Retain, the
+// VirtualMachine is deleted with orphan propagation instead, which keeps its DataVolumes for a
+// VirtualMachine with the same index. The VirtualMachineInstance is then stopped explicitly.
+func (c *PoolController) removeVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) error {
+	if volumePolicy(pool) != poolv1.VirtualMachinePoolVolumePolicyRetain {
+		background := metav1.DeletePropagationBackground
+		return c.clientset.VirtualMachine(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{PropagationPolicy: &background})
+	}
+
+	orphan := metav1.DeletePropagationOrphan
+	err := c.clientset.VirtualMachine(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{PropagationPolicy: &orphan})
+	if err != nil {
+		return err
+	}
+	err = c.clientset.VirtualMachineInstance(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// newVMFromPool renders the VirtualMachine with the given index from the template of the pool.
+// The names of the DataVolumeTemplates are prefixed with the name of the VirtualMachine, so that
+// they are unique across pools, and DataVolume volumes referencing them are updated accordingly.
+func newVMFromPool(pool *poolv1.VirtualMachinePool, index int) *virtv1.VirtualMachine {
+	template := pool.Spec.VirtualMachineTemplate.DeepCopy()
+
+	vm := &virtv1.VirtualMachine{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	vm.ObjectMeta.Name = indexedName(pool.Name, index)
+	vm.ObjectMeta.GenerateName = ""
+	vm.ObjectMeta.Namespace = pool.Namespace
+	vm.ObjectMeta.Labels = template.ObjectMeta.Labels
+	vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+
+	dataVolumeNames := map[string]string{}
+	for i := range vm.Spec.DataVolumeTemplates {
+		name := fmt.Sprintf("%s-%s", vm.Name, vm.Spec.DataVolumeTemplates[i].Name)
+		dataVolumeNames[vm.Spec.DataVolumeTemplates[i].Name] = name
+		vm.Spec.DataVolumeTemplates[i].Name = name
+	}
+
+	if vm.Spec.Template != nil {
+		for i, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.DataVolume == nil {
+				continue
+			}
+			if name, ok := dataVolumeNames[volume.DataVolume.Name]; ok {
+				vm.Spec.Template.Spec.Volumes[i].DataVolume.Name = name
+			}
+		}
+	}
+
+	return vm
+}
+
+func indexedName(name string, index int) string {
+	return fmt.Sprintf("%s-%d", name, index)
+}
+
+// vmIndex returns the index of a VirtualMachine created by the pool, or -1 if the name does not carry one.
+func vmIndex(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) int {
+	suffix := strings.TrimPrefix(vm.Name, pool.Name+"-")
+	if suffix == vm.Name {
+		return -1
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 0 {
+		return -1
+	}
+	return index
+}
+
+// freeIndexes returns the lowest count indexes which are not used by any VirtualMachine of the pool.
+func freeIndexes(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) []int {
+	used := map[int]bool{}
+	for _, vm := range vms {
+		used[vmIndex(pool, vm)] = true
+	}
+
+	indexes := []int{}
+	for index := 0; len(indexes) < count; index++ {
+		if !used[index] {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+func selectionPolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolSelectionPolicy {
+	if pool.Spec.ScaleInStrategy != nil && pool.Spec.ScaleInStrategy.SelectionPolicy != nil {
+		return *pool.Spec.ScaleInStrategy.SelectionPolicy
+	}
+	return poolv1.VirtualMachinePoolSelectionPolicyLeastReady
+}
+
+func volumePolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolVolumePolicy {
+	if pool.Spec.ScaleInStrategy != nil && pool.Spec.ScaleInStrategy.VolumePolicy != nil {
+		return *pool.Spec.ScaleInStrategy.VolumePolicy
+	}
+	return poolv1.VirtualMachinePoolVolumePolicyDelete
+}
+
+// scaleInCandidates returns the VirtualMachines ordered by the selection policy of the pool.
+// The first VirtualMachines in the list are the first ones to be removed.
+func scaleInCandidates(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	candidates := make([]*virtv1.VirtualMachine, len(vms))
+	copy(candidates, vms)
+
+	switch selectionPolicy(pool) {
+	case poolv1.VirtualMachinePoolSelectionPolicyOldest:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if !candidates[i].CreationTimestamp.Equal(&candidates[j].CreationTimestamp) {
+				return candidates[i].CreationTimestamp.Before(&candidates[j].CreationTimestamp)
+			}
+			return vmIndex(pool, candidates[i]) < vmIndex(pool, candidates[j])
+		})
+	default:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Status.Ready != candidates[j].Status.Ready {
+				return !candidates[i].Status.Ready
+			}
+			return vmIndex(pool, candidates[i]) > vmIndex(pool, candidates[j])
+		})
+	}
+	return candidates
+}
+
+// filterActiveVMs takes a list of VMs and returns all VMs which are not terminating
+func filterActiveVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	filtered := []*virtv1.VirtualMachine{}
+	for _, vm := range vms {
+		if vm.DeletionTimestamp == nil {
+			filtered = append(filtered, vm)
+		}
+	}
+	return filtered
+}
+
+// filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
+func filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	filtered := []*virtv1.VirtualMachine{}
+	for _, vm := range vms {
+		if vm.Status.Ready {
+			filtered = append(filtered, vm)
+		}
+	}
+	return filtered
+}
+
+// listVMsOwnedByPool returns all VMs from the VirtualMachine cache which are controlled by the pool
+func (c *PoolController) listVMsOwnedByPool(pool *poolv1.VirtualMachinePool) ([]*virtv1.VirtualMachine, error) {
+	objs, err := c.vmInformer.GetIndexer().ByIndex(cache.NamespaceIndex, pool.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	vms := []*virtv1.VirtualMachine{}
+	for _, obj := range objs {
+		vm := obj.(*virtv1.VirtualMachine)
+		if controllerRef := metav1.GetControllerOf(vm); controllerRef != nil && controllerRef.UID == pool.UID {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+func vmKey(vm *virtv1.VirtualMachine) string {
+	return fmt.Sprintf("%v/%v", vm.ObjectMeta.Namespace, vm.ObjectMeta.Name)
+}
+
+func vmKeys(vms []*virtv1.VirtualMachine) []string {
+	keys := []string{}
+	for _, vm := range vms {
+		keys = append(keys, vmKey(vm))
+	}
+	return keys
+}
+
+// When a vm is created, enqueue the pool that manages it and update its expectations.
+func (c *PoolController) addVM(obj interface{}) {
+	vm := obj.(*virtv1.VirtualMachine)
+
+	if vm.DeletionTimestamp != nil {
+		// on a restart of the controller manager, it's possible a new vm shows up in a state that
+		// is already pending deletion. Prevent the vm from being a creation observation.
+		c.deleteVM(vm)
+		return
+	}
+
+	controllerRef := metav1.GetControllerOf(vm)
+	if controllerRef == nil {
+		return
+	}
+	pool := c.resolveControllerRef(vm.Namespace, controllerRef)
+	if pool == nil {
+		return
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return
+	}
+	log.Log.V(4).Object(vm).Infof("VirtualMachine created")
+	c.expectations.CreationObserved(poolKey)
+	c.enqueuePool(pool)
+}
+
+// When a vm is updated, figure out what pool manages it and wake it up.
+func (c *PoolController) updateVM(old, cur interface{}) {
+	curVM := cur.(*virtv1.VirtualMachine)
+	oldVM := old.(*virtv1.VirtualMachine)
+	if curVM.ResourceVersion == oldVM.ResourceVersion {
+		// Periodic resync will send update events for all known vms.
+		// Two different versions of the same vm will always have different RVs.
+		return
+	}
+
+	if curVM.DeletionTimestamp != nil {
+		// having a vm marked for deletion is enough to count as a deletion expectation
+		c.deleteVM(curVM)
+		return
+	}
+
+	curControllerRef := metav1.GetControllerOf(curVM)
+	oldControllerRef := metav1.GetControllerOf(oldVM)
+	controllerRefChanged := !reflect.DeepEqual(curControllerRef, oldControllerRef)
+	if controllerRefChanged && oldControllerRef != nil {
+		// The ControllerRef was changed. Sync the old controller, if any.
+		if pool := c.resolveControllerRef(oldVM.Namespace, oldControllerRef); pool != nil {
+			c.enqueuePool(pool)
+		}
+	}
+
+	if curControllerRef == nil {
+		return
+	}
+	pool := c.resolveControllerRef(curVM.Namespace, curControllerRef)
+	if pool == nil {
+		return
+	}
+	log.Log.V(4).Object(curVM).Infof("VirtualMachine updated")
+	c.enqueuePool(pool)
+}
+
+// When a vm is deleted, enqueue the pool that manages the vm and update its expectations.
+// obj could be an *virtv1.VirtualMachine, or a DeletionFinalStateUnknown marker item.
+func (c *PoolController) deleteVM(obj interface{}) {
+	vm, ok := obj.(*virtv1.VirtualMachine)
+
+	// When a delete is dropped, the relist will notice a vm in the store not
+	// in the list, leading to the insertion of a tombstone object which contains
+	// the deleted key/value. Note that this value might be stale.
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vm, ok = tombstone.Obj.(*virtv1.VirtualMachine)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vm %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+
+	controllerRef := metav1.GetControllerOf(vm)
+	if controllerRef == nil {
+		// No controller should care about orphans being deleted.
+		return
+	}
+	pool := c.resolveControllerRef(vm.Namespace, controllerRef)
+	if pool == nil {
+		return
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return
+	}
+	c.expectations.DeletionObserved(poolKey, vmKey(vm))
+	c.enqueuePool(pool)
+}
+
+func (c *PoolController) addPool(obj interface{}) {
+	c.enqueuePool(obj)
+}
+
+func (c *PoolController) deletePool(obj interface{}) {
+	c.enqueuePool(obj)
+}
+
+func (c *PoolController) updatePool(old, curr interface{}) {
+	c.enqueuePool(curr)
+}
+
+func (c *PoolController) enqueuePool(obj interface{}) {
+	logger := log.Log
+	pool := obj.(*poolv1.VirtualMachinePool)
+	key, err := controller.KeyFunc(pool)
+	if err != nil {
+		logger.Object(pool).Reason(err).Error("Failed to extract poolKey from pool.")
+	}
+	c.Queue.Add(key)
+}
+
+func (c *PoolController) hasCondition(pool *poolv1.VirtualMachinePool, cond poolv1.VirtualMachinePoolConditionType) bool {
+	for _, c := range pool.Status.Conditions {
+		if c.Type == cond {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *PoolController) removeCondition(pool *poolv1.VirtualMachinePool, cond poolv1.VirtualMachinePoolConditionType) {
+	var conds []poolv1.VirtualMachinePoolCondition
+	for _, c := range pool.Status.Conditions {
+		if c.Type == cond {
+			continue
+		}
+		conds = append(conds, c)
+	}
+	pool.Status.Conditions = conds
+}
+
+func (c *PoolController) updateStatus(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, scaleErr error) error {
+	diff := c.calcDiff(pool, vms)
+	readyReplicas := int32(len(filterReadyVMs(vms)))
+	labelSelector, err := metav1.LabelSelectorAsSelector(pool.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	// check if we have reached the equilibrium
+	statesMatch := int32(len(vms)) == pool.Status.Replicas && readyReplicas == pool.Status.ReadyReplicas
+
+	// check if we need to update because of appeared or disappeared errors
+	errorsMatch := (scaleErr != nil) == c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure)
+
+	// check if we need to update because pause was modified
+	pausedMatch := pool.Spec.Paused == c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused)
+
+	// check if the label selector changed
+	labelSelectorMatch := labelSelector.String() == pool.Status.LabelSelector
+
+	// in case the replica count matches and the scaleErr and the error condition equal, don't update
+	if statesMatch && errorsMatch && pausedMatch && labelSelectorMatch {
+		return nil
+	}
+
+	pool.Status.LabelSelector = labelSelector.String()
+	pool.Status.Replicas = int32(len(vms))
+	pool.Status.ReadyReplicas = readyReplicas
+
+	// Add/Remove Paused condition
+	c.checkPaused(pool)
+
+	// Add/Remove Failure condition if necessary
+	c.checkFailure(pool, diff, scaleErr)
+
+	_, err = c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	// Finally trigger resumed or paused events
+	if !pausedMatch {
+		if pool.Spec.Paused {
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulPausedPoolReason, "Paused")
+		} else {
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumePoolReason, "Resumed")
+		}
+	}
+
+	return nil
+}
+
+func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	wantedReplicas := int32(1)
+	if pool.Spec.Replicas != nil {
+		wantedReplicas = *pool.Spec.Replicas
+	}
+
+	return len(vms) - int(wantedReplicas)
+}
+
+func (c *PoolController) checkPaused(pool *poolv1.VirtualMachinePool) {
+
+	if pool.Spec.Paused && !c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused) {
+
+		pool.Status.Conditions = append(pool.Status.Conditions, poolv1.VirtualMachinePoolCondition{
+			Type:               poolv1.VirtualMachinePoolReplicaPaused,
+			Reason:             "Paused",
+			Message:            "Controller got paused",
+			LastTransitionTime: metav1.Now(),
+			Status:             k8score.ConditionTrue,
+		})
+	} else if !pool.Spec.Paused && c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused) {
+		c.removeCondition(pool, poolv1.VirtualMachinePoolReplicaPaused)
+	}
+}
+
+func (c *PoolController) checkFailure(pool *poolv1.VirtualMachinePool, diff int, scaleErr error) {
+	if scaleErr != nil && !c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure) {
+		var reason string
+		if diff < 0 {
+			reason = FailedCreateVirtualMachineReason
+		} else {
+			reason = FailedDeleteVirtualMachineReason
+		}
+
+		pool.Status.Conditions = append(pool.Status.Conditions, poolv1.VirtualMachinePoolCondition{
+			Type:               poolv1.VirtualMachinePoolReplicaFailure,
+			Reason:             reason,
+			Message:            scaleErr.Error(),
+			LastTransitionTime: metav1.Now(),
+			Status:             k8score.ConditionTrue,
+		})
+
+	} else if scaleErr == nil && c.hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure) {
+		c.removeCondition(pool, poolv1.VirtualMachinePoolReplicaFailure)
+	}
+}
+
+func poolOwnerRef(pool *poolv1.VirtualMachinePool) metav1.OwnerReference {
+	t := true
+	return metav1.OwnerReference{
+		APIVersion:         poolv1.SchemeGroupVersion.String(),
+		Kind:               poolv1.VirtualMachinePoolKind,
+		Name:               pool.ObjectMeta.Name,
+		UID:                pool.ObjectMeta.UID,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+}
+
+// resolveControllerRef returns the controller referenced by a ControllerRef,
+// or nil if the ControllerRef could not be resolved to a matching controller
+// of the correct Kind.
+func (c *PoolController) resolveControllerRef(namespace string, controllerRef *metav1.OwnerReference) *poolv1.VirtualMachinePool {
+	// We can't look up by UID, so look up by Name and then verify UID.
+	// Don't even try to look up by Name if it's the wrong Kind.
+	if controllerRef.Kind != poolv1.VirtualMachinePoolKind {
+		return nil
+	}
+	pool, exists, err := c.poolInformer.GetStore().GetByKey(namespace + "/" + controllerRef.Name)
+	if err != nil {
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+
+	if pool.(*poolv1.VirtualMachinePool).UID != controllerRef.UID {
+		// The controller we found with this Name is not the same one that the
+		// ControllerRef points to.
+		return nil
+	}
+	return pool.(*poolv1.VirtualMachinePool)
+}
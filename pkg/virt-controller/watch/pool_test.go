@@ -0,0 +1,398 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Pool", func() {
+
+	Context("One valid Pool controller given", func() {
+
+		var ctrl *gomock.Controller
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		var virtualMachineInterface *kubecli.MockVirtualMachineInterface
+		var kubevirtClient *kubevirtfake.Clientset
+		var poolSource *framework.FakeControllerSource
+		var vmInformer cache.SharedIndexInformer
+		var poolInformer cache.SharedIndexInformer
+		var stop chan struct{}
+		var controller *PoolController
+		var recorder *record.FakeRecorder
+		var mockQueue *testutils.MockWorkQueue
+
+		syncCaches := func(stop chan struct{}) {
+			go vmInformer.Run(stop)
+			go poolInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(stop, vmInformer.HasSynced, poolInformer.HasSynced)).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			stop = make(chan struct{})
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+			virtualMachineInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+
+			vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			poolInformer, poolSource = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			recorder = record.NewFakeRecorder(100)
+
+			controller = NewPoolController(vmInformer, poolInformer, recorder, virtClient, uint(10))
+			// Wrap our workqueue to have a way to detect when we are done processing updates
+			mockQueue = testutils.NewMockWorkQueue(controller.Queue)
+			controller.Queue = mockQueue
+
+			// Set up mock client
+			virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(virtualMachineInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachinePool(metav1.NamespaceDefault).Return(kubevirtClient.PoolV1alpha1().VirtualMachinePools(metav1.NamespaceDefault)).AnyTimes()
+			syncCaches(stop)
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		addPool := func(pool *poolv1.VirtualMachinePool) {
+			_, err := kubevirtClient.PoolV1alpha1().VirtualMachinePools(pool.Namespace).Create(context.Background(), pool, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			mockQueue.ExpectAdds(1)
+			poolSource.Add(pool)
+			mockQueue.Wait()
+		}
+
+		addVM := func(vm *v1.VirtualMachine) {
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}
+
+		getPool := func(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePool {
+			updated, err := kubevirtClient.PoolV1alpha1().VirtualMachinePools(pool.Namespace).Get(context.Background(), pool.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updated
+		}
+
+		It("should create missing VMs with unique indexes and indexed DataVolumes", func() {
+			pool := DefaultPool(3)
+
+			addPool(pool)
+
+			var lock sync.Mutex
+			created := map[string]*v1.VirtualMachine{}
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).Times(3).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				created[vm.Name] = vm
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineReason, SuccessfulCreateVirtualMachineReason, SuccessfulCreateVirtualMachineReason)
+			Expect(created).To(HaveLen(3))
+			for i := 0; i < 3; i++ {
+				name := fmt.Sprintf("my-pool-%d", i)
+				Expect(created).To(HaveKey(name))
+				vm := created[name]
+				Expect(vm.Namespace).To(Equal(pool.Namespace))
+				Expect(vm.Labels).To(Equal(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels))
+				Expect(metav1.GetControllerOf(vm).UID).To(Equal(pool.UID))
+				Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal(fmt.Sprintf("my-pool-%d-disk", i)))
+				Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal(fmt.Sprintf("my-pool-%d-disk", i)))
+			}
+		})
+
+		It("should not share DataVolumes between pools using the same template", func() {
+			pool := DefaultPool(1)
+			otherPool := DefaultPool(1)
+			otherPool.Name = "other-pool"
+
+			vm := newVMFromPool(pool, 0)
+			otherVM := newVMFromPool(otherPool, 0)
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("my-pool-0-disk"))
+			Expect(otherVM.Spec.DataVolumeTemplates[0].Name).To(Equal("other-pool-0-disk"))
+			Expect(otherVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("other-pool-0-disk"))
+		})
+
+		It("should fill up the lowest free indexes", func() {
+			pool := DefaultPool(3)
+			addVM(PoolVM(pool, 1))
+
+			addPool(pool)
+
+			var lock sync.Mutex
+			names := []string{}
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).Times(2).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				names = append(names, vm.Name)
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineReason, SuccessfulCreateVirtualMachineReason)
+			Expect(names).To(ConsistOf("my-pool-0", "my-pool-2"))
+			Expect(getPool(pool).Status.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should not reuse the index of a VM which is still terminating", func() {
+			pool := DefaultPool(1)
+			vm := PoolVM(pool, 0)
+			now := metav1.Now()
+			vm.DeletionTimestamp = &now
+			addVM(vm)
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				Expect(vm.Name).To(Equal("my-pool-1"))
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+		})
+
+		It("should create missing VMs in batches of a maximum of 10 VMs at once", func() {
+			pool := DefaultPool(15)
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).Times(10).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			for i := 0; i < 10; i++ {
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			}
+		})
+
+		It("should ignore VMs which are not controlled by the pool", func() {
+			pool := DefaultPool(1)
+			vm := PoolVM(pool, 0)
+			vm.OwnerReferences = nil
+			addVM(vm)
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				Expect(vm.Name).To(Equal("my-pool-0"))
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+		})
+
+		It("should not create missing VMs when it is paused and add paused condition", func() {
+			pool := DefaultPool(3)
+			pool.Spec.Paused = true
+
+			addPool(pool)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulPausedPoolReason)
+			updated := getPool(pool)
+			Expect(updated.Status.Conditions).To(HaveLen(1))
+			Expect(updated.Status.Conditions[0].Type).To(Equal(poolv1.VirtualMachinePoolReplicaPaused))
+		})
+
+		It("should detect that it has nothing to do", func() {
+			pool := DefaultPool(1)
+			vm := PoolVM(pool, 0)
+			vm.Status.Ready = true
+			pool.Status.Replicas = 1
+			pool.Status.ReadyReplicas = 1
+			pool.Status.LabelSelector = "test=test"
+			addVM(vm)
+
+			addPool(pool)
+
+			controller.Execute()
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should update the readyReplicas counter", func() {
+			pool := DefaultPool(2)
+			vm0 := PoolVM(pool, 0)
+			vm0.Status.Ready = true
+			addVM(vm0)
+			addVM(PoolVM(pool, 1))
+
+			addPool(pool)
+
+			controller.Execute()
+
+			updated := getPool(pool)
+			Expect(updated.Status.Replicas).To(Equal(int32(2)))
+			Expect(updated.Status.ReadyReplicas).To(Equal(int32(1)))
+		})
+
+		It("should remove VMs which are not ready first and then the ones with the highest index", func() {
+			pool := DefaultPool(1)
+			for i := 0; i < 3; i++ {
+				vm := PoolVM(pool, i)
+				vm.Status.Ready = i != 0
+				addVM(vm)
+			}
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Delete("my-pool-0", gomock.Any()).Return(nil)
+			virtualMachineInterface.EXPECT().Delete("my-pool-2", gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulDeleteVirtualMachineReason, SuccessfulDeleteVirtualMachineReason)
+		})
+
+		It("should remove the oldest VMs first with the Oldest selection policy", func() {
+			pool := DefaultPool(1)
+			policy := poolv1.VirtualMachinePoolSelectionPolicyOldest
+			pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{SelectionPolicy: &policy}
+			for i := 0; i < 3; i++ {
+				vm := PoolVM(pool, i)
+				vm.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Duration(i) * -time.Minute))
+				addVM(vm)
+			}
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Delete("my-pool-1", gomock.Any()).Return(nil)
+			virtualMachineInterface.EXPECT().Delete("my-pool-2", gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulDeleteVirtualMachineReason, SuccessfulDeleteVirtualMachineReason)
+		})
+
+		It("should orphan the DataVolumes of removed VMs with the Retain volume policy", func() {
+			pool := DefaultPool(0)
+			policy := poolv1.VirtualMachinePoolVolumePolicyRetain
+			pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{VolumePolicy: &policy}
+			addVM(PoolVM(pool, 0))
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Delete("my-pool-0", gomock.Any()).Do(func(name string, options *metav1.DeleteOptions) {
+				Expect(options.PropagationPolicy).ToNot(BeNil())
+				Expect(*options.PropagationPolicy).To(Equal(metav1.DeletePropagationOrphan))
+			}).Return(nil)
+			vmiInterface.EXPECT().Delete("my-pool-0", gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+		})
+
+		It("should delete removed VMs with their DataVolumes by default", func() {
+			pool := DefaultPool(0)
+			addVM(PoolVM(pool, 0))
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Delete("my-pool-0", gomock.Any()).Do(func(name string, options *metav1.DeleteOptions) {
+				Expect(options.PropagationPolicy).ToNot(BeNil())
+				Expect(*options.PropagationPolicy).To(Equal(metav1.DeletePropagationBackground))
+			}).Return(nil)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+		})
+
+		It("should add a fail condition if scaling up fails", func() {
+			pool := DefaultPool(2)
+
+			addPool(pool)
+
+			virtualMachineInterface.EXPECT().Create(gomock.Any()).Times(2).Return(nil, fmt.Errorf("failure"))
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, FailedCreateVirtualMachineReason, FailedCreateVirtualMachineReason)
+			updated := getPool(pool)
+			Expect(updated.Status.Conditions).To(HaveLen(1))
+			cond := updated.Status.Conditions[0]
+			Expect(cond.Type).To(Equal(poolv1.VirtualMachinePoolReplicaFailure))
+			Expect(cond.Reason).To(Equal(FailedCreateVirtualMachineReason))
+			Expect(cond.Message).To(Equal("failure"))
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+		})
+	})
+})
+
+func DefaultPool(replicas int32) *poolv1.VirtualMachinePool {
+	vmi := v1.NewMinimalVMI("testvmi")
+	vmi.Spec.Volumes = []v1.Volume{
+		{
+			Name: "disk",
+			VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: "disk"},
+			},
+		},
+	}
+	labels := map[string]string{"test": "test"}
+
+	pool := &poolv1.VirtualMachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pool", Namespace: metav1.NamespaceDefault, ResourceVersion: "1", UID: types.UID("pool-uid")},
+		Spec: poolv1.VirtualMachinePoolSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.VirtualMachineSpec{
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: labels,
+						},
+						Spec: vmi.Spec,
+					},
+					DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "disk"},
+						},
+					},
+				},
+			},
+		},
+	}
+	return pool
+}
+
+func PoolVM(pool *poolv1.VirtualMachinePool, index int) *v1.VirtualMachine {
+	vm := newVMFromPool(pool, index)
+	vm.UID = types.UID(vm.Name)
+	vm.ResourceVersion = "1"
+	return vm
+}
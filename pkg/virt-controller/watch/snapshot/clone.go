@@ -0,0 +1,672 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	pvcCloneAnnotation = "clone.kubevirt.io/name"
+
+	lastCloneAnnotation = "clone.kubevirt.io/lastCloneUID"
+
+	cloneCompleteEvent = "VirtualMachineCloneComplete"
+
+	cloneErrorEvent = "VirtualMachineCloneError"
+
+	cloneFailedEvent = "VirtualMachineCloneFailed"
+)
+
+func cloneSnapshotName(vmClone *clonev1.VirtualMachineClone) string {
+	return fmt.Sprintf("tmp-clone-%s", vmClone.UID)
+}
+
+func clonePVCName(vmClone *clonev1.VirtualMachineClone, volumeName string) string {
+	return fmt.Sprintf("clone-%s-%s", vmClone.UID, volumeName)
+}
+
+func cloneID(vmClone *clonev1.VirtualMachineClone) string {
+	return fmt.Sprintf("%s-%s", vmClone.Name, vmClone.UID)
+}
+
+func vmCloneProgressing(vmClone *clonev1.VirtualMachineClone) bool {
+	return vmClone.Status.Phase != clonev1.Succeeded && vmClone.Status.Phase != clonev1.Failed
+}
+
+func vmCloneSourceIsVM(vmClone *clonev1.VirtualMachineClone) bool {
+	source := vmClone.Spec.Source
+	return source != nil && source.APIGroup != nil && *source.APIGroup == kubevirtv1.GroupName && source.Kind == "VirtualMachine"
+}
+
+func vmCloneSourceIsSnapshot(vmClone *clonev1.VirtualMachineClone) bool {
+	source := vmClone.Spec.Source
+	return source != nil && source.APIGroup != nil && *source.APIGroup == snapshotv1.SchemeGroupVersion.Group && source.Kind == "VirtualMachineSnapshot"
+}
+
+func (ctrl *VMCloneController) updateVMClone(vmCloneIn *clonev1.VirtualMachineClone) (time.Duration, error) {
+	logger := log.Log.Object(vmCloneIn)
+
+	logger.V(1).Infof("Updating VirtualMachineClone")
+
+	if !vmCloneProgressing(vmCloneIn) {
+		if vmCloneIn.Status.Phase == clonev1.Succeeded {
+			return 0, ctrl.deleteCloneSnapshot(vmCloneIn)
+		}
+		return 0, nil
+	}
+
+	vmCloneOut := vmCloneIn.DeepCopy()
+
+	var err error
+	switch vmCloneOut.Status.Phase {
+	case clonev1.PhaseUnset:
+		err = ctrl.initVMClone(vmCloneOut)
+	case clonev1.SnapshotInProgress:
+		err = ctrl.reconcileSnapshot(vmCloneOut)
+	case clonev1.RestoreInProgress:
+		err = ctrl.reconcileVolumeRestores(vmCloneOut)
+	case clonev1.CreatingTargetVM:
+		err = ctrl.reconcileTargetVM(vmCloneOut)
+	default:
+		err = fmt.Errorf("unexpected phase %q", vmCloneOut.Status.Phase)
+	}
+
+	if err != nil {
+		logger.Reason(err).Error("Error reconciling VirtualMachineClone")
+		return 0, ctrl.doUpdateError(vmCloneIn, err)
+	}
+
+	return 0, ctrl.doUpdate(vmCloneIn, vmCloneOut)
+}
+
+func (ctrl *VMCloneController) initVMClone(vmClone *clonev1.VirtualMachineClone) error {
+	var nextPhase clonev1.VirtualMachineClonePhase
+	var snapshotName string
+
+	switch {
+	case vmCloneSourceIsVM(vmClone):
+		vm, err := ctrl.getVM(vmClone.Namespace, vmClone.Spec.Source.Name)
+		if err != nil {
+			return err
+		}
+
+		if vm == nil {
+			return fmt.Errorf("source VirtualMachine %s/%s does not exist", vmClone.Namespace, vmClone.Spec.Source.Name)
+		}
+
+		nextPhase = clonev1.SnapshotInProgress
+		snapshotName = cloneSnapshotName(vmClone)
+	case vmCloneSourceIsSnapshot(vmClone):
+		nextPhase = clonev1.RestoreInProgress
+		snapshotName = vmClone.Spec.Source.Name
+	default:
+		ctrl.fail(vmClone, "unsupported source")
+		return nil
+	}
+
+	var targetName string
+	if target := vmClone.Spec.Target; target != nil {
+		if target.APIGroup == nil || *target.APIGroup != kubevirtv1.GroupName || target.Kind != "VirtualMachine" {
+			ctrl.fail(vmClone, "unsupported target")
+			return nil
+		}
+		targetName = target.Name
+	}
+
+	if targetName == "" {
+		targetName = "vm-clone-" + rand.String(5)
+	}
+
+	vm, err := ctrl.getVM(vmClone.Namespace, targetName)
+	if err != nil {
+		return err
+	}
+
+	if vm != nil {
+		ctrl.fail(vmClone, fmt.Sprintf("target VirtualMachine %s/%s already exists", vmClone.Namespace, targetName))
+		return nil
+	}
+
+	vmClone.Status.Phase = nextPhase
+	vmClone.Status.SnapshotName = &snapshotName
+	vmClone.Status.TargetName = &targetName
+	vmClone.Status.CreationTime = currentTime()
+	updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineClone"))
+	updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineClone"))
+
+	return nil
+}
+
+func (ctrl *VMCloneController) reconcileSnapshot(vmClone *clonev1.VirtualMachineClone) error {
+	vmSnapshot, err := ctrl.getVMSnapshot(vmClone.Namespace, *vmClone.Status.SnapshotName)
+	if err != nil {
+		return err
+	}
+
+	if vmSnapshot == nil {
+		if err = ctrl.createSnapshot(vmClone); err != nil {
+			return err
+		}
+
+		updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Creating VirtualMachineSnapshot"))
+		updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for VirtualMachineSnapshot"))
+		return nil
+	}
+
+	if !vmSnapshotReady(vmSnapshot) {
+		updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Waiting for VirtualMachineSnapshot"))
+		updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for VirtualMachineSnapshot"))
+		return nil
+	}
+
+	vmClone.Status.Phase = clonev1.RestoreInProgress
+	updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"))
+	updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"))
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createSnapshot(vmClone *clonev1.VirtualMachineClone) error {
+	apiGroup := kubevirtv1.GroupName
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      *vmClone.Status.SnapshotName,
+			Namespace: vmClone.Namespace,
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     vmClone.Spec.Source.Name,
+			},
+		},
+	}
+	ownByClone(vmClone, vmSnapshot)
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(vmClone.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	return err
+}
+
+func (ctrl *VMCloneController) reconcileVolumeRestores(vmClone *clonev1.VirtualMachineClone) error {
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	sourceVM := content.Spec.Source.VirtualMachine
+	if sourceVM == nil {
+		return fmt.Errorf("unexpected snapshot source")
+	}
+
+	waitingPVC := false
+	for _, vb := range content.Spec.VolumeBackups {
+		pvcName := clonePVCName(vmClone, vb.VolumeName)
+		pvc, err := ctrl.getPVC(vmClone.Namespace, pvcName)
+		if err != nil {
+			return err
+		}
+
+		if pvc == nil {
+			if err = ctrl.createClonePVC(vmClone, sourceVM, vb, pvcName); err != nil {
+				return err
+			}
+			waitingPVC = true
+		} else if pvc.Status.Phase == corev1.ClaimPending {
+			bindingMode, err := getBindingMode(ctrl.StorageClassInformer, pvc)
+			if err != nil {
+				return err
+			}
+
+			if bindingMode == nil || *bindingMode == storagev1.VolumeBindingImmediate {
+				waitingPVC = true
+			}
+		} else if pvc.Status.Phase != corev1.ClaimBound {
+			return fmt.Errorf("PVC %s/%s in status %q", pvc.Namespace, pvc.Name, pvc.Status.Phase)
+		}
+	}
+
+	if waitingPVC {
+		updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"))
+		updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"))
+		return nil
+	}
+
+	vmClone.Status.Phase = clonev1.CreatingTargetVM
+	updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Creating target VirtualMachine"))
+	updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for target VirtualMachine"))
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createClonePVC(vmClone *clonev1.VirtualMachineClone, sourceVM *kubevirtv1.VirtualMachine, volumeBackup snapshotv1.VolumeBackup, name string) error {
+	pvc, err := newRestorePVC(volumeBackup, name)
+	if err != nil {
+		return err
+	}
+
+	pvc.Annotations[pvcCloneAnnotation] = vmClone.Name
+	if dataVolumeTemplateIndex(sourceVM, volumeBackup.VolumeName) >= 0 {
+		// the datavolume created from the target template will adopt the PVC
+		pvc.Annotations[populatedForPVCAnnotation] = name
+	}
+
+	// owned by the clone until the target VirtualMachine exists
+	ownByClone(vmClone, pvc)
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	return err
+}
+
+func (ctrl *VMCloneController) reconcileTargetVM(vmClone *clonev1.VirtualMachineClone) error {
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	sourceVM := content.Spec.Source.VirtualMachine
+	if sourceVM == nil {
+		return fmt.Errorf("unexpected snapshot source")
+	}
+
+	vm, err := ctrl.getVM(vmClone.Namespace, *vmClone.Status.TargetName)
+	if err != nil {
+		return err
+	}
+
+	if vm == nil {
+		if _, err = ctrl.Client.VirtualMachine(vmClone.Namespace).Create(newCloneTargetVM(vmClone, content)); err != nil {
+			return err
+		}
+
+		updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionTrue, "Creating target VirtualMachine"))
+		updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, "Waiting for target VirtualMachine"))
+		return nil
+	}
+
+	if vm.Annotations[lastCloneAnnotation] != cloneID(vmClone) {
+		ctrl.fail(vmClone, fmt.Sprintf("target VirtualMachine %s/%s already exists", vm.Namespace, vm.Name))
+		return nil
+	}
+
+	for _, vb := range content.Spec.VolumeBackups {
+		if err = ctrl.handOverPVC(vmClone, vm, sourceVM, vb.VolumeName); err != nil {
+			return err
+		}
+	}
+
+	if err = ctrl.deleteCloneSnapshot(vmClone); err != nil {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(
+		vmClone,
+		corev1.EventTypeNormal,
+		cloneCompleteEvent,
+		"Successfully completed VirtualMachineClone %s",
+		vmClone.Name,
+	)
+
+	vmClone.Status.Phase = clonev1.Succeeded
+	updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionTrue, "Operation complete"))
+
+	return nil
+}
+
+// deleteCloneSnapshot deletes the temporary snapshot of a clone from a VirtualMachine once all cloned PVCs
+// are bound. PVCs of a WaitForFirstConsumer storage class are only populated from the volume snapshots
+// when the target VirtualMachine starts, until then the snapshot their data source points at is kept.
+func (ctrl *VMCloneController) deleteCloneSnapshot(vmClone *clonev1.VirtualMachineClone) error {
+	if !vmCloneSourceIsVM(vmClone) || vmClone.Status.SnapshotName == nil {
+		return nil
+	}
+
+	vmSnapshot, err := ctrl.getVMSnapshot(vmClone.Namespace, *vmClone.Status.SnapshotName)
+	if err != nil || vmSnapshot == nil {
+		return err
+	}
+
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	for _, vb := range content.Spec.VolumeBackups {
+		pvc, err := ctrl.getPVC(vmClone.Namespace, clonePVCName(vmClone, vb.VolumeName))
+		if err != nil {
+			return err
+		}
+
+		if pvc != nil && pvc.Status.Phase != corev1.ClaimBound {
+			log.Log.Object(vmClone).V(3).Infof("Keeping VirtualMachineSnapshot %s until PVC %s is bound", vmSnapshot.Name, pvc.Name)
+			return nil
+		}
+	}
+
+	err = ctrl.Client.VirtualMachineSnapshot(vmClone.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// handOverPVC passes the ownership of a cloned PVC from the clone to the target VM
+func (ctrl *VMCloneController) handOverPVC(vmClone *clonev1.VirtualMachineClone, vm, sourceVM *kubevirtv1.VirtualMachine, volumeName string) error {
+	pvc, err := ctrl.getPVC(vmClone.Namespace, clonePVCName(vmClone, volumeName))
+	if err != nil {
+		return err
+	}
+
+	if pvc == nil {
+		return fmt.Errorf("pvc %s/%s does not exist and should", vmClone.Namespace, clonePVCName(vmClone, volumeName))
+	}
+
+	// datavolumes will take ownership of their PVC
+	var ownerRefs []metav1.OwnerReference
+	if dataVolumeTemplateIndex(sourceVM, volumeName) < 0 {
+		ownerRefs = []metav1.OwnerReference{
+			*metav1.NewControllerRef(vm, kubevirtv1.VirtualMachineGroupVersionKind),
+		}
+	}
+
+	if reflect.DeepEqual(pvc.OwnerReferences, ownerRefs) {
+		return nil
+	}
+
+	pvc.OwnerReferences = ownerRefs
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Update(context.Background(), pvc, metav1.UpdateOptions{})
+	return err
+}
+
+// newCloneTargetVM creates the target VM out of the VM stored in the snapshot content,
+// pointing its volumes to the cloned PVCs and dropping the identity of the source
+func newCloneTargetVM(vmClone *clonev1.VirtualMachineClone, content *snapshotv1.VirtualMachineSnapshotContent) *kubevirtv1.VirtualMachine {
+	sourceVM := content.Spec.Source.VirtualMachine
+
+	vm := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        *vmClone.Status.TargetName,
+			Namespace:   vmClone.Namespace,
+			Labels:      filterKeys(sourceVM.Labels, vmClone.Spec.LabelFilters),
+			Annotations: filterKeys(sourceVM.Annotations, vmClone.Spec.AnnotationFilters),
+		},
+		Spec: *sourceVM.Spec.DeepCopy(),
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = make(map[string]string)
+	}
+	delete(vm.Annotations, lastRestoreAnnotation)
+	vm.Annotations[lastCloneAnnotation] = cloneID(vmClone)
+
+	for _, vb := range content.Spec.VolumeBackups {
+		pvcName := clonePVCName(vmClone, vb.VolumeName)
+		for i := range vm.Spec.Template.Spec.Volumes {
+			v := &vm.Spec.Template.Spec.Volumes[i]
+			if v.Name != vb.VolumeName {
+				continue
+			}
+
+			if v.DataVolume != nil {
+				if templateIndex := dataVolumeTemplateIndex(sourceVM, v.Name); templateIndex >= 0 {
+					vm.Spec.DataVolumeTemplates[templateIndex].Name = pvcName
+					v.DataVolume.Name = pvcName
+				} else {
+					// convert to PersistentVolumeClaim volume
+					v.VolumeSource = kubevirtv1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcName,
+						},
+					}
+				}
+			} else if v.PersistentVolumeClaim != nil {
+				v.PersistentVolumeClaim.ClaimName = pvcName
+			}
+		}
+	}
+
+	domain := &vm.Spec.Template.Spec.Domain
+	for i := range domain.Devices.Interfaces {
+		// an empty MAC address is generated when the VMI starts
+		iface := &domain.Devices.Interfaces[i]
+		iface.MacAddress = vmClone.Spec.NewMacAddresses[iface.Name]
+	}
+
+	if domain.Firmware != nil {
+		// an empty firmware UUID is derived from the VM name
+		if domain.Firmware.UUID != "" {
+			domain.Firmware.UUID = types.UID(uuid.NewRandom().String())
+		}
+
+		if domain.Firmware.Serial != "" {
+			domain.Firmware.Serial = uuid.NewRandom().String()
+		}
+	}
+
+	if vmClone.Spec.NewSMBiosSerial != nil {
+		if domain.Firmware == nil {
+			domain.Firmware = &kubevirtv1.Firmware{}
+		}
+		domain.Firmware.Serial = *vmClone.Spec.NewSMBiosSerial
+	}
+
+	return vm
+}
+
+func dataVolumeTemplateIndex(vm *kubevirtv1.VirtualMachine, volumeName string) int {
+	for _, v := range vm.Spec.Template.Spec.Volumes {
+		if v.Name != volumeName || v.DataVolume == nil {
+			continue
+		}
+
+		for i, dvt := range vm.Spec.DataVolumeTemplates {
+			if dvt.Name == v.DataVolume.Name {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// filterKeys returns the entries of m whose keys pass the filters. Filters are
+// evaluated in order, the last matching one wins and a leading "!" excludes the key
+func filterKeys(m map[string]string, filters []string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for k, v := range m {
+		include := len(filters) == 0
+		for _, filter := range filters {
+			negate := strings.HasPrefix(filter, "!")
+			if keyMatches(strings.TrimPrefix(filter, "!"), k) {
+				include = !negate
+			}
+		}
+
+		if include {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// keyMatches matches key against a pattern in which "*" matches any sequence of characters
+func keyMatches(pattern, key string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == key
+	}
+
+	if !strings.HasPrefix(key, parts[0]) {
+		return false
+	}
+	key = key[len(parts[0]):]
+
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		i := strings.Index(key, part)
+		if i < 0 {
+			return false
+		}
+		key = key[i+len(part):]
+	}
+
+	return strings.HasSuffix(key, parts[last])
+}
+
+func ownByClone(vmClone *clonev1.VirtualMachineClone, obj metav1.Object) {
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(vmClone, clonev1.SchemeGroupVersion.WithKind(clonev1.VirtualMachineCloneKind)),
+	})
+}
+
+func (ctrl *VMCloneController) fail(vmClone *clonev1.VirtualMachineClone, reason string) {
+	ctrl.Recorder.Eventf(
+		vmClone,
+		corev1.EventTypeWarning,
+		cloneFailedEvent,
+		"VirtualMachineClone failed: %s",
+		reason,
+	)
+
+	vmClone.Status.Phase = clonev1.Failed
+	updateCloneCondition(vmClone, newCloneProgressingCondition(corev1.ConditionFalse, reason))
+	updateCloneCondition(vmClone, newCloneReadyCondition(corev1.ConditionFalse, reason))
+}
+
+func (ctrl *VMCloneController) doUpdateError(vmClone *clonev1.VirtualMachineClone, err error) error {
+	ctrl.Recorder.Eventf(
+		vmClone,
+		corev1.EventTypeWarning,
+		cloneErrorEvent,
+		"VirtualMachineClone encountered error %s",
+		err.Error(),
+	)
+
+	updated := vmClone.DeepCopy()
+
+	updateCloneCondition(updated, newCloneProgressingCondition(corev1.ConditionFalse, err.Error()))
+	updateCloneCondition(updated, newCloneReadyCondition(corev1.ConditionFalse, err.Error()))
+	if err2 := ctrl.doUpdate(vmClone, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMCloneController) doUpdate(original, updated *clonev1.VirtualMachineClone) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineClone(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMCloneController) getSnapshotContent(vmClone *clonev1.VirtualMachineClone) (*snapshotv1.VirtualMachineSnapshotContent, error) {
+	vms, err := getReadyVMSnapshot(ctrl.VMSnapshotInformer, vmClone.Namespace, *vmClone.Status.SnapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	return getReadyVMSnapshotContent(ctrl.VMSnapshotContentInformer, vms)
+}
+
+func (ctrl *VMCloneController) getVMSnapshot(namespace, name string) (*snapshotv1.VirtualMachineSnapshot, error) {
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy(), nil
+}
+
+func (ctrl *VMCloneController) getVM(namespace, name string) (*kubevirtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
+}
+
+func (ctrl *VMCloneController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*corev1.PersistentVolumeClaim).DeepCopy(), nil
+}
+
+func newCloneReadyCondition(status corev1.ConditionStatus, reason string) clonev1.Condition {
+	return clonev1.Condition{
+		Type:               clonev1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func newCloneProgressingCondition(status corev1.ConditionStatus, reason string) clonev1.Condition {
+	return clonev1.Condition{
+		Type:               clonev1.ConditionProgressing,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCloneCondition(vmClone *clonev1.VirtualMachineClone, c clonev1.Condition) {
+	for i := range vmClone.Status.Conditions {
+		if vmClone.Status.Conditions[i].Type == c.Type {
+			if vmClone.Status.Conditions[i].Status != c.Status || vmClone.Status.Conditions[i].Reason != c.Reason {
+				vmClone.Status.Conditions[i] = c
+			}
+			return
+		}
+	}
+
+	vmClone.Status.Conditions = append(vmClone.Status.Conditions, c)
+}
@@ -0,0 +1,665 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Clone controller", func() {
+	const (
+		cloneUID     = "clone-uid"
+		cloneName    = "clone"
+		sourceVMName = "testvm"
+		targetVMName = "testvm-clone"
+		snapshotName = "tmp-clone-clone-uid"
+		pvcName      = "clone-clone-uid-disk1"
+	)
+
+	var (
+		timeStamp         = metav1.Now()
+		snapshotAPIGroup  = snapshotv1.SchemeGroupVersion.Group
+		cloneStorageClass = "sc"
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &timeStamp
+	}
+
+	createClone := func() *clonev1.VirtualMachineClone {
+		return &clonev1.VirtualMachineClone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cloneName,
+				Namespace: testNamespace,
+				UID:       cloneUID,
+			},
+			Spec: clonev1.VirtualMachineCloneSpec{
+				Source: &corev1.TypedLocalObjectReference{
+					APIGroup: &vmAPIGroup,
+					Kind:     "VirtualMachine",
+					Name:     sourceVMName,
+				},
+				Target: &corev1.TypedLocalObjectReference{
+					APIGroup: &vmAPIGroup,
+					Kind:     "VirtualMachine",
+					Name:     targetVMName,
+				},
+			},
+		}
+	}
+
+	createCloneInPhase := func(phase clonev1.VirtualMachineClonePhase) *clonev1.VirtualMachineClone {
+		c := createClone()
+		c.Status = clonev1.VirtualMachineCloneStatus{
+			Phase:        phase,
+			SnapshotName: &[]string{snapshotName}[0],
+			TargetName:   &[]string{targetVMName}[0],
+			CreationTime: timeFunc(),
+			Conditions: []clonev1.Condition{
+				newCloneProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineClone"),
+				newCloneReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineClone"),
+			},
+		}
+		return c
+	}
+
+	createSnapshot := func() *snapshotv1.VirtualMachineSnapshot {
+		s := createVirtualMachineSnapshot(testNamespace, snapshotName, sourceVMName)
+		s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			ReadyToUse:   &t,
+			CreationTime: timeFunc(),
+			SourceUID:    &vmUID,
+		}
+		return s
+	}
+
+	createSourceVM := func() *v1.VirtualMachine {
+		vm := createVirtualMachine(testNamespace, sourceVMName)
+		vm.Annotations = map[string]string{"some/annotation": "value"}
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", MacAddress: "de:ad:00:00:be:af"},
+			{Name: "secondary", MacAddress: "de:ad:00:00:be:ef"},
+		}
+		vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+			UUID:   "5d307ca9-b3ef-428c-8861-06e72d69f223",
+			Serial: "e4686d2c-6e8d-4335-b8fd-81bee22f4814",
+		}
+		return vm
+	}
+
+	createClonePVC := func(phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        pvcName,
+				Namespace:   testNamespace,
+				Annotations: map[string]string{"clone.kubevirt.io/name": cloneName},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(createClone(), clonev1.SchemeGroupVersion.WithKind("VirtualMachineClone")),
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &cloneStorageClass,
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	Context("One valid Clone controller given", func() {
+
+		var ctrl *gomock.Controller
+
+		var vmInterface *kubecli.MockVirtualMachineInterface
+
+		var vmCloneSource *framework.FakeControllerSource
+		var vmCloneInformer cache.SharedIndexInformer
+
+		var vmSnapshotSource *framework.FakeControllerSource
+		var vmSnapshotInformer cache.SharedIndexInformer
+
+		var vmSnapshotContentSource *framework.FakeControllerSource
+		var vmSnapshotContentInformer cache.SharedIndexInformer
+
+		var vmInformer cache.SharedIndexInformer
+		var vmSource *framework.FakeControllerSource
+
+		var pvcInformer cache.SharedIndexInformer
+		var pvcSource *framework.FakeControllerSource
+
+		var storageClassInformer cache.SharedIndexInformer
+		var storageClassSource *framework.FakeControllerSource
+
+		var stop chan struct{}
+		var controller *VMCloneController
+		var recorder *record.FakeRecorder
+		var mockVMCloneQueue *testutils.MockWorkQueue
+
+		var kubevirtClient *kubevirtfake.Clientset
+		var k8sClient *k8sfake.Clientset
+
+		syncCaches := func(stop chan struct{}) {
+			go vmCloneInformer.Run(stop)
+			go vmSnapshotInformer.Run(stop)
+			go vmSnapshotContentInformer.Run(stop)
+			go vmInformer.Run(stop)
+			go pvcInformer.Run(stop)
+			go storageClassInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(
+				stop,
+				vmCloneInformer.HasSynced,
+				vmSnapshotInformer.HasSynced,
+				vmSnapshotContentInformer.HasSynced,
+				vmInformer.HasSynced,
+				pvcInformer.HasSynced,
+				storageClassInformer.HasSynced,
+			)).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			stop = make(chan struct{})
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+
+			vmCloneInformer, vmCloneSource = testutils.NewFakeInformerWithIndexersFor(&clonev1.VirtualMachineClone{}, cache.Indexers{
+				"vmSource": func(obj interface{}) ([]string, error) {
+					c := obj.(*clonev1.VirtualMachineClone)
+					if c.Spec.Source != nil && c.Spec.Source.Kind == "VirtualMachine" {
+						return []string{cacheKeyFunc(c.Namespace, c.Spec.Source.Name)}, nil
+					}
+					return nil, nil
+				},
+				"snapshotSource": func(obj interface{}) ([]string, error) {
+					c := obj.(*clonev1.VirtualMachineClone)
+					if c.Spec.Source != nil && c.Spec.Source.Kind == "VirtualMachineSnapshot" {
+						return []string{cacheKeyFunc(c.Namespace, c.Spec.Source.Name)}, nil
+					}
+					return nil, nil
+				},
+				"vmTarget": func(obj interface{}) ([]string, error) {
+					c := obj.(*clonev1.VirtualMachineClone)
+					if c.Status.TargetName != nil {
+						return []string{cacheKeyFunc(c.Namespace, *c.Status.TargetName)}, nil
+					}
+					return nil, nil
+				},
+			})
+			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			vmSnapshotContentInformer, vmSnapshotContentSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+			vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+			storageClassInformer, storageClassSource = testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+
+			recorder = record.NewFakeRecorder(100)
+
+			controller = &VMCloneController{
+				Client:                    virtClient,
+				VMCloneInformer:           vmCloneInformer,
+				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMInformer:                vmInformer,
+				PVCInformer:               pvcInformer,
+				StorageClassInformer:      storageClassInformer,
+				Recorder:                  recorder,
+			}
+			controller.Init()
+
+			// Wrap our workqueue to have a way to detect when we are done processing updates
+			mockVMCloneQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
+			controller.vmCloneQueue = mockVMCloneQueue
+
+			// Set up mock client
+			virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+
+			virtClient.EXPECT().VirtualMachineClone(testNamespace).
+				Return(kubevirtClient.CloneV1alpha1().VirtualMachineClones(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+				Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+			kubevirtClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+
+			currentTime = timeFunc
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		addVirtualMachineClone := func(c *clonev1.VirtualMachineClone) {
+			syncCaches(stop)
+			mockVMCloneQueue.ExpectAdds(1)
+			vmCloneSource.Add(c)
+			mockVMCloneQueue.Wait()
+		}
+
+		expectVMCloneUpdate := func(vmClone *clonev1.VirtualMachineClone) {
+			vmClone.ResourceVersion = "1"
+			kubevirtClient.Fake.PrependReactor("update", "virtualmachineclones", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(testing.UpdateAction)
+				Expect(ok).To(BeTrue())
+
+				updateObj := update.GetObject().(*clonev1.VirtualMachineClone)
+				Expect(updateObj).To(Equal(vmClone))
+
+				return true, update.GetObject(), nil
+			})
+		}
+
+		Context("without snapshot", func() {
+			It("should initialize the clone and move to SnapshotInProgress", func() {
+				c := createClone()
+				uc := createCloneInPhase(clonev1.SnapshotInProgress)
+				vmSource.Add(createSourceVM())
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should generate a target name when none is given", func() {
+				c := createClone()
+				c.Spec.Target = nil
+				vmSource.Add(createSourceVM())
+				kubevirtClient.Fake.PrependReactor("update", "virtualmachineclones", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					updateObj := action.(testing.UpdateAction).GetObject().(*clonev1.VirtualMachineClone)
+					Expect(updateObj.Status.TargetName).ToNot(BeNil())
+					Expect(strings.HasPrefix(*updateObj.Status.TargetName, "vm-clone-")).To(BeTrue())
+					return true, updateObj, nil
+				})
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should go straight to RestoreInProgress for a snapshot source", func() {
+				c := createClone()
+				c.Spec.Source = &corev1.TypedLocalObjectReference{
+					APIGroup: &snapshotAPIGroup,
+					Kind:     "VirtualMachineSnapshot",
+					Name:     "existing-snapshot",
+				}
+				uc := createCloneInPhase(clonev1.RestoreInProgress)
+				uc.Spec.Source = c.Spec.Source
+				uc.Status.SnapshotName = &c.Spec.Source.Name
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should fail with an unsupported source", func() {
+				c := createClone()
+				c.Spec.Source.Kind = "VirtualMachineInstance"
+				reason := "unsupported source"
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.Failed
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, reason),
+					newCloneReadyCondition(corev1.ConditionFalse, reason),
+				}
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneFailed")
+			})
+
+			It("should fail if the target already exists", func() {
+				c := createClone()
+				reason := "target VirtualMachine default/testvm-clone already exists"
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.Failed
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, reason),
+					newCloneReadyCondition(corev1.ConditionFalse, reason),
+				}
+				vmSource.Add(createSourceVM())
+				vmSource.Add(createVirtualMachine(testNamespace, targetVMName))
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneFailed")
+			})
+
+			It("should error if the source VM does not exist", func() {
+				c := createClone()
+				reason := "source VirtualMachine default/testvm does not exist"
+				uc := c.DeepCopy()
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, reason),
+					newCloneReadyCondition(corev1.ConditionFalse, reason),
+				}
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneError")
+			})
+
+			It("should create a snapshot owned by the clone", func() {
+				c := createCloneInPhase(clonev1.SnapshotInProgress)
+				uc := c.DeepCopy()
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating VirtualMachineSnapshot"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for VirtualMachineSnapshot"),
+				}
+				kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					createObj := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+					Expect(createObj.Name).To(Equal(snapshotName))
+					Expect(createObj.Spec.Source.Name).To(Equal(sourceVMName))
+					Expect(createObj.OwnerReferences).To(HaveLen(1))
+					Expect(createObj.OwnerReferences[0].Kind).To(Equal("VirtualMachineClone"))
+					Expect(createObj.OwnerReferences[0].UID).To(BeEquivalentTo(cloneUID))
+					return true, createObj, nil
+				})
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+		})
+
+		Context("with initialized snapshot and content", func() {
+			var sourceVM *v1.VirtualMachine
+
+			BeforeEach(func() {
+				s := createSnapshot()
+				sourceVM = createSourceVM()
+				sc := createVirtualMachineSnapshotContent(s, sourceVM.DeepCopy())
+				s.Status.VirtualMachineSnapshotContentName = &sc.Name
+				sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					CreationTime: timeFunc(),
+					ReadyToUse:   &t,
+				}
+				bm := storagev1.VolumeBindingImmediate
+				vmSnapshotSource.Add(s)
+				vmSnapshotContentSource.Add(sc)
+				storageClassSource.Add(&storagev1.StorageClass{
+					ObjectMeta:        metav1.ObjectMeta{Name: cloneStorageClass},
+					VolumeBindingMode: &bm,
+				})
+			})
+
+			It("should move to RestoreInProgress once the snapshot is ready", func() {
+				c := createCloneInPhase(clonev1.SnapshotInProgress)
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.RestoreInProgress
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should create the PVCs from the volume snapshots", func() {
+				c := createCloneInPhase(clonev1.RestoreInProgress)
+				uc := c.DeepCopy()
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+				k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					createObj := action.(testing.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+					Expect(createObj.Name).To(Equal(pvcName))
+					Expect(createObj.Annotations).To(HaveKeyWithValue("clone.kubevirt.io/name", cloneName))
+					Expect(createObj.Annotations).To(HaveKeyWithValue("cdi.kubevirt.io/storage.populatedFor", pvcName))
+					Expect(createObj.Spec.DataSource).ToNot(BeNil())
+					Expect(createObj.Spec.DataSource.Name).To(Equal("vmsnapshot-snapshot-uid-volume-disk1"))
+					Expect(createObj.OwnerReferences[0].UID).To(BeEquivalentTo(cloneUID))
+					return true, createObj, nil
+				})
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should wait for bound PVCs", func() {
+				c := createCloneInPhase(clonev1.RestoreInProgress)
+				c.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+				pvcSource.Add(createClonePVC(corev1.ClaimPending))
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should move to CreatingTargetVM once the PVCs are bound", func() {
+				c := createCloneInPhase(clonev1.RestoreInProgress)
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.CreatingTargetVM
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating target VirtualMachine"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for target VirtualMachine"),
+				}
+				pvcSource.Add(createClonePVC(corev1.ClaimBound))
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should move to CreatingTargetVM with PVCs waiting for their first consumer", func() {
+				c := createCloneInPhase(clonev1.RestoreInProgress)
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.CreatingTargetVM
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating target VirtualMachine"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for target VirtualMachine"),
+				}
+				bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+				storageClassSource.Add(&storagev1.StorageClass{
+					ObjectMeta:        metav1.ObjectMeta{Name: cloneStorageClass},
+					VolumeBindingMode: &bindingMode,
+				})
+				pvcSource.Add(createClonePVC(corev1.ClaimPending))
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should create the target VM with a new identity", func() {
+				c := createCloneInPhase(clonev1.CreatingTargetVM)
+				c.Spec.NewMacAddresses = map[string]string{"secondary": "de:ad:00:00:00:01"}
+				c.Spec.AnnotationFilters = []string{"*", "!some/*"}
+				uc := c.DeepCopy()
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionTrue, "Creating target VirtualMachine"),
+					newCloneReadyCondition(corev1.ConditionFalse, "Waiting for target VirtualMachine"),
+				}
+				vmInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					Expect(vm.Name).To(Equal(targetVMName))
+					Expect(vm.Labels).To(Equal(sourceVM.Labels))
+					Expect(vm.Annotations).To(Equal(map[string]string{"clone.kubevirt.io/lastCloneUID": "clone-clone-uid"}))
+					Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal(pvcName))
+					Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal(pvcName))
+
+					interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+					Expect(interfaces[0].MacAddress).To(BeEmpty())
+					Expect(interfaces[1].MacAddress).To(Equal("de:ad:00:00:00:01"))
+
+					firmware := vm.Spec.Template.Spec.Domain.Firmware
+					Expect(firmware.UUID).ToNot(BeEmpty())
+					Expect(firmware.UUID).ToNot(Equal(sourceVM.Spec.Template.Spec.Domain.Firmware.UUID))
+					Expect(firmware.Serial).ToNot(BeEmpty())
+					Expect(firmware.Serial).ToNot(Equal(sourceVM.Spec.Template.Spec.Domain.Firmware.Serial))
+					return vm, nil
+				})
+				pvcSource.Add(createClonePVC(corev1.ClaimBound))
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should set the requested SMBIOS serial", func() {
+				c := createCloneInPhase(clonev1.CreatingTargetVM)
+				c.Spec.NewSMBiosSerial = &[]string{"serial"}[0]
+				vmInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					Expect(vm.Spec.Template.Spec.Domain.Firmware.Serial).To(Equal("serial"))
+					return vm, nil
+				})
+				kubevirtClient.Fake.PrependReactor("update", "virtualmachineclones", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(testing.UpdateAction).GetObject(), nil
+				})
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+			})
+
+			It("should hand over the PVCs, delete the snapshot and complete", func() {
+				c := createCloneInPhase(clonev1.CreatingTargetVM)
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.Succeeded
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newCloneReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+				targetVM := createVirtualMachine(testNamespace, targetVMName)
+				targetVM.UID = "target-uid"
+				targetVM.Annotations = map[string]string{"clone.kubevirt.io/lastCloneUID": "clone-clone-uid"}
+				vmSource.Add(targetVM)
+				pvcSource.Add(createClonePVC(corev1.ClaimBound))
+
+				k8sClient.Fake.PrependReactor("update", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					updateObj := action.(testing.UpdateAction).GetObject().(*corev1.PersistentVolumeClaim)
+					Expect(updateObj.Name).To(Equal(pvcName))
+					// the datavolume of the target takes ownership
+					Expect(updateObj.OwnerReferences).To(BeEmpty())
+					return true, updateObj, nil
+				})
+				snapshotDeleted := false
+				kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(testing.DeleteAction).GetName()).To(Equal(snapshotName))
+					snapshotDeleted = true
+					return true, nil, nil
+				})
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				Expect(snapshotDeleted).To(BeTrue())
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneComplete")
+			})
+
+			It("should complete but keep the snapshot while a PVC waits for its first consumer", func() {
+				c := createCloneInPhase(clonev1.CreatingTargetVM)
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.Succeeded
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newCloneReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+				targetVM := createVirtualMachine(testNamespace, targetVMName)
+				targetVM.UID = "target-uid"
+				targetVM.Annotations = map[string]string{"clone.kubevirt.io/lastCloneUID": "clone-clone-uid"}
+				vmSource.Add(targetVM)
+				bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+				storageClassSource.Add(&storagev1.StorageClass{
+					ObjectMeta:        metav1.ObjectMeta{Name: cloneStorageClass},
+					VolumeBindingMode: &bindingMode,
+				})
+				pvcSource.Add(createClonePVC(corev1.ClaimPending))
+
+				k8sClient.Fake.PrependReactor("update", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(testing.UpdateAction).GetObject(), nil
+				})
+				// deleting the snapshot fails the test through the catch-all reactor
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneComplete")
+			})
+
+			It("should delete the snapshot of a completed clone once its PVCs are bound", func() {
+				c := createCloneInPhase(clonev1.Succeeded)
+				pvcSource.Add(createClonePVC(corev1.ClaimBound))
+
+				snapshotDeleted := false
+				kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(testing.DeleteAction).GetName()).To(Equal(snapshotName))
+					snapshotDeleted = true
+					return true, nil, nil
+				})
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				Expect(snapshotDeleted).To(BeTrue())
+			})
+
+			It("should fail if the target VM was not created by the clone", func() {
+				c := createCloneInPhase(clonev1.CreatingTargetVM)
+				reason := "target VirtualMachine default/testvm-clone already exists"
+				uc := c.DeepCopy()
+				uc.Status.Phase = clonev1.Failed
+				uc.Status.Conditions = []clonev1.Condition{
+					newCloneProgressingCondition(corev1.ConditionFalse, reason),
+					newCloneReadyCondition(corev1.ConditionFalse, reason),
+				}
+				vmSource.Add(createVirtualMachine(testNamespace, targetVMName))
+				expectVMCloneUpdate(uc)
+				addVirtualMachineClone(c)
+				controller.processVMCloneWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineCloneFailed")
+			})
+		})
+	})
+
+	table.DescribeTable("should filter keys", func(filters []string, expected map[string]string) {
+		m := map[string]string{
+			"kubevirt.io/vm":   "vm",
+			"kubevirt.io/size": "small",
+			"app":              "web",
+		}
+		Expect(filterKeys(m, filters)).To(Equal(expected))
+	},
+		table.Entry("with no filters", nil, map[string]string{"kubevirt.io/vm": "vm", "kubevirt.io/size": "small", "app": "web"}),
+		table.Entry("with a wildcard", []string{"kubevirt.io/*"}, map[string]string{"kubevirt.io/vm": "vm", "kubevirt.io/size": "small"}),
+		table.Entry("with an exclusion", []string{"*", "!kubevirt.io/*"}, map[string]string{"app": "web"}),
+		table.Entry("with an exact key", []string{"*", "!kubevirt.io/*", "kubevirt.io/size"}, map[string]string{"kubevirt.io/size": "small", "app": "web"}),
+		table.Entry("with an infix wildcard", []string{"kube*/s*e"}, map[string]string{"kubevirt.io/size": "small"}),
+	)
+})
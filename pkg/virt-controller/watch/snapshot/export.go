@@ -0,0 +1,868 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	certutil "kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	virt_exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+)
+
+const (
+	exportPrefix = "virt-export"
+
+	exportTokenPrefix = "export-token"
+
+	exportPodLabel = "export.kubevirt.io/export"
+
+	exportCACertKey = "ca.crt"
+
+	exporterContainerName = "exporter"
+
+	exporterCommand = "/usr/bin/virt-exportserver"
+
+	exporterPort = 8443
+
+	// the qemu user, which owns the disk images
+	exporterUser int64 = 107
+
+	exportServicePort = 443
+
+	defaultExportTTL = 2 * time.Hour
+
+	exportTokenBytes = 32
+
+	exportErrorEvent = "VirtualMachineExportError"
+
+	exportExpiredEvent = "VirtualMachineExportExpired"
+)
+
+type exportVolume struct {
+	name      string
+	claimName string
+	block     bool
+}
+
+// exportSource holds the volumes and the VirtualMachine of an export
+// or the reason why the source cannot be exported
+type exportSource struct {
+	volumes []exportVolume
+	vm      *kubevirtv1.VirtualMachine
+
+	phase  exportv1.VirtualMachineExportPhase
+	reason string
+}
+
+func unavailableExportSource(phase exportv1.VirtualMachineExportPhase, reason string, args ...interface{}) *exportSource {
+	return &exportSource{
+		phase:  phase,
+		reason: fmt.Sprintf(reason, args...),
+	}
+}
+
+// exportResourceName is the name of the exporter Pod, Service and Secret.
+// It has to be a valid DNS label, long names are shortened and made unique
+// by a hash of the full name
+func exportResourceName(vmExport *exportv1.VirtualMachineExport) string {
+	name := fmt.Sprintf("%s-%s", exportPrefix, vmExport.Name)
+	if len(name) <= validation.DNS1035LabelMaxLength {
+		return name
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	hash := fmt.Sprintf("%08x", h.Sum32())
+
+	return fmt.Sprintf("%s-%s", name[:validation.DNS1035LabelMaxLength-len(hash)-1], hash)
+}
+
+func exportTokenSecretName(vmExport *exportv1.VirtualMachineExport) string {
+	return fmt.Sprintf("%s-%s", exportTokenPrefix, vmExport.Name)
+}
+
+func exportPVCName(vmExport *exportv1.VirtualMachineExport, volumeName string) string {
+	return fmt.Sprintf("export-%s-%s", vmExport.UID, volumeName)
+}
+
+func exportExpirationTime(vmExport *exportv1.VirtualMachineExport) metav1.Time {
+	ttl := defaultExportTTL
+	if vmExport.Spec.TTLDuration != nil {
+		ttl = vmExport.Spec.TTLDuration.Duration
+	}
+
+	return metav1.NewTime(vmExport.CreationTimestamp.Add(ttl))
+}
+
+func (ctrl *VMExportController) updateVMExport(vmExportIn *exportv1.VirtualMachineExport) (time.Duration, error) {
+	logger := log.Log.Object(vmExportIn)
+
+	logger.V(1).Infof("Updating VirtualMachineExport")
+
+	if vmExportIn.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	vmExportOut := vmExportIn.DeepCopy()
+	if vmExportOut.Status == nil {
+		vmExportOut.Status = &exportv1.VirtualMachineExportStatus{
+			Phase: exportv1.Pending,
+		}
+	}
+
+	expiration := exportExpirationTime(vmExportOut)
+	vmExportOut.Status.TTLExpirationTime = &expiration
+
+	remaining := expiration.Sub(currentTime().Time)
+	if remaining <= 0 {
+		ctrl.Recorder.Eventf(
+			vmExportIn,
+			corev1.EventTypeNormal,
+			exportExpiredEvent,
+			"VirtualMachineExport expired at %s",
+			expiration.String(),
+		)
+
+		err := ctrl.Client.VirtualMachineExport(vmExportIn.Namespace).Delete(context.Background(), vmExportIn.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+
+		return 0, nil
+	}
+
+	if err := ctrl.reconcileVMExport(vmExportOut); err != nil {
+		logger.Reason(err).Error("Error reconciling VirtualMachineExport")
+		return 0, ctrl.doUpdateError(vmExportIn, err)
+	}
+
+	return remaining, ctrl.doUpdate(vmExportIn, vmExportOut)
+}
+
+func (ctrl *VMExportController) reconcileVMExport(vmExport *exportv1.VirtualMachineExport) error {
+	vmExport.Status.ServiceName = exportResourceName(vmExport)
+
+	if err := ctrl.reconcileToken(vmExport); err != nil {
+		return err
+	}
+
+	source, err := ctrl.getExportSource(vmExport)
+	if err != nil {
+		return err
+	}
+
+	if source.reason == "" {
+		source, err = ctrl.checkExportVolumes(vmExport, source)
+		if err != nil {
+			return err
+		}
+	}
+
+	if source.reason != "" {
+		vmExport.Status.Phase = source.phase
+		vmExport.Status.Links = nil
+		updateExportCondition(vmExport, newExportCondition(exportv1.ConditionVolumesReady, corev1.ConditionFalse, source.reason))
+		updateExportCondition(vmExport, newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, source.reason))
+
+		// hand the volumes back to their other users
+		return ctrl.deleteExporterPod(vmExport)
+	}
+
+	if source.vm != nil {
+		vmName := source.vm.Name
+		vmExport.Status.VirtualMachineName = &vmName
+	}
+	updateExportCondition(vmExport, newExportCondition(exportv1.ConditionVolumesReady, corev1.ConditionTrue, "All volumes are available"))
+
+	pod, err := ctrl.getExporterPod(vmExport)
+	if err != nil {
+		return err
+	}
+
+	if pod == nil {
+		if err = ctrl.createExporter(vmExport, source); err != nil {
+			return err
+		}
+	}
+
+	if pod == nil || !isExporterPodReady(pod) {
+		vmExport.Status.Phase = exportv1.Pending
+		vmExport.Status.Links = nil
+		updateExportCondition(vmExport, newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, "Waiting for exporter pod"))
+		return nil
+	}
+
+	caCert, err := ctrl.getExporterCACert(vmExport)
+	if err != nil {
+		return err
+	}
+
+	links := &exportv1.VirtualMachineExportLinks{
+		Internal: newExportLink(fmt.Sprintf("%s.%s.svc", vmExport.Status.ServiceName, vmExport.Namespace), caCert, source),
+	}
+
+	host, err := ctrl.getExternalHost(vmExport)
+	if err != nil {
+		return err
+	}
+	if host != "" {
+		// the ingress terminates TLS with its own certificate
+		links.External = newExportLink(host, "", source)
+	}
+
+	vmExport.Status.Phase = exportv1.Ready
+	vmExport.Status.Links = links
+	updateExportCondition(vmExport, newExportCondition(exportv1.ConditionReady, corev1.ConditionTrue, "Exporter is serving the source"))
+
+	return nil
+}
+
+func (ctrl *VMExportController) reconcileToken(vmExport *exportv1.VirtualMachineExport) error {
+	if vmExport.Spec.TokenSecretRef != nil {
+		vmExport.Status.TokenSecretRef = vmExport.Spec.TokenSecretRef
+		return nil
+	}
+
+	if vmExport.Status.TokenSecretRef != nil {
+		return nil
+	}
+
+	token := make([]byte, exportTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exportTokenSecretName(vmExport),
+			Namespace: vmExport.Namespace,
+		},
+		Data: map[string][]byte{
+			exportv1.TokenKey: []byte(base64.RawURLEncoding.EncodeToString(token)),
+		},
+	}
+	ownByExport(vmExport, secret)
+
+	_, err := ctrl.Client.CoreV1().Secrets(vmExport.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	vmExport.Status.TokenSecretRef = &secret.Name
+
+	return nil
+}
+
+func (ctrl *VMExportController) getExportSource(vmExport *exportv1.VirtualMachineExport) (*exportSource, error) {
+	source := vmExport.Spec.Source
+	apiGroup := ""
+	if source.APIGroup != nil {
+		apiGroup = *source.APIGroup
+	}
+
+	switch {
+	case apiGroup == "" && source.Kind == "PersistentVolumeClaim":
+		return &exportSource{
+			volumes: []exportVolume{{name: source.Name, claimName: source.Name}},
+		}, nil
+	case apiGroup == kubevirtv1.GroupName && source.Kind == "VirtualMachine":
+		return ctrl.getVMExportSource(vmExport)
+	case apiGroup == snapshotv1.SchemeGroupVersion.Group && source.Kind == "VirtualMachineSnapshot":
+		return ctrl.getVMSnapshotExportSource(vmExport)
+	}
+
+	return unavailableExportSource(exportv1.Skipped, "unsupported source %s", source.Kind), nil
+}
+
+func (ctrl *VMExportController) getVMExportSource(vmExport *exportv1.VirtualMachineExport) (*exportSource, error) {
+	name := vmExport.Spec.Source.Name
+
+	vm, err := ctrl.getVM(vmExport.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if vm == nil {
+		return unavailableExportSource(exportv1.Skipped, "VirtualMachine %s does not exist", name), nil
+	}
+
+	_, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vmExport.Namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return unavailableExportSource(exportv1.Pending, "VirtualMachine %s is running", name), nil
+	}
+
+	source := &exportSource{vm: vm}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		var claimName string
+		if volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		} else if volume.DataVolume != nil {
+			claimName = volume.DataVolume.Name
+		} else {
+			continue
+		}
+
+		source.volumes = append(source.volumes, exportVolume{name: volume.Name, claimName: claimName})
+	}
+
+	if len(source.volumes) == 0 {
+		return unavailableExportSource(exportv1.Skipped, "VirtualMachine %s has no exportable volumes", name), nil
+	}
+
+	return source, nil
+}
+
+func (ctrl *VMExportController) getVMSnapshotExportSource(vmExport *exportv1.VirtualMachineExport) (*exportSource, error) {
+	name := vmExport.Spec.Source.Name
+
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmExport.Namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return unavailableExportSource(exportv1.Skipped, "VirtualMachineSnapshot %s does not exist", name), nil
+	}
+
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !vmSnapshotReady(vmSnapshot) {
+		return unavailableExportSource(exportv1.Pending, "VirtualMachineSnapshot %s is not ready", name), nil
+	}
+
+	content, err := getReadyVMSnapshotContent(ctrl.VMSnapshotContentInformer, vmSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &exportSource{vm: content.Spec.Source.VirtualMachine}
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		pvcName := exportPVCName(vmExport, volumeBackup.VolumeName)
+
+		pvc, err := ctrl.getPVC(vmExport.Namespace, pvcName)
+		if err != nil {
+			return nil, err
+		}
+
+		if pvc == nil {
+			if err = ctrl.createExportPVC(vmExport, volumeBackup, pvcName); err != nil {
+				return nil, err
+			}
+		}
+
+		source.volumes = append(source.volumes, exportVolume{name: volumeBackup.VolumeName, claimName: pvcName})
+	}
+
+	if len(source.volumes) == 0 {
+		return unavailableExportSource(exportv1.Skipped, "VirtualMachineSnapshot %s has no volumes", name), nil
+	}
+
+	return source, nil
+}
+
+func (ctrl *VMExportController) createExportPVC(vmExport *exportv1.VirtualMachineExport, volumeBackup snapshotv1.VolumeBackup, name string) error {
+	pvc, err := newRestorePVC(volumeBackup, name)
+	if err != nil {
+		return err
+	}
+
+	ownByExport(vmExport, pvc)
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmExport.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// checkExportVolumes makes sure all PVCs exist and are not used by anyone but the exporter
+func (ctrl *VMExportController) checkExportVolumes(vmExport *exportv1.VirtualMachineExport, source *exportSource) (*exportSource, error) {
+	claimNames := sets.NewString()
+	for i := range source.volumes {
+		volume := &source.volumes[i]
+
+		pvc, err := ctrl.getPVC(vmExport.Namespace, volume.claimName)
+		if err != nil {
+			return nil, err
+		}
+
+		if pvc == nil {
+			phase := exportv1.Pending
+			if vmExport.Spec.Source.Kind == "PersistentVolumeClaim" {
+				phase = exportv1.Skipped
+			}
+			return unavailableExportSource(phase, "PersistentVolumeClaim %s does not exist", volume.claimName), nil
+		}
+
+		volume.block = pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock
+		claimNames.Insert(volume.claimName)
+	}
+
+	pods, err := podsUsingPVCs(ctrl.PodInformer, vmExport.Namespace, claimNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+		if metav1.IsControlledBy(&pod, vmExport) {
+			continue
+		}
+
+		return unavailableExportSource(exportv1.Pending, "Volumes are in use by pod %s", pod.Name), nil
+	}
+
+	return source, nil
+}
+
+func (ctrl *VMExportController) createExporter(vmExport *exportv1.VirtualMachineExport, source *exportSource) error {
+	if err := ctrl.createExporterSecret(vmExport, source); err != nil {
+		return err
+	}
+
+	service := newExporterService(vmExport)
+	_, err := ctrl.Client.CoreV1().Services(vmExport.Namespace).Create(context.Background(), service, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	pod := newExporterPod(vmExport, source, ctrl.ExporterImage)
+	_, err = ctrl.Client.CoreV1().Pods(vmExport.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// createExporterSecret creates the serving certificate of the exporter and the VirtualMachine manifest
+func (ctrl *VMExportController) createExporterSecret(vmExport *exportv1.VirtualMachineExport, source *exportSource) error {
+	name := exportResourceName(vmExport)
+	// the certificate has to outlive the export
+	duration := exportExpirationTime(vmExport).Sub(currentTime().Time) + time.Hour
+
+	ca, err := triple.NewCA(exportv1.SchemeGroupVersion.Group, duration)
+	if err != nil {
+		return err
+	}
+
+	keyPair, err := triple.NewServerKeyPair(ca, fmt.Sprintf("%s.%s.svc", name, vmExport.Namespace), name, vmExport.Namespace, "cluster.local", nil, nil, duration)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vmExport.Namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certutil.EncodeCertPEM(keyPair.Cert),
+			corev1.TLSPrivateKeyKey: certutil.EncodePrivateKeyPEM(keyPair.Key),
+			exportCACertKey:         certutil.EncodeCertPEM(ca.Cert),
+		},
+	}
+	ownByExport(vmExport, secret)
+
+	if source.vm != nil {
+		manifest, err := exportManifest(source.vm)
+		if err != nil {
+			return err
+		}
+		secret.Data[filepath.Base(virt_exportserver.DefaultManifestFile)] = manifest
+	}
+
+	_, err = ctrl.Client.CoreV1().Secrets(vmExport.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ctrl *VMExportController) getExporterCACert(vmExport *exportv1.VirtualMachineExport) (string, error) {
+	if links := vmExport.Status.Links; links != nil && links.Internal != nil && links.Internal.Cert != "" {
+		return links.Internal.Cert, nil
+	}
+
+	secret, err := ctrl.Client.CoreV1().Secrets(vmExport.Namespace).Get(context.Background(), exportResourceName(vmExport), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret.Data[exportCACertKey]), nil
+}
+
+// exportManifest returns the VirtualMachine definition without any cluster specific metadata and status
+func exportManifest(vm *kubevirtv1.VirtualMachine) ([]byte, error) {
+	exported := &kubevirtv1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubevirtv1.GroupVersion.String(),
+			Kind:       kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vm.Name,
+			Labels:      vm.Labels,
+			Annotations: vm.Annotations,
+		},
+		Spec: *vm.Spec.DeepCopy(),
+	}
+
+	return yaml.Marshal(exported)
+}
+
+func newExporterService(vmExport *exportv1.VirtualMachineExport) *corev1.Service {
+	name := exportResourceName(vmExport)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vmExport.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				exportPodLabel: name,
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "export",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       exportServicePort,
+					TargetPort: intstr.FromInt(exporterPort),
+				},
+			},
+		},
+	}
+	ownByExport(vmExport, service)
+
+	return service
+}
+
+func newExporterPod(vmExport *exportv1.VirtualMachineExport, source *exportSource, image string) *corev1.Pod {
+	name := exportResourceName(vmExport)
+	runAsNonRoot := true
+	user := exporterUser
+
+	container := corev1.Container{
+		Name:    exporterContainerName,
+		Image:   image,
+		Command: []string{exporterCommand},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "export",
+				ContainerPort: exporterPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   virt_exportserver.ReadyPath,
+					Port:   intstr.FromInt(exporterPort),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+			PeriodSeconds: 5,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "token",
+				MountPath: filepath.Dir(virt_exportserver.DefaultTokenFile),
+				ReadOnly:  true,
+			},
+			{
+				Name:      "cert",
+				MountPath: filepath.Dir(virt_exportserver.DefaultCertFile),
+				ReadOnly:  true,
+			},
+		},
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name: "token",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: *vmExport.Status.TokenSecretRef,
+				},
+			},
+		},
+		{
+			Name: "cert",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: name,
+				},
+			},
+		},
+	}
+
+	for i, volume := range source.volumes {
+		// volume names of the source may clash with the names above
+		volumeName := fmt.Sprintf("volume-%d", i)
+		path := filepath.Join(virt_exportserver.DefaultVolumesDir, volume.name)
+
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volume.claimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+
+		if volume.block {
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       volumeName,
+				DevicePath: path,
+			})
+		} else {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: path,
+				ReadOnly:  true,
+			})
+		}
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vmExport.Namespace,
+			Labels: map[string]string{
+				kubevirtv1.AppLabel: exportPrefix,
+				exportPodLabel:      name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyOnFailure,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:    &user,
+				RunAsNonRoot: &runAsNonRoot,
+			},
+			Containers: []corev1.Container{container},
+			Volumes:    volumes,
+		},
+	}
+	ownByExport(vmExport, pod)
+
+	return pod
+}
+
+func newExportLink(host, cert string, source *exportSource) *exportv1.VirtualMachineExportLink {
+	link := &exportv1.VirtualMachineExportLink{
+		Cert: cert,
+	}
+
+	for _, volume := range source.volumes {
+		base := fmt.Sprintf("https://%s%s%s/", host, virt_exportserver.VolumesPath, volume.name)
+		link.Volumes = append(link.Volumes, exportv1.VirtualMachineExportVolume{
+			Name: volume.name,
+			Formats: []exportv1.VirtualMachineExportVolumeFormat{
+				{Format: exportv1.KubeVirtRaw, Url: base + virt_exportserver.RawFile},
+				{Format: exportv1.KubeVirtGz, Url: base + virt_exportserver.GzipFile},
+				{Format: exportv1.KubeVirtQcow2, Url: base + virt_exportserver.Qcow2File},
+			},
+		})
+	}
+
+	if source.vm != nil {
+		link.Manifests = append(link.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.AllManifests,
+			Url:  fmt.Sprintf("https://%s%s%s", host, virt_exportserver.ManifestsPath, exportv1.AllManifests),
+		})
+	}
+
+	return link
+}
+
+// getExternalHost returns the host of an Ingress routing to the exporter Service, if any
+func (ctrl *VMExportController) getExternalHost(vmExport *exportv1.VirtualMachineExport) (string, error) {
+	objs, err := ctrl.IngressInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vmExport.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	for _, obj := range objs {
+		ingress, ok := obj.(*networkingv1.Ingress)
+		if !ok {
+			return "", fmt.Errorf("expected Ingress, got %T", obj)
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if rule.Host == "" || rule.HTTP == nil {
+				continue
+			}
+
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service != nil && path.Backend.Service.Name == vmExport.Status.ServiceName {
+					return rule.Host, nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachineExport) (*corev1.Pod, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(cacheKeyFunc(vmExport.Namespace, exportResourceName(vmExport)))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	pod := obj.(*corev1.Pod)
+	if !metav1.IsControlledBy(pod, vmExport) {
+		return nil, fmt.Errorf("pod %s is not owned by VirtualMachineExport %s", pod.Name, vmExport.Name)
+	}
+
+	return pod.DeepCopy(), nil
+}
+
+func (ctrl *VMExportController) deleteExporterPod(vmExport *exportv1.VirtualMachineExport) error {
+	pod, err := ctrl.getExporterPod(vmExport)
+	if pod == nil || err != nil {
+		return err
+	}
+
+	err = ctrl.Client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func isExporterPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func ownByExport(vmExport *exportv1.VirtualMachineExport, obj metav1.Object) {
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(vmExport, exportv1.SchemeGroupVersion.WithKind(exportv1.VirtualMachineExportKind)),
+	})
+}
+
+func (ctrl *VMExportController) doUpdateError(vmExport *exportv1.VirtualMachineExport, err error) error {
+	ctrl.Recorder.Eventf(
+		vmExport,
+		corev1.EventTypeWarning,
+		exportErrorEvent,
+		"VirtualMachineExport encountered error %s",
+		err.Error(),
+	)
+
+	updated := vmExport.DeepCopy()
+	if updated.Status == nil {
+		updated.Status = &exportv1.VirtualMachineExportStatus{
+			Phase: exportv1.Pending,
+		}
+	}
+
+	updateExportCondition(updated, newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, err.Error()))
+	if err2 := ctrl.doUpdate(vmExport, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMExportController) doUpdate(original, updated *exportv1.VirtualMachineExport) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineExport(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMExportController) getVM(namespace, name string) (*kubevirtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
+}
+
+func (ctrl *VMExportController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if !exists || err != nil {
+		return nil, err
+	}
+
+	return obj.(*corev1.PersistentVolumeClaim).DeepCopy(), nil
+}
+
+func newExportCondition(conditionType exportv1.ConditionType, status corev1.ConditionStatus, reason string) exportv1.Condition {
+	return exportv1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateExportCondition(vmExport *exportv1.VirtualMachineExport, c exportv1.Condition) {
+	for i := range vmExport.Status.Conditions {
+		if vmExport.Status.Conditions[i].Type == c.Type {
+			if vmExport.Status.Conditions[i].Status != c.Status || vmExport.Status.Conditions[i].Reason != c.Reason {
+				vmExport.Status.Conditions[i] = c
+			}
+			return
+		}
+	}
+
+	vmExport.Status.Conditions = append(vmExport.Status.Conditions, c)
+}
@@ -0,0 +1,273 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+// VMExportController is responsible for exporting VMs, VMSnapshots and PVCs
+type VMExportController struct {
+	Client kubecli.KubevirtClient
+
+	// ExporterImage contains the virt-exportserver binary
+	ExporterImage string
+
+	VMExportInformer          cache.SharedIndexInformer
+	PVCInformer               cache.SharedIndexInformer
+	PodInformer               cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	VMIInformer               cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	IngressInformer           cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmExportQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the export controller
+func (ctrl *VMExportController) Init() {
+	ctrl.vmExportQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "export-controller-vmexport")
+
+	ctrl.VMExportInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMExport,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMExport(newObj) },
+		},
+	)
+
+	ctrl.PVCInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePVC,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePVC(newObj) },
+			DeleteFunc: ctrl.handlePVC,
+		},
+	)
+
+	ctrl.PodInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePod,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePod(newObj) },
+			DeleteFunc: ctrl.handlePod,
+		},
+	)
+
+	ctrl.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVM,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVM(newObj) },
+			DeleteFunc: ctrl.handleVM,
+		},
+	)
+
+	ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+
+	ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+
+	ctrl.IngressInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleIngress,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleIngress(newObj) },
+			DeleteFunc: ctrl.handleIngress,
+		},
+	)
+}
+
+// Run the controller
+func (ctrl *VMExportController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmExportQueue.ShutDown()
+
+	log.Log.Info("Starting export controller.")
+	defer log.Log.Info("Shutting down export controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMExportInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.IngressInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmExportWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMExportController) vmExportWorker() {
+	for ctrl.processVMExportWorkItem() {
+	}
+}
+
+func (ctrl *VMExportController) processVMExportWorkItem() bool {
+	return processWorkItem(ctrl.vmExportQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmExport worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMExportInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmExport, ok := storeObj.(*exportv1.VirtualMachineExport)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMExport(vmExport.DeepCopy())
+	})
+}
+
+func (ctrl *VMExportController) handleVMExport(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmExport, ok := obj.(*exportv1.VirtualMachineExport); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmExport)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmExport)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmExportQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMExportController) handlePVC(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+		ctrl.enqueueOwner(pvc.Namespace, pvc.OwnerReferences)
+		ctrl.enqueueIndexed("pvcSource", cacheKeyFunc(pvc.Namespace, pvc.Name))
+	}
+}
+
+func (ctrl *VMExportController) handlePod(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if pod, ok := obj.(*corev1.Pod); ok {
+		ctrl.enqueueOwner(pod.Namespace, pod.OwnerReferences)
+	}
+}
+
+func (ctrl *VMExportController) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vm, ok := obj.(*kubevirtv1.VirtualMachine); ok {
+		ctrl.enqueueIndexed("vmSource", cacheKeyFunc(vm.Namespace, vm.Name))
+	}
+}
+
+func (ctrl *VMExportController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		ctrl.enqueueIndexed("vmSource", cacheKeyFunc(vmi.Namespace, vmi.Name))
+	}
+}
+
+func (ctrl *VMExportController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		ctrl.enqueueIndexed("snapshotSource", cacheKeyFunc(vmSnapshot.Namespace, vmSnapshot.Name))
+	}
+}
+
+func (ctrl *VMExportController) handleIngress(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	// ingresses are matched by backend service, re-evaluate all exports of the namespace
+	if ingress, ok := obj.(*networkingv1.Ingress); ok {
+		ctrl.enqueueIndexed(cache.NamespaceIndex, ingress.Namespace)
+	}
+}
+
+func (ctrl *VMExportController) enqueueOwner(namespace string, ownerReferences []metav1.OwnerReference) {
+	for _, or := range ownerReferences {
+		if or.Kind == exportv1.VirtualMachineExportKind && or.APIVersion == exportv1.SchemeGroupVersion.String() {
+			ctrl.vmExportQueue.Add(cacheKeyFunc(namespace, or.Name))
+		}
+	}
+}
+
+func (ctrl *VMExportController) enqueueIndexed(indexName, indexedValue string) {
+	keys, err := ctrl.VMExportInformer.GetIndexer().IndexKeys(indexName, indexedValue)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, k := range keys {
+		ctrl.vmExportQueue.Add(k)
+	}
+}
@@ -0,0 +1,612 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Export controller", func() {
+	const (
+		exportUID     = "export-uid"
+		exportName    = "export"
+		sourceVMName  = "testvm"
+		sourcePVCName = "alpine-dv"
+		serviceName   = "virt-export-export"
+		tokenSecret   = "export-token-export"
+		exporterImage = "virt-launcher:latest"
+		caCert        = "-----BEGIN CERTIFICATE-----"
+	)
+
+	var (
+		timeStamp        = metav1.Now()
+		snapshotAPIGroup = snapshotv1.SchemeGroupVersion.Group
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &timeStamp
+	}
+
+	createExport := func(source corev1.TypedLocalObjectReference) *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              exportName,
+				Namespace:         testNamespace,
+				UID:               exportUID,
+				CreationTimestamp: timeStamp,
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: source,
+			},
+		}
+	}
+
+	pvcSource := corev1.TypedLocalObjectReference{
+		Kind: "PersistentVolumeClaim",
+		Name: sourcePVCName,
+	}
+
+	vmSource := corev1.TypedLocalObjectReference{
+		APIGroup: &vmAPIGroup,
+		Kind:     "VirtualMachine",
+		Name:     sourceVMName,
+	}
+
+	createPVC := func(name string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase: corev1.ClaimBound,
+			},
+		}
+	}
+
+	createExporterPod := func(vmExport *exportv1.VirtualMachineExport, ready corev1.ConditionStatus) *corev1.Pod {
+		pod := newExporterPod(vmExport, &exportSource{}, exporterImage)
+		pod.Status.Conditions = []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: ready},
+		}
+		return pod
+	}
+
+	It("should shorten long resource names", func() {
+		vmExport := createExport(pvcSource)
+		Expect(exportResourceName(vmExport)).To(Equal(serviceName))
+
+		vmExport.Name = strings.Repeat("a", 100)
+		name := exportResourceName(vmExport)
+		Expect(len(name)).To(Equal(63))
+		Expect(strings.HasPrefix(name, "virt-export-aaa")).To(BeTrue())
+
+		vmExport.Name = strings.Repeat("a", 99) + "b"
+		Expect(exportResourceName(vmExport)).ToNot(Equal(name))
+	})
+
+	Context("One valid Export controller given", func() {
+
+		var ctrl *gomock.Controller
+
+		var vmExportSource *framework.FakeControllerSource
+		var vmExportInformer cache.SharedIndexInformer
+
+		var pvcInformer cache.SharedIndexInformer
+		var pvcInformerSource *framework.FakeControllerSource
+
+		var podInformer cache.SharedIndexInformer
+		var podSource *framework.FakeControllerSource
+
+		var vmInformer cache.SharedIndexInformer
+		var vmInformerSource *framework.FakeControllerSource
+
+		var vmiInformer cache.SharedIndexInformer
+		var vmiSource *framework.FakeControllerSource
+
+		var vmSnapshotInformer cache.SharedIndexInformer
+		var vmSnapshotSource *framework.FakeControllerSource
+
+		var vmSnapshotContentInformer cache.SharedIndexInformer
+		var vmSnapshotContentSource *framework.FakeControllerSource
+
+		var ingressInformer cache.SharedIndexInformer
+		var ingressSource *framework.FakeControllerSource
+
+		var stop chan struct{}
+		var controller *VMExportController
+		var recorder *record.FakeRecorder
+		var mockVMExportQueue *testutils.MockWorkQueue
+
+		var kubevirtClient *kubevirtfake.Clientset
+		var k8sClient *k8sfake.Clientset
+
+		syncCaches := func(stop chan struct{}) {
+			go vmExportInformer.Run(stop)
+			go pvcInformer.Run(stop)
+			go podInformer.Run(stop)
+			go vmInformer.Run(stop)
+			go vmiInformer.Run(stop)
+			go vmSnapshotInformer.Run(stop)
+			go vmSnapshotContentInformer.Run(stop)
+			go ingressInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(
+				stop,
+				vmExportInformer.HasSynced,
+				pvcInformer.HasSynced,
+				podInformer.HasSynced,
+				vmInformer.HasSynced,
+				vmiInformer.HasSynced,
+				vmSnapshotInformer.HasSynced,
+				vmSnapshotContentInformer.HasSynced,
+				ingressInformer.HasSynced,
+			)).To(BeTrue())
+		}
+
+		sourceIndexFunc := func(kind string) cache.IndexFunc {
+			return func(obj interface{}) ([]string, error) {
+				e := obj.(*exportv1.VirtualMachineExport)
+				if e.Spec.Source.Kind == kind {
+					return []string{cacheKeyFunc(e.Namespace, e.Spec.Source.Name)}, nil
+				}
+				return nil, nil
+			}
+		}
+
+		BeforeEach(func() {
+			stop = make(chan struct{})
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+			namespaceIndexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+			vmExportInformer, vmExportSource = testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineExport{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+				"pvcSource":          sourceIndexFunc("PersistentVolumeClaim"),
+				"vmSource":           sourceIndexFunc("VirtualMachine"),
+				"snapshotSource":     sourceIndexFunc("VirtualMachineSnapshot"),
+			})
+			pvcInformer, pvcInformerSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+			podInformer, podSource = testutils.NewFakeInformerWithIndexersFor(&corev1.Pod{}, namespaceIndexers)
+			vmInformer, vmInformerSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			vmSnapshotContentInformer, vmSnapshotContentSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+			ingressInformer, ingressSource = testutils.NewFakeInformerWithIndexersFor(&networkingv1.Ingress{}, namespaceIndexers)
+
+			recorder = record.NewFakeRecorder(100)
+
+			controller = &VMExportController{
+				Client:                    virtClient,
+				ExporterImage:             exporterImage,
+				VMExportInformer:          vmExportInformer,
+				PVCInformer:               pvcInformer,
+				PodInformer:               podInformer,
+				VMInformer:                vmInformer,
+				VMIInformer:               vmiInformer,
+				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				IngressInformer:           ingressInformer,
+				Recorder:                  recorder,
+			}
+			controller.Init()
+
+			// Wrap our workqueue to have a way to detect when we are done processing updates
+			mockVMExportQueue = testutils.NewMockWorkQueue(controller.vmExportQueue)
+			controller.vmExportQueue = mockVMExportQueue
+
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineExport(testNamespace).
+				Return(kubevirtClient.ExportV1alpha1().VirtualMachineExports(testNamespace)).AnyTimes()
+
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+			kubevirtClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+
+			currentTime = timeFunc
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		addVirtualMachineExport := func(e *exportv1.VirtualMachineExport) {
+			syncCaches(stop)
+			mockVMExportQueue.ExpectAdds(1)
+			vmExportSource.Add(e)
+			mockVMExportQueue.Wait()
+		}
+
+		captureUpdate := func(target **exportv1.VirtualMachineExport) {
+			kubevirtClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				*target = action.(testing.UpdateAction).GetObject().(*exportv1.VirtualMachineExport)
+				return true, *target, nil
+			})
+		}
+
+		expectCreate := func(resource string, created *[]runtime.Object) {
+			k8sClient.Fake.PrependReactor("create", resource, func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				obj = action.(testing.CreateAction).GetObject()
+				*created = append(*created, obj)
+				return true, obj, nil
+			})
+		}
+
+		// an export which already got its token
+		createInitializedExport := func(source corev1.TypedLocalObjectReference) *exportv1.VirtualMachineExport {
+			e := createExport(source)
+			expiration := metav1.NewTime(timeStamp.Add(defaultExportTTL))
+			e.Status = &exportv1.VirtualMachineExportStatus{
+				Phase:             exportv1.Pending,
+				TokenSecretRef:    &[]string{tokenSecret}[0],
+				TTLExpirationTime: &expiration,
+				ServiceName:       serviceName,
+			}
+			return e
+		}
+
+		It("should delete an expired export", func() {
+			e := createExport(pvcSource)
+			e.CreationTimestamp = metav1.NewTime(timeStamp.Add(-3 * time.Hour))
+			deleted := false
+			kubevirtClient.Fake.PrependReactor("delete", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action.(testing.DeleteAction).GetName()).To(Equal(exportName))
+				deleted = true
+				return true, nil, nil
+			})
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+			Expect(deleted).To(BeTrue())
+			testutils.ExpectEvent(recorder, exportExpiredEvent)
+		})
+
+		It("should create a token secret and skip a missing PersistentVolumeClaim", func() {
+			e := createExport(pvcSource)
+			var created []runtime.Object
+			expectCreate("secrets", &created)
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(created).To(HaveLen(1))
+			secret := created[0].(*corev1.Secret)
+			Expect(secret.Name).To(Equal(tokenSecret))
+			Expect(secret.Data[exportv1.TokenKey]).ToNot(BeEmpty())
+			Expect(metav1.IsControlledBy(secret, e)).To(BeTrue())
+
+			Expect(updated).ToNot(BeNil())
+			Expect(updated.Status.Phase).To(Equal(exportv1.Skipped))
+			Expect(*updated.Status.TokenSecretRef).To(Equal(tokenSecret))
+			Expect(updated.Status.TTLExpirationTime.Time).To(Equal(timeStamp.Add(defaultExportTTL)))
+			Expect(updated.Status.ServiceName).To(Equal(serviceName))
+			Expect(updated.Status.Conditions).To(ContainElement(
+				newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, "PersistentVolumeClaim alpine-dv does not exist"),
+			))
+			Expect(mockVMExportQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+
+		It("should use the token secret given in the spec", func() {
+			e := createExport(pvcSource)
+			e.Spec.TokenSecretRef = &[]string{"my-token"}[0]
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(*updated.Status.TokenSecretRef).To(Equal("my-token"))
+		})
+
+		It("should create the exporter for a PersistentVolumeClaim", func() {
+			e := createInitializedExport(pvcSource)
+			pvcInformerSource.Add(createPVC(sourcePVCName))
+
+			var secrets, services, pods []runtime.Object
+			expectCreate("secrets", &secrets)
+			expectCreate("services", &services)
+			expectCreate("pods", &pods)
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(secrets).To(HaveLen(1))
+			secret := secrets[0].(*corev1.Secret)
+			Expect(secret.Name).To(Equal(serviceName))
+			Expect(secret.Data).To(HaveKey(corev1.TLSCertKey))
+			Expect(secret.Data).To(HaveKey(corev1.TLSPrivateKeyKey))
+			Expect(secret.Data).To(HaveKey(exportCACertKey))
+			Expect(secret.Data).ToNot(HaveKey("manifest.yaml"))
+
+			Expect(services).To(HaveLen(1))
+			service := services[0].(*corev1.Service)
+			Expect(service.Name).To(Equal(serviceName))
+			Expect(service.Spec.Selector).To(HaveKeyWithValue(exportPodLabel, serviceName))
+
+			Expect(pods).To(HaveLen(1))
+			pod := pods[0].(*corev1.Pod)
+			Expect(pod.Name).To(Equal(serviceName))
+			Expect(pod.Labels).To(HaveKeyWithValue(exportPodLabel, serviceName))
+			Expect(metav1.IsControlledBy(pod, e)).To(BeTrue())
+			Expect(pod.Spec.Containers[0].Image).To(Equal(exporterImage))
+			Expect(pod.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "volume-0",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: sourcePVCName,
+						ReadOnly:  true,
+					},
+				},
+			}))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "volume-0",
+				MountPath: "/export-volumes/alpine-dv",
+				ReadOnly:  true,
+			}))
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(updated.Status.Conditions).To(ContainElement(
+				newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, "Waiting for exporter pod"),
+			))
+		})
+
+		It("should pass block volumes as devices", func() {
+			e := createInitializedExport(pvcSource)
+			source := &exportSource{
+				volumes: []exportVolume{{name: "disk", claimName: "pvc", block: true}},
+			}
+			pod := newExporterPod(e, source, exporterImage)
+			Expect(pod.Spec.Containers[0].VolumeDevices).To(Equal([]corev1.VolumeDevice{
+				{Name: "volume-0", DevicePath: "/export-volumes/disk"},
+			}))
+		})
+
+		It("should wait while the volumes are in use", func() {
+			e := createInitializedExport(pvcSource)
+			pvcInformerSource.Add(createPVC(sourcePVCName))
+			for _, pod := range createPodsUsingPVCs(createVirtualMachine(testNamespace, sourceVMName)) {
+				p := pod
+				podSource.Add(&p)
+			}
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(updated.Status.Conditions).To(ContainElement(
+				newExportCondition(exportv1.ConditionVolumesReady, corev1.ConditionFalse, "Volumes are in use by pod pod-alpine-dv"),
+			))
+		})
+
+		It("should wait and stop the exporter while the VirtualMachine is running", func() {
+			e := createInitializedExport(vmSource)
+			vmInformerSource.Add(createVirtualMachine(testNamespace, sourceVMName))
+			vmiSource.Add(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: sourceVMName, Namespace: testNamespace},
+			})
+			podSource.Add(createExporterPod(e, corev1.ConditionTrue))
+			deleted := false
+			k8sClient.Fake.PrependReactor("delete", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action.(testing.DeleteAction).GetName()).To(Equal(serviceName))
+				deleted = true
+				return true, nil, nil
+			})
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(deleted).To(BeTrue())
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(updated.Status.Conditions).To(ContainElement(
+				newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, "VirtualMachine testvm is running"),
+			))
+		})
+
+		It("should report the links once the exporter is ready", func() {
+			e := createInitializedExport(vmSource)
+			vmInformerSource.Add(createVirtualMachine(testNamespace, sourceVMName))
+			pvcInformerSource.Add(createPVC(sourcePVCName))
+			podSource.Add(createExporterPod(e, corev1.ConditionTrue))
+			k8sClient.Fake.PrependReactor("get", "secrets", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action.(testing.GetAction).GetName()).To(Equal(serviceName))
+				return true, &corev1.Secret{Data: map[string][]byte{exportCACertKey: []byte(caCert)}}, nil
+			})
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Ready))
+			Expect(*updated.Status.VirtualMachineName).To(Equal(sourceVMName))
+			Expect(updated.Status.Links.External).To(BeNil())
+			internal := updated.Status.Links.Internal
+			Expect(internal.Cert).To(Equal(caCert))
+			base := "https://virt-export-export.default.svc/volumes/disk1/"
+			Expect(internal.Volumes).To(Equal([]exportv1.VirtualMachineExportVolume{
+				{
+					Name: "disk1",
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.KubeVirtRaw, Url: base + "disk.img"},
+						{Format: exportv1.KubeVirtGz, Url: base + "disk.img.gz"},
+						{Format: exportv1.KubeVirtQcow2, Url: base + "disk.qcow2"},
+					},
+				},
+			}))
+			Expect(internal.Manifests).To(Equal([]exportv1.VirtualMachineExportManifest{
+				{Type: exportv1.AllManifests, Url: "https://virt-export-export.default.svc/manifests/all"},
+			}))
+			Expect(updated.Status.Conditions).To(ContainElement(
+				newExportCondition(exportv1.ConditionReady, corev1.ConditionTrue, "Exporter is serving the source"),
+			))
+		})
+
+		It("should report external links through an Ingress", func() {
+			e := createInitializedExport(pvcSource)
+			e.Status.Links = &exportv1.VirtualMachineExportLinks{
+				Internal: &exportv1.VirtualMachineExportLink{Cert: caCert},
+			}
+			pvcInformerSource.Add(createPVC(sourcePVCName))
+			podSource.Add(createExporterPod(e, corev1.ConditionTrue))
+			ingressSource.Add(&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "export-ingress", Namespace: testNamespace},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{
+						{
+							Host: "export.example.com",
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{
+										{
+											Path: "/",
+											Backend: networkingv1.IngressBackend{
+												Service: &networkingv1.IngressServiceBackend{Name: serviceName},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			var updated *exportv1.VirtualMachineExport
+			captureUpdate(&updated)
+
+			addVirtualMachineExport(e)
+			controller.processVMExportWorkItem()
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Ready))
+			Expect(updated.Status.Links.External).ToNot(BeNil())
+			Expect(updated.Status.Links.External.Cert).To(BeEmpty())
+			Expect(updated.Status.Links.External.Volumes[0].Formats[0].Url).To(Equal("https://export.example.com/volumes/alpine-dv/disk.img"))
+			Expect(updated.Status.Links.External.Manifests).To(BeEmpty())
+		})
+
+		Context("with a VirtualMachineSnapshot source", func() {
+			var snapshotSource corev1.TypedLocalObjectReference
+
+			BeforeEach(func() {
+				snapshotSource = corev1.TypedLocalObjectReference{
+					APIGroup: &snapshotAPIGroup,
+					Kind:     "VirtualMachineSnapshot",
+					Name:     "snapshot",
+				}
+			})
+
+			It("should wait for the snapshot to become ready", func() {
+				e := createInitializedExport(snapshotSource)
+				vmSnapshotSource.Add(createVirtualMachineSnapshot(testNamespace, "snapshot", sourceVMName))
+				var updated *exportv1.VirtualMachineExport
+				captureUpdate(&updated)
+
+				addVirtualMachineExport(e)
+				controller.processVMExportWorkItem()
+
+				Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+				Expect(updated.Status.Conditions).To(ContainElement(
+					newExportCondition(exportv1.ConditionReady, corev1.ConditionFalse, "VirtualMachineSnapshot snapshot is not ready"),
+				))
+			})
+
+			It("should restore the volumes into PVCs owned by the export", func() {
+				e := createInitializedExport(snapshotSource)
+				s := createVirtualMachineSnapshot(testNamespace, "snapshot", sourceVMName)
+				sc := createVirtualMachineSnapshotContent(s, createVirtualMachine(testNamespace, sourceVMName))
+				s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					ReadyToUse:                        &t,
+					VirtualMachineSnapshotContentName: &sc.Name,
+				}
+				sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: &t,
+				}
+				vmSnapshotSource.Add(s)
+				vmSnapshotContentSource.Add(sc)
+
+				var pvcs []runtime.Object
+				expectCreate("persistentvolumeclaims", &pvcs)
+				var updated *exportv1.VirtualMachineExport
+				captureUpdate(&updated)
+
+				addVirtualMachineExport(e)
+				controller.processVMExportWorkItem()
+
+				Expect(pvcs).To(HaveLen(1))
+				pvc := pvcs[0].(*corev1.PersistentVolumeClaim)
+				Expect(pvc.Name).To(Equal("export-export-uid-disk1"))
+				Expect(pvc.Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+				Expect(metav1.IsControlledBy(pvc, e)).To(BeTrue())
+
+				// waits for the PVC to show up
+				Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+				Expect(updated.Status.Conditions).To(ContainElement(
+					newExportCondition(exportv1.ConditionVolumesReady, corev1.ConditionFalse, "PersistentVolumeClaim export-export-uid-disk1 does not exist"),
+				))
+			})
+		})
+
+		It("should enqueue exports using a PVC", func() {
+			syncCaches(stop)
+			vmExportSource.Add(createExport(pvcSource))
+			Eventually(func() []string {
+				return vmExportInformer.GetStore().ListKeys()
+			}).Should(HaveLen(1))
+			mockVMExportQueue.ExpectAdds(1)
+			pvcInformerSource.Add(createPVC(sourcePVCName))
+			mockVMExportQueue.Wait()
+		})
+	})
+})
@@ -31,6 +31,7 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
@@ -51,6 +52,8 @@ const (
 )
 
 type restoreTarget interface {
+	Exists() bool
+	Restored() bool
 	UID() types.UID
 	Ready() (bool, error)
 	Reconcile() (bool, error)
@@ -78,6 +81,10 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+func restoreID(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	return fmt.Sprintf("%s-%s", vmRestore.Name, vmRestore.UID)
+}
+
 func vmRestoreProgressing(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status == nil || vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete
 }
@@ -107,9 +114,12 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 	}
 
 	if len(vmRestoreOut.OwnerReferences) == 0 {
+		// a new target VM takes ownership once it is created
 		target.Own(vmRestoreOut)
-		updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
-		updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
+		if len(vmRestoreOut.Status.Conditions) == 0 {
+			updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
+			updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
+		}
 	}
 
 	// let's make sure everything is initialized properly before continuing
@@ -208,7 +218,7 @@ func (ctrl *VMRestoreController) doUpdate(original, updated *snapshotv1.VirtualM
 }
 
 func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget) (bool, error) {
-	content, err := ctrl.getSnapshotContent(vmRestore, target.UID())
+	content, err := ctrl.getSnapshotContent(vmRestore, target)
 	if err != nil {
 		return false, err
 	}
@@ -279,11 +289,15 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 }
 
 func (ctrl *VMRestoreController) getBindingMode(pvc *corev1.PersistentVolumeClaim) (*storagev1.VolumeBindingMode, error) {
+	return getBindingMode(ctrl.StorageClassInformer, pvc)
+}
+
+func getBindingMode(storageClassInformer cache.SharedIndexInformer, pvc *corev1.PersistentVolumeClaim) (*storagev1.VolumeBindingMode, error) {
 	if pvc.Spec.StorageClassName == nil {
 		return nil, nil
 	}
 
-	obj, exists, err := ctrl.StorageClassInformer.GetStore().GetByKey(*pvc.Spec.StorageClassName)
+	obj, exists, err := storageClassInformer.GetStore().GetByKey(*pvc.Spec.StorageClassName)
 	if err != nil {
 		return nil, err
 	}
@@ -297,13 +311,34 @@ func (ctrl *VMRestoreController) getBindingMode(pvc *corev1.PersistentVolumeClai
 	return sc.VolumeBindingMode, nil
 }
 
+func (t *vmRestoreTarget) Exists() bool {
+	return t.vm != nil
+}
+
 func (t *vmRestoreTarget) UID() types.UID {
+	if t.vm == nil {
+		return ""
+	}
 	return t.vm.UID
 }
 
+// Restored returns true if the target VM was already updated or created by the restore
+func (t *vmRestoreTarget) Restored() bool {
+	if t.vm == nil {
+		return false
+	}
+	lastRestoreID, ok := t.vm.Annotations[lastRestoreAnnotation]
+	return ok && lastRestoreID == restoreID(t.vmRestore)
+}
+
 func (t *vmRestoreTarget) Ready() (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Checking VM ready")
 
+	if t.vm == nil {
+		// the VM is created by the restore, nothing can be running
+		return true, nil
+	}
+
 	rs, err := t.vm.RunStrategy()
 	if err != nil {
 		return false, err
@@ -329,13 +364,11 @@ func (t *vmRestoreTarget) Ready() (bool, error) {
 func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconciling VM")
 
-	restoreID := fmt.Sprintf("%s-%s", t.vmRestore.Name, t.vmRestore.UID)
-
-	if lastRestoreID, ok := t.vm.Annotations[lastRestoreAnnotation]; ok && lastRestoreID == restoreID {
-		return false, nil
+	if t.Restored() {
+		return t.claimVolumes()
 	}
 
-	content, err := t.controller.getSnapshotContent(t.vmRestore, t.UID())
+	content, err := t.controller.getSnapshotContent(t.vmRestore, t)
 	if err != nil {
 		return false, err
 	}
@@ -435,17 +468,19 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	}
 
 	if updatedStatus {
-		// find DataVolumes that will no longer exist
-		for _, cdv := range t.vm.Spec.DataVolumeTemplates {
-			found := false
-			for _, ndv := range newTemplates {
-				if cdv.Name == ndv.Name {
-					found = true
-					break
+		if t.vm != nil {
+			// find DataVolumes that will no longer exist
+			for _, cdv := range t.vm.Spec.DataVolumeTemplates {
+				found := false
+				for _, ndv := range newTemplates {
+					if cdv.Name == ndv.Name {
+						found = true
+						break
+					}
+				}
+				if !found {
+					deletedDataVolumes = append(deletedDataVolumes, cdv.Name)
 				}
-			}
-			if !found {
-				deletedDataVolumes = append(deletedDataVolumes, cdv.Name)
 			}
 		}
 		t.vmRestore.Status.DeletedDataVolumes = deletedDataVolumes
@@ -453,16 +488,34 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 		return true, nil
 	}
 
-	newVM := t.vm.DeepCopy()
-	newVM.Spec = snapshotVM.Spec
+	var newVM *kubevirtv1.VirtualMachine
+	if t.vm != nil {
+		newVM = t.vm.DeepCopy()
+		newVM.Spec = snapshotVM.Spec
+	} else {
+		newVM = &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        t.vmRestore.Spec.Target.Name,
+				Namespace:   t.vmRestore.Namespace,
+				Labels:      snapshotVM.Labels,
+				Annotations: snapshotVM.Annotations,
+			},
+			Spec: snapshotVM.Spec,
+		}
+		delete(newVM.Annotations, lastRestoreAnnotation)
+	}
 	newVM.Spec.DataVolumeTemplates = newTemplates
 	newVM.Spec.Template.Spec.Volumes = newVolumes
 	if newVM.Annotations == nil {
 		newVM.Annotations = make(map[string]string)
 	}
-	newVM.Annotations[lastRestoreAnnotation] = restoreID
+	newVM.Annotations[lastRestoreAnnotation] = restoreID(t.vmRestore)
 
-	_, err = t.controller.Client.VirtualMachine(newVM.Namespace).Update(newVM)
+	if t.vm != nil {
+		_, err = t.controller.Client.VirtualMachine(newVM.Namespace).Update(newVM)
+	} else {
+		_, err = t.controller.Client.VirtualMachine(newVM.Namespace).Create(newVM)
+	}
 	if err != nil {
 		return false, err
 	}
@@ -470,7 +523,39 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	return true, nil
 }
 
+// claimVolumes makes the target VM the owner of restored PVCs that are not owned by a DataVolume
+func (t *vmRestoreTarget) claimVolumes() (bool, error) {
+	updated := false
+	for _, vr := range t.vmRestore.Status.Restores {
+		if vr.DataVolumeName != nil {
+			continue
+		}
+
+		pvc, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+		if err != nil {
+			return false, err
+		}
+
+		if pvc == nil || len(pvc.OwnerReferences) > 0 {
+			continue
+		}
+
+		t.Own(pvc)
+		_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Update(context.Background(), pvc, metav1.UpdateOptions{})
+		if err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	return updated, nil
+}
+
 func (t *vmRestoreTarget) Own(obj metav1.Object) {
+	if t.vm == nil {
+		return
+	}
+
 	b := true
 	obj.SetOwnerReferences([]metav1.OwnerReference{
 		{
@@ -504,9 +589,24 @@ func (t *vmRestoreTarget) Cleanup() error {
 	return nil
 }
 
-func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore, targetUID types.UID) (*snapshotv1.VirtualMachineSnapshotContent, error) {
-	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
-	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
+func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget) (*snapshotv1.VirtualMachineSnapshotContent, error) {
+	vms, err := getReadyVMSnapshot(ctrl.VMSnapshotInformer, vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	// a target that does not exist yet is created from the snapshot
+	if target.Exists() && !target.Restored() &&
+		(vms.Status.SourceUID == nil || *vms.Status.SourceUID != target.UID()) {
+		return nil, fmt.Errorf("VMSnapshot source and restore target differ")
+	}
+
+	return getReadyVMSnapshotContent(ctrl.VMSnapshotContentInformer, vms)
+}
+
+func getReadyVMSnapshot(vmSnapshotInformer cache.SharedIndexInformer, namespace, name string) (*snapshotv1.VirtualMachineSnapshot, error) {
+	objKey := cacheKeyFunc(namespace, name)
+	obj, exists, err := vmSnapshotInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
 	}
@@ -520,16 +620,16 @@ func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.Virtua
 		return nil, fmt.Errorf("VMSnapshot %s not ready", objKey)
 	}
 
-	if vms.Status.SourceUID == nil || *vms.Status.SourceUID != targetUID {
-		return nil, fmt.Errorf("VMSnapshot source and restore target differ")
-	}
+	return vms, nil
+}
 
+func getReadyVMSnapshotContent(vmSnapshotContentInformer cache.SharedIndexInformer, vms *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	if vms.Status.VirtualMachineSnapshotContentName == nil {
-		return nil, fmt.Errorf("no snapshot content name in %s", objKey)
+		return nil, fmt.Errorf("no snapshot content name in %s", cacheKeyFunc(vms.Namespace, vms.Name))
 	}
 
-	objKey = cacheKeyFunc(vmRestore.Namespace, *vms.Status.VirtualMachineSnapshotContentName)
-	obj, exists, err = ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
+	objKey := cacheKeyFunc(vms.Namespace, *vms.Status.VirtualMachineSnapshotContentName)
+	obj, exists, err := vmSnapshotContentInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
 	}
@@ -554,7 +654,7 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (*kubevirtv1.Virt
 	}
 
 	if !exists {
-		return nil, fmt.Errorf("VirtualMachine %s/%s does not exist", namespace, name)
+		return nil, nil
 	}
 
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
@@ -599,10 +699,29 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	volumeBackup snapshotv1.VolumeBackup,
 	volumeRestore snapshotv1.VolumeRestore,
 ) error {
+	pvc, err := newRestorePVC(volumeBackup, volumeRestore.PersistentVolumeClaimName)
+	if err != nil {
+		return err
+	}
+
+	pvc.Annotations[pvcRestoreAnnotation] = vmRestore.Name
+
+	target.Own(pvc)
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newRestorePVC returns a PVC named name which is populated from the VolumeSnapshot of volumeBackup
+func newRestorePVC(volumeBackup snapshotv1.VolumeBackup, name string) (*corev1.PersistentVolumeClaim, error) {
 	sourcePVC := volumeBackup.PersistentVolumeClaim.DeepCopy()
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        volumeRestore.PersistentVolumeClaimName,
+			Name:        name,
 			Labels:      sourcePVC.Labels,
 			Annotations: sourcePVC.Annotations,
 		},
@@ -611,7 +730,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 
 	if volumeBackup.VolumeSnapshotName == nil {
 		log.Log.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
-		return fmt.Errorf("missing VolumeSnapshot name")
+		return nil, fmt.Errorf("missing VolumeSnapshot name")
 	}
 
 	if pvc.Annotations == nil {
@@ -625,7 +744,6 @@ func (ctrl *VMRestoreController) createRestorePVC(
 			}
 		}
 	}
-	pvc.Annotations[pvcRestoreAnnotation] = vmRestore.Name
 
 	apiGroup := vsv1beta1.GroupName
 	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
@@ -635,14 +753,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	}
 	pvc.Spec.VolumeName = ""
 
-	target.Own(pvc)
-
-	_, err := ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return pvc, nil
 }
 
 func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Condition) {
@@ -608,6 +608,176 @@ var _ = Describe("Restore controlleer", func() {
 				Expect(len(l.Items)).To(BeZero())
 				testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
 			})
+
+			Context("to a new VM", func() {
+				const newVMName = "newvm"
+
+				createNewVMRestore := func() *snapshotv1.VirtualMachineRestore {
+					r := createRestoreWithOwner()
+					r.OwnerReferences = nil
+					r.Spec.Target.Name = newVMName
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+						newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+					}
+					return r
+				}
+
+				createNewVM := func() *v1.VirtualMachine {
+					vm := createSnapshotVM()
+					vm.Name = newVMName
+					vm.UID = "new-vm-uid"
+					vm.Annotations = map[string]string{"restore.kubevirt.io/lastRestoreUID": "restore-uid"}
+					vm.Spec.DataVolumeTemplates[0].Name = "restore-uid-disk1"
+					vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "restore-uid-disk1"
+					return vm
+				}
+
+				It("should initialize conditions without owner", func() {
+					r := createNewVMRestore()
+					r.Status = nil
+					rc := createNewVMRestore()
+					rc.ResourceVersion = "1"
+					expectVMRestoreUpdate(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should create restore PVCs without owner", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					}
+					addVolumeRestores(r)
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						createObj := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(createObj.Name).To(Equal("restore-uid-disk1"))
+						Expect(createObj.OwnerReferences).To(BeEmpty())
+
+						return true, create.GetObject(), nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should not delete any DataVolumes", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Waiting for target to be ready"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target to be ready"),
+					}
+					addVolumeRestores(r)
+					ur := r.DeepCopy()
+					ur.ResourceVersion = "1"
+					ur.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+					for i := range ur.Status.Restores {
+						ur.Status.Restores[i].DataVolumeName = &ur.Status.Restores[i].PersistentVolumeClaimName
+					}
+
+					vmRestoreSource.Add(r)
+					expectPVCUpdates(k8sClient, ur)
+					expectVMRestoreUpdate(kubevirtClient, ur)
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Status.Phase = corev1.ClaimBound
+						addPVC(&pvc)
+					}
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should create the VM", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+					addVolumeRestores(r)
+					for i := range r.Status.Restores {
+						r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+					}
+
+					newVM := createNewVM()
+					newVM.UID = ""
+					vmInterface.EXPECT().Create(newVM).Return(newVM, nil)
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+						pvc.Status.Phase = corev1.ClaimBound
+						pvcSource.Add(&pvc)
+					}
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should be owned by the created VM", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+					addVolumeRestores(r)
+					vm := createNewVM()
+					ur := r.DeepCopy()
+					ur.ResourceVersion = "1"
+					ur.OwnerReferences = []metav1.OwnerReference{
+						{
+							APIVersion:         kubevirtv1.GroupVersion.String(),
+							Kind:               "VirtualMachine",
+							Name:               vm.Name,
+							UID:                vm.UID,
+							Controller:         &t,
+							BlockOwnerDeletion: &t,
+						},
+					}
+
+					vmSource.Add(vm)
+					expectVMRestoreUpdate(kubevirtClient, ur)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should hand restored PVCs over to the created VM", func() {
+					r := createNewVMRestore()
+					vm := createNewVM()
+					vmRef := metav1.OwnerReference{
+						APIVersion:         kubevirtv1.GroupVersion.String(),
+						Kind:               "VirtualMachine",
+						Name:               vm.Name,
+						UID:                vm.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					}
+					r.OwnerReferences = []metav1.OwnerReference{vmRef}
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+					addVolumeRestores(r)
+
+					k8sClient.Fake.PrependReactor("update", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						update, ok := action.(testing.UpdateAction)
+						Expect(ok).To(BeTrue())
+
+						updateObj := update.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(updateObj.Name).To(Equal("restore-uid-disk1"))
+						Expect(updateObj.OwnerReferences).To(Equal([]metav1.OwnerReference{vmRef}))
+
+						return true, update.GetObject(), nil
+					})
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Status.Phase = corev1.ClaimBound
+						pvcSource.Add(&pvc)
+					}
+					vmSource.Add(vm)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+			})
 		})
 	})
 })
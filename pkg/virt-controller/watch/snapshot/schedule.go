@@ -0,0 +1,344 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+const (
+	snapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+	snapshotScheduleTimeFormat = "200601021504"
+
+	scheduleSnapshotCreatedEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	scheduleSnapshotPrunedEvent = "SuccessfulVirtualMachineSnapshotPrune"
+
+	scheduleErrorEvent = "VirtualMachineSnapshotScheduleError"
+)
+
+func scheduledSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, t time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", schedule.Name, vmName, t.UTC().Format(snapshotScheduleTimeFormat))
+}
+
+func scheduleDisabled(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+}
+
+// lastActivation returns the most recent activation after "from" that is not after "now",
+// runs missed while the controller was down are collapsed into a single one
+func lastActivation(cronSchedule *cron.Schedule, from, now time.Time) time.Time {
+	var last time.Time
+	for t := cronSchedule.Next(from); !t.IsZero() && !t.After(now); t = cronSchedule.Next(t) {
+		last = t
+	}
+	return last
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(scheduleIn *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	logger := log.Log.Object(scheduleIn)
+
+	logger.V(1).Infof("Updating VirtualMachineSnapshotSchedule")
+
+	if scheduleIn.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := scheduleIn.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.Parse(scheduleOut.Spec.Schedule)
+	if err != nil {
+		// retrying will not fix the expression, wait for the spec to change
+		ctrl.setScheduleError(scheduleOut, fmt.Sprintf("Invalid schedule: %v", err))
+		scheduleOut.Status.NextSnapshotTime = nil
+		return 0, ctrl.doUpdate(scheduleIn, scheduleOut)
+	}
+
+	now := currentTime().Time.UTC()
+	var errs []string
+
+	if !scheduleDisabled(scheduleOut) {
+		from := scheduleOut.CreationTimestamp.Time
+		if scheduleOut.Status.LastSnapshotTime != nil {
+			from = scheduleOut.Status.LastSnapshotTime.Time
+		}
+
+		if scheduledTime := lastActivation(cronSchedule, from, now); !scheduledTime.IsZero() {
+			if err := ctrl.createSnapshots(scheduleOut, scheduledTime); err != nil {
+				errs = append(errs, err.Error())
+			} else {
+				scheduleOut.Status.LastSnapshotTime = &metav1.Time{Time: scheduledTime}
+			}
+		}
+	}
+
+	nextPrune, err := ctrl.pruneSnapshots(scheduleOut, now)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	var requeueAfter time.Duration
+	scheduleOut.Status.NextSnapshotTime = nil
+	if !scheduleDisabled(scheduleOut) {
+		if next := cronSchedule.Next(now); !next.IsZero() {
+			scheduleOut.Status.NextSnapshotTime = &metav1.Time{Time: next}
+			requeueAfter = next.Sub(now)
+		}
+	}
+
+	if !nextPrune.IsZero() && (requeueAfter == 0 || nextPrune.Sub(now) < requeueAfter) {
+		requeueAfter = nextPrune.Sub(now)
+	}
+
+	if len(errs) > 0 {
+		err := fmt.Errorf("%s", strings.Join(errs, ", "))
+		logger.Reason(err).Error("Error reconciling VirtualMachineSnapshotSchedule")
+		ctrl.Recorder.Eventf(
+			scheduleOut,
+			corev1.EventTypeWarning,
+			scheduleErrorEvent,
+			"VirtualMachineSnapshotSchedule encountered error %s",
+			err.Error(),
+		)
+		ctrl.setScheduleError(scheduleOut, err.Error())
+		if err2 := ctrl.doUpdate(scheduleIn, scheduleOut); err2 != nil {
+			return 0, err2
+		}
+		return 0, err
+	}
+
+	scheduleOut.Status.Error = nil
+	if scheduleDisabled(scheduleOut) {
+		updateScheduleCondition(scheduleOut, newReadyCondition(corev1.ConditionFalse, "Schedule disabled"))
+	} else {
+		updateScheduleCondition(scheduleOut, newReadyCondition(corev1.ConditionTrue, "Schedule active"))
+	}
+
+	return requeueAfter, ctrl.doUpdate(scheduleIn, scheduleOut)
+}
+
+func (ctrl *VMSnapshotScheduleController) createSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, scheduledTime time.Time) error {
+	vms, err := ctrl.getSelectedVMs(schedule)
+	if err != nil {
+		return err
+	}
+
+	apiGroup := kubevirtv1.GroupName
+	var errs []string
+	for _, vm := range vms {
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      scheduledSnapshotName(schedule, vm.Name, scheduledTime),
+				Namespace: schedule.Namespace,
+				Labels: map[string]string{
+					snapshotScheduleLabel: schedule.Name,
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vm.Name,
+				},
+				DeletionPolicy: schedule.Spec.DeletionPolicy,
+			},
+		}
+
+		// names are deterministic so a retried run does not snapshot a VM twice
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			if !errors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Sprintf("failed to snapshot VirtualMachine %s: %v", vm.Name, err))
+			}
+			continue
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			scheduleSnapshotCreatedEvent,
+			"Created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// pruneSnapshots deletes the snapshots exceeding the retention policy and returns
+// the time the next kept snapshot will expire, if any
+func (ctrl *VMSnapshotScheduleController) pruneSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, now time.Time) (time.Time, error) {
+	var nextExpiry time.Time
+
+	retention := schedule.Spec.Retention
+	if retention == nil || (retention.MaxAge == nil && retention.MaxCount == nil) {
+		return nextExpiry, nil
+	}
+
+	vmSnapshots, err := ctrl.getScheduledSnapshots(schedule)
+	if err != nil {
+		return nextExpiry, err
+	}
+
+	bySource := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range vmSnapshots {
+		bySource[vmSnapshot.Spec.Source.Name] = append(bySource[vmSnapshot.Spec.Source.Name], vmSnapshot)
+	}
+
+	var errs []string
+	for _, group := range bySource {
+		// newest first
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[j].CreationTimestamp.Before(&group[i].CreationTimestamp)
+		})
+
+		var kept int32
+		for _, vmSnapshot := range group {
+			// in progress snapshots are neither counted nor pruned
+			if vmSnapshot.DeletionTimestamp != nil || vmSnapshotProgressing(vmSnapshot) {
+				continue
+			}
+
+			var expiry time.Time
+			if retention.MaxAge != nil {
+				expiry = vmSnapshot.CreationTimestamp.Add(retention.MaxAge.Duration)
+			}
+
+			expired := !expiry.IsZero() && !expiry.After(now)
+			overCount := retention.MaxCount != nil && kept >= *retention.MaxCount
+			if !expired && !overCount {
+				kept++
+				if !expiry.IsZero() && (nextExpiry.IsZero() || expiry.Before(nextExpiry)) {
+					nextExpiry = expiry
+				}
+				continue
+			}
+
+			// content is kept or removed by the snapshot controller according to the DeletionPolicy
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				errs = append(errs, fmt.Sprintf("failed to prune VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err))
+				continue
+			}
+
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				scheduleSnapshotPrunedEvent,
+				"Pruned VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nextExpiry, fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+
+	return nextExpiry, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) getSelectedVMs(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*kubevirtv1.VirtualMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, schedule.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			return nil, fmt.Errorf("expected VirtualMachine, got %T", obj)
+		}
+
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+
+	return vms, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) getScheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range ctrl.VMSnapshotInformer.GetStore().List() {
+		vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("expected VirtualMachineSnapshot, got %T", obj)
+		}
+
+		if vmSnapshot.Namespace == schedule.Namespace && vmSnapshot.Labels[snapshotScheduleLabel] == schedule.Name {
+			vmSnapshots = append(vmSnapshots, vmSnapshot)
+		}
+	}
+
+	return vmSnapshots, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) setScheduleError(schedule *snapshotv1.VirtualMachineSnapshotSchedule, message string) {
+	if schedule.Status.Error == nil || schedule.Status.Error.Message == nil || *schedule.Status.Error.Message != message {
+		schedule.Status.Error = newError(message)
+	}
+	updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionFalse, message))
+}
+
+func (ctrl *VMSnapshotScheduleController) doUpdate(original, updated *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineSnapshotSchedule(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateScheduleCondition(schedule *snapshotv1.VirtualMachineSnapshotSchedule, c snapshotv1.Condition) {
+	schedule.Status.Conditions = updateCondition(schedule.Status.Conditions, c, true)
+}
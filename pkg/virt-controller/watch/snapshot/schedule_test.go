@@ -0,0 +1,383 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		scheduleName = "nightly"
+		vmName       = "testvm"
+	)
+
+	var (
+		// half an hour after the first daily activation of the schedule
+		timeStamp = metav1.NewTime(time.Date(2022, 6, 1, 0, 30, 0, 0, time.UTC))
+		created   = metav1.NewTime(time.Date(2022, 5, 31, 12, 0, 0, 0, time.UTC))
+		lastRun   = metav1.NewTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+		nextRun   = metav1.NewTime(time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC))
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &timeStamp
+	}
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: created,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "@daily",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubevirt.io/vm": "vm-alpine-datavolume"},
+				},
+			},
+		}
+	}
+
+	createScheduledSnapshot := func(name string, age time.Duration, ready bool) *snapshotv1.VirtualMachineSnapshot {
+		s := createVirtualMachineSnapshot(testNamespace, name, vmName)
+		s.Labels = map[string]string{snapshotScheduleLabel: scheduleName}
+		s.CreationTimestamp = metav1.NewTime(timeStamp.Add(-age))
+		s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			ReadyToUse: &ready,
+		}
+		return s
+	}
+
+	Context("One valid Schedule controller given", func() {
+
+		var ctrl *gomock.Controller
+
+		var vmSnapshotScheduleSource *framework.FakeControllerSource
+		var vmSnapshotScheduleInformer cache.SharedIndexInformer
+
+		var vmSnapshotSource *framework.FakeControllerSource
+		var vmSnapshotInformer cache.SharedIndexInformer
+
+		var vmInformer cache.SharedIndexInformer
+		var vmSource *framework.FakeControllerSource
+
+		var stop chan struct{}
+		var controller *VMSnapshotScheduleController
+		var recorder *record.FakeRecorder
+		var mockVMSnapshotScheduleQueue *testutils.MockWorkQueue
+
+		var kubevirtClient *kubevirtfake.Clientset
+
+		syncCaches := func(stop chan struct{}) {
+			go vmSnapshotScheduleInformer.Run(stop)
+			go vmSnapshotInformer.Run(stop)
+			go vmInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(
+				stop,
+				vmSnapshotScheduleInformer.HasSynced,
+				vmSnapshotInformer.HasSynced,
+				vmInformer.HasSynced,
+			)).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			stop = make(chan struct{})
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+			vmSnapshotScheduleInformer, vmSnapshotScheduleSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+
+			recorder = record.NewFakeRecorder(100)
+
+			controller = &VMSnapshotScheduleController{
+				Client:                     virtClient,
+				VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+				VMSnapshotInformer:         vmSnapshotInformer,
+				VMInformer:                 vmInformer,
+				Recorder:                   recorder,
+			}
+			controller.Init()
+
+			// Wrap our workqueue to have a way to detect when we are done processing updates
+			mockVMSnapshotScheduleQueue = testutils.NewMockWorkQueue(controller.vmSnapshotScheduleQueue)
+			controller.vmSnapshotScheduleQueue = mockVMSnapshotScheduleQueue
+
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+
+			virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+				Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+				Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+
+			kubevirtClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+
+			currentTime = timeFunc
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		addVirtualMachineSnapshotSchedule := func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+			syncCaches(stop)
+			mockVMSnapshotScheduleQueue.ExpectAdds(1)
+			vmSnapshotScheduleSource.Add(s)
+			mockVMSnapshotScheduleQueue.Wait()
+		}
+
+		expectScheduleUpdate := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+			updated := &snapshotv1.VirtualMachineSnapshotSchedule{}
+			kubevirtClient.Fake.PrependReactor("update", "virtualmachinesnapshotschedules", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(testing.UpdateAction)
+				Expect(ok).To(BeTrue())
+
+				update.GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule).DeepCopyInto(updated)
+
+				return true, update.GetObject(), nil
+			})
+			return updated
+		}
+
+		expectSnapshotCreates := func(err error) *[]*snapshotv1.VirtualMachineSnapshot {
+			var createdSnapshots []*snapshotv1.VirtualMachineSnapshot
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, e error) {
+				create, ok := action.(testing.CreateAction)
+				Expect(ok).To(BeTrue())
+
+				createObj := create.GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				createdSnapshots = append(createdSnapshots, createObj)
+
+				return true, createObj, err
+			})
+			return &createdSnapshots
+		}
+
+		expectSnapshotDeletes := func() *[]string {
+			var deleted []string
+			kubevirtClient.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				del, ok := action.(testing.DeleteAction)
+				Expect(ok).To(BeTrue())
+
+				deleted = append(deleted, del.GetName())
+
+				return true, nil, nil
+			})
+			return &deleted
+		}
+
+		It("should snapshot the selected VirtualMachines when the schedule is due", func() {
+			deletionPolicy := snapshotv1.VirtualMachineSnapshotContentRetain
+			s := createSchedule()
+			s.Spec.DeletionPolicy = &deletionPolicy
+
+			other := createVirtualMachine(testNamespace, "othervm")
+			other.Labels = map[string]string{"app": "other"}
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+			vmSource.Add(other)
+
+			createdSnapshots := expectSnapshotCreates(nil)
+			updated := expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(s)
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(*createdSnapshots).To(HaveLen(1))
+			vmSnapshot := (*createdSnapshots)[0]
+			Expect(vmSnapshot.Name).To(Equal("nightly-testvm-202206010000"))
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotScheduleLabel, scheduleName))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+			Expect(*vmSnapshot.Spec.Source.APIGroup).To(Equal(vmAPIGroup))
+			Expect(vmSnapshot.Spec.DeletionPolicy).To(Equal(&deletionPolicy))
+			testutils.ExpectEvent(recorder, scheduleSnapshotCreatedEvent)
+
+			Expect(updated.Status.LastSnapshotTime.Time).To(Equal(lastRun.Time))
+			Expect(updated.Status.NextSnapshotTime.Time).To(Equal(nextRun.Time))
+			Expect(updated.Status.Error).To(BeNil())
+			Expect(updated.Status.Conditions).To(ConsistOf(newReadyCondition(corev1.ConditionTrue, "Schedule active")))
+		})
+
+		It("should ignore snapshots which already exist", func() {
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+
+			createdSnapshots := expectSnapshotCreates(errors.NewAlreadyExists(snapshotv1.Resource("virtualmachinesnapshots"), "nightly-testvm-202206010000"))
+			updated := expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(createSchedule())
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(*createdSnapshots).To(HaveLen(1))
+			Expect(updated.Status.LastSnapshotTime.Time).To(Equal(lastRun.Time))
+			Expect(updated.Status.Error).To(BeNil())
+		})
+
+		It("should not snapshot before the schedule is due and requeue for the next run", func() {
+			s := createSchedule()
+			s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastSnapshotTime: &lastRun,
+			}
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+
+			updated := expectScheduleUpdate()
+			syncCaches(stop)
+			requeueAfter, err := controller.updateVMSnapshotSchedule(s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeueAfter).To(Equal(nextRun.Sub(timeStamp.Time)))
+			Expect(updated.Status.LastSnapshotTime.Time).To(Equal(lastRun.Time))
+			Expect(updated.Status.NextSnapshotTime.Time).To(Equal(nextRun.Time))
+		})
+
+		It("should collapse missed runs into a single snapshot", func() {
+			s := createSchedule()
+			s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastSnapshotTime: &metav1.Time{Time: lastRun.AddDate(0, 0, -3)},
+			}
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+
+			createdSnapshots := expectSnapshotCreates(nil)
+			updated := expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(s)
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(*createdSnapshots).To(HaveLen(1))
+			Expect((*createdSnapshots)[0].Name).To(Equal("nightly-testvm-202206010000"))
+			Expect(updated.Status.LastSnapshotTime.Time).To(Equal(lastRun.Time))
+		})
+
+		It("should not snapshot when disabled", func() {
+			s := createSchedule()
+			s.Spec.Disabled = &t
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+
+			updated := expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(s)
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(updated.Status.LastSnapshotTime).To(BeNil())
+			Expect(updated.Status.NextSnapshotTime).To(BeNil())
+			Expect(updated.Status.Conditions).To(ConsistOf(newReadyCondition(corev1.ConditionFalse, "Schedule disabled")))
+		})
+
+		It("should report an invalid schedule", func() {
+			s := createSchedule()
+			s.Spec.Schedule = "0 25 * * *"
+
+			updated := expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(s)
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(updated.Status.Error).ToNot(BeNil())
+			Expect(*updated.Status.Error.Message).To(ContainSubstring("Invalid schedule"))
+			Expect(updated.Status.Conditions).To(HaveLen(1))
+			Expect(updated.Status.Conditions[0].Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("should report snapshot creation failures", func() {
+			vmSource.Add(createVirtualMachine(testNamespace, vmName))
+
+			expectSnapshotCreates(errors.NewForbidden(snapshotv1.Resource("virtualmachinesnapshots"), "nightly-testvm-202206010000", nil))
+			updated := expectScheduleUpdate()
+			syncCaches(stop)
+			_, err := controller.updateVMSnapshotSchedule(createSchedule())
+			Expect(err).To(HaveOccurred())
+			testutils.ExpectEvent(recorder, scheduleErrorEvent)
+
+			Expect(updated.Status.LastSnapshotTime).To(BeNil())
+			Expect(updated.Status.Error).ToNot(BeNil())
+			Expect(*updated.Status.Error.Message).To(ContainSubstring("failed to snapshot VirtualMachine testvm"))
+		})
+
+		It("should prune snapshots exceeding the max count", func() {
+			maxCount := int32(2)
+			s := createSchedule()
+			s.Spec.Disabled = &t
+			s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{MaxCount: &maxCount}
+
+			vmSnapshotSource.Add(createScheduledSnapshot("inprogress", 0, false))
+			vmSnapshotSource.Add(createScheduledSnapshot("day1", 24*time.Hour, true))
+			vmSnapshotSource.Add(createScheduledSnapshot("day2", 48*time.Hour, true))
+			vmSnapshotSource.Add(createScheduledSnapshot("day3", 72*time.Hour, true))
+			vmSnapshotSource.Add(createScheduledSnapshot("day4", 96*time.Hour, true))
+			unrelated := createScheduledSnapshot("unrelated", 120*time.Hour, true)
+			unrelated.Labels = nil
+			vmSnapshotSource.Add(unrelated)
+
+			deleted := expectSnapshotDeletes()
+			expectScheduleUpdate()
+			addVirtualMachineSnapshotSchedule(s)
+			controller.processVMSnapshotScheduleWorkItem()
+
+			Expect(*deleted).To(ConsistOf("day3", "day4"))
+			testutils.ExpectEvent(recorder, scheduleSnapshotPrunedEvent)
+		})
+
+		It("should prune snapshots exceeding the max age and requeue for the next expiry", func() {
+			s := createSchedule()
+			s.Spec.Disabled = &t
+			s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				MaxAge: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+			}
+
+			vmSnapshotSource.Add(createScheduledSnapshot("recent", 13*24*time.Hour, true))
+			vmSnapshotSource.Add(createScheduledSnapshot("expired", 15*24*time.Hour, true))
+
+			deleted := expectSnapshotDeletes()
+			expectScheduleUpdate()
+			syncCaches(stop)
+			requeueAfter, err := controller.updateVMSnapshotSchedule(s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeueAfter).To(Equal(24 * time.Hour))
+			Expect(*deleted).To(ConsistOf("expired"))
+		})
+
+		It("should enqueue the schedule of a created snapshot", func() {
+			syncCaches(stop)
+			mockVMSnapshotScheduleQueue.ExpectAdds(1)
+			vmSnapshotSource.Add(createScheduledSnapshot("day1", 24*time.Hour, true))
+			mockVMSnapshotScheduleQueue.Wait()
+			Expect(mockVMSnapshotScheduleQueue.Len()).To(Equal(1))
+			key, _ := mockVMSnapshotScheduleQueue.Get()
+			Expect(key).To(Equal(cacheKeyFunc(testNamespace, scheduleName)))
+		})
+	})
+})
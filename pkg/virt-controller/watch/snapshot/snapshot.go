@@ -58,6 +58,10 @@ const (
 	volumeSnapshotMissingEvent = "VolumeSnapshotMissing"
 
 	snapshotRetryInterval = 5 * time.Second
+
+	// unfreezeTimeout is the hard limit after which virt-launcher thaws the
+	// guest filesystems even if the controller never asked it to
+	unfreezeTimeout = 5 * time.Minute
 )
 
 type snapshotSource interface {
@@ -65,6 +69,10 @@ type snapshotSource interface {
 	Locked() bool
 	Lock() (bool, error)
 	Unlock() (bool, error)
+	Online() (bool, error)
+	GuestAgent() (bool, error)
+	Freeze() error
+	Unfreeze() error
 	Spec() snapshotv1.SourceSpec
 	PersistentVolumeClaims() map[string]string
 }
@@ -166,6 +174,10 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 
 		// create content if does not exist
 		if content == nil {
+			if err := source.Freeze(); err != nil {
+				return 0, err
+			}
+
 			return 0, ctrl.createContent(vmSnapshot)
 		}
 	}
@@ -238,7 +250,7 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		volueSnapshotStatus = append(volueSnapshotStatus, vss)
 	}
 
-	ready := true
+	created, ready := true, true
 	errorMessage := ""
 	contentCpy := content.DeepCopy()
 	if contentCpy.Status == nil {
@@ -254,13 +266,23 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		errorMessage = fmt.Sprintf("VolumeSnapshots (%s) skipped because in error state", strings.Join(skippedSnapshots, ","))
 	} else {
 		for _, vss := range volueSnapshotStatus {
+			if vss.CreationTime == nil {
+				created = false
+			}
+
 			if vss.ReadyToUse == nil || !*vss.ReadyToUse {
 				ready = false
-				break
 			}
 		}
 	}
 
+	// once every VolumeSnapshot has been cut the guest no longer has to be frozen
+	if created && !currentlyReady {
+		if err := ctrl.unfreezeSource(content); err != nil {
+			return 0, err
+		}
+	}
+
 	if ready && (contentCpy.Status.ReadyToUse == nil || !*contentCpy.Status.ReadyToUse) {
 		contentCpy.Status.CreationTime = currentTime()
 	}
@@ -343,6 +365,32 @@ func (ctrl *VMSnapshotController) createVolumeSnapshot(
 	return volumeSnapshot, nil
 }
 
+func (ctrl *VMSnapshotController) unfreezeSource(content *snapshotv1.VirtualMachineSnapshotContent) error {
+	if content.Spec.VirtualMachineSnapshotName == nil {
+		return nil
+	}
+
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(content.Namespace, *content.Spec.VirtualMachineSnapshotName))
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	source, err := ctrl.getSnapshotSource(obj.(*snapshotv1.VirtualMachineSnapshot))
+	if err != nil {
+		return err
+	}
+
+	if source == nil {
+		return nil
+	}
+
+	return source.Unfreeze()
+}
+
 func (ctrl *VMSnapshotController) getSnapshotSource(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (snapshotSource, error) {
 	switch vmSnapshot.Spec.Source.Kind {
 	case "VirtualMachine":
@@ -604,6 +652,14 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		}
 
 		if source != nil {
+			if vmSnapshotCpy.Status.VirtualMachineSnapshotContentName == nil {
+				indications, err := getIndications(source)
+				if err != nil {
+					return err
+				}
+				vmSnapshotCpy.Status.Indications = indications
+			}
+
 			if source.Locked() {
 				updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"))
 			} else {
@@ -814,40 +870,23 @@ func (s *vmSnapshotSource) Lock() (bool, error) {
 		return true, nil
 	}
 
-	rs, err := s.vm.RunStrategy()
-	if err != nil {
-		return false, err
-	}
-
-	if rs != kubevirtv1.RunStrategyHalted {
-		log.Log.V(3).Infof("Snapshottting a running VM is not supported yet")
-		return false, nil
-	}
-
-	key, err := controller.KeyFunc(s.vm)
+	online, err := s.Online()
 	if err != nil {
 		return false, err
 	}
 
-	_, exists, err := s.controller.VMIInformer.GetStore().GetByKey(key)
-	if err != nil {
-		return false, err
-	}
-
-	if exists {
-		log.Log.V(3).Infof("VMI still running")
-		return false, nil
-	}
-
-	pvcNames := s.pvcNames()
-	pods, err := podsUsingPVCs(s.controller.PodInformer, s.vm.Namespace, pvcNames)
-	if err != nil {
-		return false, err
-	}
+	// a running VMI is expected to use its PVCs, anything else has to be gone
+	if !online {
+		pvcNames := s.pvcNames()
+		pods, err := podsUsingPVCs(s.controller.PodInformer, s.vm.Namespace, pvcNames)
+		if err != nil {
+			return false, err
+		}
 
-	if len(pods) > 0 {
-		log.Log.V(3).Infof("%d pods using PVCs %+v", len(pods), pvcNames)
-		return false, nil
+		if len(pods) > 0 {
+			log.Log.V(3).Infof("%d pods using PVCs %+v", len(pods), pvcNames)
+			return false, nil
+		}
 	}
 
 	if s.vm.Status.SnapshotInProgress != nil && *s.vm.Status.SnapshotInProgress != s.snapshot.Name {
@@ -882,6 +921,11 @@ func (s *vmSnapshotSource) Unlock() (bool, error) {
 		return false, nil
 	}
 
+	// never leave the guest frozen behind
+	if err := s.Unfreeze(); err != nil {
+		return false, err
+	}
+
 	var err error
 	vmCopy := s.vm.DeepCopy()
 
@@ -902,6 +946,80 @@ func (s *vmSnapshotSource) Unlock() (bool, error) {
 	return true, nil
 }
 
+func (s *vmSnapshotSource) getVMI() (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := s.controller.VMIInformer.GetStore().GetByKey(cacheKeyFunc(s.vm.Namespace, s.vm.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance), nil
+}
+
+func (s *vmSnapshotSource) Online() (bool, error) {
+	vmi, err := s.getVMI()
+	if err != nil {
+		return false, err
+	}
+
+	return vmi != nil, nil
+}
+
+func (s *vmSnapshotSource) GuestAgent() (bool, error) {
+	vmi, err := s.getVMI()
+	if err != nil {
+		return false, err
+	}
+
+	if vmi == nil {
+		return false, nil
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected, corev1.ConditionTrue), nil
+}
+
+func (s *vmSnapshotSource) Freeze() error {
+	if !s.Locked() {
+		return fmt.Errorf("attempting to freeze unlocked VM")
+	}
+
+	guestAgent, err := s.GuestAgent()
+	if err != nil {
+		return err
+	}
+
+	if !guestAgent {
+		return nil
+	}
+
+	log.Log.V(3).Infof("Freezing vm %s file system before taking the snapshot", s.vm.Name)
+
+	return s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Freeze(s.vm.Name, unfreezeTimeout)
+}
+
+func (s *vmSnapshotSource) Unfreeze() error {
+	if !s.Locked() {
+		return nil
+	}
+
+	guestAgent, err := s.GuestAgent()
+	if err != nil {
+		return err
+	}
+
+	if !guestAgent {
+		return nil
+	}
+
+	log.Log.V(3).Infof("Unfreezing vm %s file system after taking the snapshot", s.vm.Name)
+
+	return s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Unfreeze(s.vm.Name)
+}
+
 func (s *vmSnapshotSource) Spec() snapshotv1.SourceSpec {
 	vmCpy := s.vm.DeepCopy()
 	vmCpy.Status = kubevirtv1.VirtualMachineStatus{}
@@ -944,6 +1062,34 @@ func getPVCsFromVolumes(volumes []kubevirtv1.Volume) map[string]string {
 	return pvcs
 }
 
+func getIndications(source snapshotSource) ([]snapshotv1.Indication, error) {
+	var indications []snapshotv1.Indication
+
+	online, err := source.Online()
+	if err != nil {
+		return nil, err
+	}
+
+	if !online {
+		return indications, nil
+	}
+
+	indications = append(indications, snapshotv1.VMSnapshotOnlineSnapshotIndication)
+
+	guestAgent, err := source.GuestAgent()
+	if err != nil {
+		return nil, err
+	}
+
+	if guestAgent {
+		indications = append(indications, snapshotv1.VMSnapshotGuestAgentIndication)
+	} else {
+		indications = append(indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
+	}
+
+	return indications, nil
+}
+
 func updateSnapshotCondition(ss *snapshotv1.VirtualMachineSnapshot, c snapshotv1.Condition) {
 	ss.Status.Conditions = updateCondition(ss.Status.Conditions, c, false)
 }
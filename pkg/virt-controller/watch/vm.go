@@ -21,17 +21,22 @@ package watch
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
 	authv1 "k8s.io/api/authorization/v1"
 	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -43,7 +48,10 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/util/status"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 type CloneAuthFunc func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error)
@@ -61,8 +69,10 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 	vmiVMInformer cache.SharedIndexInformer,
 	dataVolumeInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
+	instancetypeMethods instancetype.Methods,
 	recorder record.EventRecorder,
-	clientset kubecli.KubevirtClient) *VMController {
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig) *VMController {
 
 	proxy := &sarProxy{client: clientset}
 
@@ -72,6 +82,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		vmiVMInformer:          vmiVMInformer,
 		dataVolumeInformer:     dataVolumeInformer,
 		pvcInformer:            pvcInformer,
+		instancetypeMethods:    instancetypeMethods,
 		recorder:               recorder,
 		clientset:              clientset,
 		expectations:           controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -80,6 +91,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 			return cdiclone.CanServiceAccountClonePVC(proxy, pvcNamespace, pvcName, saNamespace, saName)
 		},
 		statusUpdater: status.NewVMStatusUpdater(clientset),
+		clusterConfig: clusterConfig,
 	}
 
 	c.vmiVMInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -118,11 +130,13 @@ type VMController struct {
 	vmiVMInformer          cache.SharedIndexInformer
 	dataVolumeInformer     cache.SharedIndexInformer
 	pvcInformer            cache.SharedIndexInformer
+	instancetypeMethods    instancetype.Methods
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
 	dataVolumeExpectations *controller.UIDTrackingControllerExpectations
 	cloneAuthFunc          CloneAuthFunc
 	statusUpdater          *status.VMStatusUpdater
+	clusterConfig          *virtconfig.ClusterConfig
 }
 
 func (c *VMController) Run(threadiness int, stopCh <-chan struct{}) {
@@ -204,6 +218,18 @@ func (c *VMController) execute(key string) error {
 		return nil
 	}
 
+	// Capture the referenced instancetype and preference before anything else, changes to
+	// them must not affect the VirtualMachine once it has been created.
+	if vm.ObjectMeta.DeletionTimestamp == nil && instancetype.NeedsControllerRevisions(vm) {
+		if err := c.instancetypeMethods.StoreControllerRevisions(vm.DeepCopy()); err != nil {
+			logger.Reason(err).Error("Failed to store ControllerRevisions of the referenced instancetype and preference.")
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error storing instancetype and preference revisions: %v", err)
+			return err
+		}
+		// The VirtualMachine was patched with the revision names, the update will re-enqueue it
+		return nil
+	}
+
 	vmKey, err := controller.KeyFunc(vm)
 	if err != nil {
 		return err
@@ -278,6 +304,18 @@ func (c *VMController) execute(key string) error {
 
 			createErr = c.handleVolumeRequests(vm, vmi)
 		}
+
+		if c.needsSync(key) && createErr == nil {
+			createErr = c.handleInterfaceRequests(vm, vmi)
+		}
+
+		if createErr == nil {
+			createErr = c.handleMemoryDumpRequest(vm, vmi)
+		}
+
+		if createErr == nil {
+			createErr = c.handleLiveUpdates(vm, vmi)
+		}
 	}
 
 	// If the controller is going to be deleted and the orphan finalizer is the next one, release the VMIs. Don't update the status
@@ -589,6 +627,671 @@ func (c *VMController) handleVolumeRequests(vm *virtv1.VirtualMachine, vmi *virt
 	return nil
 }
 
+// handleInterfaceRequests applies the interface requests of the VM on its template and hotplugs or unplugs the
+// requested interfaces on the running VMI.
+func (c *VMController) handleInterfaceRequests(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if len(vm.Status.InterfaceRequests) == 0 {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmiInterfaceMap := make(map[string]virtv1.Interface)
+	if vmi != nil {
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			vmiInterfaceMap[iface.Name] = iface
+		}
+	}
+
+	for i, request := range vm.Status.InterfaceRequests {
+		vmCopy.Spec.Template.Spec = *controller.ApplyInterfaceRequestOnVMISpec(&vmCopy.Spec.Template.Spec, &vm.Status.InterfaceRequests[i])
+
+		if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+			continue
+		}
+
+		if request.AddInterfaceOptions != nil {
+			if _, exists := vmiInterfaceMap[request.AddInterfaceOptions.Name]; exists {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).AddInterface(vmi.Name, request.AddInterfaceOptions); err != nil {
+				return err
+			}
+		} else if request.RemoveInterfaceOptions != nil {
+			if _, exists := vmiInterfaceMap[request.RemoveInterfaceOptions.Name]; !exists {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).RemoveInterface(vmi.Name, request.RemoveInterfaceOptions); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(vm, vmCopy) {
+		_, err := c.clientset.VirtualMachine(vmCopy.Namespace).Update(vmCopy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleLiveUpdates propagates the changes of the VM template which can be applied without a restart to the running VMI.
+// All other changes are reported by the RestartRequired condition until the VM is restarted.
+func (c *VMController) handleLiveUpdates(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || !vmi.IsRunning() || vmi.DeletionTimestamp != nil || vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+		return nil
+	}
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	if err := c.handleMetadataUpdate(vm, vmi); err != nil {
+		return err
+	}
+	if err := c.handleAccessCredentialsUpdate(vm, vmi); err != nil {
+		return err
+	}
+	if err := c.handleCPUHotplug(vm, vmi); err != nil {
+		return err
+	}
+	if err := c.handleMemoryHotplug(vm, vmi); err != nil {
+		return err
+	}
+	return c.handleInterfaceBandwidthUpdate(vm, vmi)
+}
+
+// cpuTopologyChanged returns true if the CPU topology of the VM template differs from the one of the VMI.
+// VMs with an instancetype are skipped, their CPU topology is not part of the template.
+func cpuTopologyChanged(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if vm.Spec.Instancetype != nil || vm.Spec.Template == nil || vmi == nil {
+		return false
+	}
+	vmCPU, vmiCPU := vm.Spec.Template.Spec.Domain.CPU, vmi.Spec.Domain.CPU
+	if vmCPU == nil || vmCPU.Sockets == 0 || vmiCPU == nil {
+		return false
+	}
+	return vmCPU.Sockets != vmiCPU.Sockets ||
+		vmCPU.MaxSockets != vmiCPU.MaxSockets ||
+		vmCPU.Cores != vmiCPU.Cores ||
+		vmCPU.Threads != vmiCPU.Threads
+}
+
+// cpuSocketsHotpluggable returns true if the sockets of the VM template can be hotplugged into the VMI,
+// which requires the VMI to have room for them and the rest of the topology to be unchanged.
+func cpuSocketsHotpluggable(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	vmCPU, vmiCPU := vm.Spec.Template.Spec.Domain.CPU, vmi.Spec.Domain.CPU
+	return !vmiCPU.DedicatedCPUPlacement &&
+		vmCPU.Sockets <= vmiCPU.MaxSockets &&
+		vmCPU.MaxSockets == vmiCPU.MaxSockets &&
+		vmCPU.Cores == vmiCPU.Cores &&
+		vmCPU.Threads == vmiCPU.Threads
+}
+
+// handleCPUHotplug propagates a change of the sockets in the VM template to the running VMI.
+func (c *VMController) handleCPUHotplug(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if !cpuTopologyChanged(vm, vmi) || !cpuSocketsHotpluggable(vm, vmi) {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`[{ "op": "test", "path": "/spec/domain/cpu/sockets", "value": %d}, { "op": "replace", "path": "/spec/domain/cpu/sockets", "value": %d}]`,
+		vmi.Spec.Domain.CPU.Sockets, vm.Spec.Template.Spec.Domain.CPU.Sockets)
+	log.Log.Object(vmi).V(3).Infof("Hotplugging CPU sockets: %s", patch)
+	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+// guestMemoryChanged returns true if the guest memory of the VM template differs from the one of the VMI.
+// VMs with an instancetype are skipped, their guest memory is not part of the template.
+func guestMemoryChanged(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if vm.Spec.Instancetype != nil || vm.Spec.Template == nil || vmi == nil {
+		return false
+	}
+	vmMemory, vmiMemory := vm.Spec.Template.Spec.Domain.Memory, vmi.Spec.Domain.Memory
+	if vmMemory == nil || vmMemory.Guest == nil || vmiMemory == nil || vmiMemory.Guest == nil {
+		return false
+	}
+	if vmMemory.Guest.Cmp(*vmiMemory.Guest) != 0 {
+		return true
+	}
+	if vmMemory.MaxGuest == nil || vmiMemory.MaxGuest == nil {
+		return vmMemory.MaxGuest != vmiMemory.MaxGuest
+	}
+	return vmMemory.MaxGuest.Cmp(*vmiMemory.MaxGuest) != 0
+}
+
+// guestMemoryHotpluggable returns true if the guest memory of the VM template can be hotplugged into the VMI,
+// which requires it to lie between the memory the VMI was started with and the maximum guest memory of the VMI.
+func guestMemoryHotpluggable(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	vmMemory, vmiMemory := vm.Spec.Template.Spec.Domain.Memory, vmi.Spec.Domain.Memory
+	if vmMemory.MaxGuest == nil || vmiMemory.MaxGuest == nil || vmMemory.MaxGuest.Cmp(*vmiMemory.MaxGuest) != 0 {
+		return false
+	}
+	if vmi.Status.Memory != nil && vmi.Status.Memory.GuestAtBoot != nil && vmMemory.Guest.Cmp(*vmi.Status.Memory.GuestAtBoot) < 0 {
+		return false
+	}
+	return vmMemory.Guest.Cmp(*vmiMemory.MaxGuest) <= 0
+}
+
+// handleMemoryHotplug propagates a change of the guest memory in the VM template to the running VMI.
+func (c *VMController) handleMemoryHotplug(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if !guestMemoryChanged(vm, vmi) || !guestMemoryHotpluggable(vm, vmi) {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`[{ "op": "test", "path": "/spec/domain/memory/guest", "value": "%s"}, { "op": "replace", "path": "/spec/domain/memory/guest", "value": "%s"}]`,
+		vmi.Spec.Domain.Memory.Guest.String(), vm.Spec.Template.Spec.Domain.Memory.Guest.String())
+	log.Log.Object(vmi).V(3).Infof("Hotplugging guest memory: %s", patch)
+	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+// liveUpdatableBandwidths returns the bandwidth of the interfaces in the VM template which differs from the one of the
+// running VMI and can be applied to it, which requires the interface to use a binding supporting bandwidth limits and
+// to be unchanged otherwise. Interfaces which are not part of the VMI yet are skipped.
+func liveUpdatableBandwidths(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) map[string]*virtv1.InterfaceBandwidth {
+	vmiInterfaces := map[string]virtv1.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		vmiInterfaces[iface.Name] = iface
+	}
+	bandwidths := map[string]*virtv1.InterfaceBandwidth{}
+	for _, vmIface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		vmiIface, exists := vmiInterfaces[vmIface.Name]
+		if !exists || equality.Semantic.DeepEqual(vmIface.Bandwidth, vmiIface.Bandwidth) {
+			continue
+		}
+		if vmiIface.Bridge == nil && vmiIface.Masquerade == nil && vmiIface.Macvtap == nil {
+			continue
+		}
+		bandwidth := vmIface.Bandwidth
+		vmIface.Bandwidth, vmiIface.Bandwidth = nil, nil
+		if equality.Semantic.DeepEqual(vmIface, vmiIface) {
+			bandwidths[vmIface.Name] = bandwidth
+		}
+	}
+	return bandwidths
+}
+
+// handleInterfaceBandwidthUpdate propagates a change of the interface bandwidth in the VM template to the running VMI.
+func (c *VMController) handleInterfaceBandwidthUpdate(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	bandwidths := liveUpdatableBandwidths(vm, vmi)
+	if len(bandwidths) == 0 {
+		return nil
+	}
+
+	var ops []string
+	for idx, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		bandwidth, changed := bandwidths[iface.Name]
+		if !changed {
+			continue
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/interfaces/%d/name", "value": %q}`, idx, iface.Name))
+		if bandwidth == nil {
+			ops = append(ops, fmt.Sprintf(`{ "op": "remove", "path": "/spec/domain/devices/interfaces/%d/bandwidth"}`, idx))
+			continue
+		}
+		value, err := json.Marshal(bandwidth)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "add", "path": "/spec/domain/devices/interfaces/%d/bandwidth", "value": %s}`, idx, string(value)))
+	}
+
+	patch := fmt.Sprintf("[%s]", strings.Join(ops, ", "))
+	log.Log.Object(vmi).V(3).Infof("Updating VMI interface bandwidth: %s", patch)
+	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+// handleMetadataUpdate adds the labels and annotations of the VM template which are missing or differ on the running VMI.
+// Labels and annotations removed from the template are kept, since the VMI can't tell where they came from.
+func (c *VMController) handleMetadataUpdate(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	labelOps, err := metadataPatchOps("labels", vm.Spec.Template.ObjectMeta.Labels, vmi.Labels)
+	if err != nil {
+		return err
+	}
+	annotationOps, err := metadataPatchOps("annotations", vm.Spec.Template.ObjectMeta.Annotations, vmi.Annotations)
+	if err != nil {
+		return err
+	}
+	ops := append(labelOps, annotationOps...)
+	if len(ops) == 0 {
+		return nil
+	}
+
+	patch := fmt.Sprintf("[%s]", strings.Join(ops, ", "))
+	log.Log.Object(vmi).V(3).Infof("Updating VMI metadata: %s", patch)
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+// jsonPointerEscaper escapes label and annotation keys for the use in JSON patch paths.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// metadataPatchOps returns the JSON patch operations which add the template values missing or differing in the VMI values.
+func metadataPatchOps(field string, templateValues, vmiValues map[string]string) ([]string, error) {
+	if len(templateValues) == 0 {
+		return nil, nil
+	}
+	if vmiValues == nil {
+		value, err := json.Marshal(templateValues)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf(`{ "op": "add", "path": "/metadata/%s", "value": %s}`, field, string(value))}, nil
+	}
+
+	keys := make([]string, 0, len(templateValues))
+	for key := range templateValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var ops []string
+	for _, key := range keys {
+		if value, exists := vmiValues[key]; exists && value == templateValues[key] {
+			continue
+		}
+		value, err := json.Marshal(templateValues[key])
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, fmt.Sprintf(`{ "op": "add", "path": "/metadata/%s/%s", "value": %s}`, field, jsonPointerEscaper.Replace(key), string(value)))
+	}
+	return ops, nil
+}
+
+// accessCredentialsLiveUpdatable returns true if the access credentials of the VM template can be applied to the running VMI.
+// The guest agent propagates them at runtime, but only from secrets which are already mounted into the virt-launcher pod.
+func accessCredentialsLiveUpdatable(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	secrets := func(accessCredentials []virtv1.AccessCredential) (map[string]bool, bool) {
+		names := map[string]bool{}
+		for _, accessCredential := range accessCredentials {
+			switch {
+			case accessCredential.SSHPublicKey != nil:
+				if accessCredential.SSHPublicKey.PropagationMethod.QemuGuestAgent == nil || accessCredential.SSHPublicKey.Source.Secret == nil {
+					return nil, false
+				}
+				names[accessCredential.SSHPublicKey.Source.Secret.SecretName] = true
+			case accessCredential.UserPassword != nil:
+				if accessCredential.UserPassword.PropagationMethod.QemuGuestAgent == nil || accessCredential.UserPassword.Source.Secret == nil {
+					return nil, false
+				}
+				names[accessCredential.UserPassword.Source.Secret.SecretName] = true
+			}
+		}
+		return names, true
+	}
+	vmSecrets, vmOk := secrets(vm.Spec.Template.Spec.AccessCredentials)
+	vmiSecrets, vmiOk := secrets(vmi.Spec.AccessCredentials)
+	return vmOk && vmiOk && reflect.DeepEqual(vmSecrets, vmiSecrets)
+}
+
+// handleAccessCredentialsUpdate propagates a change of the access credentials in the VM template to the running VMI.
+func (c *VMController) handleAccessCredentialsUpdate(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	vmAccessCredentials := vm.Spec.Template.Spec.AccessCredentials
+	if equality.Semantic.DeepEqual(vmAccessCredentials, vmi.Spec.AccessCredentials) || !accessCredentialsLiveUpdatable(vm, vmi) {
+		return nil
+	}
+
+	oldValue, err := json.Marshal(vmi.Spec.AccessCredentials)
+	if err != nil {
+		return err
+	}
+	newValue, err := json.Marshal(vmAccessCredentials)
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`[{ "op": "test", "path": "/spec/accessCredentials", "value": %s}, { "op": "replace", "path": "/spec/accessCredentials", "value": %s}]`, string(oldValue), string(newValue))
+	log.Log.Object(vmi).V(3).Infof("Updating VMI access credentials: %s", patch)
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+// restartRequiredFields returns the fields of the VM template which differ from the running VMI and can only be applied by a restart.
+// Only fields set in the template are compared, so that fields defaulted on the VMI don't show up. Hotplugged volumes
+// and interfaces, and changes which are propagated to the VMI by handleLiveUpdates are ignored.
+func restartRequiredFields(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) ([]string, error) {
+	if vm.Spec.Template == nil || vmi == nil || vmi.IsFinal() {
+		return nil, nil
+	}
+	vmSpec := vm.Spec.Template.Spec.DeepCopy()
+	vmiSpec := vmi.Spec.DeepCopy()
+
+	hotplugVolumes := map[string]bool{}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil || volumeStatus.MemoryDumpVolume != nil {
+			hotplugVolumes[volumeStatus.Name] = true
+		}
+	}
+	for _, request := range vm.Status.VolumeRequests {
+		if request.AddVolumeOptions != nil {
+			hotplugVolumes[request.AddVolumeOptions.Name] = true
+		} else if request.RemoveVolumeOptions != nil {
+			hotplugVolumes[request.RemoveVolumeOptions.Name] = true
+		}
+	}
+	hotplugInterfaces := map[string]bool{}
+	for _, request := range vm.Status.InterfaceRequests {
+		if request.AddInterfaceOptions != nil {
+			hotplugInterfaces[request.AddInterfaceOptions.Name] = true
+		} else if request.RemoveInterfaceOptions != nil {
+			hotplugInterfaces[request.RemoveInterfaceOptions.Name] = true
+		}
+	}
+	for _, spec := range []*virtv1.VirtualMachineInstanceSpec{vmSpec, vmiSpec} {
+		spec.Volumes = withoutHotplugVolumes(spec.Volumes, hotplugVolumes)
+		spec.Domain.Devices.Disks = withoutHotplugDisks(spec.Domain.Devices.Disks, hotplugVolumes)
+		spec.Networks = withoutHotplugNetworks(spec.Networks, hotplugInterfaces)
+		spec.Domain.Devices.Interfaces = withoutHotplugInterfaces(spec.Domain.Devices.Interfaces, hotplugInterfaces)
+	}
+
+	if accessCredentialsLiveUpdatable(vm, vmi) {
+		vmSpec.AccessCredentials = vmiSpec.AccessCredentials
+	}
+	if cpuTopologyChanged(vm, vmi) && cpuSocketsHotpluggable(vm, vmi) {
+		vmSpec.Domain.CPU.Sockets = vmiSpec.Domain.CPU.Sockets
+	}
+	if guestMemoryChanged(vm, vmi) && guestMemoryHotpluggable(vm, vmi) {
+		vmSpec.Domain.Memory.Guest = vmiSpec.Domain.Memory.Guest
+	}
+	bandwidths := liveUpdatableBandwidths(vm, vmi)
+	for i, iface := range vmSpec.Domain.Devices.Interfaces {
+		if _, changed := bandwidths[iface.Name]; !changed {
+			continue
+		}
+		for _, vmiIface := range vmiSpec.Domain.Devices.Interfaces {
+			if vmiIface.Name == iface.Name {
+				vmSpec.Domain.Devices.Interfaces[i].Bandwidth = vmiIface.Bandwidth
+			}
+		}
+	}
+
+	vmFields, err := specToMap(vmSpec)
+	if err != nil {
+		return nil, err
+	}
+	vmiFields, err := specToMap(vmiSpec)
+	if err != nil {
+		return nil, err
+	}
+	fields := changedFields("spec", vmFields, vmiFields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func withoutHotplugVolumes(volumes []virtv1.Volume, hotplugVolumes map[string]bool) []virtv1.Volume {
+	var filtered []virtv1.Volume
+	for _, volume := range volumes {
+		if !hotplugVolumes[volume.Name] {
+			filtered = append(filtered, volume)
+		}
+	}
+	return filtered
+}
+
+func withoutHotplugNetworks(networks []virtv1.Network, hotplugInterfaces map[string]bool) []virtv1.Network {
+	var filtered []virtv1.Network
+	for _, network := range networks {
+		if !hotplugInterfaces[network.Name] {
+			filtered = append(filtered, network)
+		}
+	}
+	return filtered
+}
+
+func withoutHotplugInterfaces(interfaces []virtv1.Interface, hotplugInterfaces map[string]bool) []virtv1.Interface {
+	var filtered []virtv1.Interface
+	for _, iface := range interfaces {
+		if !hotplugInterfaces[iface.Name] {
+			filtered = append(filtered, iface)
+		}
+	}
+	return filtered
+}
+
+func vmiInterfaceNames(vmi *virtv1.VirtualMachineInstance) map[string]bool {
+	names := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		names[iface.Name] = true
+	}
+	return names
+}
+
+func withoutHotplugDisks(disks []virtv1.Disk, hotplugVolumes map[string]bool) []virtv1.Disk {
+	var filtered []virtv1.Disk
+	for _, disk := range disks {
+		if !hotplugVolumes[disk.Name] {
+			filtered = append(filtered, disk)
+		}
+	}
+	return filtered
+}
+
+func specToMap(spec *virtv1.VirtualMachineInstanceSpec) (map[string]interface{}, error) {
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(specJSON, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// restartRequiredFieldDepth lists the fields whose changes are reported per subfield, to tell which part of them changed.
+var restartRequiredFieldDepth = map[string]bool{
+	"spec":                true,
+	"spec.domain":         true,
+	"spec.domain.devices": true,
+}
+
+// changedFields returns the paths of the template fields whose value isn't contained in the VMI value.
+func changedFields(path string, vmValues, vmiValues map[string]interface{}) []string {
+	var fields []string
+	for key, vmValue := range vmValues {
+		fieldPath := path + "." + key
+		vmiValue := vmiValues[key]
+		if vmMap, isMap := vmValue.(map[string]interface{}); isMap && restartRequiredFieldDepth[fieldPath] {
+			vmiMap, _ := vmiValue.(map[string]interface{})
+			fields = append(fields, changedFields(fieldPath, vmMap, vmiMap)...)
+		} else if !containedIn(vmValue, vmiValue) {
+			fields = append(fields, fieldPath)
+		}
+	}
+	return fields
+}
+
+// containedIn returns true if every value set in vmValue is set to the same value in vmiValue.
+// Zero values are treated as unset, since not every field of the API is omitted when empty.
+func containedIn(vmValue, vmiValue interface{}) bool {
+	switch vmValue := vmValue.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		vmiMap, isMap := vmiValue.(map[string]interface{})
+		if !isMap {
+			return false
+		}
+		for key, value := range vmValue {
+			if !containedIn(value, vmiMap[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vmiSlice, isSlice := vmiValue.([]interface{})
+		if !isSlice || len(vmValue) != len(vmiSlice) {
+			return false
+		}
+		for i := range vmValue {
+			if !containedIn(vmValue[i], vmiSlice[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.ValueOf(vmValue).IsZero() || reflect.DeepEqual(vmValue, vmiValue)
+	}
+}
+
+// syncRestartRequiredCondition adds the RestartRequired condition, listing the fields of the VM template which can only be
+// applied by restarting the VM, and removes it once the VMI matches the template again, e.g. after a restart.
+func syncRestartRequiredCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmCondManager := controller.NewVirtualMachineConditionManager()
+	fields, err := restartRequiredFields(vm, vmi)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to compare the VM template with the VMI")
+		return
+	}
+	if len(fields) == 0 {
+		vmCondManager.RemoveCondition(vm, virtv1.VirtualMachineRestartRequired)
+		return
+	}
+
+	message := fmt.Sprintf("a restart is required to apply the changes of %s", strings.Join(fields, ", "))
+	for i := range vm.Status.Conditions {
+		if vm.Status.Conditions[i].Type == virtv1.VirtualMachineRestartRequired {
+			vm.Status.Conditions[i].Message = message
+			return
+		}
+	}
+	log.Log.Object(vm).V(3).Info("Adding restart required condition")
+	now := v1.NewTime(time.Now())
+	vm.Status.Conditions = append(vm.Status.Conditions, virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineRestartRequired,
+		Status:             k8score.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             "NotLiveUpdatable",
+		Message:            message,
+	})
+}
+
+// updateMemoryDumpRequest moves the memory dump request through its phases, following
+// the status of the memory dump volume reported in the VMI.
+func updateMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	request := vm.Status.MemoryDumpRequest
+	if request == nil {
+		return
+	}
+
+	var volumeStatus *virtv1.VolumeStatus
+	if vmi != nil {
+		for i := range vmi.Status.VolumeStatus {
+			if vmi.Status.VolumeStatus[i].Name == request.ClaimName {
+				volumeStatus = &vmi.Status.VolumeStatus[i]
+			}
+		}
+	}
+
+	switch request.Phase {
+	case virtv1.MemoryDumpAssociating, virtv1.MemoryDumpInProgress:
+		if vmi == nil || vmi.IsFinal() {
+			request.Phase = virtv1.MemoryDumpFailed
+			request.Message = "VirtualMachineInstance stopped before the memory dump completed"
+			return
+		}
+		if volumeStatus == nil || volumeStatus.MemoryDumpVolume == nil {
+			return
+		}
+		switch volumeStatus.Phase {
+		case virtv1.MemoryDumpVolumeInProgress:
+			request.Phase = virtv1.MemoryDumpInProgress
+			request.StartTimestamp = volumeStatus.MemoryDumpVolume.StartTimestamp
+		case virtv1.MemoryDumpVolumeCompleted:
+			fileName := volumeStatus.MemoryDumpVolume.TargetFileName
+			request.Phase = virtv1.MemoryDumpUnmounting
+			request.StartTimestamp = volumeStatus.MemoryDumpVolume.StartTimestamp
+			request.EndTimestamp = volumeStatus.MemoryDumpVolume.EndTimestamp
+			request.FileName = &fileName
+		case virtv1.MemoryDumpVolumeFailed:
+			request.Phase = virtv1.MemoryDumpFailed
+			request.Message = volumeStatus.Message
+		}
+	case virtv1.MemoryDumpUnmounting:
+		// the memory dump is completed once the volume is detached from the VMI
+		if volumeStatus == nil {
+			request.Phase = virtv1.MemoryDumpCompleted
+		}
+	case virtv1.MemoryDumpDissociating:
+		if volumeStatus == nil {
+			vm.Status.MemoryDumpRequest = nil
+		}
+	}
+}
+
+// handleMemoryDumpRequest hotplugs the memory dump volume into the VMI while the memory
+// dump is requested and unplugs it again once the dump is done or the request is removed.
+func (c *VMController) handleMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	request := vm.Status.MemoryDumpRequest
+	if request == nil || vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	volumeIndex := -1
+	for i, volume := range vmi.Spec.Volumes {
+		if volume.Name == request.ClaimName {
+			volumeIndex = i
+		}
+	}
+
+	newVolumes := make([]virtv1.Volume, 0, len(vmi.Spec.Volumes)+1)
+	switch request.Phase {
+	case virtv1.MemoryDumpAssociating:
+		if volumeIndex != -1 || !vmi.IsRunning() {
+			return nil
+		}
+		newVolumes = append(newVolumes, vmi.Spec.Volumes...)
+		newVolumes = append(newVolumes, virtv1.Volume{
+			Name: request.ClaimName,
+			VolumeSource: virtv1.VolumeSource{
+				MemoryDump: &virtv1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
+						ClaimName: request.ClaimName,
+					},
+				},
+			},
+		})
+	case virtv1.MemoryDumpUnmounting, virtv1.MemoryDumpFailed, virtv1.MemoryDumpDissociating:
+		if volumeIndex == -1 {
+			return nil
+		}
+		newVolumes = append(newVolumes, vmi.Spec.Volumes[:volumeIndex]...)
+		newVolumes = append(newVolumes, vmi.Spec.Volumes[volumeIndex+1:]...)
+	default:
+		return nil
+	}
+
+	patch, err := generateVMIVolumesPatch(vmi.Spec.Volumes, newVolumes)
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+func generateVMIVolumesPatch(oldVolumes, newVolumes []virtv1.Volume) (string, error) {
+	verb := "add"
+	if len(oldVolumes) > 0 {
+		verb = "replace"
+	}
+
+	oldVolumesJson, err := json.Marshal(oldVolumes)
+	if err != nil {
+		return "", err
+	}
+	newVolumesJson, err := json.Marshal(newVolumes)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`[{ "op": "test", "path": "/spec/volumes", "value": %s}, { "op": "%s", "path": "/spec/volumes", "value": %s}]`,
+		string(oldVolumesJson), verb, string(newVolumesJson)), nil
+}
+
 func (c *VMController) startStop(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
@@ -741,6 +1444,18 @@ func (c *VMController) startVMI(vm *virtv1.VirtualMachine) error {
 	// start it
 	vmi := c.setupVMIFromVM(vm)
 
+	if err := c.ensurePersistentStatePVC(vm, vmi); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to create the persistent state PVC")
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error creating the persistent state PVC: %v", err)
+		return err
+	}
+
+	if err := c.applyInstancetypeToVmi(vm, vmi); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to apply instancetype and preference to VirtualMachineInstance")
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error applying instancetype and preference: %v", err)
+		return err
+	}
+
 	c.expectations.ExpectCreations(vmKey, 1)
 	vmi, err = c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Create(vmi)
 	if err != nil {
@@ -754,6 +1469,30 @@ func (c *VMController) startVMI(vm *virtv1.VirtualMachine) error {
 	return nil
 }
 
+// ensurePersistentStatePVC creates the PVC holding the persistent state of the VM, e.g. the EFI NVRAM or the TPM state, if the VMI needs it.
+// The PVC is owned by the VM and kept across restarts of the VMI.
+func (c *VMController) ensurePersistentStatePVC(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if !kubevirttypes.HasPersistentState(&vmi.Spec) {
+		return nil
+	}
+
+	pvcName := kubevirttypes.PersistentStatePVCName(vm.Name)
+	_, exists, err := c.pvcInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", vm.Namespace, pvcName))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	pvc := kubevirttypes.NewPersistentStatePVC(vm, c.clusterConfig.GetConfig().VMStateStorageClass)
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Create(context.Background(), pvc, v1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func (c *VMController) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		// nothing to do
@@ -784,6 +1523,30 @@ func (c *VMController) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMac
 	return nil
 }
 
+// applyInstancetypeToVmi expands the instancetype and preference referenced by the VirtualMachine into the VirtualMachineInstance spec.
+func (c *VMController) applyInstancetypeToVmi(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	instancetypeSpec, err := c.instancetypeMethods.FindInstancetypeSpec(vm)
+	if err != nil {
+		return err
+	}
+
+	preferenceSpec, err := c.instancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		return err
+	}
+
+	if instancetypeSpec == nil && preferenceSpec == nil {
+		return nil
+	}
+
+	conflicts := c.instancetypeMethods.ApplyToVmi(k8sfield.NewPath("spec"), instancetypeSpec, preferenceSpec, &vmi.Spec)
+	if len(conflicts) > 0 {
+		return fmt.Errorf("VMI conflicts with instancetype spec in fields: [%s]", conflicts.String())
+	}
+
+	return nil
+}
+
 // setupVMIfromVM creates a VirtualMachineInstance object from one VirtualMachine object.
 func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
 
@@ -792,7 +1555,7 @@ func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.Virtual
 	vmi.ObjectMeta.Name = vm.ObjectMeta.Name
 	vmi.ObjectMeta.GenerateName = ""
 	vmi.ObjectMeta.Namespace = vm.ObjectMeta.Namespace
-	vmi.Spec = vm.Spec.Template.Spec
+	vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
 
 	setupStableFirmwareUUID(vm, vmi)
 
@@ -1290,6 +2053,49 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 		vm.Status.VolumeRequests = tmpVolRequests
 	}
 
+	if len(vm.Status.InterfaceRequests) > 0 {
+		networkMap := make(map[string]virtv1.Network)
+		interfaceMap := make(map[string]virtv1.Interface)
+
+		for _, network := range vm.Spec.Template.Spec.Networks {
+			networkMap[network.Name] = network
+		}
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			interfaceMap[iface.Name] = iface
+		}
+
+		tmpInterfaceRequests := vm.Status.InterfaceRequests[:0]
+		for _, request := range vm.Status.InterfaceRequests {
+
+			var added bool
+			var ifaceName string
+
+			if request.AddInterfaceOptions != nil {
+				ifaceName = request.AddInterfaceOptions.Name
+				added = true
+			} else if request.RemoveInterfaceOptions != nil {
+				ifaceName = request.RemoveInterfaceOptions.Name
+				added = false
+			}
+
+			_, networkExists := networkMap[ifaceName]
+			_, ifaceExists := interfaceMap[ifaceName]
+
+			// the request is done once the template reflects it and a running VMI did pick it up
+			removeRequest := added && networkExists && ifaceExists || !added && !networkExists && !ifaceExists
+			if removeRequest && vmi != nil && vmi.IsRunning() {
+				removeRequest = vmiInterfaceNames(vmi)[ifaceName] == added
+			}
+
+			if !removeRequest {
+				tmpInterfaceRequests = append(tmpInterfaceRequests, request)
+			}
+		}
+		vm.Status.InterfaceRequests = tmpInterfaceRequests
+	}
+
+	updateMemoryDumpRequest(vm, vmi)
+
 	if vmRenamedAndDeleted {
 		return nil
 	}
@@ -1307,6 +2113,8 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 		c.processFailure(vm, vmi, createErr)
 	}
 
+	syncRestartRequiredCondition(vm, vmi)
+
 	// Add/Remove Paused condition (VMI paused by user)
 	vmiCondManager := controller.NewVirtualMachineInstanceConditionManager()
 	if vmiCondManager.HasCondition(vmi, virtv1.VirtualMachineInstancePaused) {
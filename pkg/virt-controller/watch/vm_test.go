@@ -1,6 +1,7 @@
 package watch
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/errors"
@@ -10,9 +11,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pborman/uuid"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
@@ -20,11 +24,15 @@ import (
 
 	v1 "kubevirt.io/client-go/api/v1"
 	virtv1 "kubevirt.io/client-go/api/v1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
 	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = Describe("VirtualMachine", func() {
@@ -48,6 +56,9 @@ var _ = Describe("VirtualMachine", func() {
 		var vmiFeeder *testutils.VirtualMachineFeeder
 		var dataVolumeFeeder *testutils.DataVolumeFeeder
 		var cdiClient *cdifake.Clientset
+		var instancetypeMethods *testutils.MockInstancetypeMethods
+		var kubeClient *k8sfake.Clientset
+		var kvInformer cache.SharedIndexInformer
 
 		syncCaches := func(stop chan struct{}) {
 			go vmiInformer.Run(stop)
@@ -69,7 +80,18 @@ var _ = Describe("VirtualMachine", func() {
 			pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			recorder = record.NewFakeRecorder(100)
 
-			controller = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, recorder, virtClient)
+			instancetypeMethods = testutils.NewMockInstancetypeMethods()
+			var config *virtconfig.ClusterConfig
+			config, _, _, kvInformer = testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kubevirt",
+					Namespace: "kubevirt",
+				},
+				Status: v1.KubeVirtStatus{
+					Phase: v1.KubeVirtPhaseDeploying,
+				},
+			})
+			controller = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, instancetypeMethods, recorder, virtClient, config)
 			// Wrap our workqueue to have a way to detect when we are done processing updates
 			mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 			controller.Queue = mockQueue
@@ -81,6 +103,9 @@ var _ = Describe("VirtualMachine", func() {
 			virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
 			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
 
+			kubeClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
 			cdiClient = cdifake.NewSimpleClientset()
 			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 			cdiClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -251,6 +276,73 @@ var _ = Describe("VirtualMachine", func() {
 			table.Entry("that is not running", false),
 		)
 
+		table.DescribeTable("should hotplug an interface", func(isRunning bool) {
+			vm, vmi := DefaultVirtualMachine(isRunning)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.InterfaceRequests = []v1.VirtualMachineInterfaceRequest{
+				{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						NetworkAttachmentDefinitionName: "red-net",
+						Name:                            "red",
+					},
+				},
+			}
+
+			addVirtualMachine(vm)
+
+			if isRunning {
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				vmiInterface.EXPECT().AddInterface(vmi.ObjectMeta.Name, vm.Status.InterfaceRequests[0].AddInterfaceOptions)
+			}
+
+			vmInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Spec.Template.Spec.Networks).To(ContainElement(v1.Network{
+					Name:          "red",
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+				}))
+				Expect(arg.(*v1.VirtualMachine).Spec.Template.Spec.Domain.Devices.Interfaces).To(ContainElement(v1.Interface{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				}))
+			}).Return(nil, nil)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				// the interface request shouldn't be cleared until update status observes the new interface
+				Expect(arg.(*v1.VirtualMachine).Status.InterfaceRequests).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+
+			table.Entry("that is running", true),
+			table.Entry("that is not running", false),
+		)
+
+		It("should clear InterfaceRequests for unplugged interfaces that are satisfied", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.InterfaceRequests = []v1.VirtualMachineInterfaceRequest{
+				{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "red",
+					},
+				},
+			}
+
+			addVirtualMachine(vm)
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.InterfaceRequests).To(BeEmpty())
+			}).Return(nil, nil)
+
+			controller.Execute()
+		})
+
 		table.DescribeTable("should clear VolumeRequests for added volumes that are satisfied", func(isRunning bool) {
 			vm, vmi := DefaultVirtualMachine(isRunning)
 			vm.Status.Created = true
@@ -328,6 +420,129 @@ var _ = Describe("VirtualMachine", func() {
 			table.Entry("that is not running", false),
 		)
 
+		It("should hotplug the memory dump volume into the VMI when a memory dump is requested", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "testclaim",
+				Phase:     v1.MemoryDumpAssociating,
+			}
+
+			addVirtualMachine(vm)
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, body []byte, _ ...interface{}) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).To(ContainSubstring(`"memoryDump":{"claimName":"testclaim"}`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpAssociating))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		})
+
+		It("should unplug the memory dump volume from the VMI once the memory dump completed", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "testclaim",
+				Phase:     v1.MemoryDumpInProgress,
+			}
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testclaim",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			})
+			now := metav1.Now()
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:  "testclaim",
+					Phase: v1.MemoryDumpVolumeCompleted,
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+						StartTimestamp: &now,
+						EndTimestamp:   &now,
+						ClaimName:      "testclaim",
+						TargetFileName: "testvmi-testclaim.memory.dump",
+					},
+				},
+			}
+
+			addVirtualMachine(vm)
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, body []byte, _ ...interface{}) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).ToNot(ContainSubstring("memoryDump"))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				request := arg.(*v1.VirtualMachine).Status.MemoryDumpRequest
+				Expect(request.Phase).To(Equal(v1.MemoryDumpUnmounting))
+				Expect(request.EndTimestamp).ToNot(BeNil())
+				Expect(*request.FileName).To(Equal("testvmi-testclaim.memory.dump"))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		})
+
+		table.DescribeTable("should update the memory dump request phase", func(phase v1.MemoryDumpPhase, volumePhase v1.VolumePhase, expectedPhase v1.MemoryDumpPhase) {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "testclaim",
+				Phase:     phase,
+			}
+			if volumePhase != "" {
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{
+					{
+						Name:             "testclaim",
+						Phase:            volumePhase,
+						MemoryDumpVolume: &v1.DomainMemoryDumpInfo{ClaimName: "testclaim"},
+					},
+				}
+			}
+
+			updateMemoryDumpRequest(vm, vmi)
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(expectedPhase))
+		},
+			table.Entry("to InProgress once the dump started", v1.MemoryDumpAssociating, v1.MemoryDumpVolumeInProgress, v1.MemoryDumpInProgress),
+			table.Entry("to Failed if the dump failed", v1.MemoryDumpInProgress, v1.MemoryDumpVolumeFailed, v1.MemoryDumpFailed),
+			table.Entry("not while the volume is still attaching", v1.MemoryDumpAssociating, v1.VolumePending, v1.MemoryDumpAssociating),
+			table.Entry("to Completed once the volume is detached", v1.MemoryDumpUnmounting, v1.VolumePhase(""), v1.MemoryDumpCompleted),
+			table.Entry("not while the volume is still detaching", v1.MemoryDumpUnmounting, v1.HotplugVolumeDetaching, v1.MemoryDumpUnmounting),
+		)
+
+		It("should clear the memory dump request once the volume is dissociated", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "testclaim",
+				Phase:     v1.MemoryDumpDissociating,
+				Remove:    true,
+			}
+
+			updateMemoryDumpRequest(vm, vmi)
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should fail the memory dump request if the VMI is gone", func() {
+			vm, _ := DefaultVirtualMachine(false)
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "testclaim",
+				Phase:     v1.MemoryDumpInProgress,
+			}
+
+			updateMemoryDumpRequest(vm, nil)
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpFailed))
+		})
+
 		It("should not delete failed DataVolume for VirtualMachineInstance", func() {
 			vm, _ := DefaultVirtualMachine(true)
 			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
@@ -748,6 +963,178 @@ var _ = Describe("VirtualMachine", func() {
 			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
 		})
 
+		Context("with persistent EFI", func() {
+
+			persistentEFIVirtualMachine := func() (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := DefaultVirtualMachine(true)
+				persistent := true
+				vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+					Bootloader: &v1.Bootloader{
+						EFI: &v1.EFI{Persistent: &persistent},
+					},
+				}
+				return vm, vmi
+			}
+
+			It("should create the persistent state PVC before the VirtualMachineInstance", func() {
+				vm, vmi := persistentEFIVirtualMachine()
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							VMStateStorageClass: "rwx-storage",
+						},
+					},
+					Status: v1.KubeVirtStatus{
+						Phase: v1.KubeVirtPhaseDeploying,
+					},
+				})
+
+				addVirtualMachine(vm)
+
+				vmiInterface.EXPECT().Create(gomock.Any()).Return(vmi, nil)
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.Background(), kubevirttypes.PersistentStatePVCName(vm.Name), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.OwnerReferences).To(HaveLen(1))
+				Expect(pvc.OwnerReferences[0].UID).To(Equal(vm.UID))
+				Expect(*pvc.OwnerReferences[0].Controller).To(BeTrue())
+				Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+				Expect(*pvc.Spec.StorageClassName).To(Equal("rwx-storage"))
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should reuse an existing persistent state PVC", func() {
+				vm, vmi := persistentEFIVirtualMachine()
+				pvc := kubevirttypes.NewPersistentStatePVC(vm, "")
+				Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+				kubeClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Fail("the persistent state PVC should not be created again")
+					return true, nil, nil
+				})
+
+				addVirtualMachine(vm)
+
+				vmiInterface.EXPECT().Create(gomock.Any()).Return(vmi, nil)
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should not create the VirtualMachineInstance if the persistent state PVC can't be created", func() {
+				vm, _ := persistentEFIVirtualMachine()
+				kubeClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, fmt.Errorf("quota exceeded")
+				})
+
+				addVirtualMachine(vm)
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, FailedCreateVirtualMachineReason)
+			})
+		})
+
+		Context("with an instancetype and preference", func() {
+
+			It("should store ControllerRevisions before creating the VirtualMachineInstance", func() {
+				vm, _ := DefaultVirtualMachine(true)
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					Name: "instancetype",
+				}
+
+				addVirtualMachine(vm)
+
+				storeCalled := false
+				instancetypeMethods.StoreControllerRevisionsFunc = func(vm *v1.VirtualMachine) error {
+					storeCalled = true
+					return nil
+				}
+
+				// No VirtualMachineInstance is created until the revision names are observed
+				controller.Execute()
+				Expect(storeCalled).To(BeTrue())
+			})
+
+			It("should create the VirtualMachineInstance with the instancetype applied", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					Name:         "instancetype",
+					RevisionName: "instancetype-revision",
+				}
+				vm.Spec.Preference = &v1.PreferenceMatcher{
+					Name:         "preference",
+					RevisionName: "preference-revision",
+				}
+
+				addVirtualMachine(vm)
+
+				instancetypeSpec := &instancetypev1alpha1.VirtualMachineInstancetypeSpec{
+					CPU: instancetypev1alpha1.CPUInstancetype{
+						Guest: uint32(2),
+					},
+				}
+				preferenceSpec := &instancetypev1alpha1.VirtualMachinePreferenceSpec{}
+				instancetypeMethods.FindInstancetypeSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error) {
+					return instancetypeSpec, nil
+				}
+				instancetypeMethods.FindPreferenceSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachinePreferenceSpec, error) {
+					return preferenceSpec, nil
+				}
+				instancetypeMethods.ApplyToVmiFunc = func(_ *k8sfield.Path, iSpec *instancetypev1alpha1.VirtualMachineInstancetypeSpec, pSpec *instancetypev1alpha1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) instancetype.Conflicts {
+					Expect(iSpec).To(Equal(instancetypeSpec))
+					Expect(pSpec).To(Equal(preferenceSpec))
+					vmiSpec.Domain.CPU = &v1.CPU{Sockets: iSpec.CPU.Guest}
+					return nil
+				}
+
+				vmiInterface.EXPECT().Create(gomock.Any()).Do(func(arg interface{}) {
+					Expect(arg.(*v1.VirtualMachineInstance).Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+				}).Return(vmi, nil)
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+				// The VirtualMachine itself must not be changed by the expansion
+				Expect(vm.Spec.Template.Spec.Domain.CPU).To(BeNil())
+			})
+
+			It("should fail to create the VirtualMachineInstance on conflicts", func() {
+				vm, _ := DefaultVirtualMachine(true)
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+					Name:         "instancetype",
+					RevisionName: "instancetype-revision",
+				}
+
+				addVirtualMachine(vm)
+
+				instancetypeMethods.FindInstancetypeSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1alpha1.VirtualMachineInstancetypeSpec, error) {
+					return &instancetypev1alpha1.VirtualMachineInstancetypeSpec{}, nil
+				}
+				instancetypeMethods.ApplyToVmiFunc = func(field *k8sfield.Path, _ *instancetypev1alpha1.VirtualMachineInstancetypeSpec, _ *instancetypev1alpha1.VirtualMachinePreferenceSpec, _ *v1.VirtualMachineInstanceSpec) instancetype.Conflicts {
+					return instancetype.Conflicts{field.Child("domain", "cpu")}
+				}
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(arg.(*v1.VirtualMachine), v1.VirtualMachineFailure)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Message).To(ContainSubstring("spec.domain.cpu"))
+				}).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, FailedCreateVirtualMachineReason)
+			})
+		})
+
 		It("should ignore the name of a VirtualMachineInstance templates", func() {
 			vm, vmi := DefaultVirtualMachineWithNames(true, "vmname", "vminame")
 
@@ -1025,6 +1412,258 @@ var _ = Describe("VirtualMachine", func() {
 			controller.Execute()
 		})
 
+		It("should hotplug CPU sockets into a running VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2, Threads: 1}
+			vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 3, MaxSockets: 4, Cores: 2, Threads: 1}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(patch)).To(Equal(`[{ "op": "test", "path": "/spec/domain/cpu/sockets", "value": 1}, { "op": "replace", "path": "/spec/domain/cpu/sockets", "value": 3}]`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should add the restart required condition if the CPU topology can't be hotplugged", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2, Threads: 1}
+			vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 4, Threads: 1}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				cond := virtcontroller.NewVirtualMachineConditionManager().
+					GetCondition(objVM, v1.VirtualMachineRestartRequired)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should hotplug guest memory into a running VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			guest := resource.MustParse("1Gi")
+			newGuest := resource.MustParse("3Gi")
+			maxGuest := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guest, MaxGuest: &maxGuest}
+			vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newGuest, MaxGuest: &maxGuest}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(patch)).To(Equal(`[{ "op": "test", "path": "/spec/domain/memory/guest", "value": "1Gi"}, { "op": "replace", "path": "/spec/domain/memory/guest", "value": "3Gi"}]`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should add the restart required condition if the guest memory exceeds the maximum guest memory", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			guest := resource.MustParse("1Gi")
+			newGuest := resource.MustParse("8Gi")
+			maxGuest := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guest, MaxGuest: &maxGuest}
+			vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newGuest, MaxGuest: &maxGuest}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				cond := virtcontroller.NewVirtualMachineConditionManager().
+					GetCondition(objVM, v1.VirtualMachineRestartRequired)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Message).To(ContainSubstring("spec.domain.memory"))
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should propagate the interface bandwidth of the template to a running VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")},
+			}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(patch)).To(Equal(`[{ "op": "test", "path": "/spec/domain/devices/interfaces/0/name", "value": "default"}, { "op": "add", "path": "/spec/domain/devices/interfaces/0/bandwidth", "value": {"inbound":{"average":"1Mi"}}}]`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should add the restart required condition if the bandwidth of an interface with an unsupported binding changes", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultSlirpNetworkInterface()}
+			vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultSlirpNetworkInterface()}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")},
+			}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				cond := virtcontroller.NewVirtualMachineConditionManager().
+					GetCondition(objVM, v1.VirtualMachineRestartRequired)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Message).To(ContainSubstring("spec.domain.devices.interfaces"))
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should propagate labels and annotations of the template to a running VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vmi.Labels = map[string]string{"app": "old"}
+			vm.Spec.Template.ObjectMeta.Labels = map[string]string{"app": "new", "kubevirt.io/size": "small"}
+			vm.Spec.Template.ObjectMeta.Annotations = map[string]string{"note": "value"}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(patch)).To(Equal(`[{ "op": "add", "path": "/metadata/labels/app", "value": "new"}, { "op": "add", "path": "/metadata/labels/kubevirt.io~1size", "value": "small"}, { "op": "add", "path": "/metadata/annotations/note", "value": "value"}]`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should propagate access credentials from already mounted secrets to a running VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			accessCredential := func(users ...string) v1.AccessCredential {
+				return v1.AccessCredential{
+					SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+						Source: v1.SSHPublicKeyAccessCredentialSource{
+							Secret: &v1.AccessCredentialSecretSource{SecretName: "my-keys"},
+						},
+						PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+							QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{Users: users},
+						},
+					},
+				}
+			}
+			vmi.Spec.AccessCredentials = []v1.AccessCredential{accessCredential("root")}
+			vm.Spec.Template.Spec.AccessCredentials = []v1.AccessCredential{accessCredential("root", "larry")}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(patch)).To(ContainSubstring(`{ "op": "replace", "path": "/spec/accessCredentials", "value": [{"sshPublicKey":{"source":{"secret":{"secretName":"my-keys"}},"propagationMethod":{"qemuGuestAgent":{"users":["root","larry"]}}}}]}`))
+				return vmi, nil
+			})
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should list the fields which require a restart in the restart required condition", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.NodeSelector = map[string]string{"zone": "east"}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				cond := virtcontroller.NewVirtualMachineConditionManager().
+					GetCondition(objVM, v1.VirtualMachineRestartRequired)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Message).To(Equal("a restart is required to apply the changes of spec.domain.devices.disks, spec.nodeSelector"))
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should not add the restart required condition for fields only defaulted on the VirtualMachineInstance", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}}
+			vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
+			vmi.Spec.Domain.Devices.Disks[0].Disk = &v1.DiskTarget{Bus: "virtio"}
+			vmi.Spec.Domain.Machine = v1.Machine{Type: "q35"}
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
+		It("should remove the restart required condition once the VirtualMachineInstance matches the template", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Conditions = append(vm.Status.Conditions, virtv1.VirtualMachineCondition{
+				Type:   virtv1.VirtualMachineRestartRequired,
+				Status: k8sv1.ConditionTrue,
+			})
+			addVirtualMachine(vm)
+
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(obj interface{}) {
+				objVM := obj.(*v1.VirtualMachine)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(objVM, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			}).Return(vm, nil)
+
+			controller.Execute()
+		})
+
 		It("should back off if a sync error occurs", func() {
 			vm, vmi := DefaultVirtualMachine(false)
 
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,6 +43,8 @@ import (
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
@@ -108,6 +111,12 @@ const (
 	PVCNotReadyReason = "PVCNotReady"
 	// FailedHotplugSyncReason is set when a hotplug specific failure occurs during sync
 	FailedHotplugSyncReason = "FailedHotplugSync"
+	// MemoryHotplugMigrationReason is added when the VMI is migrated into a pod which can accommodate the hotplugged memory
+	MemoryHotplugMigrationReason = "MemoryHotplugMigration"
+	// FailedMemoryHotplugReason is added when the hotplugged memory can't be accommodated
+	FailedMemoryHotplugReason = "FailedMemoryHotplug"
+	// FailedInterfaceHotplugReason is added when the networks of the pod can't be synced with the VMI
+	FailedInterfaceHotplugReason = "FailedInterfaceHotplug"
 )
 
 const failedToRenderLaunchManifestErrFormat = "failed to render launch manifest: %v"
@@ -130,6 +139,7 @@ func NewVMIController(templateService services.TemplateService,
 		clientset:          clientset,
 		podExpectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		dataVolumeInformer: dataVolumeInformer,
+		cidsMap:            newCIDsMap(),
 	}
 
 	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -181,6 +191,7 @@ type VMIController struct {
 	recorder           record.EventRecorder
 	podExpectations    *controller.UIDTrackingControllerExpectations
 	dataVolumeInformer cache.SharedIndexInformer
+	cidsMap            *cidsMap
 }
 
 func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
@@ -191,6 +202,13 @@ func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
 	// Wait for cache sync before we start the pod controller
 	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.dataVolumeInformer.HasSynced)
 
+	// Sync the CIDs of existing VMIs before new ones are allocated
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		vmis = append(vmis, obj.(*virtv1.VirtualMachineInstance))
+	}
+	c.cidsMap.Sync(vmis)
+
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
@@ -235,6 +253,7 @@ func (c *VMIController) execute(key string) error {
 	// Once all finalizers are removed the vmi gets deleted and we can clean all expectations
 	if !exists {
 		c.podExpectations.DeleteExpectations(key)
+		c.cidsMap.Remove(key)
 		return nil
 	}
 	vmi := obj.(*virtv1.VirtualMachineInstance)
@@ -250,6 +269,18 @@ func (c *VMIController) execute(key string) error {
 		return err
 	}
 
+	// The CID has to be known before the pod is created, a VMI which is already processed won't get a vsock device anymore
+	if util.IsAutoAttachVSOCK(vmi) && vmi.Status.VSOCKCID == nil && vmi.IsUnprocessed() && vmi.DeletionTimestamp == nil {
+		cid, err := c.cidsMap.Allocate(vmi)
+		if err != nil {
+			return err
+		}
+		vmi := vmi.DeepCopy()
+		vmi.Status.VSOCKCID = &cid
+		_, err = c.clientset.VirtualMachineInstance(vmi.ObjectMeta.Namespace).Update(vmi)
+		return err
+	}
+
 	// Only consider pods which belong to this vmi
 	// excluding unfinalized migration targets from this list.
 	pod, err := c.currentPod(vmi)
@@ -416,6 +447,12 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 					if vmiCopy.Labels == nil {
 						vmiCopy.Labels = map[string]string{}
 					}
+					if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil && vmi.Spec.Domain.Memory.MaxGuest != nil {
+						vmiCopy.Status.Memory = &virtv1.MemoryStatus{
+							GuestAtBoot:    vmi.Spec.Domain.Memory.Guest,
+							GuestRequested: vmi.Spec.Domain.Memory.Guest,
+						}
+					}
 					vmiCopy.ObjectMeta.Labels[virtv1.NodeNameLabel] = pod.Spec.NodeName
 					vmiCopy.Status.NodeName = pod.Spec.NodeName
 				}
@@ -469,6 +506,10 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 		patchOps := []string{}
 		if vmiPodExists {
 			c.updateVolumeStatus(vmiCopy, pod)
+			updateGuestMemoryRequest(vmiCopy, pod)
+			if err := updateInterfaceStatus(vmiCopy, pod); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Failed to update the interface status")
+			}
 		}
 		logger := log.Log.Object(vmi)
 		if !reflect.DeepEqual(vmiCopy.Status.VolumeStatus, vmi.Status.VolumeStatus) {
@@ -509,6 +550,42 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			log.Log.V(3).Object(vmi).Infof("Patching VMI conditions")
 		}
 
+		if !reflect.DeepEqual(vmiCopy.Status.Memory, vmi.Status.Memory) {
+			newMemory, err := json.Marshal(vmiCopy.Status.Memory)
+			if err != nil {
+				return err
+			}
+			oldMemory, err := json.Marshal(vmi.Status.Memory)
+			if err != nil {
+				return err
+			}
+
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "/status/memory", "value": %s }`, string(oldMemory)))
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "/status/memory", "value": %s }`, string(newMemory)))
+
+			log.Log.V(3).Object(vmi).Infof("Patching VMI memory status")
+		}
+
+		if !reflect.DeepEqual(vmiCopy.Status.Interfaces, vmi.Status.Interfaces) {
+			newInterfaces, err := json.Marshal(vmiCopy.Status.Interfaces)
+			if err != nil {
+				return err
+			}
+			oldInterfaces, err := json.Marshal(vmi.Status.Interfaces)
+			if err != nil {
+				return err
+			}
+
+			if string(oldInterfaces) == "null" {
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "add", "path": "/status/interfaces", "value": %s }`, string(newInterfaces)))
+			} else {
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "/status/interfaces", "value": %s }`, string(oldInterfaces)))
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "/status/interfaces", "value": %s }`, string(newInterfaces)))
+			}
+
+			log.Log.V(3).Object(vmi).Infof("Patching VMI interfaces")
+		}
+
 		if !reflect.DeepEqual(vmiCopy.Status.ActivePods, vmi.Status.ActivePods) {
 			newPods, err := json.Marshal(vmiCopy.Status.ActivePods)
 			if err != nil {
@@ -698,6 +775,12 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 	if err != nil {
 		return &syncErrorImpl{fmt.Errorf("failed to get attachment pods: %v", err), FailedHotplugSyncReason}
 	}
+	if err := c.handleMemoryHotplugMigration(vmi, pod); err != nil {
+		return &syncErrorImpl{fmt.Errorf("failed to migrate for memory hotplug: %v", err), FailedMemoryHotplugReason}
+	}
+	if err := c.handleInterfaceHotplug(vmi, pod); err != nil {
+		return &syncErrorImpl{fmt.Errorf("failed to sync the networks of the pod: %v", err), FailedInterfaceHotplugReason}
+	}
 	if pod.DeletionTimestamp == nil && !isWaitForFirstConsumer && c.needsHandleHotplug(hotplugVolumes, hotplugAttachmentPods) {
 		var hotplugSyncErr syncError = nil
 		hotplugSyncErr = c.handleHotplugVolumes(hotplugVolumes, hotplugAttachmentPods, vmi, pod)
@@ -713,6 +796,197 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 	return nil
 }
 
+func guestMemoryHotplugPending(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Status.Memory != nil && vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil &&
+		(vmi.Status.Memory.GuestRequested == nil || vmi.Spec.Domain.Memory.Guest.Cmp(*vmi.Status.Memory.GuestRequested) != 0)
+}
+
+// updateGuestMemoryRequest requests the guest memory of the VMI to be plugged, once the pod can accommodate it.
+func updateGuestMemoryRequest(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if !guestMemoryHotplugPending(vmi) || !services.PodAccommodatesGuestMemory(vmi, pod) {
+		return
+	}
+	guest := vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	vmi.Status.Memory.GuestRequested = &guest
+}
+
+// handleMemoryHotplugMigration migrates the VMI into a bigger pod if the current pod can't accommodate the hotplugged memory.
+func (c *VMIController) handleMemoryHotplugMigration(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	if !vmi.IsRunning() || pod.DeletionTimestamp != nil || !guestMemoryHotplugPending(vmi) || services.PodAccommodatesGuestMemory(vmi, pod) {
+		return nil
+	}
+	if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+		return nil
+	}
+	if !vmi.IsMigratable() {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedMemoryHotplugReason, "The pod can't accommodate guest memory %s and the VMI is not live migratable", vmi.Spec.Domain.Memory.Guest.String())
+		return nil
+	}
+
+	// The name is derived from the VMI and the requested memory, so the migration is only created once
+	migration := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: v1.ObjectMeta{
+			Name: fmt.Sprintf("kubevirt-memory-hotplug-%s-%d", vmi.UID, vmi.Spec.Domain.Memory.Guest.Value()),
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	if _, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(migration); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, MemoryHotplugMigrationReason, "Migrating into a pod which can accommodate guest memory %s", vmi.Spec.Domain.Memory.Guest.String())
+	return nil
+}
+
+// handleInterfaceHotplug syncs the Multus networks annotation of the pod with the networks of the running VMI,
+// so that Multus attaches the pod interfaces of hotplugged networks and detaches the ones of unplugged networks.
+// An unplugged network stays in the annotation until virt-handler detached its interface from the domain,
+// otherwise Multus would remove the pod interface the domain is still connected to.
+func (c *VMIController) handleInterfaceHotplug(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	if !vmi.IsRunning() || pod.DeletionTimestamp != nil {
+		return nil
+	}
+	annotation, err := services.GenerateMultusNetworksAnnotation(vmi)
+	if err != nil {
+		return err
+	}
+	podAnnotation, podHasAnnotation := pod.Annotations[multus.NetworksAnnotation]
+	if !podHasAnnotation && annotation == "" {
+		return nil
+	}
+	if annotation == "" {
+		annotation = "[]"
+	}
+	if pending := networksPendingDetach(vmi, podAnnotation); len(pending) > 0 {
+		var networks []map[string]string
+		if err := json.Unmarshal([]byte(annotation), &networks); err != nil {
+			return err
+		}
+		networksJSON, err := json.Marshal(append(networks, pending...))
+		if err != nil {
+			return err
+		}
+		annotation = string(networksJSON)
+	}
+	if multusNetworksEqual(podAnnotation, annotation) {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{multus.NetworksAnnotation: annotation},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.MergePatchType, patch, v1.PatchOptions{})
+	return err
+}
+
+// networksPendingDetach returns the entries of the Multus networks annotation of the pod which belong to networks
+// unplugged from the VMI whose interface is still attached to the domain. virt-handler reports a detached
+// interface by clearing its MAC in the status of the VMI.
+func networksPendingDetach(vmi *virtv1.VirtualMachineInstance, podAnnotation string) []map[string]string {
+	specNetworks := map[string]bool{}
+	for _, network := range vmi.Spec.Networks {
+		specNetworks[network.Name] = true
+	}
+	attached := map[string]bool{}
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.PodInterfaceName != "" && iface.MAC != "" && !specNetworks[iface.Name] {
+			attached[iface.PodInterfaceName] = true
+		}
+	}
+	if len(attached) == 0 {
+		return nil
+	}
+
+	var podNetworks, pending []map[string]string
+	if err := json.Unmarshal([]byte(podAnnotation), &podNetworks); err != nil {
+		return nil
+	}
+	for _, network := range podNetworks {
+		if attached[network["interface"]] {
+			pending = append(pending, network)
+		}
+	}
+	return pending
+}
+
+// multusNetworksEqual compares two Multus networks annotations regardless of their formatting and of the order
+// of the networks, every network has its own pod interface
+func multusNetworksEqual(a, b string) bool {
+	var networksA, networksB []map[string]string
+	if err := json.Unmarshal([]byte(a), &networksA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &networksB); err != nil {
+		return false
+	}
+	byInterface := func(networks []map[string]string) func(i, j int) bool {
+		return func(i, j int) bool {
+			return networks[i]["interface"] < networks[j]["interface"]
+		}
+	}
+	sort.SliceStable(networksA, byInterface(networksA))
+	sort.SliceStable(networksB, byInterface(networksB))
+	return equality.Semantic.DeepEqual(networksA, networksB)
+}
+
+// updateInterfaceStatus records the pod interface names of the secondary networks which Multus reports in the
+// network status of the pod, and drops the interfaces of unplugged networks once they are detached from the
+// domain, which virt-handler reports by clearing their MAC, and their pod interface is gone.
+func updateInterfaceStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	networkStatus, err := multus.ParseNetworkStatus(pod.Annotations)
+	if err != nil || len(networkStatus) == 0 {
+		return err
+	}
+	podInterfaces := map[string]bool{}
+	for _, status := range networkStatus {
+		podInterfaces[status.Interface] = true
+	}
+	specNetworks := map[string]bool{}
+	for _, network := range vmi.Spec.Networks {
+		specNetworks[network.Name] = true
+	}
+	podInterfaceNames := multus.PodInterfaceNames(vmi)
+
+	var interfaces []virtv1.VirtualMachineInstanceNetworkInterface
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.PodInterfaceName != "" && iface.MAC == "" && !specNetworks[iface.Name] && !podInterfaces[iface.PodInterfaceName] {
+			continue
+		}
+		interfaces = append(interfaces, iface)
+	}
+	for _, network := range vmi.Spec.Networks {
+		podInterfaceName, isSecondary := podInterfaceNames[network.Name]
+		if !isSecondary || !podInterfaces[podInterfaceName] {
+			continue
+		}
+		found := false
+		for i := range interfaces {
+			if interfaces[i].Name == network.Name {
+				interfaces[i].PodInterfaceName = podInterfaceName
+				found = true
+				break
+			}
+		}
+		if !found {
+			interfaces = append(interfaces, virtv1.VirtualMachineInstanceNetworkInterface{
+				Name:             network.Name,
+				PodInterfaceName: podInterfaceName,
+			})
+		}
+	}
+	vmi.Status.Interfaces = interfaces
+	return nil
+}
+
 func (c *VMIController) handleSyncDataVolumes(vmi *virtv1.VirtualMachineInstance, dataVolumes []*cdiv1.DataVolume) (bool, bool, syncError) {
 
 	ready := true
@@ -1216,7 +1490,7 @@ func (c *VMIController) getHotplugVolumes(vmi *virtv1.VirtualMachineInstance, vi
 		podVolumeMap[podVolume.Name] = podVolume
 	}
 	for _, vmiVolume := range vmiVolumes {
-		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil) {
+		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil || vmiVolume.MemoryDump != nil) {
 			hotplugVolumes = append(hotplugVolumes, vmiVolume.DeepCopy())
 		}
 	}
@@ -1400,6 +1674,8 @@ func (c *VMIController) createAttachmentPodTemplate(volume *virtv1.Volume, virtl
 		claimName = volume.DataVolume.Name
 	} else if volume.PersistentVolumeClaim != nil {
 		claimName = volume.PersistentVolumeClaim.ClaimName
+	} else if volume.MemoryDump != nil {
+		claimName = volume.MemoryDump.ClaimName
 	}
 	if claimName == "" {
 		return nil, errors.New("Unable to hotplug, claim not PVC or Datavolume")
@@ -1555,6 +1831,9 @@ func (c *VMIController) getVolumePhaseMessageReason(volume *virtv1.Volume, names
 	if volume.PersistentVolumeClaim != nil {
 		claimName = volume.PersistentVolumeClaim.ClaimName
 	}
+	if volume.MemoryDump != nil {
+		claimName = volume.MemoryDump.ClaimName
+	}
 	pvcInterface, pvcExists, _ := c.pvcInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", namespace, claimName))
 	if !pvcExists {
 		return virtv1.VolumePending, FailedPvcNotFoundReason, "Unable to determine PVC name"
@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
 
@@ -234,6 +235,58 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		mockQueue.Wait()
 	}
 
+	Context("On valid VirtualMachineInstance given with vsock", func() {
+
+		newVSOCKVirtualMachine := func() *v1.VirtualMachineInstance {
+			vmi := NewPendingVirtualMachine("testvmi")
+			autoattachVSOCK := true
+			vmi.Spec.Domain.Devices.AutoattachVSOCK = &autoattachVSOCK
+			return vmi
+		}
+
+		It("should allocate a CID before creating the Pod", func() {
+			vmi := newVSOCKVirtualMachine()
+			addVirtualMachine(vmi)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachineInstance).Status.VSOCKCID).ToNot(BeNil())
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+
+		It("should create the Pod once the CID is allocated", func() {
+			vmi := newVSOCKVirtualMachine()
+			cid := uint32(100)
+			vmi.Status.VSOCKCID = &cid
+			addVirtualMachine(vmi)
+
+			shouldExpectPodCreation(vmi.UID)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should release the CID on deletion", func() {
+			vmi := newVSOCKVirtualMachine()
+			addVirtualMachine(vmi)
+
+			var allocated *uint32
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				allocated = arg.(*v1.VirtualMachineInstance).Status.VSOCKCID
+			}).Return(vmi, nil)
+			controller.Execute()
+			Expect(controller.cidsMap.reverse).To(HaveKey(*allocated))
+
+			mockQueue.ExpectAdds(1)
+			vmiSource.Delete(vmi)
+			mockQueue.Wait()
+			controller.Execute()
+			Expect(controller.cidsMap.cids).To(BeEmpty())
+			Expect(controller.cidsMap.reverse).To(BeEmpty())
+		})
+	})
+
 	Context("On valid VirtualMachineInstance given with DataVolume source", func() {
 
 		dvVolumeSource := v1.VolumeSource{
@@ -1284,6 +1337,173 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			controller.Execute()
 		})
 
+		Context("with hotpluggable memory", func() {
+			newMemoryHotplugVMIAndPod := func(podMemory string) (*v1.VirtualMachineInstance, *k8sv1.Pod) {
+				vmi := NewPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = v1.Running
+				bootMemory := resource.MustParse("1Gi")
+				guestMemory := resource.MustParse("2Gi")
+				maxGuestMemory := resource.MustParse("4Gi")
+				vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestMemory}
+				vmi.Status.Memory = &v1.MemoryStatus{GuestAtBoot: &bootMemory, GuestRequested: &bootMemory}
+				pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Spec.Containers = []k8sv1.Container{{
+					Name: "compute",
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(podMemory)},
+					},
+				}}
+				addActivePods(vmi, pod.UID, "")
+				return vmi, pod
+			}
+
+			It("should request the guest memory once the pod can accommodate it", func() {
+				vmi, pod := newMemoryHotplugVMIAndPod("8Gi")
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(ContainSubstring(`{ "op": "replace", "path": "/status/memory", "value": {"guestAtBoot":"1Gi","guestRequested":"2Gi"} }`))
+					return vmi, nil
+				})
+
+				controller.Execute()
+			})
+
+			It("should migrate the VMI if the pod can't accommodate the guest memory", func() {
+				vmi, pod := newMemoryHotplugVMIAndPod("1Gi")
+				vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				})
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				migrationInterface := kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+				virtClient.EXPECT().VirtualMachineInstanceMigration(vmi.Namespace).Return(migrationInterface)
+				migrationInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(migration *v1.VirtualMachineInstanceMigration) (*v1.VirtualMachineInstanceMigration, error) {
+					Expect(migration.Spec.VMIName).To(Equal(vmi.Name))
+					return migration, nil
+				})
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, MemoryHotplugMigrationReason)
+			})
+		})
+
+		Context("with hotpluggable interfaces", func() {
+			newInterfaceHotplugVMIAndPod := func() (*v1.VirtualMachineInstance, *k8sv1.Pod) {
+				vmi := NewPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Networks = []v1.Network{
+					*v1.DefaultPodNetwork(),
+					{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}}},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					*v1.DefaultBridgeNetworkInterface(),
+					{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				}
+				pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Annotations[multus.NetworksAnnotation] = `[{"interface":"net1","name":"red-net","namespace":"default"}]`
+				pod.Annotations[multus.NetworkStatusAnnotation] = `[{"name":"kindnet","interface":"eth0","default":true},{"name":"default/red-net","interface":"net1"}]`
+				addActivePods(vmi, pod.UID, "")
+				return vmi, pod
+			}
+
+			It("should record the pod interfaces reported by Multus", func() {
+				vmi, pod := newInterfaceHotplugVMIAndPod()
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(ContainSubstring(`{ "op": "add", "path": "/status/interfaces", "value": [{"name":"red","podInterfaceName":"net1"}] }`))
+					return vmi, nil
+				})
+
+				controller.Execute()
+			})
+
+			It("should add hotplugged networks to the networks of the pod", func() {
+				vmi, pod := newInterfaceHotplugVMIAndPod()
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "red", PodInterfaceName: "net1"}}
+				vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-net"}}})
+				vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patch, ok := action.(testing.PatchAction)
+					Expect(ok).To(BeTrue())
+					Expect(patch.GetPatchType()).To(Equal(types.MergePatchType))
+					Expect(string(patch.GetPatch())).To(ContainSubstring(multus.HashedPodInterfaceName("blue")))
+					return true, pod, nil
+				})
+
+				controller.Execute()
+			})
+
+			newInterfaceUnplugVMIAndPod := func(blueMAC string) (*v1.VirtualMachineInstance, *k8sv1.Pod) {
+				vmi, pod := newInterfaceHotplugVMIAndPod()
+				blueInterface := multus.HashedPodInterfaceName("blue")
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+					{Name: "red", PodInterfaceName: "net1", MAC: "02:00:00:00:00:01"},
+					{Name: "blue", PodInterfaceName: blueInterface, MAC: blueMAC},
+				}
+				pod.Annotations[multus.NetworksAnnotation] = fmt.Sprintf(`[{"interface":"%s","name":"blue-net","namespace":"default"},{"interface":"net1","name":"red-net","namespace":"default"}]`, blueInterface)
+				pod.Annotations[multus.NetworkStatusAnnotation] = fmt.Sprintf(`[{"name":"kindnet","interface":"eth0","default":true},{"name":"default/red-net","interface":"net1"},{"name":"default/blue-net","interface":"%s"}]`, blueInterface)
+				return vmi, pod
+			}
+
+			It("should keep unplugged networks in the networks of the pod until their interface is detached", func() {
+				vmi, pod := newInterfaceUnplugVMIAndPod("02:00:00:00:00:02")
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Fail("the networks of the pod should not be patched")
+					return true, nil, nil
+				})
+
+				controller.Execute()
+			})
+
+			It("should remove unplugged networks from the networks of the pod once their interface is detached", func() {
+				vmi, pod := newInterfaceUnplugVMIAndPod("")
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				patched := false
+				kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patch, ok := action.(testing.PatchAction)
+					Expect(ok).To(BeTrue())
+					Expect(string(patch.GetPatch())).To(ContainSubstring("red-net"))
+					Expect(string(patch.GetPatch())).ToNot(ContainSubstring("blue-net"))
+					patched = true
+					return true, pod, nil
+				})
+
+				controller.Execute()
+				Expect(patched).To(BeTrue())
+			})
+
+			It("should drop unplugged interfaces once their pod interface is gone", func() {
+				vmi, pod := newInterfaceHotplugVMIAndPod()
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+					{Name: "red", PodInterfaceName: "net1"},
+					{Name: "blue", PodInterfaceName: multus.HashedPodInterfaceName("blue")},
+				}
+				addVirtualMachine(vmi)
+				podFeeder.Add(pod)
+
+				vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, patch []byte, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(ContainSubstring(`{ "op": "replace", "path": "/status/interfaces", "value": [{"name":"red","podInterfaceName":"net1"}] }`))
+					return vmi, nil
+				})
+
+				controller.Execute()
+			})
+		})
+
 		table.DescribeTable("should not add a ready condition if the vmi is", func(phase v1.VirtualMachineInstancePhase) {
 			vmi := NewPendingVirtualMachine("testvmi")
 			vmi.Status.Phase = phase
@@ -1583,6 +1803,39 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Expect(pod.Spec.Volumes[0].Name).To(Equal(volume.Name))
 		})
 
+		It("CreateAttachmentPodTemplate should create a pod for a memory dump volume", func() {
+			vmi := NewPendingVirtualMachine("testvmi")
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "memorydump-pvc",
+					Namespace: vmi.Namespace,
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Phase: k8sv1.ClaimBound,
+				},
+			}
+			kubeClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, pvc, nil
+			})
+			addVirtualMachine(vmi)
+			podFeeder.Add(virtlauncherPod)
+			volume := &v1.Volume{
+				Name: "memorydump",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "memorydump-pvc",
+						},
+					},
+				},
+			}
+			pod, err := controller.createAttachmentPodTemplate(volume, virtlauncherPod, vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.Volumes[0].Name).To(Equal(volume.Name))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("memorydump-pvc"))
+		})
+
 		makePodsWithVirtlauncher := func(virtlauncherPod *k8sv1.Pod, indexes ...int) []*k8sv1.Pod {
 			res := make([]*k8sv1.Pod, 0)
 			for _, index := range indexes {
@@ -1945,6 +2198,18 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			table.Entry("should return a volume if vmi has one more than virtlauncher", makeK8sVolumes(), makeVolumes(1), 1),
 			table.Entry("should return a volume if vmi has one more than virtlauncher, with matching volumes", makeK8sVolumes(1, 3), makeVolumes(1, 2, 3), 2),
 			table.Entry("should return multiple volumes if vmi has multiple more than virtlauncher, with matching volumes", makeK8sVolumes(1, 3), makeVolumes(1, 2, 3, 4, 5), 2, 4, 5),
+			table.Entry("should return a memory dump volume", makeK8sVolumes(), []*v1.Volume{
+				{
+					Name: "volume1",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "memorydump-pvc",
+							},
+						},
+					},
+				},
+			}, 1),
 		)
 
 		truncateSprintf := func(str string, args ...interface{}) string {
@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// CIDs 0, 1 and 2 are reserved for the hypervisor, the loopback and the host
+	minVSOCKCID = uint32(3)
+	// CID 0xFFFFFFFF is VMADDR_CID_ANY
+	maxVSOCKCID = uint32(math.MaxUint32 - 1)
+)
+
+// cidsMap keeps track of the vsock CIDs allocated to VMIs, so that every CID is unique in the cluster.
+type cidsMap struct {
+	lock sync.Mutex
+	// cids maps VMI keys to their CIDs
+	cids map[string]uint32
+	// reverse maps CIDs to the keys of the VMIs they are allocated to
+	reverse map[uint32]string
+	// randCID returns the CID to start searching for a free one at
+	randCID func() uint32
+}
+
+func newCIDsMap() *cidsMap {
+	return &cidsMap{
+		cids:    map[string]uint32{},
+		reverse: map[uint32]string{},
+		randCID: func() uint32 {
+			return minVSOCKCID + uint32(rand.Int63n(int64(maxVSOCKCID-minVSOCKCID+1)))
+		},
+	}
+}
+
+// Sync registers the CIDs of existing VMIs, e.g. after a restart of virt-controller.
+func (m *cidsMap) Sync(vmis []*virtv1.VirtualMachineInstance) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, vmi := range vmis {
+		if vmi.Status.VSOCKCID == nil {
+			continue
+		}
+		key := controller.VirtualMachineKey(vmi)
+		m.cids[key] = *vmi.Status.VSOCKCID
+		m.reverse[*vmi.Status.VSOCKCID] = key
+	}
+}
+
+// Allocate returns the CID of the VMI. A free CID is allocated if the VMI does not have one yet.
+func (m *cidsMap) Allocate(vmi *virtv1.VirtualMachineInstance) (uint32, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	key := controller.VirtualMachineKey(vmi)
+	if cid, exists := m.cids[key]; exists {
+		return cid, nil
+	}
+	if vmi.Status.VSOCKCID != nil {
+		m.cids[key] = *vmi.Status.VSOCKCID
+		m.reverse[*vmi.Status.VSOCKCID] = key
+		return *vmi.Status.VSOCKCID, nil
+	}
+
+	start := m.randCID()
+	cid := start
+	for {
+		if _, used := m.reverse[cid]; !used {
+			m.cids[key] = cid
+			m.reverse[cid] = key
+			return cid, nil
+		}
+		if cid == maxVSOCKCID {
+			cid = minVSOCKCID
+		} else {
+			cid++
+		}
+		if cid == start {
+			return 0, fmt.Errorf("no free vsock CID")
+		}
+	}
+}
+
+// Remove releases the CID of the VMI with the given key.
+func (m *cidsMap) Remove(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if cid, exists := m.cids[key]; exists {
+		delete(m.reverse, cid)
+		delete(m.cids, key)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("vsock CIDs map", func() {
+
+	var m *cidsMap
+
+	newVMI := func(name string, cid *uint32) *v1.VirtualMachineInstance {
+		vmi := v1.NewMinimalVMI(name)
+		vmi.Status.VSOCKCID = cid
+		return vmi
+	}
+
+	BeforeEach(func() {
+		m = newCIDsMap()
+	})
+
+	It("should allocate unique CIDs", func() {
+		m.randCID = func() uint32 { return minVSOCKCID }
+
+		cid1, err := m.Allocate(newVMI("vmi1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		cid2, err := m.Allocate(newVMI("vmi2", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cid1).To(Equal(minVSOCKCID))
+		Expect(cid2).To(Equal(minVSOCKCID + 1))
+	})
+
+	It("should return the same CID for the same VMI", func() {
+		vmi := newVMI("vmi1", nil)
+		cid1, err := m.Allocate(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		cid2, err := m.Allocate(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cid1).To(Equal(cid2))
+	})
+
+	It("should wrap around at the highest CID", func() {
+		m.randCID = func() uint32 { return maxVSOCKCID }
+
+		cid1, err := m.Allocate(newVMI("vmi1", nil))
+		Expect(err).ToNot(HaveOccurred())
+		cid2, err := m.Allocate(newVMI("vmi2", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cid1).To(Equal(maxVSOCKCID))
+		Expect(cid2).To(Equal(minVSOCKCID))
+	})
+
+	It("should not allocate CIDs of synced VMIs", func() {
+		cid := uint32(42)
+		m.Sync([]*v1.VirtualMachineInstance{newVMI("vmi1", &cid), newVMI("vmi2", nil)})
+		m.randCID = func() uint32 { return cid }
+
+		allocated, err := m.Allocate(newVMI("vmi2", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(cid + 1))
+	})
+
+	It("should reuse released CIDs", func() {
+		m.randCID = func() uint32 { return minVSOCKCID }
+
+		vmi := newVMI("vmi1", nil)
+		_, err := m.Allocate(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		m.Remove("default/vmi1")
+
+		cid, err := m.Allocate(newVMI("vmi2", nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cid).To(Equal(minVSOCKCID))
+	})
+})
@@ -0,0 +1,247 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package virt_exportserver
+
+import (
+	"compress/gzip"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-exportserver/qcow2"
+)
+
+const (
+	// DefaultListenAddr is the address the exporter listens on
+	DefaultListenAddr = ":8443"
+	// DefaultVolumesDir contains one entry per exported volume, either a
+	// directory holding disk.img or a block device
+	DefaultVolumesDir = "/export-volumes"
+	// DefaultTokenFile contains the token clients have to present
+	DefaultTokenFile = "/token/" + exportv1.TokenKey
+	// DefaultCertFile is the serving certificate
+	DefaultCertFile = "/cert/tls.crt"
+	// DefaultKeyFile is the key of the serving certificate
+	DefaultKeyFile = "/cert/tls.key"
+	// DefaultManifestFile contains the VirtualMachine definition, if any
+	DefaultManifestFile = "/cert/manifest.yaml"
+
+	// ReadyPath is served without authentication for the readiness probe
+	ReadyPath = "/readyz"
+	// VolumesPath is the prefix of all volume downloads
+	VolumesPath = "/volumes/"
+	// ManifestsPath is the prefix of all manifest downloads
+	ManifestsPath = "/manifests/"
+
+	// RawFile is the name of the raw image of a volume
+	RawFile = "disk.img"
+	// GzipFile is the name of the gzip compressed raw image of a volume
+	GzipFile = "disk.img.gz"
+	// Qcow2File is the name of the qcow2 image of a volume
+	Qcow2File = "disk.qcow2"
+)
+
+// ExportServer serves the images of the volumes mounted into the exporter pod
+type ExportServer struct {
+	ListenAddr   string
+	CertFile     string
+	KeyFile      string
+	TokenFile    string
+	VolumesDir   string
+	ManifestFile string
+
+	token []byte
+}
+
+// NewExportServer returns an ExportServer with the default paths set
+func NewExportServer() *ExportServer {
+	return &ExportServer{
+		ListenAddr:   DefaultListenAddr,
+		CertFile:     DefaultCertFile,
+		KeyFile:      DefaultKeyFile,
+		TokenFile:    DefaultTokenFile,
+		VolumesDir:   DefaultVolumesDir,
+		ManifestFile: DefaultManifestFile,
+	}
+}
+
+// Run serves the exported volumes until the server fails
+func (s *ExportServer) Run() error {
+	token, err := ioutil.ReadFile(s.TokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read token: %v", err)
+	}
+	s.token = []byte(strings.TrimSpace(string(token)))
+	if len(s.token) == 0 {
+		return fmt.Errorf("token file %s is empty", s.TokenFile)
+	}
+
+	server := &http.Server{
+		Addr:              s.ListenAddr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Log.Infof("Serving volumes from %s on %s", s.VolumesDir, s.ListenAddr)
+	return server.ListenAndServeTLS(s.CertFile, s.KeyFile)
+}
+
+// Handler returns the http.Handler serving volumes and manifests
+func (s *ExportServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ReadyPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle(VolumesPath, s.authorized(http.HandlerFunc(s.serveVolume)))
+	mux.Handle(ManifestsPath, s.authorized(http.HandlerFunc(s.serveManifest)))
+	return mux
+}
+
+func (s *ExportServer) authorized(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(exportv1.TokenHeader)
+		if token == "" {
+			token = r.URL.Query().Get(exportv1.TokenHeader)
+		}
+		if len(s.token) == 0 || subtle.ConstantTimeCompare([]byte(token), s.token) != 1 {
+			http.Error(w, "invalid or missing export token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *ExportServer) serveManifest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if strings.TrimPrefix(r.URL.Path, ManifestsPath) != string(exportv1.AllManifests) {
+		http.NotFound(w, r)
+		return
+	}
+
+	manifest, err := ioutil.ReadFile(s.ManifestFile)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		log.Log.Reason(err).Error("Failed to read manifest")
+		http.Error(w, "failed to read manifest", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(manifest)
+}
+
+func (s *ExportServer) serveVolume(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, VolumesPath), "/")
+	if len(parts) != 2 || parts[0] == "" || strings.HasPrefix(parts[0], ".") {
+		http.NotFound(w, r)
+		return
+	}
+	volume, file := parts[0], parts[1]
+
+	disk, err := s.openVolume(volume)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		log.Log.Reason(err).Errorf("Failed to open volume %s", volume)
+		http.Error(w, "failed to open volume", http.StatusInternalServerError)
+		return
+	}
+	defer disk.Close()
+
+	// block devices report a size of zero, seek to find their end
+	size, err := disk.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = disk.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to determine size of volume %s", volume)
+		http.Error(w, "failed to read volume", http.StatusInternalServerError)
+		return
+	}
+
+	switch file {
+	case RawFile:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, RawFile, time.Time{}, disk)
+	case GzipFile:
+		w.Header().Set("Content-Type", "application/gzip")
+		if r.Method == http.MethodHead {
+			return
+		}
+		gz := gzip.NewWriter(w)
+		if _, err := io.Copy(gz, disk); err != nil {
+			log.Log.Reason(err).Errorf("Failed to send gzip image of volume %s", volume)
+			return
+		}
+		if err := gz.Close(); err != nil {
+			log.Log.Reason(err).Errorf("Failed to send gzip image of volume %s", volume)
+		}
+	case Qcow2File:
+		img, err := qcow2.NewImage(disk, size)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to scan volume %s", volume)
+			http.Error(w, "failed to read volume", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", img.Size()))
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := img.WriteTo(w); err != nil {
+			log.Log.Reason(err).Errorf("Failed to send qcow2 image of volume %s", volume)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// openVolume opens disk.img of a filesystem volume or the block device
+func (s *ExportServer) openVolume(volume string) (*os.File, error) {
+	path := filepath.Join(s.VolumesDir, volume)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		path = filepath.Join(path, RawFile)
+	}
+	return os.Open(path)
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package virt_exportserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+)
+
+var _ = Describe("ExportServer", func() {
+	const token = "secret-token"
+
+	var tmpDir string
+	var server *httptest.Server
+	var content []byte
+
+	get := func(path string, header bool) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if header {
+			req.Header.Set(exportv1.TokenHeader, token)
+		}
+		resp, err := server.Client().Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	readBody := func(resp *http.Response) []byte {
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return body
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "exportserver")
+		Expect(err).ToNot(HaveOccurred())
+
+		content = bytes.Repeat([]byte("kubevirt"), 1024)
+		Expect(os.MkdirAll(filepath.Join(tmpDir, "volumes", "disk0"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "volumes", "disk0", RawFile), content, 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yaml"), []byte("kind: VirtualMachine\n"), 0644)).To(Succeed())
+
+		s := NewExportServer()
+		s.VolumesDir = filepath.Join(tmpDir, "volumes")
+		s.ManifestFile = filepath.Join(tmpDir, "manifest.yaml")
+		s.token = []byte(token)
+		server = httptest.NewServer(s.Handler())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should serve the readiness endpoint without token", func() {
+		resp := get(ReadyPath, false)
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	table.DescribeTable("should reject requests without valid token", func(path string) {
+		resp := get(path, false)
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		resp = get(path+"?"+exportv1.TokenHeader+"=wrong", false)
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	},
+		table.Entry("for volumes", "/volumes/disk0/"+RawFile),
+		table.Entry("for manifests", "/manifests/all"),
+	)
+
+	It("should serve the raw image", func() {
+		resp := get("/volumes/disk0/"+RawFile, true)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(readBody(resp)).To(Equal(content))
+	})
+
+	It("should accept the token as query parameter", func() {
+		resp := get("/volumes/disk0/"+RawFile+"?"+exportv1.TokenHeader+"="+token, false)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(readBody(resp)).To(Equal(content))
+	})
+
+	It("should serve the gzip compressed image", func() {
+		resp := get("/volumes/disk0/"+GzipFile, true)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		gz, err := gzip.NewReader(bytes.NewReader(readBody(resp)))
+		Expect(err).ToNot(HaveOccurred())
+		raw, err := ioutil.ReadAll(gz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(raw).To(Equal(content))
+	})
+
+	It("should serve the qcow2 image", func() {
+		resp := get("/volumes/disk0/"+Qcow2File, true)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body := readBody(resp)
+		Expect(resp.ContentLength).To(Equal(int64(len(body))))
+		Expect(body[:4]).To(Equal([]byte{'Q', 'F', 'I', 0xfb}))
+	})
+
+	It("should serve the manifest", func() {
+		resp := get("/manifests/all", true)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(readBody(resp))).To(Equal("kind: VirtualMachine\n"))
+	})
+
+	table.DescribeTable("should return not found", func(path string) {
+		resp := get(path, true)
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	},
+		table.Entry("for unknown volumes", "/volumes/disk1/"+RawFile),
+		table.Entry("for unknown formats", "/volumes/disk0/disk.vmdk"),
+		table.Entry("for paths leaving the volumes directory", "/volumes/../manifest.yaml"),
+		table.Entry("for unknown manifests", "/manifests/vm"),
+	)
+})
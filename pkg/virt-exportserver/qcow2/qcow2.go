@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+// Package qcow2 converts raw disk images to qcow2 while streaming them.
+//
+// The raw image is read twice: once to find the clusters holding data and
+// once to copy them. Knowing the allocated clusters upfront allows writing
+// all metadata before the data, so no scratch space is needed and the size
+// of the result is known before the first byte is sent.
+package qcow2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+const (
+	magic       = 0x514649fb
+	version     = 2
+	clusterBits = 16
+	clusterSize = 1 << clusterBits
+	headerSize  = 72
+
+	// entries of 8 bytes in L1, L2 and refcount tables
+	tableEntries = clusterSize / 8
+	// 16 bit refcounts, the only width supported by version 2
+	refcountEntries = clusterSize / 2
+
+	// the cluster is used exactly once, no copy on write needed
+	oflagCopied = uint64(1) << 63
+)
+
+// Image is a raw image that is converted to qcow2
+type Image struct {
+	src  io.ReaderAt
+	size int64
+
+	allocated []bool
+	l2Used    []bool
+
+	nL2, nData                             int64
+	l1Clusters, rtClusters, rbClusters     int64
+	l1Offset, rtOffset, rbOffset, l2Offset int64
+	dataOffset, totalClusters              int64
+}
+
+// NewImage scans the raw image of the given size for clusters holding data
+func NewImage(src io.ReaderAt, size int64) (*Image, error) {
+	img := &Image{
+		src:  src,
+		size: size,
+	}
+
+	nClusters := divRoundUp(size, clusterSize)
+	img.allocated = make([]bool, nClusters)
+	img.l2Used = make([]bool, divRoundUp(nClusters, tableEntries))
+
+	buf := make([]byte, clusterSize)
+	zero := make([]byte, clusterSize)
+	for i := int64(0); i < nClusters; i++ {
+		n, err := readCluster(src, buf, i, size)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(buf[:n], zero[:n]) {
+			img.allocated[i] = true
+			img.l2Used[i/tableEntries] = true
+			img.nData++
+		}
+	}
+
+	for _, used := range img.l2Used {
+		if used {
+			img.nL2++
+		}
+	}
+
+	img.layout()
+
+	return img, nil
+}
+
+// layout places header, L1 table, refcount table, refcount blocks,
+// L2 tables and data in this order
+func (img *Image) layout() {
+	img.l1Clusters = divRoundUp(int64(len(img.l2Used))*8, clusterSize)
+	if img.l1Clusters == 0 {
+		img.l1Clusters = 1
+	}
+
+	// the refcount structures have to cover themselves
+	img.rtClusters, img.rbClusters = 1, 1
+	for {
+		total := 1 + img.l1Clusters + img.rtClusters + img.rbClusters + img.nL2 + img.nData
+		rb := divRoundUp(total, refcountEntries)
+		rt := divRoundUp(rb, tableEntries)
+		if rb == img.rbClusters && rt == img.rtClusters {
+			img.totalClusters = total
+			break
+		}
+		img.rbClusters, img.rtClusters = rb, rt
+	}
+
+	img.l1Offset = clusterSize
+	img.rtOffset = img.l1Offset + img.l1Clusters*clusterSize
+	img.rbOffset = img.rtOffset + img.rtClusters*clusterSize
+	img.l2Offset = img.rbOffset + img.rbClusters*clusterSize
+	img.dataOffset = img.l2Offset + img.nL2*clusterSize
+}
+
+// Size returns the size of the qcow2 image
+func (img *Image) Size() int64 {
+	return img.totalClusters * clusterSize
+}
+
+// WriteTo writes the qcow2 image to w
+func (img *Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+
+	steps := []func(io.Writer) error{
+		img.writeHeader,
+		img.writeL1Table,
+		img.writeRefcountTable,
+		img.writeRefcountBlocks,
+		img.writeL2Tables,
+		img.writeData,
+	}
+	for _, step := range steps {
+		if err := step(cw); err != nil {
+			return cw.n, err
+		}
+	}
+
+	return cw.n, nil
+}
+
+func (img *Image) writeHeader(w io.Writer) error {
+	header := make([]byte, clusterSize)
+	be := binary.BigEndian
+	be.PutUint32(header[0:], magic)
+	be.PutUint32(header[4:], version)
+	// backing file offset and size stay zero
+	be.PutUint32(header[20:], clusterBits)
+	be.PutUint64(header[24:], uint64(img.size))
+	// no encryption
+	be.PutUint32(header[36:], uint32(len(img.l2Used)))
+	be.PutUint64(header[40:], uint64(img.l1Offset))
+	be.PutUint64(header[48:], uint64(img.rtOffset))
+	be.PutUint32(header[56:], uint32(img.rtClusters))
+	// no snapshots
+	_, err := w.Write(header)
+	return err
+}
+
+func (img *Image) writeL1Table(w io.Writer) error {
+	entries := make([]uint64, img.l1Clusters*tableEntries)
+	l2 := int64(0)
+	for i, used := range img.l2Used {
+		if used {
+			entries[i] = uint64(img.l2Offset+l2*clusterSize) | oflagCopied
+			l2++
+		}
+	}
+	return binary.Write(w, binary.BigEndian, entries)
+}
+
+func (img *Image) writeRefcountTable(w io.Writer) error {
+	entries := make([]uint64, img.rtClusters*tableEntries)
+	for i := int64(0); i < img.rbClusters; i++ {
+		entries[i] = uint64(img.rbOffset + i*clusterSize)
+	}
+	return binary.Write(w, binary.BigEndian, entries)
+}
+
+func (img *Image) writeRefcountBlocks(w io.Writer) error {
+	refcounts := make([]uint16, refcountEntries)
+	for block := int64(0); block < img.rbClusters; block++ {
+		for i := range refcounts {
+			refcounts[i] = 0
+			if block*refcountEntries+int64(i) < img.totalClusters {
+				refcounts[i] = 1
+			}
+		}
+		if err := binary.Write(w, binary.BigEndian, refcounts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeL2Tables(w io.Writer) error {
+	entries := make([]uint64, tableEntries)
+	data := int64(0)
+	for table, used := range img.l2Used {
+		first := int64(table) * tableEntries
+		for i := range entries {
+			entries[i] = 0
+			cluster := first + int64(i)
+			if cluster < int64(len(img.allocated)) && img.allocated[cluster] {
+				entries[i] = uint64(img.dataOffset+data*clusterSize) | oflagCopied
+				data++
+			}
+		}
+		if !used {
+			continue
+		}
+		if err := binary.Write(w, binary.BigEndian, entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeData(w io.Writer) error {
+	buf := make([]byte, clusterSize)
+	for i, allocated := range img.allocated {
+		if !allocated {
+			continue
+		}
+		n, err := readCluster(img.src, buf, int64(i), img.size)
+		if err != nil {
+			return err
+		}
+		// the last cluster may be partial
+		for j := n; j < clusterSize; j++ {
+			buf[j] = 0
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readCluster(src io.ReaderAt, buf []byte, cluster, size int64) (int, error) {
+	offset := cluster * clusterSize
+	n := clusterSize
+	if remaining := size - offset; remaining < int64(n) {
+		n = int(remaining)
+	}
+	read, err := src.ReadAt(buf[:n], offset)
+	if err != nil && !(err == io.EOF && read == n) {
+		return 0, err
+	}
+	return n, nil
+}
+
+func divRoundUp(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package qcow2
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 follows the L1 and L2 tables of a qcow2 image and returns the
+// guest visible content, checking the refcounts on the way
+func readQcow2(image []byte) []byte {
+	be := binary.BigEndian
+	Expect(be.Uint32(image[0:])).To(Equal(uint32(magic)))
+	Expect(be.Uint32(image[4:])).To(Equal(uint32(version)))
+	Expect(be.Uint32(image[20:])).To(Equal(uint32(clusterBits)))
+	size := be.Uint64(image[24:])
+	l1Size := be.Uint32(image[36:])
+	l1Offset := be.Uint64(image[40:])
+	rtOffset := be.Uint64(image[48:])
+	rtClusters := be.Uint32(image[56:])
+	Expect(len(image) % clusterSize).To(BeZero())
+
+	refcount := func(cluster uint64) uint16 {
+		rtIndex := cluster / refcountEntries
+		Expect(rtIndex).To(BeNumerically("<", uint64(rtClusters)*tableEntries))
+		rbOffset := be.Uint64(image[rtOffset+rtIndex*8:])
+		Expect(rbOffset).ToNot(BeZero())
+		return be.Uint16(image[rbOffset+(cluster%refcountEntries)*2:])
+	}
+	for cluster := uint64(0); cluster < uint64(len(image)/clusterSize); cluster++ {
+		Expect(refcount(cluster)).To(Equal(uint16(1)), "cluster %d", cluster)
+	}
+
+	raw := make([]byte, size)
+	for l1Index := uint64(0); l1Index < uint64(l1Size); l1Index++ {
+		l2Offset := be.Uint64(image[l1Offset+l1Index*8:]) &^ oflagCopied
+		if l2Offset == 0 {
+			continue
+		}
+		for l2Index := uint64(0); l2Index < tableEntries; l2Index++ {
+			dataOffset := be.Uint64(image[l2Offset+l2Index*8:]) &^ oflagCopied
+			if dataOffset == 0 {
+				continue
+			}
+			guestOffset := (l1Index*tableEntries + l2Index) * clusterSize
+			copy(raw[guestOffset:], image[dataOffset:dataOffset+clusterSize])
+		}
+	}
+	return raw
+}
+
+var _ = Describe("Qcow2", func() {
+
+	convert := func(raw []byte) (*Image, []byte) {
+		img, err := NewImage(bytes.NewReader(raw), int64(len(raw)))
+		Expect(err).ToNot(HaveOccurred())
+		out := &bytes.Buffer{}
+		n, err := img.WriteTo(out)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(img.Size()))
+		Expect(int64(out.Len())).To(Equal(img.Size()))
+		return img, out.Bytes()
+	}
+
+	table.DescribeTable("should preserve the content", func(size int64, dataAt ...int64) {
+		raw := make([]byte, size)
+		for _, offset := range dataAt {
+			copy(raw[offset:], []byte("kubevirt"))
+		}
+
+		img, out := convert(raw)
+		Expect(img.nData).To(Equal(int64(len(dataAt))))
+		Expect(readQcow2(out)).To(Equal(raw))
+	},
+		table.Entry("with an empty image", int64(0)),
+		table.Entry("with a sparse image", int64(10*clusterSize)),
+		table.Entry("with data in the first cluster", int64(10*clusterSize), int64(0)),
+		table.Entry("with data in a partial last cluster", int64(3*clusterSize+100), int64(3*clusterSize+50)),
+		table.Entry("with data behind the first L2 table", int64(2*tableEntries*clusterSize), int64(clusterSize), int64(tableEntries*clusterSize+10)),
+	)
+
+	It("should only store allocated clusters", func() {
+		raw := make([]byte, 1024*clusterSize)
+		raw[5*clusterSize] = 1
+
+		img, out := convert(raw)
+		// header, L1, refcount table, refcount block, one L2 table and one data cluster
+		Expect(len(out)).To(Equal(6 * clusterSize))
+		Expect(img.nL2).To(Equal(int64(1)))
+	})
+})
@@ -81,18 +81,24 @@ type LauncherClient interface {
 	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error
+	UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	RebootVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	SyncMigrationTarget(vmi *v1.VirtualMachineInstance) error
 	ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	KillVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	MigrateVirtualMachine(vmi *v1.VirtualMachineInstance, options *MigrationOptions) error
 	CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error
 	SetVirtualMachineGuestTime(vmi *v1.VirtualMachineInstance) error
+	SetInterfaceBandwidth(vmi *v1.VirtualMachineInstance) error
 	DeleteDomain(vmi *v1.VirtualMachineInstance) error
 	GetDomain() (*api.Domain, bool, error)
 	GetDomainStats() (*stats.DomainStats, bool, error)
 	GetGuestInfo() (*v1.VirtualMachineInstanceGuestAgentInfo, error)
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestExec(vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error)
 	Ping() error
 	Close()
 }
@@ -414,6 +420,56 @@ func (c *VirtLauncherClient) UnpauseVirtualMachine(vmi *v1.VirtualMachineInstanc
 	return c.genericSendVMICmd("Unpause", c.v1client.UnpauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.FreezeRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		UnfreezeTimeoutSeconds: unfreezeTimeoutSeconds,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.FreezeVirtualMachine(ctx, request)
+
+	err = handleError(err, "Freeze", response)
+	return err
+}
+
+func (c *VirtLauncherClient) UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("Unfreeze", c.v1client.UnfreezeVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) RebootVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("Reboot", c.v1client.RebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.MemoryDumpRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		DumpPath: dumpPath,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+	response, err := c.v1client.VirtualMachineMemoryDump(ctx, request)
+
+	err = handleError(err, "MemoryDump", response)
+	return err
+}
+
 func (c *VirtLauncherClient) ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("Shutdown", c.v1client.ShutdownVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -467,6 +523,10 @@ func (c *VirtLauncherClient) SetVirtualMachineGuestTime(vmi *v1.VirtualMachineIn
 	return c.genericSendVMICmd("SetVirtualMachineGuestTime", c.v1client.SetVirtualMachineGuestTime, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) SetInterfaceBandwidth(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("SetInterfaceBandwidth", c.v1client.SetInterfaceBandwidth, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) GetDomain() (*api.Domain, bool, error) {
 
 	domain := &api.Domain{}
@@ -624,3 +684,46 @@ func (c *VirtLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSyst
 
 	return filesystemList, nil
 }
+
+// GuestExec runs a command in the guest through the guest agent and waits for it to exit
+func (c *VirtLauncherClient) GuestExec(vmi *v1.VirtualMachineInstance, execRequest *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeoutSeconds int32
+	if execRequest.TimeoutSeconds != nil {
+		timeoutSeconds = *execRequest.TimeoutSeconds
+	}
+
+	request := &cmdv1.GuestExecRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Command:        execRequest.Command,
+		Args:           execRequest.Args,
+		Stdin:          execRequest.Stdin,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	// the launcher polls the guest for up to timeoutSeconds, give it some room on top
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second+shortTimeout)
+	defer cancel()
+
+	execResponse, err := c.v1client.GuestExec(ctx, request)
+	var response *cmdv1.Response
+	if execResponse != nil {
+		response = execResponse.Response
+	}
+
+	if err = handleError(err, "GuestExec", response); err != nil {
+		return nil, err
+	}
+
+	return &v1.VirtualMachineInstanceGuestExecResult{
+		ExitCode: execResponse.ExitCode,
+		Stdout:   execResponse.Stdout,
+		Stderr:   execResponse.Stderr,
+	}, nil
+}
@@ -31,9 +31,10 @@ import (
 )
 
 var permanentDevicePluginPaths = map[string]string{
-	"kvm":       "/dev/kvm",
-	"tun":       "/dev/net/tun",
-	"vhost-net": "/dev/vhost-net",
+	"kvm":         "/dev/kvm",
+	"tun":         "/dev/net/tun",
+	"vhost-net":   "/dev/vhost-net",
+	"vhost-vsock": "/dev/vhost-vsock",
 }
 
 type DeviceController struct {
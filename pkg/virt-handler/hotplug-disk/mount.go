@@ -31,6 +31,16 @@ import (
 	"kubevirt.io/client-go/log"
 )
 
+const csiVolumePlugin = "kubernetes.io~csi"
+
+// nonPersistentVolumePlugins are the volume plugins of the attachment pod volumes which are not backed by the hotplugged PVC
+var nonPersistentVolumePlugins = map[string]bool{
+	"kubernetes.io~empty-dir": true,
+	"kubernetes.io~projected": true,
+	"kubernetes.io~secret":    true,
+	"kubernetes.io~configmap": true,
+}
+
 var (
 	deviceBasePath = func(podUID types.UID) string {
 		return fmt.Sprintf("/proc/1/root/var/lib/kubelet/pods/%s/volumeDevices", string(podUID))
@@ -229,6 +239,12 @@ func (m *volumeMounter) Mount(vmi *v1.VirtualMachineInstance) error {
 	if err != nil {
 		return err
 	}
+	memoryDumpVolumes := make(map[string]bool)
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			memoryDumpVolumes[volume.Name] = true
+		}
+	}
 	for _, volumeStatus := range vmi.Status.VolumeStatus {
 		if volumeStatus.HotplugVolume == nil {
 			// Skip non hotplug volumes
@@ -244,7 +260,7 @@ func (m *volumeMounter) Mount(vmi *v1.VirtualMachineInstance) error {
 				}
 			} else {
 				logger.V(4).Infof("Mounting file system volume: %s", volumeStatus.Name)
-				if err := m.mountFileSystemHotplugVolume(vmi, volumeStatus.Name, sourceUID, record); err != nil {
+				if err := m.mountFileSystemHotplugVolume(vmi, volumeStatus.Name, sourceUID, record, memoryDumpVolumes[volumeStatus.Name]); err != nil {
 					return err
 				}
 			}
@@ -518,8 +534,16 @@ func (m *volumeMounter) createBlockDeviceFile(deviceName string, major, minor in
 	return deviceName, nil
 }
 
-func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInstance, volume string, sourceUID types.UID, record *vmiMountTargetRecord) error {
-	sourcePath, err := m.getSourcePodFilePath(sourceUID)
+// mountFileSystemHotplugVolume bind mounts the directory containing the disk image of the attachment pod volume into the
+// virt-launcher pod. If mountVolumeRoot is set the root of the volume is mounted instead, regardless of its content.
+func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInstance, volume string, sourceUID types.UID, record *vmiMountTargetRecord, mountVolumeRoot bool) error {
+	var sourcePath string
+	var err error
+	if mountVolumeRoot {
+		sourcePath, err = m.getSourcePodVolumePath(sourceUID)
+	} else {
+		sourcePath, err = m.getSourcePodFilePath(sourceUID)
+	}
 	if err != nil {
 		log.DefaultLogger().Infof("Error finding source path: %v", err)
 		return nil
@@ -583,6 +607,35 @@ func (m *volumeMounter) getSourcePodFilePath(sourceUID types.UID) (string, error
 	return diskPath, nil
 }
 
+// getSourcePodVolumePath returns the path of the single persistent volume mounted in the attachment pod.
+func (m *volumeMounter) getSourcePodVolumePath(sourceUID types.UID) (string, error) {
+	if sourceUID != types.UID("") {
+		basepath := sourcePodBasePath(sourceUID)
+		plugins, err := ioutil.ReadDir(basepath)
+		if err != nil {
+			return "", err
+		}
+		for _, plugin := range plugins {
+			if !plugin.IsDir() || nonPersistentVolumePlugins[plugin.Name()] {
+				continue
+			}
+			volumes, err := ioutil.ReadDir(filepath.Join(basepath, plugin.Name()))
+			if err != nil {
+				return "", err
+			}
+			for _, volume := range volumes {
+				volumePath := filepath.Join(basepath, plugin.Name(), volume.Name())
+				// CSI volumes are mounted in a subdirectory next to the volume metadata
+				if plugin.Name() == csiVolumePlugin {
+					volumePath = filepath.Join(volumePath, "mount")
+				}
+				return volumePath, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("Unable to find source volume path for pod %s", sourceUID)
+}
+
 // Unmount unmounts all hotplug disk that are no longer part of the VMI
 func (m *volumeMounter) Unmount(vmi *v1.VirtualMachineInstance) error {
 	if vmi.UID != "" {
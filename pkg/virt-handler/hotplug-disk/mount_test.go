@@ -644,6 +644,66 @@ var _ = Describe("HotplugVolume filesystem volumes", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("getSourcePodVolumePath should find the persistent volume, skipping other volumes", func() {
+		path := filepath.Join(tempDir, "ghfjk", "volumes")
+		sourcePodBasePath = func(podUID types.UID) string {
+			return path
+		}
+		Expect(os.MkdirAll(filepath.Join(path, "kubernetes.io~empty-dir", "hotplug-disks"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(path, "kubernetes.io~projected", "kube-api-access"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(path, "kubernetes.io~nfs", "pv-memorydump"), 0755)).To(Succeed())
+		volumePath, err := m.getSourcePodVolumePath("ghfjk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumePath).To(Equal(filepath.Join(path, "kubernetes.io~nfs", "pv-memorydump")))
+	})
+
+	It("getSourcePodVolumePath should use the mount directory of csi volumes", func() {
+		path := filepath.Join(tempDir, "ghfjk", "volumes")
+		sourcePodBasePath = func(podUID types.UID) string {
+			return path
+		}
+		Expect(os.MkdirAll(filepath.Join(path, "kubernetes.io~csi", "pvc-1234", "mount"), 0755)).To(Succeed())
+		volumePath, err := m.getSourcePodVolumePath("ghfjk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumePath).To(Equal(filepath.Join(path, "kubernetes.io~csi", "pvc-1234", "mount")))
+	})
+
+	It("getSourcePodVolumePath should return error if there is no persistent volume", func() {
+		path := filepath.Join(tempDir, "ghfjk", "volumes")
+		sourcePodBasePath = func(podUID types.UID) string {
+			return path
+		}
+		Expect(os.MkdirAll(filepath.Join(path, "kubernetes.io~empty-dir", "hotplug-disks"), 0755)).To(Succeed())
+		_, err := m.getSourcePodVolumePath("ghfjk")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should mount the volume root of a memory dump volume", func() {
+		sourcePodUID := "ghfjk"
+		path := filepath.Join(tempDir, sourcePodUID, "volumes")
+		sourcePodBasePath = func(podUID types.UID) string {
+			return path
+		}
+		volumePath := filepath.Join(path, "kubernetes.io~nfs", "pv-memorydump")
+		Expect(os.MkdirAll(volumePath, 0755)).To(Succeed())
+		hotplugdisk.SetKubeletPodsDirectory(tempDir)
+		targetPodPath := filepath.Join(tempDir, string(m.findVirtlauncherUID(vmi)), "volumes/kubernetes.io~empty-dir/hotplug-disks")
+		Expect(os.MkdirAll(targetPodPath, 0755)).To(Succeed())
+		targetFilePath := filepath.Join(targetPodPath, "memorydump")
+		mountCommand = func(sourcePath, targetPath string) ([]byte, error) {
+			Expect(sourcePath).To(Equal(volumePath))
+			Expect(targetPath).To(Equal(targetFilePath))
+			return []byte("Success"), nil
+		}
+		isMounted = func(diskPath string) (bool, error) {
+			return false, nil
+		}
+
+		err = m.mountFileSystemHotplugVolume(vmi, "memorydump", types.UID(sourcePodUID), record, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record.MountTargetEntries[0].TargetFile).To(Equal(targetFilePath))
+	})
+
 	It("should properly mount and unmount filesystem", func() {
 		sourcePodUID := "ghfjk"
 		path := filepath.Join(tempDir, sourcePodUID, "volumes")
@@ -665,7 +725,7 @@ var _ = Describe("HotplugVolume filesystem volumes", func() {
 			return []byte("Success"), nil
 		}
 
-		err = m.mountFileSystemHotplugVolume(vmi, "testvolume", types.UID(sourcePodUID), record)
+		err = m.mountFileSystemHotplugVolume(vmi, "testvolume", types.UID(sourcePodUID), record, false)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(record.MountTargetEntries[0].TargetFile).To(Equal(targetFilePath))
 
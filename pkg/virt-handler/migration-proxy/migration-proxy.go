@@ -30,6 +30,7 @@ import (
 	"strings"
 	"sync"
 
+	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 )
@@ -57,6 +58,7 @@ type migrationProxyManager struct {
 	managerLock     sync.Mutex
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	migrationIP     string
 }
 
 type migrationProxy struct {
@@ -82,15 +84,43 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 	return
 }
 
-func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) ProxyManager {
+// NewMigrationProxyManager returns a ProxyManager whose target listeners bind to migrationIP,
+// or to all addresses if it is empty
+func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, migrationIP string) ProxyManager {
 	return &migrationProxyManager{
 		sourceProxies:   make(map[string][]*migrationProxy),
 		targetProxies:   make(map[string][]*migrationProxy),
 		serverTLSConfig: serverTLSConfig,
 		clientTLSConfig: clientTLSConfig,
+		migrationIP:     migrationIP,
 	}
 }
 
+// FindMigrationIP returns the IP address of the migration interface, which virt-operator attaches to
+// virt-handler pods when a migration network is configured. Without it, migrations go over the pod
+// network and an empty address is returned.
+func FindMigrationIP() (string, error) {
+	return findInterfaceIP(v1.MigrationInterfaceName)
+}
+
+func findInterfaceIP(interfaceName string) (string, error) {
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return "", nil
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the addresses of interface %s: %v", interfaceName, err)
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if ok && ipNet.IP.IsGlobalUnicast() {
+			return ipNet.IP.String(), nil
+		}
+	}
+	return "", fmt.Errorf("interface %s has no global unicast address", interfaceName)
+}
+
 func SourceUnixFile(baseDir string, key string) string {
 	return filepath.Join(baseDir, "migrationproxy", key+"-source.sock")
 }
@@ -128,11 +158,14 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 		}
 	}
 
-	zeroAddress := ip.GetIPZeroAddress()
+	bindAddress := m.migrationIP
+	if bindAddress == "" {
+		bindAddress = ip.GetIPZeroAddress()
+	}
 	proxiesList := []*migrationProxy{}
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
-		proxy := NewTargetProxy(zeroAddress, 0, m.serverTLSConfig, m.clientTLSConfig, targetUnixFile)
+		proxy := NewTargetProxy(bindAddress, 0, m.serverTLSConfig, m.clientTLSConfig, targetUnixFile)
 
 		err := proxy.StartListening()
 		if err != nil {
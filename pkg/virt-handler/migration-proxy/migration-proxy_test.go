@@ -152,7 +152,7 @@ var _ = Describe("MigrationProxy", func() {
 
 				Expect(err).ShouldNot(HaveOccurred())
 
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, "")
 				manager.StartTargetListener("mykey", []string{libvirtdSock, directSock})
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
 				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
@@ -198,6 +198,41 @@ var _ = Describe("MigrationProxy", func() {
 					}
 				}
 			})
+
+			It("by binding the target listeners to the migration IP", func() {
+				libvirtdSock := tmpDir + "/libvirtd-sock"
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, "127.0.0.1")
+				Expect(manager.StartTargetListener("mykey", []string{libvirtdSock})).To(Succeed())
+				defer manager.StopTargetListener("mykey")
+
+				proxies := manager.(*migrationProxyManager).targetProxies["mykey"]
+				Expect(proxies).To(HaveLen(1))
+				Expect(proxies[0].listener.Addr().(*net.TCPAddr).IP.String()).To(Equal("127.0.0.1"))
+			})
+
+			It("by binding the target listeners to all addresses without a migration IP", func() {
+				libvirtdSock := tmpDir + "/libvirtd-sock"
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, "")
+				Expect(manager.StartTargetListener("mykey", []string{libvirtdSock})).To(Succeed())
+				defer manager.StopTargetListener("mykey")
+
+				proxies := manager.(*migrationProxyManager).targetProxies["mykey"]
+				Expect(proxies).To(HaveLen(1))
+				Expect(proxies[0].listener.Addr().(*net.TCPAddr).IP.IsUnspecified()).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("migration IP", func() {
+		It("should return no address without a migration interface", func() {
+			migrationIP, err := findInterfaceIP("nonexistent0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrationIP).To(BeEmpty())
+		})
+
+		It("should fail if the migration interface has no global unicast address", func() {
+			_, err := findInterfaceIP("lo")
+			Expect(err).To(HaveOccurred())
 		})
 	})
 })
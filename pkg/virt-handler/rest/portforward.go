@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
+)
+
+type PortForwardHandler struct {
+	podIsolationDetector isolation.PodIsolationDetector
+	vmiInformer          cache.SharedIndexInformer
+}
+
+func NewPortForwardHandler(podIsolationDetector isolation.PodIsolationDetector, vmiInformer cache.SharedIndexInformer) *PortForwardHandler {
+	return &PortForwardHandler{
+		podIsolationDetector: podIsolationDetector,
+		vmiInformer:          vmiInformer,
+	}
+}
+
+func (p *PortForwardHandler) PortForwardHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, p.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	port, protocol, err := portForwardParameters(request)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	address, err := portForwardAddress(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to find the address to forward to")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	target := net.JoinHostPort(address, strconv.Itoa(port))
+
+	res, err := p.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect isolation of the VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	// The connection is created in the network namespace of the launcher pod,
+	// the socket stays in it after switching back.
+	var conn net.Conn
+	err = res.DoNetNS(func() error {
+		conn, err = net.Dial(protocol, target)
+		return err
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to dial %s %s", protocol, target)
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	defer conn.Close()
+
+	upgrader := kubecli.NewUpgrader()
+	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to upgrade client websocket connection")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer clientSocket.Close()
+
+	log.Log.Object(vmi).Infof("Forwarding %s connection to %s", protocol, target)
+
+	errCh := make(chan error, 2)
+	go func() {
+		_, err := kubecli.CopyTo(clientSocket, conn)
+		errCh <- err
+	}()
+
+	go func() {
+		_, err := kubecli.CopyFrom(conn, clientSocket)
+		errCh <- err
+	}()
+
+	if err := <-errCh; err != nil && err != io.EOF {
+		log.Log.Object(vmi).Reason(err).Errorf("Error in forwarding %s connection to %s", protocol, target)
+	}
+}
+
+func portForwardParameters(request *restful.Request) (int, string, error) {
+	port, err := strconv.Atoi(request.PathParameter("port"))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, "", fmt.Errorf("invalid port %q", request.PathParameter("port"))
+	}
+	protocol := request.PathParameter("protocol")
+	if protocol != "tcp" && protocol != "udp" {
+		return 0, "", fmt.Errorf("unsupported protocol %q, only tcp and udp are supported", protocol)
+	}
+	return port, protocol, nil
+}
+
+// portForwardAddress returns the address the VMI can be reached on from the
+// network namespace of its launcher pod. Only the masquerade binding has an
+// address of the VMI which is routed in the pod.
+func portForwardAddress(vmi *v1.VirtualMachineInstance) (string, error) {
+	var podNetwork *v1.Network
+	for i, n := range vmi.Spec.Networks {
+		if n.Pod != nil {
+			podNetwork = &vmi.Spec.Networks[i]
+			break
+		}
+	}
+	if podNetwork == nil {
+		return "", fmt.Errorf("VMI %s is not connected to the pod network", vmi.Name)
+	}
+
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Name != podNetwork.Name {
+			continue
+		}
+		if iface.Masquerade == nil {
+			return "", fmt.Errorf("port-forward requires the masquerade binding on interface %s", iface.Name)
+		}
+		cidr := podNetwork.Pod.VMNetworkCIDR
+		if cidr == "" {
+			cidr = api.DefaultVMCIDR
+		}
+		_, vmAddress, err := (&network.NetworkUtilsHandler{}).GetHostAndGwAddressesFromCIDR(cidr)
+		if err != nil {
+			return "", err
+		}
+		ip, _, err := net.ParseCIDR(vmAddress)
+		if err != nil {
+			return "", err
+		}
+		return ip.String(), nil
+	}
+	return "", fmt.Errorf("VMI %s has no interface on the pod network", vmi.Name)
+}
@@ -33,12 +33,14 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,6 +50,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 
 	container_disk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
@@ -60,6 +63,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	clusterutils "kubevirt.io/kubevirt/pkg/util/cluster"
 	pvcutils "kubevirt.io/kubevirt/pkg/util/types"
@@ -86,6 +90,7 @@ func NewController(
 	clientset kubecli.KubevirtClient,
 	host string,
 	ipAddress string,
+	migrationIpAddress string,
 	virtShareDir string,
 	virtPrivateDir string,
 	vmiSourceInformer cache.SharedIndexInformer,
@@ -115,7 +120,7 @@ func NewController(
 		gracefulShutdownInformer: gracefulShutdownInformer,
 		heartBeatInterval:        1 * time.Minute,
 		watchdogTimeoutSeconds:   watchdogTimeoutSeconds,
-		migrationProxy:           migrationproxy.NewMigrationProxyManager(serverTLSConfig, clientTLSConfig),
+		migrationProxy:           migrationproxy.NewMigrationProxyManager(serverTLSConfig, clientTLSConfig, migrationIpAddress),
 		podIsolationDetector:     podIsolationDetector,
 		containerDiskMounter:     container_disk.NewMounter(podIsolationDetector, virtPrivateDir+"/container-disk-mount-state"),
 		hotplugVolumeMounter:     hotplug_volume.NewVolumeMounter(podIsolationDetector, virtPrivateDir+"/hotplug-volume-mount-state"),
@@ -148,6 +153,7 @@ func NewController(
 
 	c.launcherClients = make(map[types.UID]*launcherClientInfo)
 	c.phase1NetworkSetupCache = make(map[types.UID]int)
+	c.hotplugNetworkSetupCache = make(map[types.UID]string)
 	c.podInterfaceCache = make(map[string]*network.PodCacheInterface)
 
 	c.domainNotifyPipes = make(map[string]string)
@@ -187,6 +193,11 @@ type VirtualMachineController struct {
 	phase1NetworkSetupCache     map[types.UID]int
 	phase1NetworkSetupCacheLock sync.Mutex
 
+	// records the attached secondary networks for which phase1 has
+	// completed on a running vmi, so that phase1 only runs again
+	// when networks are hotplugged.
+	hotplugNetworkSetupCache map[types.UID]string
+
 	// key is the file path, value is the contents.
 	// if key exists, then don't read directly from file.
 	podInterfaceCache     map[string]*network.PodCacheInterface
@@ -395,6 +406,7 @@ func (d *VirtualMachineController) clearPodNetworkPhase1(uid types.UID) {
 	}
 	d.phase1NetworkSetupCacheLock.Lock()
 	delete(d.phase1NetworkSetupCache, uid)
+	delete(d.hotplugNetworkSetupCache, uid)
 	d.phase1NetworkSetupCacheLock.Unlock()
 
 	// Clean Pod interface cache from map and files
@@ -488,7 +500,90 @@ func canUpdateToMounted(currentPhase v1.VolumePhase) bool {
 }
 
 func canUpdateToUnmounted(currentPhase v1.VolumePhase) bool {
-	return currentPhase == v1.VolumeReady || currentPhase == v1.HotplugVolumeMounted || currentPhase == v1.HotplugVolumeAttachedToNode
+	return currentPhase == v1.VolumeReady || currentPhase == v1.HotplugVolumeMounted || currentPhase == v1.HotplugVolumeAttachedToNode ||
+		currentPhase == v1.MemoryDumpVolumeInProgress || currentPhase == v1.MemoryDumpVolumeCompleted || currentPhase == v1.MemoryDumpVolumeFailed
+}
+
+// memoryDumpFileName returns the name of the file the memory of the vmi is dumped to, made unique by the time of the request.
+func memoryDumpFileName(vmi *v1.VirtualMachineInstance, claimName string) string {
+	return fmt.Sprintf("%s-%s-%s.memory.dump", vmi.Name, claimName, time.Now().UTC().Format("20060102-150405"))
+}
+
+// updateCurrentCPUTopology reports the sockets which are online in the domain, which can be less
+// than the sockets of the domain topology if sockets can be hotplugged.
+func updateCurrentCPUTopology(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	topology := domain.Spec.CPU.Topology
+	if topology == nil || domain.Spec.VCPU == nil || topology.Cores == 0 || topology.Threads == 0 {
+		return
+	}
+	sockets := topology.Sockets
+	if domain.Spec.VCPU.Current != 0 {
+		sockets = domain.Spec.VCPU.Current / (topology.Cores * topology.Threads)
+	}
+	vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+		Cores:   topology.Cores,
+		Sockets: sockets,
+		Threads: topology.Threads,
+	}
+}
+
+// updateCurrentGuestMemory reports the boot memory plus the memory currently plugged into the virtio-mem device.
+func updateCurrentGuestMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if vmi.Status.Memory == nil || domain.Spec.CPU.NUMA == nil {
+		return
+	}
+	var current uint64
+	for _, cell := range domain.Spec.CPU.NUMA.Cells {
+		value, err := strconv.ParseUint(cell.Memory, 10, 64)
+		if err != nil {
+			return
+		}
+		bytes, err := api.Memory{Value: value, Unit: cell.Unit}.Bytes()
+		if err != nil {
+			return
+		}
+		current += bytes
+	}
+	for _, device := range domain.Spec.Devices.Memory {
+		if device.Model != "virtio-mem" || device.Target == nil || device.Target.Current == nil {
+			continue
+		}
+		bytes, err := device.Target.Current.Bytes()
+		if err != nil {
+			return
+		}
+		current += bytes
+	}
+	vmi.Status.Memory.GuestCurrent = resource.NewQuantity(int64(current), resource.BinarySI)
+}
+
+// updateMemoryDumpInfo reflects the progress of the memory dump, reported by virt-launcher in the domain metadata, in the volume status.
+func updateMemoryDumpInfo(volumeStatus *v1.VolumeStatus, domain *api.Domain) {
+	if volumeStatus.MemoryDumpVolume == nil || (volumeStatus.Phase != v1.HotplugVolumeMounted && volumeStatus.Phase != v1.MemoryDumpVolumeInProgress) {
+		return
+	}
+	memoryDumpMetadata := domain.Spec.Metadata.KubeVirt.MemoryDump
+	if memoryDumpMetadata == nil || memoryDumpMetadata.FileName != volumeStatus.MemoryDumpVolume.TargetFileName {
+		// the memory dump to this volume was not triggered yet
+		return
+	}
+
+	volumeStatus.MemoryDumpVolume.StartTimestamp = memoryDumpMetadata.StartTimestamp
+	volumeStatus.MemoryDumpVolume.EndTimestamp = memoryDumpMetadata.EndTimestamp
+	switch {
+	case memoryDumpMetadata.Completed:
+		volumeStatus.Phase = v1.MemoryDumpVolumeCompleted
+		volumeStatus.Message = fmt.Sprintf("Memory dump to volume %s has completed successfully", volumeStatus.Name)
+		volumeStatus.Reason = "MemoryDumpCompleted"
+	case memoryDumpMetadata.Failed:
+		volumeStatus.Phase = v1.MemoryDumpVolumeFailed
+		volumeStatus.Message = fmt.Sprintf("Memory dump to volume %s failed: %s", volumeStatus.Name, memoryDumpMetadata.FailureReason)
+		volumeStatus.Reason = "MemoryDumpFailed"
+	default:
+		volumeStatus.Phase = v1.MemoryDumpVolumeInProgress
+		volumeStatus.Message = fmt.Sprintf("Memory dump to volume %s is in progress", volumeStatus.Name)
+		volumeStatus.Reason = "MemoryDumpInProgress"
+	}
 }
 
 func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain, syncError error) (err error) {
@@ -516,6 +611,8 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 			vmi.Status.GuestOSInfo.KernelVersion = domain.Status.OSInfo.KernelVersion
 			vmi.Status.GuestOSInfo.ID = domain.Status.OSInfo.Id
 		}
+		updateCurrentCPUTopology(vmi, domain)
+		updateCurrentGuestMemory(vmi, domain)
 		// This is needed to be backwards compatible with vmi's which have status interfaces
 		// with the name not being set
 		if len(domain.Spec.Devices.Interfaces) == 0 && len(vmi.Status.Interfaces) == 1 && vmi.Status.Interfaces[0].Name == "" {
@@ -552,7 +649,14 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 								volumeStatus.Phase = v1.HotplugVolumeMounted
 								volumeStatus.Message = fmt.Sprintf("Volume %s has been mounted in virt-launcher pod", volumeStatus.Name)
 								volumeStatus.Reason = "VolumeMountedToPod"
+								if volume := specVolumeMap[volumeStatus.Name]; volume.MemoryDump != nil {
+									volumeStatus.MemoryDumpVolume = &v1.DomainMemoryDumpInfo{
+										ClaimName:      volume.MemoryDump.ClaimName,
+										TargetFileName: memoryDumpFileName(vmi, volume.MemoryDump.ClaimName),
+									}
+								}
 							}
+							updateMemoryDumpInfo(&volumeStatus, domain)
 						} else {
 							// Not mounted, check if the volume is in the spec, if not update status
 							if _, ok := specVolumeMap[volumeStatus.Name]; !ok && canUpdateToUnmounted(volumeStatus.Phase) {
@@ -580,7 +684,7 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 			vmi.Status.VolumeStatus = newStatuses
 		}
 
-		if len(vmi.Status.Interfaces) == 0 {
+		if len(vmi.Status.Interfaces) == 0 || onlyPodInterfaceRecords(vmi.Status.Interfaces) {
 			// Set Pod Interface
 			interfaces := make([]v1.VirtualMachineInstanceNetworkInterface, 0)
 			for _, network := range vmi.Spec.Networks {
@@ -597,7 +701,7 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 					interfaces = append(interfaces, ifc)
 				}
 			}
-			vmi.Status.Interfaces = interfaces
+			vmi.Status.Interfaces = append(interfaces, vmi.Status.Interfaces...)
 		}
 
 		if len(domain.Spec.Devices.Interfaces) > 0 || len(domain.Status.Interfaces) > 0 {
@@ -636,7 +740,8 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 				var newInterface v1.VirtualMachineInstanceNetworkInterface
 				var isForwardingBindingInterface = false
 
-				if existingInterfacesSpecByName[domainInterface.Alias.Name].Masquerade != nil || existingInterfacesSpecByName[domainInterface.Alias.Name].Slirp != nil {
+				interfaceSpec := existingInterfacesSpecByName[domainInterface.Alias.Name]
+				if interfaceSpec.Masquerade != nil || interfaceSpec.Slirp != nil || interfaceSpec.Passt != nil {
 					isForwardingBindingInterface = true
 				}
 
@@ -646,8 +751,8 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 					newInterface = existingInterface
 					newInterface.MAC = interfaceMAC
 
-					// If it is a Combination of Masquerade+Pod network, check IP from file cache
-					if existingInterfacesSpecByName[domainInterface.Alias.Name].Masquerade != nil && existingNetworksByName[domainInterface.Alias.Name].NetworkSource.Pod != nil {
+					// If it is a Combination of Masquerade or Passt+Pod network, check IP from file cache
+					if (interfaceSpec.Masquerade != nil || interfaceSpec.Passt != nil) && existingNetworksByName[domainInterface.Alias.Name].NetworkSource.Pod != nil {
 						iface, err := d.getPodInterfacefromFileCache(vmi.UID, domainInterface.Alias.Name)
 						if err != nil {
 							return err
@@ -679,6 +784,7 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 					}
 					delete(domainInterfaceStatusByMac, interfaceMAC)
 				}
+				newInterface.Bandwidth = interfaceBandwidth(domainInterface.BandWidth)
 				newInterfaces = append(newInterfaces, newInterface)
 			}
 
@@ -696,6 +802,22 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 				}
 				newInterfaces = append(newInterfaces, newInterface)
 			}
+
+			// Keep the pod interfaces recorded by virt-controller for interfaces which are not (or no longer)
+			// attached to the domain, they are needed to plug and unplug them. The missing MAC tells
+			// virt-controller that an unplugged interface is detached from the domain.
+			newInterfaceNames := map[string]bool{}
+			for _, newInterface := range newInterfaces {
+				newInterfaceNames[newInterface.Name] = true
+			}
+			for _, existingInterfaceStatus := range vmi.Status.Interfaces {
+				if existingInterfaceStatus.PodInterfaceName != "" && !newInterfaceNames[existingInterfaceStatus.Name] {
+					newInterfaces = append(newInterfaces, v1.VirtualMachineInstanceNetworkInterface{
+						Name:             existingInterfaceStatus.Name,
+						PodInterfaceName: existingInterfaceStatus.PodInterfaceName,
+					})
+				}
+			}
 			vmi.Status.Interfaces = newInterfaces
 		}
 	}
@@ -1940,6 +2062,20 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			blockMigrate = true
 		}
 	}
+
+	if pvcutils.HasPersistentState(&vmi.Spec) {
+		// The persistent state is not copied by a block migration, the source and the target have to share it
+		pvcName := pvcutils.PersistentStatePVCName(vmi.Name)
+		_, shared, err := pvcutils.IsSharedPVCFromClient(d.clientset, vmi.Namespace, pvcName)
+		if errors.IsNotFound(err) {
+			return blockMigrate, fmt.Errorf("persistentvolumeclaim %v not found", pvcName)
+		} else if err != nil {
+			return blockMigrate, err
+		}
+		if !shared {
+			return blockMigrate, fmt.Errorf("cannot migrate VMI: persistent state PVC %v is not shared, live migration requires the ReadWriteMany access mode", pvcName)
+		}
+	}
 	return
 }
 
@@ -2158,6 +2294,9 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 			if err := d.hotplugVolumeMounter.Mount(vmi); err != nil {
 				return err
 			}
+			if err := d.hotplugPodNetworkPhase1(vmi); err != nil {
+				return err
+			}
 		}
 
 		smbios := d.clusterConfig.GetSMBIOS()
@@ -2188,12 +2327,133 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 			if err := d.hotplugVolumeMounter.Unmount(vmi); err != nil {
 				return err
 			}
+			if err := d.hotplugMemoryDump(vmi, client); err != nil {
+				return err
+			}
+			if err := syncInterfaceBandwidth(vmi, client); err != nil {
+				return err
+			}
 		}
 	}
 
 	return err
 }
 
+// attachedSecondaryNetworks returns the sorted names of the secondary networks of the vmi whose pod interface is attached
+func attachedSecondaryNetworks(vmi *v1.VirtualMachineInstance) string {
+	var names []string
+	for _, network := range vmi.Spec.Networks {
+		if multus.IsSecondaryNetwork(network) && multus.IsPodInterfaceAttached(vmi, network) {
+			names = append(names, network.Name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// hotplugPodNetworkPhase1 runs phase1 of the pod network setup again on a running vmi when the attached
+// secondary networks change, so that the pod interfaces of hotplugged networks get configured.
+// Interfaces which are already configured are skipped by phase1.
+func (d *VirtualMachineController) hotplugPodNetworkPhase1(vmi *v1.VirtualMachineInstance) error {
+	if !d.clusterConfig.HotplugNICsEnabled() {
+		return nil
+	}
+	attached := attachedSecondaryNetworks(vmi)
+
+	d.phase1NetworkSetupCacheLock.Lock()
+	cachedAttached, ok := d.hotplugNetworkSetupCache[vmi.UID]
+	d.phase1NetworkSetupCacheLock.Unlock()
+	if ok && cachedAttached == attached {
+		return nil
+	}
+
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to detect isolation for launcher pod: %v", err)
+	}
+	pid := res.Pid()
+	if err := res.DoNetNS(func() error { return network.SetupPodNetworkPhase1(vmi, pid) }); err != nil {
+		return fmt.Errorf("failed to configure hotplugged vmi network: %v", err)
+	}
+
+	d.phase1NetworkSetupCacheLock.Lock()
+	d.hotplugNetworkSetupCache[vmi.UID] = attached
+	d.phase1NetworkSetupCacheLock.Unlock()
+	return nil
+}
+
+// hotplugMemoryDump asks virt-launcher to dump the memory of the vmi to the memory dump volumes which are mounted.
+func (d *VirtualMachineController) hotplugMemoryDump(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
+	memoryDumpVolumes := make(map[string]bool)
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			memoryDumpVolumes[volume.Name] = true
+		}
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if !memoryDumpVolumes[volumeStatus.Name] || volumeStatus.MemoryDumpVolume == nil || volumeStatus.Phase != v1.HotplugVolumeMounted {
+			continue
+		}
+		dumpPath := filepath.Join(hotplugdisk.GetVolumeMountDir(volumeStatus.Name), volumeStatus.MemoryDumpVolume.TargetFileName)
+		if err := client.VirtualMachineMemoryDump(vmi, dumpPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncInterfaceBandwidth asks virt-launcher to apply the bandwidth limits of the vmi interfaces, when they differ
+// from the limits reported in the status of the vmi.
+func syncInterfaceBandwidth(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
+	if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+		return nil
+	}
+	statusBandwidths := map[string]*api.BandWidth{}
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.Name != "" && iface.MAC != "" {
+			statusBandwidths[iface.Name] = api.NewBandWidth(iface.Bandwidth)
+		}
+	}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		statusBandwidth, exists := statusBandwidths[iface.Name]
+		if exists && !reflect.DeepEqual(api.NewBandWidth(iface.Bandwidth), statusBandwidth) {
+			return client.SetInterfaceBandwidth(vmi)
+		}
+	}
+	return nil
+}
+
+// interfaceBandwidth converts the bandwidth limits of a domain interface, which are in kilobytes, to the vmi status
+func interfaceBandwidth(bandwidth *api.BandWidth) *v1.InterfaceBandwidth {
+	if bandwidth == nil || bandwidth.Inbound == nil && bandwidth.Outbound == nil {
+		return nil
+	}
+	return &v1.InterfaceBandwidth{
+		Inbound:  bandwidthLimit(bandwidth.Inbound),
+		Outbound: bandwidthLimit(bandwidth.Outbound),
+	}
+}
+
+func bandwidthLimit(limit *api.BandWidthLimit) *v1.BandwidthLimit {
+	if limit == nil {
+		return nil
+	}
+	fromKiB := func(value uint) *resource.Quantity {
+		if value == 0 {
+			return nil
+		}
+		return resource.NewQuantity(int64(value)*1024, resource.BinarySI)
+	}
+	bandwidthLimit := &v1.BandwidthLimit{
+		Peak:  fromKiB(limit.Peak),
+		Burst: fromKiB(limit.Burst),
+	}
+	if average := fromKiB(limit.Average); average != nil {
+		bandwidthLimit.Average = *average
+	}
+	return bandwidthLimit
+}
+
 func (d *VirtualMachineController) setVmPhaseForStatusReason(domain *api.Domain, vmi *v1.VirtualMachineInstance) error {
 	phase, err := d.calculateVmPhaseForStatusReason(domain, vmi)
 	if err != nil {
@@ -2412,6 +2672,16 @@ func isACPIEnabled(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
 		domain.Spec.Features.ACPI != nil
 }
 
+// onlyPodInterfaceRecords returns true if the interfaces only carry the pod interface names recorded by virt-controller
+func onlyPodInterfaceRecords(interfaces []v1.VirtualMachineInstanceNetworkInterface) bool {
+	for _, iface := range interfaces {
+		if iface.PodInterfaceName == "" || iface.MAC != "" || iface.IP != "" {
+			return false
+		}
+	}
+	return true
+}
+
 func setMissingSRIOVInterfacesNames(interfacesSpecByName map[string]v1.Interface, interfacesStatusByMac map[string]api.InterfaceStatus) {
 	for name, ifaceSpec := range interfacesSpecByName {
 		if ifaceSpec.SRIOV == nil || ifaceSpec.MacAddress == "" {
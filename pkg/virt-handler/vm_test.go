@@ -183,6 +183,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			virtClient,
 			host,
 			podIpAddress,
+			"",
 			shareDir,
 			privateDir,
 			vmiSourceInformer,
@@ -1674,6 +1675,34 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
 		})
+		table.DescribeTable("should require a shared persistent state PVC with persistent EFI",
+			func(accessMode k8sv1.PersistentVolumeAccessMode, expectedErr error) {
+				vmi := v1.NewMinimalVMI("testvmi")
+				persistent := true
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					Bootloader: &v1.Bootloader{
+						EFI: &v1.EFI{Persistent: &persistent},
+					},
+				}
+
+				pvc := &k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "persistent-state-for-testvmi",
+						Namespace: vmi.Namespace,
+					},
+					Spec: k8sv1.PersistentVolumeClaimSpec{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{accessMode},
+					},
+				}
+				virtClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+				blockMigrate, err := controller.checkVolumesForMigration(vmi)
+				Expect(blockMigrate).To(BeFalse())
+				Expect(err).To(Equal(expectedErr))
+			},
+			table.Entry("and allow ReadWriteMany", k8sv1.ReadWriteMany, nil),
+			table.Entry("and fail for ReadWriteOnce", k8sv1.ReadWriteOnce,
+				fmt.Errorf("cannot migrate VMI: persistent state PVC persistent-state-for-testvmi is not shared, live migration requires the ReadWriteMany access mode")),
+		)
 		It("should fail migration for non-shared data volume PVCs", func() {
 
 			vmi := v1.NewMinimalVMI("testvmi")
@@ -2125,6 +2154,41 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 
+		It("should report the bandwidth of the domain interface", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Interfaces = []api.Interface{
+				{
+					MAC:   &api.MAC{MAC: "1C:CE:C0:01:BE:E7"},
+					Alias: &api.Alias{Name: "default"},
+					BandWidth: &api.BandWidth{
+						Inbound: &api.BandWidthLimit{Average: 1024, Burst: 64},
+					},
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				interfaces := arg.(*v1.VirtualMachineInstance).Status.Interfaces
+				Expect(interfaces).To(HaveLen(1))
+				Expect(interfaces[0].Bandwidth).ToNot(BeNil())
+				Expect(interfaces[0].Bandwidth.Outbound).To(BeNil())
+				Expect(interfaces[0].Bandwidth.Inbound.Average.Value()).To(Equal(int64(1024 * 1024)))
+				Expect(interfaces[0].Bandwidth.Inbound.Peak).To(BeNil())
+				Expect(interfaces[0].Bandwidth.Inbound.Burst.Value()).To(Equal(int64(64 * 1024)))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+
 		It("should update existing interface with IPs", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2450,6 +2514,83 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 	})
+
+	Context("memory dump", func() {
+		const targetFileName = "testvmi-testclaim.memory.dump"
+
+		newMemoryDumpVolumeStatus := func(phase v1.VolumePhase) *v1.VolumeStatus {
+			return &v1.VolumeStatus{
+				Name:  "testclaim",
+				Phase: phase,
+				MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+					ClaimName:      "testclaim",
+					TargetFileName: targetFileName,
+				},
+			}
+		}
+
+		table.DescribeTable("should update the volume status from the domain metadata", func(metadata *api.MemoryDumpMetadata, expectedPhase v1.VolumePhase) {
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.MemoryDump = metadata
+			volumeStatus := newMemoryDumpVolumeStatus(v1.HotplugVolumeMounted)
+
+			updateMemoryDumpInfo(volumeStatus, domain)
+			Expect(volumeStatus.Phase).To(Equal(expectedPhase))
+		},
+			table.Entry("when the memory dump was not triggered yet", nil, v1.HotplugVolumeMounted),
+			table.Entry("when the metadata belongs to another memory dump", &api.MemoryDumpMetadata{FileName: "other.memory.dump", Completed: true}, v1.HotplugVolumeMounted),
+			table.Entry("when the memory dump is in progress", &api.MemoryDumpMetadata{FileName: targetFileName}, v1.MemoryDumpVolumeInProgress),
+			table.Entry("when the memory dump completed", &api.MemoryDumpMetadata{FileName: targetFileName, Completed: true}, v1.MemoryDumpVolumeCompleted),
+			table.Entry("when the memory dump failed", &api.MemoryDumpMetadata{FileName: targetFileName, Failed: true, FailureReason: "no space"}, v1.MemoryDumpVolumeFailed),
+		)
+
+		It("should trigger the memory dump on mounted memory dump volumes", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			client := cmdclient.NewMockLauncherClient(ctrl)
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testclaim",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "testclaim"},
+					},
+				},
+			})
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{*newMemoryDumpVolumeStatus(v1.HotplugVolumeMounted)}
+
+			client.EXPECT().VirtualMachineMemoryDump(vmi, "/var/run/kubevirt/hotplug-disks/testclaim/"+targetFileName).Return(nil)
+
+			Expect((&VirtualMachineController{}).hotplugMemoryDump(vmi, client)).To(Succeed())
+		})
+	})
+
+	Context("interface bandwidth", func() {
+		table.DescribeTable("should set the bandwidth only when it differs from the status", func(status *v1.InterfaceBandwidth, expectCall bool) {
+			ctrl := gomock.NewController(GinkgoT())
+			client := cmdclient.NewMockLauncherClient(ctrl)
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")},
+			}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: "default", MAC: "1C:CE:C0:01:BE:E7", Bandwidth: status},
+			}
+
+			if expectCall {
+				client.EXPECT().SetInterfaceBandwidth(vmi).Return(nil)
+			}
+			Expect(syncInterfaceBandwidth(vmi, client)).To(Succeed())
+		},
+			table.Entry("without a bandwidth in the status", nil, true),
+			table.Entry("with a different bandwidth in the status", &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("2Mi")},
+			}, true),
+			table.Entry("with the same bandwidth in the status", &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: *resource.NewQuantity(1024*1024, resource.BinarySI)},
+			}, false),
+		)
+	})
 })
 
 var _ = Describe("DomainNotifyServerRestarts", func() {
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"k8s.io/apimachinery/pkg/api/equality"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
@@ -52,21 +53,8 @@ type readReturn struct {
 	Return readReturnData `json:"return"`
 }
 
-type execReturn struct {
-	Return execReturnData `json:"return"`
-}
-type execReturnData struct {
-	Pid int `json:"pid"`
-}
-
-type execStatusReturn struct {
-	Return execStatusReturnData `json:"return"`
-}
-type execStatusReturnData struct {
-	Exited   bool   `json:"exited"`
-	ExitCode int    `json:"exitcode"`
-	OutData  string `json:"out-data"`
-}
+// guestExecTimeout is how long a command run to propagate credentials may take in the guest
+const guestExecTimeout = 10 * time.Second
 
 type AccessCredentialManager struct {
 	virConn cli.Connection
@@ -74,6 +62,9 @@ type AccessCredentialManager struct {
 	// access credential propagation watchLock
 	watchLock            sync.Mutex
 	secretWatcherStarted bool
+	// access credentials of the VMI the secret watcher was last started or updated with
+	accessCredentials        []v1.AccessCredential
+	accessCredentialsUpdated chan *v1.VirtualMachineInstance
 
 	stopCh                     chan struct{}
 	resyncCheckIntervalSeconds int
@@ -89,6 +80,7 @@ func NewManager(connection cli.Connection, domainModifyLock *sync.Mutex) *Access
 		stopCh:                     make(chan struct{}),
 		resyncCheckIntervalSeconds: 15,
 		domainModifyLock:           domainModifyLock,
+		accessCredentialsUpdated:   make(chan *v1.VirtualMachineInstance, 1),
 	}
 }
 
@@ -226,65 +218,14 @@ func (l *AccessCredentialManager) readGuestFile(domName string, filePath string)
 }
 
 func (l *AccessCredentialManager) agentGuestExec(domName string, command string, args []string) (string, error) {
-	stdOut := ""
-	argsStr := ""
-	for _, arg := range args {
-		if argsStr == "" {
-			argsStr = fmt.Sprintf("\"%s\"", arg)
-		} else {
-			argsStr = argsStr + fmt.Sprintf(", \"%s\"", arg)
-		}
-	}
-
-	cmdExec := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "%s", "arg": [ %s ], "capture-output":true } }`, command, argsStr)
-	output, err := l.virConn.QemuAgentCommand(cmdExec, domName)
+	result, err := util.GuestExec(l.virConn, domName, command, args, "", guestExecTimeout)
 	if err != nil {
 		return "", err
-	}
-	execRes := &execReturn{}
-	err = json.Unmarshal([]byte(output), execRes)
-	if err != nil {
-		return "", err
-	}
-
-	if execRes.Return.Pid <= 0 {
-		return "", fmt.Errorf("Invalid pid [%d] returned from qemu agent during access credential injection: %s", execRes.Return.Pid, output)
+	} else if result.ExitCode != 0 {
+		return result.Stdout, fmt.Errorf("Non-zero exit code [%d] for guest command [%s] with args [%v]: %s", result.ExitCode, command, args, result.Stdout)
 	}
 
-	exited := false
-	exitCode := 0
-	for i := 10; i > 0; i-- {
-		cmdExecStatus := fmt.Sprintf(`{"execute": "guest-exec-status", "arguments": { "pid": %d } }`, execRes.Return.Pid)
-		output, err := l.virConn.QemuAgentCommand(cmdExecStatus, domName)
-		if err != nil {
-			return "", err
-		}
-		execStatusRes := &execStatusReturn{}
-		err = json.Unmarshal([]byte(output), execStatusRes)
-		if err != nil {
-			return "", err
-		}
-
-		if execStatusRes.Return.Exited {
-			stdOutBytes, err := base64.StdEncoding.DecodeString(execStatusRes.Return.OutData)
-			if err != nil {
-				return "", err
-			}
-			stdOut = string(stdOutBytes)
-			exitCode = execStatusRes.Return.ExitCode
-			exited = true
-			break
-		}
-		time.Sleep(1 * time.Second)
-	}
-
-	if !exited {
-		return "", fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit", execRes.Return.Pid, command)
-	} else if exitCode != 0 {
-		return stdOut, fmt.Errorf("Non-zero exit code [%d] for guest command [%s] with args [%v]: %s", exitCode, command, args, stdOut)
-	}
-
-	return stdOut, nil
+	return result.Stdout, nil
 }
 
 // Requires usage of mkdir, chown, chmod
@@ -523,6 +464,10 @@ func (l *AccessCredentialManager) watchSecrets(vmi *v1.VirtualMachineInstance) {
 				reload = true
 				logger.Info("Reloading access credentials because secret changed")
 			}
+		case updatedVMI := <-l.accessCredentialsUpdated:
+			vmi = updatedVMI
+			reload = true
+			logger.Info("Reloading access credentials because they changed")
 		case <-l.stopCh:
 			logger.Info("Signalled to stop watching access credential secrets")
 			return
@@ -699,6 +644,27 @@ func (l *AccessCredentialManager) HandleQemuAgentAccessCredentials(vmi *v1.Virtu
 
 	go l.watchSecrets(vmi)
 	l.secretWatcherStarted = true
+	l.accessCredentials = vmi.DeepCopy().Spec.AccessCredentials
 
 	return nil
 }
+
+// UpdateQemuAgentAccessCredentials hands changed access credentials of a running VMI over to the secret watcher.
+// Only the secrets which were mounted into the pod at start can be read, so changes are limited to e.g. the users.
+func (l *AccessCredentialManager) UpdateQemuAgentAccessCredentials(vmi *v1.VirtualMachineInstance) {
+	l.watchLock.Lock()
+	defer l.watchLock.Unlock()
+
+	if !l.secretWatcherStarted || equality.Semantic.DeepEqual(l.accessCredentials, vmi.Spec.AccessCredentials) {
+		return
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	l.accessCredentials = vmiCopy.Spec.AccessCredentials
+	// replace an update which wasn't picked up yet
+	select {
+	case <-l.accessCredentialsUpdated:
+	default:
+	}
+	l.accessCredentialsUpdated <- vmiCopy
+}
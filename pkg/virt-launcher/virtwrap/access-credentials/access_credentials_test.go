@@ -87,8 +87,8 @@ var _ = Describe("AccessCredentials", func() {
 		command := "some-command"
 		args := []string{"arg1", "arg2"}
 
-		expectedCmd := `{"execute": "guest-exec", "arguments": { "path": "some-command", "arg": [ "arg1", "arg2" ], "capture-output":true } }`
-		expectedStatusCmd := `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
+		expectedCmd := `{"execute":"guest-exec","arguments":{"path":"some-command","arg":["arg1","arg2"],"capture-output":true}}`
+		expectedStatusCmd := `{"execute":"guest-exec-status","arguments":{"pid":789}}`
 
 		mockConn.EXPECT().QemuAgentCommand(expectedCmd, domName).Return(`{"return":{"pid":789}}`, nil)
 		mockConn.EXPECT().QemuAgentCommand(expectedStatusCmd, domName).Return(`{"return":{"exitcode":0,"out-data":"c3NoIHNvbWVrZXkxMjMgdGVzdC1rZXkK","exited":true}}`, nil)
@@ -132,25 +132,25 @@ var _ = Describe("AccessCredentials", func() {
 		expectedCloseCmd := `{"execute": "guest-file-close", "arguments": { "handle": 1000 } }`
 
 		expectedExecReturn := `{"return":{"pid":789}}`
-		expectedStatusCmd := `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
+		expectedStatusCmd := `{"execute":"guest-exec-status","arguments":{"pid":789}}`
 
 		getentBase64Str := base64.StdEncoding.EncodeToString([]byte("someowner:x:1111:2222:Some Owner:/home/someowner:/bin/bash"))
-		expectedHomeDirCmd := `{"execute": "guest-exec", "arguments": { "path": "getent", "arg": [ "passwd", "someowner" ], "capture-output":true } }`
+		expectedHomeDirCmd := `{"execute":"guest-exec","arguments":{"path":"getent","arg":["passwd","someowner"],"capture-output":true}}`
 		expectedHomeDirCmdRes := fmt.Sprintf(`{"return":{"exitcode":0,"out-data":"%s","exited":true}}`, getentBase64Str)
 
-		expectedMkdirCmd := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "mkdir", "arg": [ "-p", "%s" ], "capture-output":true } }`, filePath)
+		expectedMkdirCmd := fmt.Sprintf(`{"execute":"guest-exec","arguments":{"path":"mkdir","arg":["-p","%s"],"capture-output":true}}`, filePath)
 		expectedMkdirRes := `{"return":{"exitcode":0,"out-data":"","exited":true}}`
 
-		expectedParentChownCmd := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "chown", "arg": [ "1111:2222", "%s" ], "capture-output":true } }`, filePath)
+		expectedParentChownCmd := fmt.Sprintf(`{"execute":"guest-exec","arguments":{"path":"chown","arg":["1111:2222","%s"],"capture-output":true}}`, filePath)
 		expectedParentChownRes := `{"return":{"exitcode":0,"out-data":"","exited":true}}`
 
-		expectedParentChmodCmd := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "chmod", "arg": [ "700", "%s" ], "capture-output":true } }`, filePath)
+		expectedParentChmodCmd := fmt.Sprintf(`{"execute":"guest-exec","arguments":{"path":"chmod","arg":["700","%s"],"capture-output":true}}`, filePath)
 		expectedParentChmodRes := `{"return":{"exitcode":0,"out-data":"","exited":true}}`
 
-		expectedFileChownCmd := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "chown", "arg": [ "1111:2222", "%s/authorized_keys" ], "capture-output":true } }`, filePath)
+		expectedFileChownCmd := fmt.Sprintf(`{"execute":"guest-exec","arguments":{"path":"chown","arg":["1111:2222","%s/authorized_keys"],"capture-output":true}}`, filePath)
 		expectedFileChownRes := `{"return":{"exitcode":0,"out-data":"","exited":true}}`
 
-		expectedFileChmodCmd := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "chmod", "arg": [ "600", "%s/authorized_keys" ], "capture-output":true } }`, filePath)
+		expectedFileChmodCmd := fmt.Sprintf(`{"execute":"guest-exec","arguments":{"path":"chmod","arg":["600","%s/authorized_keys"],"capture-output":true}}`, filePath)
 		expectedFileChmodRes := `{"return":{"exitcode":0,"out-data":"","exited":true}}`
 
 		//
@@ -209,6 +209,37 @@ var _ = Describe("AccessCredentials", func() {
 		Expect(err).To(BeNil())
 	})
 
+	It("should hand changed access credentials over to the running secret watcher", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.AccessCredentials = []v1.AccessCredential{
+			{
+				SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+					Source: v1.SSHPublicKeyAccessCredentialSource{
+						Secret: &v1.AccessCredentialSecretSource{
+							SecretName: "some-secret",
+						},
+					},
+					PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+						QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{
+							Users: []string{"root"},
+						},
+					},
+				},
+			},
+		}
+		manager.secretWatcherStarted = true
+		manager.accessCredentials = vmi.DeepCopy().Spec.AccessCredentials
+
+		manager.UpdateQemuAgentAccessCredentials(vmi)
+		Expect(manager.accessCredentialsUpdated).To(BeEmpty())
+
+		vmi.Spec.AccessCredentials[0].SSHPublicKey.PropagationMethod.QemuGuestAgent.Users = []string{"root", "fakeuser"}
+		manager.UpdateQemuAgentAccessCredentials(vmi)
+		Expect(manager.accessCredentialsUpdated).To(HaveLen(1))
+		updatedVMI := <-manager.accessCredentialsUpdated
+		Expect(updatedVMI.Spec.AccessCredentials).To(Equal(vmi.Spec.AccessCredentials))
+	})
+
 	It("should trigger updating a credential when secret propagation change occurs.", func() {
 		var err error
 
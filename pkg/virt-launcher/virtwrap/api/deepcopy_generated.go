@@ -149,6 +149,16 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -162,6 +172,22 @@ func (in *BandWidth) DeepCopy() *BandWidth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandWidthLimit) DeepCopyInto(out *BandWidthLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandWidthLimit.
+func (in *BandWidthLimit) DeepCopy() *BandWidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandWidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Boot) DeepCopyInto(out *Boot) {
 	*out = *in
@@ -215,6 +241,22 @@ func (in *BootOrder) DeepCopy() *BootOrder {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CID) DeepCopyInto(out *CID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CID.
+func (in *CID) DeepCopy() *CID {
+	if in == nil {
+		return nil
+	}
+	out := new(CID)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPU) DeepCopyInto(out *CPU) {
 	*out = *in
@@ -698,6 +740,23 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = make([]MemoryDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TPMs != nil {
+		in, out := &in.TPMs, &out.TPMs
+		*out = make([]TPM, len(*in))
+		copy(*out, *in)
+	}
+	if in.VSOCK != nil {
+		in, out := &in.VSOCK, &out.VSOCK
+		*out = new(VSOCK)
+		**out = **in
+	}
 	return
 }
 
@@ -974,6 +1033,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
 	out.XMLName = in.XMLName
 	out.Memory = in.Memory
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		*out = new(MaxMemory)
+		**out = **in
+	}
 	if in.MemoryBacking != nil {
 		in, out := &in.MemoryBacking, &out.MemoryBacking
 		*out = new(MemoryBacking)
@@ -1743,7 +1807,7 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.BandWidth != nil {
 		in, out := &in.BandWidth, &out.BandWidth
 		*out = new(BandWidth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
@@ -1885,6 +1949,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(AccessCredentialMetadata)
 		**out = **in
 	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(MemoryDumpMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1962,6 +2031,22 @@ func (in *MTU) DeepCopy() *MTU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxMemory) DeepCopyInto(out *MaxMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxMemory.
+func (in *MaxMemory) DeepCopy() *MaxMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemBalloon) DeepCopyInto(out *MemBalloon) {
 	*out = *in
@@ -2067,6 +2152,81 @@ func (in *MemoryBackingSource) DeepCopy() *MemoryBackingSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDevice) DeepCopyInto(out *MemoryDevice) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(MemoryTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(Alias)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDevice.
+func (in *MemoryDevice) DeepCopy() *MemoryDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDumpMetadata) DeepCopyInto(out *MemoryDumpMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDumpMetadata.
+func (in *MemoryDumpMetadata) DeepCopy() *MemoryDumpMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDumpMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryTarget) DeepCopyInto(out *MemoryTarget) {
+	*out = *in
+	out.Size = in.Size
+	out.Block = in.Block
+	out.Requested = in.Requested
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		*out = new(Memory)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryTarget.
+func (in *MemoryTarget) DeepCopy() *MemoryTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -2414,6 +2574,11 @@ func (in *Serial) DeepCopyInto(out *Serial) {
 		*out = new(Alias)
 		**out = **in
 	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(SerialLog)
+		**out = **in
+	}
 	return
 }
 
@@ -2427,6 +2592,22 @@ func (in *Serial) DeepCopy() *Serial {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialLog) DeepCopyInto(out *SerialLog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialLog.
+func (in *SerialLog) DeepCopy() *SerialLog {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SerialSource) DeepCopyInto(out *SerialSource) {
 	*out = *in
@@ -2516,6 +2697,39 @@ func (in *SysInfo) DeepCopy() *SysInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPM) DeepCopyInto(out *TPM) {
+	*out = *in
+	out.Backend = in.Backend
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPM.
+func (in *TPM) DeepCopy() *TPM {
+	if in == nil {
+		return nil
+	}
+	out := new(TPM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMBackend) DeepCopyInto(out *TPMBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMBackend.
+func (in *TPMBackend) DeepCopy() *TPMBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -2596,6 +2810,23 @@ func (in *VCPU) DeepCopy() *VCPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSOCK) DeepCopyInto(out *VSOCK) {
+	*out = *in
+	out.CID = in.CID
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSOCK.
+func (in *VSOCK) DeepCopy() *VSOCK {
+	if in == nil {
+		return nil
+	}
+	out := new(VSOCK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Video) DeepCopyInto(out *Video) {
 	*out = *in
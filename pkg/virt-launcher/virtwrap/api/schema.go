@@ -25,6 +25,7 @@ import (
 
 	kubev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -173,6 +174,7 @@ type DomainSpec struct {
 	Name          string         `xml:"name"`
 	UUID          string         `xml:"uuid,omitempty"`
 	Memory        Memory         `xml:"memory"`
+	MaxMemory     *MaxMemory     `xml:"maxMemory,omitempty"`
 	MemoryBacking *MemoryBacking `xml:"memoryBacking,omitempty"`
 	OS            OS             `xml:"os"`
 	SysInfo       *SysInfo       `xml:"sysinfo,omitempty"`
@@ -210,6 +212,7 @@ type CPUEmulatorPin struct {
 
 type VCPU struct {
 	Placement string `xml:"placement,attr"`
+	Current   uint32 `xml:"current,attr,omitempty"`
 	CPUs      uint32 `xml:",chardata"`
 }
 
@@ -301,6 +304,16 @@ type KubeVirtMetadata struct {
 	GracePeriod      *GracePeriodMetadata      `xml:"graceperiod,omitempty"`
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
+	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+}
+
+type MemoryDumpMetadata struct {
+	FileName       string       `xml:"fileName,omitempty"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -348,6 +361,53 @@ type Memory struct {
 	Unit  string `xml:"unit,attr"`
 }
 
+// Bytes returns the amount of memory in bytes, taking the unit libvirt reports it in into account
+func (m Memory) Bytes() (uint64, error) {
+	switch m.Unit {
+	case "b", "bytes", "":
+		return m.Value, nil
+	case "KB":
+		return m.Value * 1000, nil
+	case "k", "KiB":
+		return m.Value * 1024, nil
+	case "MB":
+		return m.Value * 1000 * 1000, nil
+	case "M", "MiB":
+		return m.Value * 1024 * 1024, nil
+	case "GB":
+		return m.Value * 1000 * 1000 * 1000, nil
+	case "G", "GiB":
+		return m.Value * 1024 * 1024 * 1024, nil
+	case "TB":
+		return m.Value * 1000 * 1000 * 1000 * 1000, nil
+	case "T", "TiB":
+		return m.Value * 1024 * 1024 * 1024 * 1024, nil
+	}
+	return 0, fmt.Errorf("unknown memory unit %s", m.Unit)
+}
+
+type MaxMemory struct {
+	Value uint64 `xml:",chardata"`
+	Unit  string `xml:"unit,attr"`
+	Slots uint64 `xml:"slots,attr"`
+}
+
+// MemoryDevice mirroring libvirt XML under https://libvirt.org/formatdomain.html#memory-devices
+type MemoryDevice struct {
+	XMLName xml.Name      `xml:"memory"`
+	Model   string        `xml:"model,attr"`
+	Target  *MemoryTarget `xml:"target"`
+	Alias   *Alias        `xml:"alias,omitempty"`
+}
+
+type MemoryTarget struct {
+	Size      Memory  `xml:"size"`
+	Node      string  `xml:"node"`
+	Block     Memory  `xml:"block"`
+	Requested Memory  `xml:"requested"`
+	Current   *Memory `xml:"current,omitempty"`
+}
+
 // MemoryBacking mirroring libvirt XML under https://libvirt.org/formatdomain.html#elementsMemoryBacking
 type MemoryBacking struct {
 	HugePages *HugePages           `xml:"hugepages,omitempty"`
@@ -390,6 +450,30 @@ type Devices struct {
 	Watchdog    *Watchdog          `xml:"watchdog,omitempty"`
 	Rng         *Rng               `xml:"rng,omitempty"`
 	Filesystems []FilesystemDevice `xml:"filesystem,omitempty"`
+	Memory      []MemoryDevice     `xml:"memory,omitempty"`
+	TPMs        []TPM              `xml:"tpm,omitempty"`
+	VSOCK       *VSOCK             `xml:"vsock,omitempty"`
+}
+
+type VSOCK struct {
+	Model string `xml:"model,attr,omitempty"`
+	CID   CID    `xml:"cid"`
+}
+
+type CID struct {
+	Auto    string `xml:"auto,attr"`
+	Address uint32 `xml:"address,attr,omitempty"`
+}
+
+type TPM struct {
+	Model   string     `xml:"model,attr"`
+	Backend TPMBackend `xml:"backend"`
+}
+
+type TPMBackend struct {
+	Type            string `xml:"type,attr"`
+	Version         string `xml:"version,attr"`
+	PersistentState string `xml:"persistent_state,attr,omitempty"`
 }
 
 type FilesystemDevice struct {
@@ -559,6 +643,7 @@ type Serial struct {
 	Target *SerialTarget `xml:"target,omitempty"`
 	Source *SerialSource `xml:"source,omitempty"`
 	Alias  *Alias        `xml:"alias,omitempty"`
+	Log    *SerialLog    `xml:"log,omitempty"`
 }
 
 type SerialTarget struct {
@@ -570,6 +655,11 @@ type SerialSource struct {
 	Path string `xml:"path,attr,omitempty"`
 }
 
+type SerialLog struct {
+	File   string `xml:"file,attr,omitempty"`
+	Append string `xml:"append,attr,omitempty"`
+}
+
 // END Serial -----------------------------
 
 // BEGIN Console -----------------------------
@@ -623,6 +713,43 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+// BandWidthLimit is in kilobytes per second, the burst in kilobytes
+type BandWidthLimit struct {
+	Average uint `xml:"average,attr"`
+	Peak    uint `xml:"peak,attr,omitempty"`
+	Burst   uint `xml:"burst,attr,omitempty"`
+}
+
+// NewBandWidth converts the bandwidth limits of an interface, which are in bytes, to libvirt, it is nil without limits
+func NewBandWidth(bandwidth *v1.InterfaceBandwidth) *BandWidth {
+	if bandwidth == nil || bandwidth.Inbound == nil && bandwidth.Outbound == nil {
+		return nil
+	}
+	return &BandWidth{
+		Inbound:  newBandWidthLimit(bandwidth.Inbound),
+		Outbound: newBandWidthLimit(bandwidth.Outbound),
+	}
+}
+
+func newBandWidthLimit(limit *v1.BandwidthLimit) *BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+	toKiB := func(quantity *resource.Quantity) uint {
+		if quantity == nil {
+			return 0
+		}
+		return uint(quantity.Value() / 1024)
+	}
+	return &BandWidthLimit{
+		Average: toKiB(&limit.Average),
+		Peak:    toKiB(limit.Peak),
+		Burst:   toKiB(limit.Burst),
+	}
 }
 
 type BootOrder struct {
@@ -646,6 +773,8 @@ type InterfaceSource struct {
 	Device  string   `xml:"dev,attr,omitempty"`
 	Bridge  string   `xml:"bridge,attr,omitempty"`
 	Mode    string   `xml:"mode,attr,omitempty"`
+	Type    string   `xml:"type,attr,omitempty"`
+	Path    string   `xml:"path,attr,omitempty"`
 	Address *Address `xml:"address,omitempty"`
 }
 
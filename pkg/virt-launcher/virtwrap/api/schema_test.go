@@ -26,6 +26,9 @@ import (
 	. "github.com/onsi/ginkgo"
 	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/client-go/api/v1"
 )
 
 var exampleXMLwithNoneMemballoon string
@@ -420,4 +423,57 @@ var _ = Describe("Schema", func() {
 			Expect(newCpuTune).To(Equal(exampleCpuTune))
 		})
 	})
+
+	Context("With memory devices", func() {
+		var testXML = `<memory model="virtio-mem"><target><size unit="KiB">1048576</size><node>0</node><block unit="KiB">2048</block><requested unit="KiB">524288</requested><current unit="KiB">262144</current></target><alias name="ua-virtiomem"></alias></memory>`
+
+		It("Unmarshal into struct", func() {
+			device := MemoryDevice{}
+			Expect(xml.Unmarshal([]byte(testXML), &device)).To(Succeed())
+			Expect(device.Model).To(Equal("virtio-mem"))
+			Expect(device.Target.Requested).To(Equal(Memory{Value: 524288, Unit: "KiB"}))
+			Expect(device.Target.Current).To(Equal(&Memory{Value: 262144, Unit: "KiB"}))
+			Expect(device.Alias).To(Equal(&Alias{Name: "virtiomem"}))
+		})
+
+		table.DescribeTable("should convert memory to bytes", func(memory Memory, expected uint64) {
+			bytes, err := memory.Bytes()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(expected))
+		},
+			table.Entry("with bytes", Memory{Value: 1024, Unit: "b"}, uint64(1024)),
+			table.Entry("with KiB", Memory{Value: 2048, Unit: "KiB"}, uint64(2*1024*1024)),
+			table.Entry("with MB", Memory{Value: 2, Unit: "MB"}, uint64(2*1000*1000)),
+			table.Entry("with GiB", Memory{Value: 1, Unit: "GiB"}, uint64(1024*1024*1024)),
+		)
+	})
+
+	Context("With interface bandwidth", func() {
+		var testXML = `<bandwidth><inbound average="1000" peak="5000" burst="1024"></inbound><outbound average="128"></outbound></bandwidth>`
+
+		It("Unmarshal into struct", func() {
+			bandwidth := BandWidth{}
+			Expect(xml.Unmarshal([]byte(testXML), &bandwidth)).To(Succeed())
+			Expect(bandwidth.Inbound).To(Equal(&BandWidthLimit{Average: 1000, Peak: 5000, Burst: 1024}))
+			Expect(bandwidth.Outbound).To(Equal(&BandWidthLimit{Average: 128}))
+		})
+
+		It("should convert the limits of an interface to KiB", func() {
+			peak := resource.MustParse("5000Ki")
+			burst := resource.MustParse("1Mi")
+			bandwidth := NewBandWidth(&v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: resource.MustParse("1000Ki"), Peak: &peak, Burst: &burst},
+				Outbound: &v1.BandwidthLimit{Average: resource.MustParse("128k")},
+			})
+			Expect(bandwidth).To(Equal(&BandWidth{
+				Inbound:  &BandWidthLimit{Average: 1000, Peak: 5000, Burst: 1024},
+				Outbound: &BandWidthLimit{Average: 125},
+			}))
+		})
+
+		It("should not convert an interface without limits", func() {
+			Expect(NewBandWidth(nil)).To(BeNil())
+			Expect(NewBandWidth(&v1.InterfaceBandwidth{})).To(BeNil())
+		})
+	})
 })
@@ -333,6 +333,26 @@ func (_mr *_MockVirDomainRecorder) ShutdownFlags(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ShutdownFlags", arg0)
 }
 
+func (_m *MockVirDomain) Reboot(flags libvirt_go.DomainRebootFlagValues) error {
+	ret := _m.ctrl.Call(_m, "Reboot", flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) Reboot(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Reboot", arg0)
+}
+
+func (_m *MockVirDomain) SetInterfaceParameters(device string, params *libvirt_go.DomainInterfaceParameters, flags libvirt_go.DomainModificationImpact) error {
+	ret := _m.ctrl.Call(_m, "SetInterfaceParameters", device, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetInterfaceParameters(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInterfaceParameters", arg0, arg1, arg2)
+}
+
 func (_m *MockVirDomain) UndefineFlags(flags libvirt_go.DomainUndefineFlagsValues) error {
 	ret := _m.ctrl.Call(_m, "UndefineFlags", flags)
 	ret0, _ := ret[0].(error)
@@ -481,6 +501,36 @@ func (_mr *_MockVirDomainRecorder) AbortJob() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortJob")
 }
 
+func (_m *MockVirDomain) CoreDumpWithFormat(to string, format libvirt_go.DomainCoreDumpFormat, flags libvirt_go.DomainCoreDumpFlags) error {
+	ret := _m.ctrl.Call(_m, "CoreDumpWithFormat", to, format, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) CoreDumpWithFormat(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CoreDumpWithFormat", arg0, arg1, arg2)
+}
+
+func (_m *MockVirDomain) SetVcpusFlags(vcpu uint, flags libvirt_go.DomainVcpuFlags) error {
+	ret := _m.ctrl.Call(_m, "SetVcpusFlags", vcpu, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetVcpusFlags(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetVcpusFlags", arg0, arg1)
+}
+
+func (_m *MockVirDomain) UpdateDeviceFlags(xml string, flags libvirt_go.DomainDeviceModifyFlags) error {
+	ret := _m.ctrl.Call(_m, "UpdateDeviceFlags", xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) UpdateDeviceFlags(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDeviceFlags", arg0, arg1)
+}
+
 func (_m *MockVirDomain) Free() error {
 	ret := _m.ctrl.Call(_m, "Free")
 	ret0, _ := ret[0].(error)
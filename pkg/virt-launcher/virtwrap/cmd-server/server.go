@@ -202,6 +202,57 @@ func (l *Launcher) UnpauseVirtualMachine(ctx context.Context, request *cmdv1.VMI
 	return response, nil
 }
 
+func (l *Launcher) FreezeVirtualMachine(ctx context.Context, request *cmdv1.FreezeRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.FreezeVMI(vmi, request.UnfreezeTimeoutSeconds); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to freeze vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Freezed vmi")
+	return response, nil
+}
+
+func (l *Launcher) UnfreezeVirtualMachine(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.UnfreezeVMI(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to unfreeze vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Unfreezed vmi")
+	return response, nil
+}
+
+func (l *Launcher) VirtualMachineMemoryDump(ctx context.Context, request *cmdv1.MemoryDumpRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.MemoryDump(vmi, request.DumpPath); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to dump vmi memory")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Dumping vmi memory")
+	return response, nil
+}
+
 func (l *Launcher) KillVirtualMachine(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
@@ -238,6 +289,24 @@ func (l *Launcher) ShutdownVirtualMachine(ctx context.Context, request *cmdv1.VM
 	return response, nil
 }
 
+func (l *Launcher) RebootVirtualMachine(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.RebootVMI(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to signal reboot for vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Signaled vmi reboot")
+	return response, nil
+}
+
 func (l *Launcher) DeleteVirtualMachine(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
@@ -275,6 +344,24 @@ func (l *Launcher) SetVirtualMachineGuestTime(ctx context.Context, request *cmdv
 
 }
 
+func (l *Launcher) SetInterfaceBandwidth(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.SetInterfaceBandwidth(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to set the interface bandwidth of vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Set the interface bandwidth of vmi")
+	return response, nil
+}
+
 func (l *Launcher) GetDomain(ctx context.Context, request *cmdv1.EmptyRequest) (*cmdv1.DomainResponse, error) {
 
 	response := &cmdv1.DomainResponse{
@@ -414,6 +501,35 @@ func (l *Launcher) GetFilesystems(ctx context.Context, request *cmdv1.EmptyReque
 	return response, nil
 }
 
+// GuestExec runs a command in the guest through the guest agent
+func (l *Launcher) GuestExec(ctx context.Context, request *cmdv1.GuestExecRequest) (*cmdv1.GuestExecResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	execResponse := &cmdv1.GuestExecResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return execResponse, nil
+	}
+
+	result, err := l.domainManager.GuestExec(vmi, &v1.VirtualMachineInstanceGuestExecRequest{
+		Command:        request.Command,
+		Args:           request.Args,
+		Stdin:          request.Stdin,
+		TimeoutSeconds: &request.TimeoutSeconds,
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to run guest command %s", request.Command)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return execResponse, nil
+	}
+
+	execResponse.ExitCode = result.ExitCode
+	execResponse.Stdout = result.Stdout
+	execResponse.Stderr = result.Stderr
+	return execResponse, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
@@ -20,6 +20,7 @@
 package cmdserver
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -106,6 +107,20 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should reboot a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RebootVMI(vmi)
+			err := client.RebootVirtualMachine(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should set the interface bandwidth of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SetInterfaceBandwidth(vmi)
+			err := client.SetInterfaceBandwidth(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should pause a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().PauseVMI(vmi)
@@ -120,6 +135,27 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should freeze a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().FreezeVMI(vmi, int32(0))
+			err := client.FreezeVirtualMachine(vmi, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should unfreeze a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().UnfreezeVMI(vmi)
+			err := client.UnfreezeVirtualMachine(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should dump the memory of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().MemoryDump(vmi, "/tmp/memory.dump")
+			err := client.VirtualMachineMemoryDump(vmi, "/tmp/memory.dump")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should list domains", func() {
 			var list []*api.Domain
 			list = append(list, api.NewMinimalDomain("testvmi1"))
@@ -211,6 +247,39 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred(), "should fetch filesystems without any issue")
 			Expect(fetchedList.Items).To(Equal(fsList), "fetched list should be the same")
 		})
+
+		It("should run a command in the guest", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			timeout := int32(10)
+			request := &v1.VirtualMachineInstanceGuestExecRequest{
+				Command:        "cat",
+				Args:           []string{"-n"},
+				Stdin:          "hello",
+				TimeoutSeconds: &timeout,
+			}
+			result := &v1.VirtualMachineInstanceGuestExecResult{
+				ExitCode: 1,
+				Stdout:   "     1\thello",
+				Stderr:   "oops",
+			}
+
+			domainManager.EXPECT().GuestExec(gomock.Any(), request).Return(result, nil)
+
+			fetchedResult, err := client.GuestExec(vmi, request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedResult).To(Equal(result))
+		})
+
+		It("should report a failed guest command", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			request := &v1.VirtualMachineInstanceGuestExecRequest{Command: "ls"}
+
+			domainManager.EXPECT().GuestExec(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("agent not responding"))
+
+			_, err := client.GuestExec(vmi, request)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("agent not responding"))
+		})
 	})
 
 	Describe("Version mismatch", func() {
@@ -45,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/config"
 
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
@@ -52,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
 )
 
 type HostDeviceType string
@@ -441,6 +443,12 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 	if source.ServiceAccount != nil {
 		return Convert_v1_Config_To_api_Disk(source.Name, disk, config.ServiceAccount)
 	}
+	if source.Sysprep != nil {
+		return Convert_v1_Config_To_api_Disk(source.Name, disk, config.Sysprep)
+	}
+	if source.DownwardMetrics != nil {
+		return Convert_v1_DownwardMetricSource_To_api_Disk(disk, c)
+	}
 
 	return fmt.Errorf("disk %s references an unsupported source", disk.Alias.Name)
 }
@@ -480,6 +488,9 @@ func Convert_v1_Config_To_api_Disk(volumeName string, disk *api.Disk, configType
 	case config.ServiceAccount:
 		disk.Source.File = config.GetServiceAccountDiskPath()
 		break
+	case config.Sysprep:
+		disk.Source.File = config.GetSysprepDiskPath(volumeName)
+		break
 	default:
 		return fmt.Errorf("Cannot convert config '%s' to disk, unrecognized type", configType)
 	}
@@ -487,6 +498,14 @@ func Convert_v1_Config_To_api_Disk(volumeName string, disk *api.Disk, configType
 	return nil
 }
 
+func Convert_v1_DownwardMetricSource_To_api_Disk(disk *api.Disk, c *ConverterContext) error {
+	disk.Type = "file"
+	disk.ReadOnly = toApiReadOnly(true)
+	disk.Driver.Type = "raw"
+	disk.Source.File = downwardmetrics.DownwardMetricDisk
+	return nil
+}
+
 func GetFilesystemVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt-private", "vmi-disks", volumeName, "disk.img")
 }
@@ -693,6 +712,30 @@ func Convert_v1_Rng_To_api_Rng(_ *v1.Rng, rng *api.Rng, c *ConverterContext) err
 	return nil
 }
 
+func Convert_v1_TPM_To_api_TPM(tpmDevice *v1.TPMDevice, tpm *api.TPM, c *ConverterContext) error {
+	// CRB is the interface of TPM 2.0 devices, the TIS interface is used where CRB is not available
+	switch c.Architecture {
+	case "ppc64le":
+		tpm.Model = "tpm-spapr"
+	case "arm64":
+		tpm.Model = "tpm-tis"
+	default:
+		tpm.Model = "tpm-crb"
+	}
+
+	// libvirt starts swtpm in the launcher pod, which emulates the TPM for QEMU
+	tpm.Backend = api.TPMBackend{
+		Type:    "emulator",
+		Version: "2.0",
+	}
+	if tpmDevice.Persistent != nil && *tpmDevice.Persistent {
+		// Keep the state when the domain is undefined, it lives on the persistent state PVC
+		tpm.Backend.PersistentState = "yes"
+	}
+
+	return nil
+}
+
 func Convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input, c *ConverterContext) error {
 	if input.Bus != "virtio" && input.Bus != "usb" && input.Bus != "" {
 		return fmt.Errorf("input contains unsupported bus %s", input.Bus)
@@ -886,6 +929,12 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 		Placement: "static",
 		CPUs:      cpuCount,
 	}
+	// Leave room for sockets to be hotplugged, only the requested sockets are online
+	if vmiCPU := vmi.Spec.Domain.CPU; vmiCPU != nil && vmiCPU.MaxSockets > cpuTopology.Sockets {
+		domain.Spec.CPU.Topology.Sockets = vmiCPU.MaxSockets
+		domain.Spec.VCPU.CPUs = calculateRequestedVCPUs(domain.Spec.CPU.Topology)
+		domain.Spec.VCPU.Current = cpuCount
+	}
 
 	if _, err := os.Stat("/dev/kvm"); os.IsNotExist(err) {
 		if c.UseEmulation {
@@ -935,6 +984,13 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 		}
 
 		if vmi.Spec.Domain.Firmware.Bootloader != nil && vmi.Spec.Domain.Firmware.Bootloader.EFI != nil {
+			// libvirt creates the NVRAM from the template only if it does not exist yet,
+			// so a persistent NVRAM keeps the changes made by the guest across restarts
+			nvramDir := "/tmp"
+			if kubevirttypes.HasPersistentEFI(&vmi.Spec) {
+				nvramDir = kubevirttypes.PersistentStateNVRAMPath
+			}
+
 			if vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot {
 				domain.Spec.OS.BootLoader = &api.Loader{
 					Path:     filepath.Join(c.OVMFPath, EFICodeSecureBoot),
@@ -944,7 +1000,7 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 				}
 
 				domain.Spec.OS.NVRam = &api.NVRam{
-					NVRam:    filepath.Join("/tmp", domain.Spec.Name),
+					NVRam:    filepath.Join(nvramDir, domain.Spec.Name),
 					Template: filepath.Join(c.OVMFPath, EFIVarsSecureBoot),
 				}
 			} else {
@@ -956,7 +1012,7 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 				}
 
 				domain.Spec.OS.NVRam = &api.NVRam{
-					NVRam:    filepath.Join("/tmp", domain.Spec.Name),
+					NVRam:    filepath.Join(nvramDir, domain.Spec.Name),
 					Template: filepath.Join(c.OVMFPath, EFIVars),
 				}
 			}
@@ -1033,7 +1089,8 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 		}
 	}
 
-	if domain.Spec.Memory, err = QuantityToByte(*getVirtualMemory(vmi)); err != nil {
+	bootMemory := getBootMemory(vmi)
+	if domain.Spec.Memory, err = QuantityToByte(*bootMemory); err != nil {
 		return err
 	}
 
@@ -1046,8 +1103,8 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and the vhost-user interfaces of passt require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || util.IsPasstVMI(vmi) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1067,13 +1124,19 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 				{
 					ID:     "0",
 					CPUs:   fmt.Sprintf("0-%d", domain.Spec.VCPU.CPUs-1),
-					Memory: fmt.Sprintf("%d", getVirtualMemory(vmi).Value()/int64(1024)),
+					Memory: fmt.Sprintf("%d", bootMemory.Value()/int64(1024)),
 					Unit:   "KiB",
 				},
 			},
 		}
 	}
 
+	if isMemoryHotplugEnabled(vmi) {
+		if err := convertVirtioMem(vmi, domain, bootMemory); err != nil {
+			return err
+		}
+	}
+
 	volumeIndices := map[string]int{}
 	volumes := map[string]*v1.Volume{}
 	for i, volume := range vmi.Spec.Volumes {
@@ -1252,6 +1315,29 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 		domain.Spec.Devices.Rng = newRng
 	}
 
+	if vmi.Spec.Domain.Devices.TPM != nil {
+		newTPM := api.TPM{}
+		err := Convert_v1_TPM_To_api_TPM(vmi.Spec.Domain.Devices.TPM, &newTPM, c)
+		if err != nil {
+			return err
+		}
+		domain.Spec.Devices.TPMs = []api.TPM{newTPM}
+	}
+
+	if util.IsAutoAttachVSOCK(vmi) {
+		if vmi.Status.VSOCKCID == nil {
+			return fmt.Errorf("no vsock CID allocated to the VMI")
+		}
+		// The CID is allocated by virt-controller, so it is unique in the cluster and survives migrations
+		domain.Spec.Devices.VSOCK = &api.VSOCK{
+			Model: translateModel(c, "virtio"),
+			CID: api.CID{
+				Auto:    "no",
+				Address: *vmi.Status.VSOCKCID,
+			},
+		}
+	}
+
 	isUSBDevicePresent := false
 	if vmi.Spec.Domain.Devices.Inputs != nil {
 		inputDevices := make([]api.Input, 0)
@@ -1428,6 +1514,14 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 				},
 			},
 		}
+
+		if kubevirttypes.HasSerialConsoleLog(&vmi.Spec) {
+			// virtlogd writes the output to the log file and rotates it, the socket keeps working
+			domain.Spec.Devices.Serials[0].Log = &api.SerialLog{
+				File:   kubevirttypes.SerialConsoleLogPath,
+				Append: "on",
+			}
+		}
 	}
 
 	if vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == nil || *vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == true {
@@ -1501,12 +1595,13 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 			domainIface.Address = addr
 		}
 
-		if iface.Bridge != nil || iface.Masquerade != nil {
+		if iface.Bridge != nil || iface.Masquerade != nil || iface.Passt != nil || iface.Binding != nil {
 			// TODO:(ihar) consider abstracting interface type conversion /
 			// detection into drivers
 
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
+			// passt and binding plugins start from it as well and decorate it in virt-launcher
 			domainIface.Type = "ethernet"
 			if iface.BootOrder != nil {
 				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
@@ -1543,6 +1638,10 @@ func Convert_v1_VirtualMachine_To_api_Domain(vmi *v1.VirtualMachineInstance, dom
 				domainIface.Rom = &api.Rom{Enabled: "no"}
 			}
 		}
+		// libvirt limits the bandwidth of the tap devices
+		if iface.Bridge != nil || iface.Masquerade != nil || iface.Macvtap != nil {
+			domainIface.BandWidth = api.NewBandWidth(iface.Bandwidth)
+		}
 		domain.Spec.Devices.Interfaces = append(domain.Spec.Devices.Interfaces, domainIface)
 	}
 
@@ -1611,6 +1710,99 @@ func getVirtualMemory(vmi *v1.VirtualMachineInstance) *resource.Quantity {
 	return &v
 }
 
+func isMemoryHotplugEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil && vmi.Spec.Domain.Memory.MaxGuest != nil
+}
+
+// getBootMemory returns the memory the domain is started with. A vmi with hotpluggable memory keeps
+// the memory it was started with, the hotplugged memory is provided by the virtio-mem device.
+func getBootMemory(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	if isMemoryHotplugEnabled(vmi) && vmi.Status.Memory != nil && vmi.Status.Memory.GuestAtBoot != nil {
+		return vmi.Status.Memory.GuestAtBoot
+	}
+	return getVirtualMemory(vmi)
+}
+
+// getRequestedMemory returns the guest memory which should currently be plugged into the domain.
+func getRequestedMemory(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	if vmi.Status.Memory != nil && vmi.Status.Memory.GuestRequested != nil {
+		return vmi.Status.Memory.GuestRequested
+	}
+	return vmi.Spec.Domain.Memory.Guest
+}
+
+// getVirtioMemBlockSize returns the granularity in which memory is plugged into the virtio-mem device,
+// which has to match the page size backing the guest memory.
+func getVirtioMemBlockSize(vmi *v1.VirtualMachineInstance) (resource.Quantity, error) {
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil {
+		return resource.ParseQuantity(vmi.Spec.Domain.Memory.Hugepages.PageSize)
+	}
+	return resource.MustParse("2Mi"), nil
+}
+
+// convertVirtioMem adds a virtio-mem device covering the difference between the boot memory and the maximum guest memory.
+func convertVirtioMem(vmi *v1.VirtualMachineInstance, domain *api.Domain, bootMemory *resource.Quantity) error {
+	maxGuest := vmi.Spec.Domain.Memory.MaxGuest
+	if maxGuest.Cmp(*bootMemory) <= 0 {
+		return nil
+	}
+
+	size := maxGuest.DeepCopy()
+	size.Sub(*bootMemory)
+	requested := getRequestedMemory(vmi).DeepCopy()
+	requested.Sub(*bootMemory)
+	if requested.Sign() < 0 {
+		requested = *resource.NewQuantity(0, resource.BinarySI)
+	} else if requested.Cmp(size) > 0 {
+		requested = size
+	}
+	block, err := getVirtioMemBlockSize(vmi)
+	if err != nil {
+		return err
+	}
+
+	maxMemory, err := QuantityToByte(*maxGuest)
+	if err != nil {
+		return err
+	}
+	domain.Spec.MaxMemory = &api.MaxMemory{
+		Value: maxMemory.Value,
+		Unit:  maxMemory.Unit,
+		Slots: 1,
+	}
+
+	// virtio-mem devices are assigned to a NUMA node
+	if domain.Spec.CPU.NUMA == nil {
+		domain.Spec.CPU.NUMA = &api.NUMA{
+			Cells: []api.NUMACell{
+				{
+					ID:     "0",
+					CPUs:   fmt.Sprintf("0-%d", domain.Spec.VCPU.CPUs-1),
+					Memory: fmt.Sprintf("%d", bootMemory.Value()/int64(1024)),
+					Unit:   "KiB",
+				},
+			},
+		}
+	}
+
+	device := api.MemoryDevice{
+		Model:  "virtio-mem",
+		Target: &api.MemoryTarget{Node: "0"},
+		Alias:  &api.Alias{Name: "virtiomem"},
+	}
+	if device.Target.Size, err = QuantityToByte(size); err != nil {
+		return err
+	}
+	if device.Target.Block, err = QuantityToByte(block); err != nil {
+		return err
+	}
+	if device.Target.Requested, err = QuantityToByte(requested); err != nil {
+		return err
+	}
+	domain.Spec.Devices.Memory = append(domain.Spec.Devices.Memory, device)
+	return nil
+}
+
 func getCPUTopology(vmi *v1.VirtualMachineInstance) *api.CPUTopology {
 	cores := uint32(1)
 	threads := uint32(1)
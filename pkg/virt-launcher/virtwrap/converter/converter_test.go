@@ -133,6 +133,37 @@ var _ = Describe("Converter", func() {
 			Expect(xml).To(Equal(convertedDisk))
 		})
 
+		It("should attach a sysprep volume as its generated iso", func() {
+			volume := &v1.Volume{
+				Name: "sysprep",
+				VolumeSource: v1.VolumeSource{
+					Sysprep: &v1.SysprepSource{
+						ConfigMap: &k8sv1.LocalObjectReference{Name: "sysprep-config"},
+					},
+				},
+			}
+			disk := &api.Disk{Driver: &api.DiskDriver{}}
+			Expect(Convert_v1_Volume_To_api_Disk(volume, disk, &ConverterContext{}, 0)).To(Succeed())
+			Expect(disk.Type).To(Equal("file"))
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.Source.File).To(Equal("/var/run/kubevirt-private/sysprep-disks/sysprep.iso"))
+		})
+
+		It("should attach a downwardMetrics volume as the read-only metrics disk", func() {
+			volume := &v1.Volume{
+				Name: "metrics",
+				VolumeSource: v1.VolumeSource{
+					DownwardMetrics: &v1.DownwardMetricsVolumeSource{},
+				},
+			}
+			disk := &api.Disk{Driver: &api.DiskDriver{}}
+			Expect(Convert_v1_Volume_To_api_Disk(volume, disk, &ConverterContext{}, 0)).To(Succeed())
+			Expect(disk.Type).To(Equal("file"))
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.ReadOnly).ToNot(BeNil())
+			Expect(disk.Source.File).To(Equal("/var/run/kubevirt-private/downwardapi-disks/vhostmd0"))
+		})
+
 	})
 
 	Context("with v1.VirtualMachineInstance", func() {
@@ -1190,6 +1221,22 @@ var _ = Describe("Converter", func() {
 				Expect(domainSpec.VCPU.CPUs).To(Equal(uint32(3)), "Expect vcpus")
 			})
 
+			It("should leave room for hotplugged CPU sockets up to max sockets", func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Sockets:    2,
+					MaxSockets: 4,
+					Cores:      2,
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+				Expect(domainSpec.CPU.Topology.Cores).To(Equal(uint32(2)), "Expect cores")
+				Expect(domainSpec.CPU.Topology.Sockets).To(Equal(uint32(4)), "Expect sockets")
+				Expect(domainSpec.CPU.Topology.Threads).To(Equal(uint32(1)), "Expect threads")
+				Expect(domainSpec.VCPU.CPUs).To(Equal(uint32(8)), "Expect vcpus")
+				Expect(domainSpec.VCPU.Current).To(Equal(uint32(4)), "Expect current vcpus")
+			})
+
 			It("should convert CPU threads", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 				vmi.Spec.Domain.CPU = &v1.CPU{
@@ -1509,6 +1556,52 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		It("should add a virtio-mem device for the memory up to max guest", func() {
+			guestMemory := resource.MustParse("1Gi")
+			maxGuestMemory := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:    &guestMemory,
+				MaxGuest: &maxGuestMemory,
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+			Expect(domainSpec.Memory.Value).To(Equal(uint64(1073741824)))
+			Expect(domainSpec.MaxMemory.Value).To(Equal(uint64(4294967296)))
+			Expect(domainSpec.CPU.NUMA.Cells).To(HaveLen(1))
+			Expect(domainSpec.CPU.NUMA.Cells[0].Memory).To(Equal("1048576"))
+			Expect(domainSpec.Devices.Memory).To(HaveLen(1))
+			device := domainSpec.Devices.Memory[0]
+			Expect(device.Model).To(Equal("virtio-mem"))
+			Expect(device.Target.Size.Value).To(Equal(uint64(3221225472)))
+			Expect(device.Target.Block.Value).To(Equal(uint64(2097152)))
+			Expect(device.Target.Requested.Value).To(Equal(uint64(0)))
+		})
+
+		It("should keep the boot memory and request the hotplugged memory from the virtio-mem device", func() {
+			guestMemory := resource.MustParse("2Gi")
+			maxGuestMemory := resource.MustParse("4Gi")
+			bootMemory := resource.MustParse("1Gi")
+			requestedMemory := resource.MustParse("1536Mi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:    &guestMemory,
+				MaxGuest: &maxGuestMemory,
+			}
+			vmi.Status.Memory = &v1.MemoryStatus{
+				GuestAtBoot:    &bootMemory,
+				GuestRequested: &requestedMemory,
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+			Expect(domainSpec.Memory.Value).To(Equal(uint64(1073741824)))
+			Expect(domainSpec.Devices.Memory).To(HaveLen(1))
+			Expect(domainSpec.Devices.Memory[0].Target.Size.Value).To(Equal(uint64(3221225472)))
+			Expect(domainSpec.Devices.Memory[0].Target.Requested.Value).To(Equal(uint64(536870912)))
+		})
+
 		It("should not add RNG when not present", func() {
 			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
 			Expect(domainSpec.Devices.Rng).To(BeNil())
@@ -1520,6 +1613,50 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Devices.Rng).ToNot(BeNil())
 		})
 
+		It("should not add a vsock device when not requested", func() {
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.VSOCK).To(BeNil())
+		})
+
+		It("should add a vsock device with the CID allocated to the VMI", func() {
+			cid := uint32(100)
+			vmi.Spec.Domain.Devices.AutoattachVSOCK = True()
+			vmi.Status.VSOCKCID = &cid
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.VSOCK).To(Equal(&api.VSOCK{
+				Model: "virtio-non-transitional",
+				CID:   api.CID{Auto: "no", Address: 100},
+			}))
+		})
+
+		It("should fail to add a vsock device without an allocated CID", func() {
+			vmi.Spec.Domain.Devices.AutoattachVSOCK = True()
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachine_To_api_Domain(vmi, domain, c)).ToNot(Succeed())
+		})
+
+		It("should not add a TPM when not present", func() {
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.TPMs).To(BeEmpty())
+		})
+
+		table.DescribeTable("should add an emulated TPM 2.0 device when present",
+			func(arch string, persistent *bool, expectedTPM api.TPM) {
+				vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: persistent}
+				c.Architecture = arch
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(domainSpec.Devices.TPMs).To(Equal([]api.TPM{expectedTPM}))
+			},
+			table.Entry("with the CRB interface on amd64", "amd64", nil,
+				api.TPM{Model: "tpm-crb", Backend: api.TPMBackend{Type: "emulator", Version: "2.0"}}),
+			table.Entry("with the TIS interface on arm64", "arm64", nil,
+				api.TPM{Model: "tpm-tis", Backend: api.TPMBackend{Type: "emulator", Version: "2.0"}}),
+			table.Entry("with the SPAPR interface on ppc64le", "ppc64le", nil,
+				api.TPM{Model: "tpm-spapr", Backend: api.TPMBackend{Type: "emulator", Version: "2.0"}}),
+			table.Entry("with persistent state", "amd64", True(),
+				api.TPM{Model: "tpm-crb", Backend: api.TPMBackend{Type: "emulator", Version: "2.0", PersistentState: "yes"}}),
+		)
+
 	})
 	Context("Network convert", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -1748,6 +1885,59 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
 			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
 		})
+		It("Should create an ethernet interface for a network binding plugin to decorate", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name1 := "Name"
+
+			iface1 := v1.Interface{Name: name1, Binding: &v1.PluginBinding{Name: "vhostuser"}}
+			net1 := v1.DefaultPodNetwork()
+			net1.Name = name1
+
+			vmi.Spec.Networks = []v1.Network{*net1}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(Equal(nil))
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+			Expect(domain.Spec.Devices.Interfaces[0].Alias.Name).To(Equal(name1))
+		})
+		It("Should use shared memory for a passt interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name1 := "Name"
+
+			iface1 := v1.Interface{Name: name1, InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}}}
+			net1 := v1.DefaultPodNetwork()
+			net1.Name = name1
+
+			vmi.Spec.Networks = []v1.Network{*net1}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(Equal(nil))
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+			Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+			Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+		})
+		It("Should limit the bandwidth of a masquerade interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name1 := "Name"
+
+			iface1 := v1.Interface{Name: name1, InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}}
+			iface1.Bandwidth = &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")}}
+			net1 := v1.DefaultPodNetwork()
+			net1.Name = name1
+
+			vmi.Spec.Networks = []v1.Network{*net1}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(Equal(nil))
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{Inbound: &api.BandWidthLimit{Average: 1024}}))
+		})
 		It("Should create network configuration for masquerade interface and the pod network and a secondary network using multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			name1 := "Name"
@@ -1947,6 +2137,41 @@ var _ = Describe("Converter", func() {
 			table.Entry("and add the serial console if it is set to true", True(), 1),
 			table.Entry("and not add the serial console if it is set to false", False(), 0),
 		)
+
+		table.DescribeTable("should check logSerialConsole", func(autoAttach *bool, logSerialConsole *bool, expectLog bool) {
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "default",
+					UID:       "1234",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							AutoattachSerialConsole: autoAttach,
+							LogSerialConsole:        logSerialConsole,
+						},
+					},
+				},
+			}
+			domain := vmiToDomain(&vmi, &ConverterContext{UseEmulation: true})
+			if !expectLog {
+				for _, serial := range domain.Spec.Devices.Serials {
+					Expect(serial.Log).To(BeNil())
+				}
+				return
+			}
+			Expect(domain.Spec.Devices.Serials).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Serials[0].Log).To(Equal(&api.SerialLog{
+				File:   "/var/run/kubevirt-serial-console-log/virt-serial0-log",
+				Append: "on",
+			}))
+		},
+			table.Entry("and log the serial console if it is set to true", nil, True(), true),
+			table.Entry("and not log the serial console if it is not set", nil, nil, false),
+			table.Entry("and not log the serial console if it is set to false", nil, False(), false),
+			table.Entry("and not log the serial console if it is not attached", False(), True(), false),
+		)
 	})
 
 	Context("IOThreads", func() {
@@ -2426,6 +2651,19 @@ var _ = Describe("Converter", func() {
 				Expect(path.Base(domainSpec.OS.NVRam.Template)).To(Equal(EFIVarsSecureBoot))
 				Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/tmp/mynamespace_testvmi"))
 			})
+
+			It("should keep the NVRAM on the persistent state volume if EFI persistent option", func() {
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					Bootloader: &v1.Bootloader{
+						EFI: &v1.EFI{
+							Persistent: True(),
+						},
+					},
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(path.Base(domainSpec.OS.NVRam.Template)).To(Equal(EFIVarsSecureBoot))
+				Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/var/lib/libvirt/qemu/nvram/mynamespace_testvmi"))
+			})
 		})
 	})
 
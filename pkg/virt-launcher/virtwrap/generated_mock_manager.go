@@ -65,6 +65,36 @@ func (_mr *_MockDomainManagerRecorder) UnpauseVMI(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnpauseVMI", arg0)
 }
 
+func (_m *MockDomainManager) FreezeVMI(_param0 *v1.VirtualMachineInstance, _param1 int32) error {
+	ret := _m.ctrl.Call(_m, "FreezeVMI", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) FreezeVMI(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FreezeVMI", arg0, arg1)
+}
+
+func (_m *MockDomainManager) UnfreezeVMI(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "UnfreezeVMI", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) UnfreezeVMI(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnfreezeVMI", arg0)
+}
+
+func (_m *MockDomainManager) MemoryDump(_param0 *v1.VirtualMachineInstance, _param1 string) error {
+	ret := _m.ctrl.Call(_m, "MemoryDump", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) MemoryDump(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MemoryDump", arg0, arg1)
+}
+
 func (_m *MockDomainManager) KillVMI(_param0 *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "KillVMI", _param0)
 	ret0, _ := ret[0].(error)
@@ -85,6 +115,26 @@ func (_mr *_MockDomainManagerRecorder) DeleteVMI(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVMI", arg0)
 }
 
+func (_m *MockDomainManager) RebootVMI(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "RebootVMI", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) RebootVMI(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RebootVMI", arg0)
+}
+
+func (_m *MockDomainManager) SetInterfaceBandwidth(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "SetInterfaceBandwidth", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) SetInterfaceBandwidth(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInterfaceBandwidth", arg0)
+}
+
 func (_m *MockDomainManager) SignalShutdownVMI(_param0 *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "SignalShutdownVMI", _param0)
 	ret0, _ := ret[0].(error)
@@ -190,6 +240,17 @@ func (_mr *_MockDomainManagerRecorder) GetFilesystems() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetFilesystems")
 }
 
+func (_m *MockDomainManager) GuestExec(_param0 *v1.VirtualMachineInstance, _param1 *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", _param0, _param1)
+	ret0, _ := ret[0].(*v1.VirtualMachineInstanceGuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestExec(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1)
+}
+
 func (_m *MockDomainManager) SetGuestTime(_param0 *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "SetGuestTime", _param0)
 	ret0, _ := ret[0].(error)
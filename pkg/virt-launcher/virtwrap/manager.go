@@ -27,11 +27,13 @@ package virtwrap
 
 import (
 	"context"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -54,6 +56,7 @@ import (
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
 	"kubevirt.io/kubevirt/pkg/config"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
@@ -62,6 +65,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/ignition"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	accesscredentials "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/access-credentials"
 	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
@@ -80,6 +84,12 @@ const (
 	vgpuEnvPrefix              = "VGPU_PASSTHROUGH_DEVICES"
 	PCI_RESOURCE_PREFIX        = "PCI_RESOURCE"
 	MDEV_RESOURCE_PREFIX       = "MDEV_PCI_RESOURCE"
+
+	fsFreezeCommand       = "guest-fsfreeze-freeze"
+	fsThawCommand         = "guest-fsfreeze-thaw"
+	fsFreezeStatusCommand = "guest-fsfreeze-status"
+	fsFrozen              = "frozen"
+	fsThawed              = "thawed"
 )
 
 type contextStore struct {
@@ -91,9 +101,14 @@ type DomainManager interface {
 	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
 	PauseVMI(*v1.VirtualMachineInstance) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
+	FreezeVMI(*v1.VirtualMachineInstance, int32) error
+	UnfreezeVMI(*v1.VirtualMachineInstance) error
+	MemoryDump(*v1.VirtualMachineInstance, string) error
 	KillVMI(*v1.VirtualMachineInstance) error
 	DeleteVMI(*v1.VirtualMachineInstance) error
 	SignalShutdownVMI(*v1.VirtualMachineInstance) error
+	RebootVMI(*v1.VirtualMachineInstance) error
+	SetInterfaceBandwidth(*v1.VirtualMachineInstance) error
 	MarkGracefulShutdownVMI(*v1.VirtualMachineInstance) error
 	ListAllDomains() ([]*api.Domain, error)
 	MigrateVMI(*v1.VirtualMachineInstance, *cmdclient.MigrationOptions) error
@@ -104,6 +119,7 @@ type DomainManager interface {
 	GetUsers() ([]v1.VirtualMachineInstanceGuestOSUser, error)
 	GetFilesystems() ([]v1.VirtualMachineInstanceFileSystem, error)
 	SetGuestTime(*v1.VirtualMachineInstance) error
+	GuestExec(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error)
 }
 
 type LibvirtDomainManager struct {
@@ -113,6 +129,8 @@ type LibvirtDomainManager struct {
 	domainModifyLock sync.Mutex
 	// mutex to control access to the guest time context
 	setGuestTimeLock sync.Mutex
+	// mutex to control access to the safety unfreeze context
+	safetyUnfreezeLock sync.Mutex
 
 	credManager *accesscredentials.AccessCredentialManager
 
@@ -123,6 +141,7 @@ type LibvirtDomainManager struct {
 	agentData              *agentpoller.AsyncAgentStore
 	cloudInitDataStore     *cloudinit.CloudInitData
 	setGuestTimeContextPtr *contextStore
+	safetyUnfreezeCtxPtr   *contextStore
 	ovmfPath               string
 }
 
@@ -366,7 +385,8 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 		}
 		if volSrc.ConfigMap != nil || volSrc.Secret != nil || volSrc.DownwardAPI != nil ||
 			volSrc.ServiceAccount != nil || volSrc.CloudInitNoCloud != nil ||
-			volSrc.CloudInitConfigDrive != nil || volSrc.ContainerDisk != nil {
+			volSrc.CloudInitConfigDrive != nil || volSrc.ContainerDisk != nil || volSrc.Sysprep != nil ||
+			volSrc.DownwardMetrics != nil {
 			disks.generated[volume.Name] = true
 		}
 	}
@@ -1080,6 +1100,14 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	if err := config.CreateServiceAccountDisk(vmi); err != nil {
 		return domain, fmt.Errorf("creating service account disk failed: %v", err)
 	}
+	// create Sysprep disks if they exists
+	if err := config.CreateSysprepDisks(vmi); err != nil {
+		return domain, fmt.Errorf("creating sysprep disks failed: %v", err)
+	}
+	// create the DownwardMetrics disk if it exists
+	if err := downwardmetrics.CreateDownwardMetricDisk(vmi); err != nil {
+		return domain, fmt.Errorf("creating downward metric disk failed: %v", err)
+	}
 
 	// set drivers cache mode
 	for i := range domain.Spec.Devices.Disks {
@@ -1420,10 +1448,159 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, useEmulat
 		}
 	}
 
+	if !cli.IsDown(domState) {
+		if err := syncVCPUs(dom, &oldSpec, &domain.Spec); err != nil {
+			logger.Reason(err).Error("hotplugging vCPUs failed")
+			return nil, err
+		}
+		if err := syncMemory(dom, &oldSpec, &domain.Spec); err != nil {
+			logger.Reason(err).Error("resizing the virtio-mem device failed")
+			return nil, err
+		}
+		if err := syncInterfaces(vmi, dom, &oldSpec, domain); err != nil {
+			logger.Reason(err).Error("hotplugging interfaces failed")
+			return nil, err
+		}
+		l.credManager.UpdateQemuAgentAccessCredentials(vmi)
+	}
+
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return &oldSpec, nil
 }
 
+func onlineVCPUs(vcpu *api.VCPU) uint32 {
+	if vcpu.Current != 0 {
+		return vcpu.Current
+	}
+	return vcpu.CPUs
+}
+
+// syncVCPUs sets the number of online vCPUs of a running domain to the number of the desired spec,
+// as long as the maximum number of vCPUs is the same.
+func syncVCPUs(dom cli.VirDomain, oldSpec, newSpec *api.DomainSpec) error {
+	if oldSpec.VCPU == nil || newSpec.VCPU == nil || oldSpec.VCPU.CPUs != newSpec.VCPU.CPUs {
+		return nil
+	}
+	current, desired := onlineVCPUs(oldSpec.VCPU), onlineVCPUs(newSpec.VCPU)
+	if current == desired {
+		return nil
+	}
+	log.Log.Infof("Changing the number of online vCPUs from %d to %d", current, desired)
+	return dom.SetVcpusFlags(uint(desired), libvirt.DOMAIN_VCPU_LIVE)
+}
+
+func getVirtioMemDevice(spec *api.DomainSpec) *api.MemoryDevice {
+	for i, device := range spec.Devices.Memory {
+		if device.Model == "virtio-mem" {
+			return &spec.Devices.Memory[i]
+		}
+	}
+	return nil
+}
+
+// syncMemory sets the requested size of the virtio-mem device of a running domain to the one of the desired spec.
+func syncMemory(dom cli.VirDomain, oldSpec, newSpec *api.DomainSpec) error {
+	oldDevice, newDevice := getVirtioMemDevice(oldSpec), getVirtioMemDevice(newSpec)
+	if oldDevice == nil || newDevice == nil {
+		return nil
+	}
+	current, err := oldDevice.Target.Requested.Bytes()
+	if err != nil {
+		return err
+	}
+	desired, err := newDevice.Target.Requested.Bytes()
+	if err != nil {
+		return err
+	}
+	if current == desired {
+		return nil
+	}
+
+	device := oldDevice.DeepCopy()
+	device.Target.Requested = newDevice.Target.Requested
+	device.Target.Current = nil
+	deviceXML, err := xml.Marshal(device)
+	if err != nil {
+		return err
+	}
+	log.Log.Infof("Changing the requested size of the virtio-mem device from %d to %d bytes", current, desired)
+	return dom.UpdateDeviceFlags(string(deviceXML), libvirt.DOMAIN_DEVICE_MODIFY_LIVE)
+}
+
+// syncInterfaces attaches the interfaces which were hotplugged to the vmi, once phase1 plugged them into the pod,
+// and detaches the interfaces which were unplugged from the vmi, cleaning up what they left behind in the pod.
+func syncInterfaces(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, oldSpec *api.DomainSpec, domain *api.Domain) error {
+	oldInterfaces := map[string]bool{}
+	for _, iface := range oldSpec.Devices.Interfaces {
+		if iface.Alias != nil {
+			oldInterfaces[iface.Alias.Name] = true
+		}
+	}
+	vmiInterfaces := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		vmiInterfaces[iface.Name] = true
+	}
+	podInterfaceNames := map[string]string{}
+	for _, iface := range vmi.Status.Interfaces {
+		podInterfaceNames[iface.Name] = iface.PodInterfaceName
+	}
+
+	for _, iface := range oldSpec.Devices.Interfaces {
+		if iface.Alias == nil || vmiInterfaces[iface.Alias.Name] {
+			continue
+		}
+		podInterfaceName, recorded := podInterfaceNames[iface.Alias.Name]
+		if !recorded || podInterfaceName == "" {
+			// only hotplugged interfaces have their pod interface recorded
+			continue
+		}
+		log.Log.Object(vmi).Infof("Detaching interface %s", iface.Alias.Name)
+		detachBytes, err := xml.Marshal(iface)
+		if err != nil {
+			return err
+		}
+		if err := dom.DetachDevice(string(detachBytes)); err != nil {
+			return err
+		}
+		if err := network.UnplugPodNetwork(iface.Alias.Name, podInterfaceName); err != nil {
+			return err
+		}
+	}
+
+	var attachNames []string
+	attach := map[string]bool{}
+	for _, iface := range domain.Spec.Devices.Interfaces {
+		if iface.Alias == nil || oldInterfaces[iface.Alias.Name] || podInterfaceNames[iface.Alias.Name] == "" {
+			continue
+		}
+		if !network.IsPodNetworkPhase1Done(iface.Alias.Name) {
+			continue
+		}
+		attachNames = append(attachNames, iface.Alias.Name)
+		attach[iface.Alias.Name] = true
+	}
+	if len(attachNames) == 0 {
+		return nil
+	}
+	if err := network.SetupPodNetworkPhase2ForInterfaces(vmi, domain, attachNames); err != nil {
+		return err
+	}
+	for _, iface := range domain.Spec.Devices.Interfaces {
+		if iface.Alias == nil || !attach[iface.Alias.Name] {
+			continue
+		}
+		log.Log.Object(vmi).Infof("Attaching interface %s", iface.Alias.Name)
+		attachBytes, err := xml.Marshal(iface)
+		if err != nil {
+			return err
+		}
+		if err := dom.AttachDevice(string(attachBytes)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getSourceFile(disk api.Disk) string {
 	file := disk.Source.File
 	if disk.Source.File == "" {
@@ -1645,6 +1822,228 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 	return nil
 }
 
+func (l *LibvirtDomainManager) getFSFreezeStatus(domName string) (string, error) {
+	output, err := l.virConn.QemuAgentCommand(`{"execute":"`+fsFreezeStatusCommand+`"}`, domName)
+	if err != nil {
+		return "", err
+	}
+
+	status := struct {
+		Return string `json:"return"`
+	}{}
+	if err := json.Unmarshal([]byte(output), &status); err != nil {
+		return "", err
+	}
+
+	return status.Return, nil
+}
+
+func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	// a new freeze always replaces a pending safety unfreeze
+	l.cancelSafetyUnfreeze()
+
+	status, err := l.getFSFreezeStatus(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the guest filesystem freeze status failed.")
+		return err
+	}
+
+	if status != fsFrozen {
+		_, err = l.virConn.QemuAgentCommand(`{"execute":"`+fsFreezeCommand+`"}`, domName)
+		if err != nil {
+			logger.Reason(err).Error("Freezing the guest filesystems failed.")
+			return err
+		}
+		logger.Infof("Froze the guest filesystems of %s", vmi.GetObjectMeta().GetName())
+	} else {
+		logger.Infof("Guest filesystems are already frozen for %s", vmi.GetObjectMeta().GetName())
+	}
+
+	if unfreezeTimeoutSeconds > 0 {
+		l.scheduleSafetyUnfreeze(vmi, time.Duration(unfreezeTimeoutSeconds)*time.Second)
+	}
+
+	return nil
+}
+
+func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error {
+	l.cancelSafetyUnfreeze()
+	return l.unfreezeVMI(vmi)
+}
+
+func (l *LibvirtDomainManager) unfreezeVMI(vmi *v1.VirtualMachineInstance) error {
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	status, err := l.getFSFreezeStatus(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the guest filesystem freeze status failed.")
+		return err
+	}
+
+	if status == fsThawed {
+		logger.Infof("Guest filesystems are not frozen for %s", vmi.GetObjectMeta().GetName())
+		return nil
+	}
+
+	_, err = l.virConn.QemuAgentCommand(`{"execute":"`+fsThawCommand+`"}`, domName)
+	if err != nil {
+		logger.Reason(err).Error("Thawing the guest filesystems failed.")
+		return err
+	}
+	logger.Infof("Thawed the guest filesystems of %s", vmi.GetObjectMeta().GetName())
+
+	return nil
+}
+
+// scheduleSafetyUnfreeze thaws the guest filesystems after the given timeout,
+// in case whoever froze them never asks for it.
+func (l *LibvirtDomainManager) scheduleSafetyUnfreeze(vmi *v1.VirtualMachineInstance, timeout time.Duration) {
+	l.safetyUnfreezeLock.Lock()
+	defer l.safetyUnfreezeLock.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.safetyUnfreezeCtxPtr = &contextStore{ctx: ctx, cancel: cancel}
+
+	go func() {
+		select {
+		case <-time.After(timeout):
+			log.Log.Object(vmi).Warningf("Unfreeze timeout of %v expired, thawing the guest filesystems", timeout)
+			if err := l.unfreezeVMI(vmi); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Safety unfreeze failed")
+			}
+		case <-ctx.Done():
+		}
+	}()
+}
+
+func (l *LibvirtDomainManager) cancelSafetyUnfreeze() {
+	l.safetyUnfreezeLock.Lock()
+	defer l.safetyUnfreezeLock.Unlock()
+
+	if l.safetyUnfreezeCtxPtr != nil {
+		l.safetyUnfreezeCtxPtr.cancel()
+		l.safetyUnfreezeCtxPtr = nil
+	}
+}
+
+// MemoryDump starts dumping the memory of the domain to dumpPath in the background.
+// The progress is reported through the domain metadata. Asking again for the same
+// dumpPath is a no-op.
+func (l *LibvirtDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+	triggered, err := l.initializeMemoryDumpMetadata(vmi, dumpPath)
+	if err != nil || triggered {
+		return err
+	}
+
+	go func() {
+		err := l.memoryDump(vmi, dumpPath)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Memory dump failed")
+		}
+		if err := l.setMemoryDumpResult(vmi, err); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to set the memory dump result")
+		}
+	}()
+	return nil
+}
+
+func (l *LibvirtDomainManager) initializeMemoryDumpMetadata(vmi *v1.VirtualMachineInstance, dumpPath string) (bool, error) {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Getting the domain for memory dump failed.")
+		return false, err
+	}
+
+	defer dom.Free()
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return false, err
+	}
+
+	fileName := filepath.Base(dumpPath)
+	memoryDumpMetadata := domainSpec.Metadata.KubeVirt.MemoryDump
+	if memoryDumpMetadata != nil {
+		if memoryDumpMetadata.FileName == fileName {
+			// the memory dump was already triggered
+			return true, nil
+		}
+		if memoryDumpMetadata.EndTimestamp == nil {
+			return false, fmt.Errorf("memory dump to %s is already in progress", memoryDumpMetadata.FileName)
+		}
+	}
+
+	now := metav1.Now()
+	domainSpec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+		FileName:       fileName,
+		StartTimestamp: &now,
+	}
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		return false, err
+	}
+	defer d.Free()
+	return false, nil
+}
+
+func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	log.Log.Object(vmi).Infof("Dumping the domain memory to %s", dumpPath)
+	// the raw format combined with memory only results in an ELF core dump
+	return dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+}
+
+func (l *LibvirtDomainManager) setMemoryDumpResult(vmi *v1.VirtualMachineInstance, dumpErr error) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	defer dom.Free()
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+	if domainSpec.Metadata.KubeVirt.MemoryDump == nil {
+		return nil
+	}
+
+	now := metav1.Now()
+	domainSpec.Metadata.KubeVirt.MemoryDump.EndTimestamp = &now
+	if dumpErr != nil {
+		domainSpec.Metadata.KubeVirt.MemoryDump.Failed = true
+		domainSpec.Metadata.KubeVirt.MemoryDump.FailureReason = dumpErr.Error()
+	} else {
+		domainSpec.Metadata.KubeVirt.MemoryDump.Completed = true
+	}
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	defer d.Free()
+	return nil
+}
+
 func (l *LibvirtDomainManager) MarkGracefulShutdownVMI(vmi *v1.VirtualMachineInstance) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
@@ -1685,6 +2084,108 @@ func (l *LibvirtDomainManager) MarkGracefulShutdownVMI(vmi *v1.VirtualMachineIns
 
 }
 
+// RebootVMI asks the guest to reboot, which keeps the domain and with it the virt-launcher pod.
+// The reboot is requested through the guest agent and falls back to ACPI if the agent is not available.
+func (l *LibvirtDomainManager) RebootVMI(vmi *v1.VirtualMachineInstance) error {
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the domain for reboot failed.")
+		return err
+	}
+	defer dom.Free()
+
+	domState, _, err := dom.GetState()
+	if err != nil {
+		logger.Reason(err).Error("Getting the domain state failed.")
+		return err
+	}
+	if domState != libvirt.DOMAIN_RUNNING {
+		return fmt.Errorf("domain is not running")
+	}
+
+	if err = dom.Reboot(libvirt.DOMAIN_REBOOT_GUEST_AGENT); err == nil {
+		logger.Infof("Signaled reboot through the guest agent for %s", vmi.GetObjectMeta().GetName())
+		return nil
+	}
+	logger.Reason(err).Warning("Rebooting through the guest agent failed, falling back to ACPI.")
+
+	if err = dom.Reboot(libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN); err != nil {
+		logger.Reason(err).Error("Signalling reboot failed.")
+		return err
+	}
+	logger.Infof("Signaled reboot through ACPI for %s", vmi.GetObjectMeta().GetName())
+	return nil
+}
+
+// SetInterfaceBandwidth applies the bandwidth limits of the vmi interfaces to the running domain.
+// Limits which were removed from an interface are cleared by setting them to zero.
+func (l *LibvirtDomainManager) SetInterfaceBandwidth(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the domain for the bandwidth update failed.")
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	bandwidths := map[string]*api.BandWidth{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		bandwidths[iface.Name] = api.NewBandWidth(iface.Bandwidth)
+	}
+	for _, domainIface := range domainSpec.Devices.Interfaces {
+		if domainIface.Alias == nil || domainIface.MAC == nil {
+			continue
+		}
+		bandwidth, exists := bandwidths[domainIface.Alias.Name]
+		current := domainIface.BandWidth
+		if current != nil && current.Inbound == nil && current.Outbound == nil {
+			current = nil
+		}
+		if !exists || reflect.DeepEqual(bandwidth, current) {
+			continue
+		}
+		if err := dom.SetInterfaceParameters(domainIface.MAC.MAC, interfaceParameters(bandwidth), libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			logger.Reason(err).Errorf("Setting the bandwidth of interface %s failed.", domainIface.Alias.Name)
+			return err
+		}
+		logger.Infof("Set the bandwidth of interface %s", domainIface.Alias.Name)
+	}
+	return nil
+}
+
+// interfaceParameters sets all bandwidth parameters, so that the ones which are not limited are cleared
+func interfaceParameters(bandwidth *api.BandWidth) *libvirt.DomainInterfaceParameters {
+	params := &libvirt.DomainInterfaceParameters{
+		BandwidthInAverageSet:  true,
+		BandwidthInPeakSet:     true,
+		BandwidthInBurstSet:    true,
+		BandwidthOutAverageSet: true,
+		BandwidthOutPeakSet:    true,
+		BandwidthOutBurstSet:   true,
+	}
+	if bandwidth == nil {
+		return params
+	}
+	if inbound := bandwidth.Inbound; inbound != nil {
+		params.BandwidthInAverage, params.BandwidthInPeak, params.BandwidthInBurst = inbound.Average, inbound.Peak, inbound.Burst
+	}
+	if outbound := bandwidth.Outbound; outbound != nil {
+		params.BandwidthOutAverage, params.BandwidthOutPeak, params.BandwidthOutBurst = outbound.Average, outbound.Peak, outbound.Burst
+	}
+	return params
+}
+
 func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
@@ -1791,7 +2292,12 @@ func (l *LibvirtDomainManager) DeleteVMI(vmi *v1.VirtualMachineInstance) error {
 	}
 	defer dom.Free()
 
-	err = dom.UndefineFlags(libvirt.DOMAIN_UNDEFINE_NVRAM)
+	undefineFlags := libvirt.DOMAIN_UNDEFINE_NVRAM
+	if kubevirttypes.HasPersistentEFI(&vmi.Spec) {
+		// The NVRAM lives on the persistent state PVC and has to survive the VMI
+		undefineFlags = libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM
+	}
+	err = dom.UndefineFlags(undefineFlags)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Undefining the domain failed.")
 		return err
@@ -1964,3 +2470,23 @@ func (l *LibvirtDomainManager) GetFilesystems() ([]v1.VirtualMachineInstanceFile
 
 	return fsList, nil
 }
+
+// GuestExec runs a command through the guest agent and waits up to the requested timeout for it to exit
+func (l *LibvirtDomainManager) GuestExec(vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	var timeoutSeconds int32
+	if request.TimeoutSeconds != nil {
+		timeoutSeconds = *request.TimeoutSeconds
+	}
+
+	result, err := util.GuestExec(l.virConn, util.VMINamespaceKeyFunc(vmi), request.Command, request.Args, request.Stdin, time.Duration(timeoutSeconds)*time.Second)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Running guest command %s failed.", request.Command)
+		return nil, err
+	}
+
+	return &v1.VirtualMachineInstanceGuestExecResult{
+		ExitCode: result.ExitCode,
+		Stdout:   result.Stdout,
+		Stderr:   result.Stderr,
+	}, nil
+}
@@ -232,6 +232,53 @@ var _ = Describe("Manager", func() {
 			Expect(err).To(BeNil())
 			Expect(newspec).ToNot(BeNil())
 		})
+		It("should hotplug vCPUs if sockets were added to a running VirtualMachineInstance", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4, Cores: 2, Threads: 1}
+			oldDomainSpec := expectIsolationDetectionForVMI(vmi)
+			xml, err := xml.MarshalIndent(oldDomainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oldDomainSpec.VCPU.CPUs).To(Equal(uint32(8)))
+			Expect(oldDomainSpec.VCPU.Current).To(Equal(uint32(2)))
+
+			vmi.Spec.Domain.CPU.Sockets = 3
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+			mockDomain.EXPECT().SetVcpusFlags(uint(6), libvirt.DOMAIN_VCPU_LIVE).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).To(BeNil())
+			Expect(newspec).ToNot(BeNil())
+		})
+		It("should resize the virtio-mem device if guest memory was added to a running VirtualMachineInstance", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			guestMemory := resource.MustParse("1Gi")
+			maxGuestMemory := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestMemory}
+			oldDomainSpec := expectIsolationDetectionForVMI(vmi)
+			xml, err := xml.MarshalIndent(oldDomainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oldDomainSpec.Devices.Memory).To(HaveLen(1))
+
+			requestedMemory := resource.MustParse("3Gi")
+			vmi.Status.Memory = &v1.MemoryStatus{GuestAtBoot: &guestMemory, GuestRequested: &requestedMemory}
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+			mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).DoAndReturn(func(deviceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+				Expect(deviceXML).To(ContainSubstring(`<requested unit="b">2147483648</requested>`))
+				return nil
+			})
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).To(BeNil())
+			Expect(newspec).ToNot(BeNil())
+		})
 		It("should not unpause a paused VirtualMachineInstance on SyncVMI, which was paused by user", func() {
 			// Make sure that we always free the domain after use
 			mockDomain.EXPECT().Free()
@@ -271,6 +318,74 @@ var _ = Describe("Manager", func() {
 			err := manager.PauseVMI(vmi)
 			Expect(err).To(BeNil())
 		})
+		It("should reboot a VirtualMachineInstance through the guest agent", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().Reboot(libvirt.DOMAIN_REBOOT_GUEST_AGENT).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.RebootVMI(vmi)
+			Expect(err).To(BeNil())
+		})
+		It("should fall back to ACPI to reboot a VirtualMachineInstance without guest agent", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().Reboot(libvirt.DOMAIN_REBOOT_GUEST_AGENT).Return(fmt.Errorf("guest agent is not connected"))
+			mockDomain.EXPECT().Reboot(libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.RebootVMI(vmi)
+			Expect(err).To(BeNil())
+		})
+		It("should set the changed interface bandwidth of a running VirtualMachineInstance", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			burst := resource.MustParse("64Ki")
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi"), Burst: &burst},
+			}
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{
+				{Alias: &api.Alias{Name: "default"}, MAC: &api.MAC{MAC: "de:ad:00:00:be:af"}},
+				{Alias: &api.Alias{Name: "red"}, MAC: &api.MAC{MAC: "de:ad:00:00:be:ef"}, BandWidth: &api.BandWidth{
+					Outbound: &api.BandWidthLimit{Average: 512},
+				}},
+			}
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+			allSet := libvirt.DomainInterfaceParameters{
+				BandwidthInAverageSet:  true,
+				BandwidthInPeakSet:     true,
+				BandwidthInBurstSet:    true,
+				BandwidthOutAverageSet: true,
+				BandwidthOutPeakSet:    true,
+				BandwidthOutBurstSet:   true,
+			}
+			limited := allSet
+			limited.BandwidthInAverage, limited.BandwidthInBurst = 1024, 64
+			mockDomain.EXPECT().SetInterfaceParameters("de:ad:00:00:be:af", &limited, libvirt.DOMAIN_AFFECT_LIVE).Return(nil)
+			mockDomain.EXPECT().SetInterfaceParameters("de:ad:00:00:be:ef", &allSet, libvirt.DOMAIN_AFFECT_LIVE).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.SetInterfaceBandwidth(vmi)
+			Expect(err).To(BeNil())
+		})
 		It("should not try to pause a paused VirtualMachineInstance", func() {
 			// Make sure that we always free the domain after use
 			mockDomain.EXPECT().Free()
@@ -325,6 +440,92 @@ var _ = Describe("Manager", func() {
 			Expect(err).To(BeNil())
 
 		})
+		It("should freeze a VirtualMachineInstance", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, testDomainName).Return(`{"return":"thawed"}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, testDomainName).Return(`{"return":1}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.FreezeVMI(vmi, 0)
+			Expect(err).To(BeNil())
+		})
+		It("should not try to freeze a frozen VirtualMachineInstance", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, testDomainName).Return(`{"return":"frozen"}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+			// no call to freeze
+
+			err := manager.FreezeVMI(vmi, 0)
+			Expect(err).To(BeNil())
+		})
+		It("should unfreeze a VirtualMachineInstance", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, testDomainName).Return(`{"return":"frozen"}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return(`{"return":1}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.UnfreezeVMI(vmi)
+			Expect(err).To(BeNil())
+		})
+		It("should automatically unfreeze a VirtualMachineInstance after the unfreeze timeout", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			isThawCalled := make(chan bool, 1)
+			defer close(isThawCalled)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, testDomainName).Return(`{"return":"thawed"}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, testDomainName).Return(`{"return":1}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-status"}`, testDomainName).Return(`{"return":"frozen"}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).DoAndReturn(func(string, string) (string, error) {
+				isThawCalled <- true
+				return `{"return":1}`, nil
+			})
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.FreezeVMI(vmi, 1)
+			Expect(err).To(BeNil())
+			Eventually(isThawCalled, 5*time.Second).Should(Receive(BeTrue()), "guest filesystems weren't thawed")
+		})
+		It("should run a command in the guest and collect its output", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			timeout := int32(5)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"cat","arg":["-"],"input-data":"aGVsbG8=","capture-output":true}}`, testDomainName).Return(`{"return":{"pid":42}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, testDomainName).Return(`{"return":{"exited":false}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, testDomainName).Return(`{"return":{"exited":true,"exitcode":3,"out-data":"aGVsbG8=","err-data":"b29wcw=="}}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			result, err := manager.GuestExec(vmi, &v1.VirtualMachineInstanceGuestExecRequest{
+				Command:        "cat",
+				Args:           []string{"-"},
+				Stdin:          "hello",
+				TimeoutSeconds: &timeout,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(&v1.VirtualMachineInstanceGuestExecResult{
+				ExitCode: 3,
+				Stdout:   "hello",
+				Stderr:   "oops",
+			}))
+		})
+		It("should fail when a guest command does not exit in time", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			timeout := int32(1)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"sleep","arg":["100"],"capture-output":true}}`, testDomainName).Return(`{"return":{"pid":42}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, testDomainName).AnyTimes().Return(`{"return":{"exited":false}}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			_, err := manager.GuestExec(vmi, &v1.VirtualMachineInstanceGuestExecRequest{
+				Command:        "sleep",
+				Args:           []string{"100"},
+				TimeoutSeconds: &timeout,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timed out"))
+		})
 		It("should hotplug a disk if a volume was hotplugged", func() {
 			// Make sure that we always free the domain after use
 			mockDomain.EXPECT().Free()
@@ -785,6 +986,92 @@ var _ = Describe("Manager", func() {
 			manager.MarkGracefulShutdownVMI(vmi)
 		})
 	})
+	Context("test memory dump", func() {
+		const dumpPath = "/var/run/kubevirt/hotplug-disks/memorydump/testvmi-memory.dump"
+
+		expectDomainSpec := func(domainSpec *api.DomainSpec) {
+			domainXML, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).To(BeNil())
+			mockDomain.EXPECT().Free().AnyTimes()
+			mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).AnyTimes().Return(string(domainXML), nil)
+		}
+
+		It("should dump the memory and report the completion in the metadata", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			expectDomainSpec(expectIsolationDetectionForVMI(vmi))
+
+			definedXML := make(chan string, 2)
+			mockConn.EXPECT().DomainDefineXML(gomock.Any()).Times(2).DoAndReturn(func(xml string) (cli.VirDomain, error) {
+				definedXML <- xml
+				return mockDomain, nil
+			})
+			mockDomain.EXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+
+			var started string
+			Eventually(definedXML, 5*time.Second).Should(Receive(&started))
+			Expect(started).To(ContainSubstring("<fileName>testvmi-memory.dump</fileName>"))
+			Expect(started).ToNot(ContainSubstring("<completed>"))
+			var completed string
+			Eventually(definedXML, 5*time.Second).Should(Receive(&completed))
+			Expect(completed).To(ContainSubstring("<completed>true</completed>"))
+		})
+
+		It("should report a failed memory dump in the metadata", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			expectDomainSpec(expectIsolationDetectionForVMI(vmi))
+
+			definedXML := make(chan string, 2)
+			mockConn.EXPECT().DomainDefineXML(gomock.Any()).Times(2).DoAndReturn(func(xml string) (cli.VirDomain, error) {
+				definedXML <- xml
+				return mockDomain, nil
+			})
+			mockDomain.EXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(fmt.Errorf("no space left on device"))
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+
+			Eventually(definedXML, 5*time.Second).Should(Receive())
+			var failed string
+			Eventually(definedXML, 5*time.Second).Should(Receive(&failed))
+			Expect(failed).To(ContainSubstring("<failed>true</failed>"))
+			Expect(failed).To(ContainSubstring("<failureReason>no space left on device</failureReason>"))
+		})
+
+		It("should not dump the memory twice to the same file", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			domainSpec := expectIsolationDetectionForVMI(vmi)
+			now := metav1.Now()
+			domainSpec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+				FileName:       "testvmi-memory.dump",
+				StartTimestamp: &now,
+			}
+			expectDomainSpec(domainSpec)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+		})
+
+		It("should fail if another memory dump is in progress", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			domainSpec := expectIsolationDetectionForVMI(vmi)
+			now := metav1.Now()
+			domainSpec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+				FileName:       "other-memory.dump",
+				StartTimestamp: &now,
+			}
+			expectDomainSpec(domainSpec)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err := manager.MemoryDump(vmi, dumpPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("already in progress"))
+		})
+	})
 	Context("test migration monitor", func() {
 		It("migration should be canceled if it's not progressing", func() {
 			migrationErrorChan := make(chan error)
@@ -1237,6 +1524,21 @@ var _ = Describe("Manager", func() {
 			table.Entry("crashed", libvirt.DOMAIN_CRASHED),
 			table.Entry("shutoff", libvirt.DOMAIN_SHUTOFF),
 		)
+		It("should keep the NVRAM of a VirtualMachineInstance with persistent EFI", func() {
+			mockDomain.EXPECT().Free()
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().UndefineFlags(libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+			vmi := newVMI(testNamespace, testVmName)
+			persistent := true
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{Persistent: &persistent},
+				},
+			}
+			err := manager.DeleteVMI(vmi)
+			Expect(err).To(BeNil())
+		})
 		table.DescribeTable("should try to destroy a VirtualMachineInstance in state",
 			func(state libvirt.DomainState) {
 				// Make sure that we always free the domain after use
@@ -95,6 +95,7 @@ type NetworkHandler interface {
 	LinkSetDown(link netlink.Link) error
 	LinkSetUp(link netlink.Link) error
 	LinkAdd(link netlink.Link) error
+	LinkDel(link netlink.Link) error
 	LinkSetLearningOff(link netlink.Link) error
 	ParseAddr(s string) (*netlink.Addr, error)
 	GetHostAndGwAddressesFromCIDR(s string) (string, string, error)
@@ -116,6 +117,7 @@ type NetworkHandler interface {
 	CreateTapDevice(tapName string, queueNumber uint32, launcherPID int, mtu int) error
 	BindTapDeviceToBridge(tapName string, bridgeName string) error
 	DisableTXOffloadChecksum(ifaceName string) error
+	StartPasst(args []string) error
 }
 
 type NetworkUtilsHandler struct{}
@@ -150,6 +152,9 @@ func (h *NetworkUtilsHandler) LinkSetUp(link netlink.Link) error {
 func (h *NetworkUtilsHandler) LinkAdd(link netlink.Link) error {
 	return netlink.LinkAdd(link)
 }
+func (h *NetworkUtilsHandler) LinkDel(link netlink.Link) error {
+	return netlink.LinkDel(link)
+}
 func (h *NetworkUtilsHandler) LinkSetLearningOff(link netlink.Link) error {
 	return netlink.LinkSetLearning(link, false)
 }
@@ -384,6 +389,16 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInter
 	return nil
 }
 
+// StartPasst runs passt, which forks to the background once it listens on its socket
+func (h *NetworkUtilsHandler) StartPasst(args []string) error {
+	// #nosec No risk for attacket injection. The arguments are built from validated interface ports
+	output, err := exec.Command("passt", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to start passt: %v: %s", err, string(output))
+	}
+	return nil
+}
+
 // Generate a random mac for interface
 // Avoid MAC address starting with reserved value 0xFE (https://github.com/kubevirt/kubevirt/issues/1494)
 func (h *NetworkUtilsHandler) GenerateRandomMac() (net.HardwareAddr, error) {
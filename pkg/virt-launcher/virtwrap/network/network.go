@@ -27,8 +27,12 @@ package network
 
 import (
 	"fmt"
+	"os"
+
+	"github.com/vishvananda/netlink"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -63,17 +67,12 @@ type podNIC interface {
 	PlugPhase2(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) error
 }
 
-func getNetworksAndCniNetworks(vmi *v1.VirtualMachineInstance) (map[string]*v1.Network, map[string]int) {
+func getNetworks(vmi *v1.VirtualMachineInstance) map[string]*v1.Network {
 	networks := map[string]*v1.Network{}
-	cniNetworks := map[string]int{}
 	for _, network := range vmi.Spec.Networks {
 		networks[network.Name] = network.DeepCopy()
-		if networks[network.Name].Multus != nil && !networks[network.Name].Multus.Default {
-			// multus pod interfaces start from 1
-			cniNetworks[network.Name] = len(cniNetworks) + 1
-		}
 	}
-	return networks, cniNetworks
+	return networks
 }
 
 func invokePodNICFactory(networks map[string]*v1.Network, ifaceName string) (podNIC, error) {
@@ -84,13 +83,11 @@ func invokePodNICFactory(networks map[string]*v1.Network, ifaceName string) (pod
 	return podNICFactory(network)
 }
 
-func getPodInterfaceName(networks map[string]*v1.Network, cniNetworks map[string]int, ifaceName string) string {
-	if networks[ifaceName].Multus != nil && !networks[ifaceName].Multus.Default {
-		// multus pod interfaces named netX
-		return fmt.Sprintf("net%d", cniNetworks[ifaceName])
-	} else {
-		return primaryPodInterfaceName
+func getPodInterfaceName(podInterfaceNames map[string]string, ifaceName string) string {
+	if podInterfaceName, isSecondary := podInterfaceNames[ifaceName]; isSecondary {
+		return podInterfaceName
 	}
+	return primaryPodInterfaceName
 }
 
 func SetupPodNetworkPhase1(vmi *v1.VirtualMachineInstance, pid int) error {
@@ -98,13 +95,18 @@ func SetupPodNetworkPhase1(vmi *v1.VirtualMachineInstance, pid int) error {
 	if err != nil {
 		return err
 	}
-	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
+	networks := getNetworks(vmi)
+	podInterfaceNames := multus.PodInterfaceNames(vmi)
 	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		podnic, err := invokePodNICFactory(networks, iface.Name)
 		if err != nil {
 			return err
 		}
-		podInterfaceName := getPodInterfaceName(networks, cniNetworks, iface.Name)
+		// hotplugged interfaces are plugged once their pod interface is attached
+		if !multus.IsPodInterfaceAttached(vmi, *networks[iface.Name]) {
+			continue
+		}
+		podInterfaceName := getPodInterfaceName(podInterfaceNames, iface.Name)
 		err = podNIC.PlugPhase1(podnic, vmi, &vmi.Spec.Domain.Devices.Interfaces[i], networks[iface.Name], podInterfaceName, pid)
 		if err != nil {
 			return err
@@ -114,13 +116,35 @@ func SetupPodNetworkPhase1(vmi *v1.VirtualMachineInstance, pid int) error {
 }
 
 func SetupPodNetworkPhase2(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
-	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
+	var ifaceNames []string
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		ifaceNames = append(ifaceNames, iface.Name)
+	}
+	return SetupPodNetworkPhase2ForInterfaces(vmi, domain, ifaceNames)
+}
+
+// SetupPodNetworkPhase2ForInterfaces runs phase2 for the given interfaces of the vmi only, it is used
+// to plug interfaces which are hotplugged to a running vmi. Interfaces whose pod interface is not
+// attached yet are skipped.
+func SetupPodNetworkPhase2ForInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain, ifaceNames []string) error {
+	networks := getNetworks(vmi)
+	podInterfaceNames := multus.PodInterfaceNames(vmi)
+	requested := map[string]bool{}
+	for _, name := range ifaceNames {
+		requested[name] = true
+	}
 	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if !requested[iface.Name] {
+			continue
+		}
 		podnic, err := invokePodNICFactory(networks, iface.Name)
 		if err != nil {
 			return err
 		}
-		podInterfaceName := getPodInterfaceName(networks, cniNetworks, iface.Name)
+		if !multus.IsPodInterfaceAttached(vmi, *networks[iface.Name]) {
+			continue
+		}
+		podInterfaceName := getPodInterfaceName(podInterfaceNames, iface.Name)
 		err = podNIC.PlugPhase2(podnic, vmi, &vmi.Spec.Domain.Devices.Interfaces[i], networks[iface.Name], domain, podInterfaceName)
 		if err != nil {
 			return err
@@ -129,6 +153,43 @@ func SetupPodNetworkPhase2(vmi *v1.VirtualMachineInstance, domain *api.Domain) e
 	return nil
 }
 
+// IsPodNetworkPhase1Done returns true if phase1 cached the configuration of the interface
+func IsPodNetworkPhase1Done(ifaceName string) bool {
+	_, err := os.Stat(getInterfaceCacheFile(virtLauncherCachedPattern, "self", ifaceName))
+	return err == nil
+}
+
+// UnplugPodNetwork removes what phase1 and phase2 set up in the pod for an interface unplugged from a
+// running vmi: the in-pod bridge, the tap device and the cached configuration, so that the interface
+// can be plugged again later.
+func UnplugPodNetwork(ifaceName string, podInterfaceName string) error {
+	initHandler()
+
+	for _, linkName := range []string{fmt.Sprintf("k6t-%s", podInterfaceName), generateTapDeviceName(podInterfaceName)} {
+		link, err := Handler.LinkByName(linkName)
+		if err != nil {
+			if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+				continue
+			}
+			return err
+		}
+		if err := Handler.LinkDel(link); err != nil {
+			return fmt.Errorf("failed to delete link %s: %v", linkName, err)
+		}
+	}
+
+	for _, file := range []string{
+		getInterfaceCacheFile(virtLauncherCachedPattern, "self", ifaceName),
+		getVifFilePath("self", ifaceName),
+		fmt.Sprintf("/var/run/kubevirt-private/dhcp_started-%s", podInterfaceName),
+	} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func newpodNIC(network *v1.Network) (podNIC, error) {
 	if network.Pod != nil || network.Multus != nil {
 		return new(podNICImpl), nil
@@ -27,6 +27,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 var _ = Describe("Network", func() {
@@ -137,5 +139,47 @@ var _ = Describe("Network", func() {
 			err := SetupPodNetworkPhase1(vm, pid)
 			Expect(err).To(BeNil())
 		})
+		It("should only configure hotplugged multus interfaces once their pod interface is attached", func() {
+			podNICFactory = func(network *v1.Network) (podNIC, error) {
+				return mockpodNIC, nil
+			}
+
+			vm := newVMIBridgeInterface("testnamespace", "testVmName")
+			vm.Status.Phase = v1.Running
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				{Name: "green", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			redNet := &v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red"}}}
+			blueNet := &v1.Network{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue"}}}
+			greenNet := &v1.Network{Name: "green", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "green"}}}
+			vm.Spec.Networks = []v1.Network{*redNet, *blueNet, *greenNet}
+			vm.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: "red", PodInterfaceName: "net1"},
+				{Name: "blue", PodInterfaceName: multus.HashedPodInterfaceName("blue")},
+			}
+
+			mockpodNIC.EXPECT().PlugPhase1(vm, &vm.Spec.Domain.Devices.Interfaces[0], redNet, "net1", pid)
+			mockpodNIC.EXPECT().PlugPhase1(vm, &vm.Spec.Domain.Devices.Interfaces[1], blueNet, multus.HashedPodInterfaceName("blue"), pid)
+			err := SetupPodNetworkPhase1(vm, pid)
+			Expect(err).To(BeNil())
+		})
+		It("should run phase2 for the requested interfaces only", func() {
+			podNICFactory = func(network *v1.Network) (podNIC, error) {
+				return mockpodNIC, nil
+			}
+
+			vm := newVMIBridgeInterface("testnamespace", "testVmName")
+			vm.Spec.Domain.Devices.Interfaces = append(vm.Spec.Domain.Devices.Interfaces,
+				v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+			redNet := &v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red"}}}
+			vm.Spec.Networks = append(vm.Spec.Networks, *redNet)
+			domain := &api.Domain{}
+
+			mockpodNIC.EXPECT().PlugPhase2(vm, &vm.Spec.Domain.Devices.Interfaces[1], redNet, domain, "net1")
+			err := SetupPodNetworkPhase2ForInterfaces(vm, domain, []string{"red"})
+			Expect(err).To(BeNil())
+		})
 	})
 })
@@ -22,13 +22,16 @@
 package network
 
 import (
+	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	netutils "k8s.io/utils/net"
@@ -41,11 +44,17 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
+	"kubevirt.io/kubevirt/pkg/hooks"
+	bindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/network/v1alpha1"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 var bridgeFakeIP = "169.254.75.1%d/32"
 
+// the sidecar of a binding plugin may still be starting when the domain is defined
+const pluginConnectTimeoutSeconds = 30
+
 type BindMechanism interface {
 	discoverPodNetworkInterface() error
 	preparePodNetworkInterfaces(queueNumber uint32, launcherPID int) error
@@ -182,8 +191,8 @@ func (l *podNICImpl) PlugPhase1(vmi *v1.VirtualMachineInstance, iface *v1.Interf
 		return err
 	}
 
-	// ignore the bindMechanism.loadCachedInterface for slirp and set the Pod interface cache
-	if !isExist || iface.Slirp != nil {
+	// ignore the bindMechanism.loadCachedInterface for slirp, passt and binding plugins and set the Pod interface cache
+	if !isExist || iface.Slirp != nil || iface.Passt != nil || iface.Binding != nil {
 		err := setPodInterfaceCache(iface, podInterfaceName, string(vmi.ObjectMeta.UID))
 		if err != nil {
 			return err
@@ -296,74 +305,142 @@ func getPhase1Binding(vmi *v1.VirtualMachineInstance, iface *v1.Interface, netwo
 }
 
 func getPhase2Binding(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
-	retrieveMacAddress := func(iface *v1.Interface) (*net.HardwareAddr, error) {
-		if iface.MacAddress != "" {
-			macAddress, err := net.ParseMAC(iface.MacAddress)
-			if err != nil {
-				return nil, err
-			}
-			return &macAddress, nil
-		}
-		return nil, nil
+	newBindMechanism, exists := bindMechanisms[getBindingName(iface)]
+	if !exists {
+		return nil, fmt.Errorf("Not implemented")
 	}
+	return newBindMechanism(vmi, iface, network, domain, podInterfaceName)
+}
 
-	if iface.Bridge != nil {
-		mac, err := retrieveMacAddress(iface)
-		if err != nil {
-			return nil, err
-		}
-		vif := &VIF{Name: podInterfaceName}
-		if mac != nil {
-			vif.MAC = *mac
-		}
-		return &BridgeBindMechanism{iface: iface,
-			virtIface:           &api.Interface{},
-			vmi:                 vmi,
-			vif:                 vif,
-			domain:              domain,
-			podInterfaceName:    podInterfaceName,
-			bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
-	}
-	if iface.Masquerade != nil {
-		mac, err := retrieveMacAddress(iface)
-		if err != nil {
-			return nil, err
-		}
-		vif := &VIF{Name: podInterfaceName}
-		if mac != nil {
-			vif.MAC = *mac
-		}
-		return &MasqueradeBindMechanism{iface: iface,
-			virtIface:           &api.Interface{},
-			vmi:                 vmi,
-			vif:                 vif,
-			domain:              domain,
-			podInterfaceName:    podInterfaceName,
-			vmNetworkCIDR:       network.Pod.VMNetworkCIDR,
-			vmIpv6NetworkCIDR:   "", // TODO add ipv6 cidr to PodNetwork schema
-			bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
-	}
-	if iface.Slirp != nil {
-		return &SlirpBindMechanism{vmi: vmi, iface: iface, domain: domain}, nil
-	}
-	if iface.Macvtap != nil {
-		mac, err := retrieveMacAddress(iface)
+// bindMechanismFactory creates the BindMechanism of an interface, the domain is nil in phase1
+type bindMechanismFactory func(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error)
+
+const (
+	bridgeBinding     = "bridge"
+	masqueradeBinding = "masquerade"
+	slirpBinding      = "slirp"
+	macvtapBinding    = "macvtap"
+	passtBinding      = "passt"
+	pluginBinding     = "plugin"
+)
+
+// bindMechanisms holds the bindings by name. All the bindings of the interface API are
+// implemented here, while the binding plugins registered in the cluster share one
+// mechanism which delegates to the sidecar of the plugin.
+var bindMechanisms = map[string]bindMechanismFactory{
+	bridgeBinding:     newBridgeBindMechanism,
+	masqueradeBinding: newMasqueradeBindMechanism,
+	slirpBinding:      newSlirpBindMechanism,
+	macvtapBinding:    newMacvtapBindMechanism,
+	passtBinding:      newPasstBindMechanism,
+	pluginBinding:     newPluginBindMechanism,
+}
+
+func getBindingName(iface *v1.Interface) string {
+	switch {
+	case iface.Binding != nil:
+		return pluginBinding
+	case iface.Bridge != nil:
+		return bridgeBinding
+	case iface.Masquerade != nil:
+		return masqueradeBinding
+	case iface.Slirp != nil:
+		return slirpBinding
+	case iface.Macvtap != nil:
+		return macvtapBinding
+	case iface.Passt != nil:
+		return passtBinding
+	}
+	return ""
+}
+
+func retrieveMacAddress(iface *v1.Interface) (*net.HardwareAddr, error) {
+	if iface.MacAddress != "" {
+		macAddress, err := net.ParseMAC(iface.MacAddress)
 		if err != nil {
 			return nil, err
 		}
-		virtIface := &api.Interface{}
-		if mac != nil {
-			virtIface.MAC = &api.MAC{MAC: mac.String()}
-		}
-		return &MacvtapBindMechanism{
-			vmi:              vmi,
-			iface:            iface,
-			virtIface:        virtIface,
-			domain:           domain,
-			podInterfaceName: podInterfaceName,
-		}, nil
+		return &macAddress, nil
+	}
+	return nil, nil
+}
+
+func newBridgeBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	mac, err := retrieveMacAddress(iface)
+	if err != nil {
+		return nil, err
+	}
+	vif := &VIF{Name: podInterfaceName}
+	if mac != nil {
+		vif.MAC = *mac
+	}
+	return &BridgeBindMechanism{iface: iface,
+		virtIface:           &api.Interface{},
+		vmi:                 vmi,
+		vif:                 vif,
+		domain:              domain,
+		podInterfaceName:    podInterfaceName,
+		bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
+}
+
+func newMasqueradeBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	mac, err := retrieveMacAddress(iface)
+	if err != nil {
+		return nil, err
+	}
+	vif := &VIF{Name: podInterfaceName}
+	if mac != nil {
+		vif.MAC = *mac
+	}
+	return &MasqueradeBindMechanism{iface: iface,
+		virtIface:           &api.Interface{},
+		vmi:                 vmi,
+		vif:                 vif,
+		domain:              domain,
+		podInterfaceName:    podInterfaceName,
+		vmNetworkCIDR:       network.Pod.VMNetworkCIDR,
+		vmIpv6NetworkCIDR:   "", // TODO add ipv6 cidr to PodNetwork schema
+		bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
+}
+
+func newSlirpBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	return &SlirpBindMechanism{vmi: vmi, iface: iface, domain: domain}, nil
+}
+
+func newMacvtapBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	mac, err := retrieveMacAddress(iface)
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("Not implemented")
+	virtIface := &api.Interface{}
+	if mac != nil {
+		virtIface.MAC = &api.MAC{MAC: mac.String()}
+	}
+	return &MacvtapBindMechanism{
+		vmi:              vmi,
+		iface:            iface,
+		virtIface:        virtIface,
+		domain:           domain,
+		podInterfaceName: podInterfaceName,
+	}, nil
+}
+
+func newPasstBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	return &PasstBindMechanism{
+		iface:            iface,
+		domain:           domain,
+		podInterfaceName: podInterfaceName,
+		socketPath:       passtSocketPath(iface.Name),
+	}, nil
+}
+
+func newPluginBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	return &PluginBindMechanism{
+		vmi:        vmi,
+		iface:      iface,
+		domain:     domain,
+		socketPath: hooks.NetworkBindingSocketPath(iface.Binding.Name),
+	}, nil
 }
 
 type BridgeBindMechanism struct {
@@ -1254,6 +1331,194 @@ func (b *MacvtapBindMechanism) startDHCP(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// PasstBindMechanism connects the interface through passt, which runs in the network namespace of
+// the pod and connects the guest to the pod interface in user space. The guest keeps the IP
+// addresses of the pod, passt serves DHCP, DHCPv6 and NDP to hand them out and only forwards the
+// ports of the interface, or all of them if none is listed.
+type PasstBindMechanism struct {
+	iface            *v1.Interface
+	domain           *api.Domain
+	podInterfaceName string
+	socketPath       string
+}
+
+func passtSocketPath(ifaceName string) string {
+	return fmt.Sprintf("/var/run/kubevirt-private/passt-%s.sock", ifaceName)
+}
+
+func (p *PasstBindMechanism) discoverPodNetworkInterface() error {
+	return nil
+}
+
+func (p *PasstBindMechanism) preparePodNetworkInterfaces(queueNumber uint32, launcherPID int) error {
+	return nil
+}
+
+func (p *PasstBindMechanism) decorateConfig() error {
+	ifaces := p.domain.Spec.Devices.Interfaces
+	for i, iface := range ifaces {
+		if iface.Alias != nil && iface.Alias.Name == p.iface.Name {
+			// the guest is connected to passt with vhost-user, qemu connects to the socket passt listens on
+			ifaces[i].Type = "vhostuser"
+			ifaces[i].Source = api.InterfaceSource{
+				Type: "unix",
+				Path: p.socketPath,
+				Mode: "client",
+			}
+			// vhost-user has its own backend, the in-kernel vhost-net driver can't be used
+			if ifaces[i].Driver != nil {
+				ifaces[i].Driver.Name = ""
+			}
+			if p.iface.MacAddress != "" {
+				// We assume address was already validated in API layer so just pass it to libvirt as-is.
+				ifaces[i].MAC = &api.MAC{MAC: p.iface.MacAddress}
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to find interface %s in vmi spec", p.iface.Name)
+}
+
+// startDHCP starts passt, which serves DHCP and DHCPv6 in place of the DHCP servers of virt-launcher
+func (p *PasstBindMechanism) startDHCP(vmi *v1.VirtualMachineInstance) error {
+	return Handler.StartPasst(passtArgs(p.podInterfaceName, p.socketPath, p.iface.Ports))
+}
+
+func passtArgs(podInterfaceName, socketPath string, ports []v1.Port) []string {
+	args := []string{
+		"--vhost-user",
+		"--socket", socketPath,
+		"--interface", podInterfaceName,
+	}
+	if len(ports) == 0 {
+		return append(args, "--tcp-ports", "all", "--udp-ports", "all")
+	}
+
+	var tcpPorts, udpPorts []string
+	for _, port := range ports {
+		if strings.EqualFold(port.Protocol, "UDP") {
+			udpPorts = append(udpPorts, strconv.Itoa(int(port.Port)))
+		} else {
+			tcpPorts = append(tcpPorts, strconv.Itoa(int(port.Port)))
+		}
+	}
+	if len(tcpPorts) != 0 {
+		args = append(args, "--tcp-ports", strings.Join(tcpPorts, ","))
+	}
+	if len(udpPorts) != 0 {
+		args = append(args, "--udp-ports", strings.Join(udpPorts, ","))
+	}
+	return args
+}
+
+func (p *PasstBindMechanism) loadCachedInterface(pid, name string) (bool, error) {
+	return true, nil
+}
+
+func (p *PasstBindMechanism) setCachedInterface(pid, name string) error {
+	return nil
+}
+
+func (p *PasstBindMechanism) loadCachedVIF(pid, name string) (bool, error) {
+	return true, nil
+}
+
+func (p *PasstBindMechanism) setCachedVIF(pid, name string) error {
+	return nil
+}
+
+// PluginBindMechanism connects the interface through a network binding plugin registered in
+// the cluster. The pod networking is left to the plugin, while the domain interface is passed
+// to the sidecar of the plugin, which returns it decorated.
+type PluginBindMechanism struct {
+	vmi        *v1.VirtualMachineInstance
+	iface      *v1.Interface
+	domain     *api.Domain
+	socketPath string
+}
+
+func (p *PluginBindMechanism) discoverPodNetworkInterface() error {
+	return nil
+}
+
+func (p *PluginBindMechanism) preparePodNetworkInterfaces(queueNumber uint32, launcherPID int) error {
+	return nil
+}
+
+func (p *PluginBindMechanism) startDHCP(vmi *v1.VirtualMachineInstance) error {
+	return nil
+}
+
+func (p *PluginBindMechanism) decorateConfig() error {
+	ifaces := p.domain.Spec.Devices.Interfaces
+	for i, iface := range ifaces {
+		if iface.Alias != nil && iface.Alias.Name == p.iface.Name {
+			decoratedIface, err := p.onDefineInterface(&ifaces[i])
+			if err != nil {
+				return err
+			}
+			ifaces[i] = *decoratedIface
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to find interface %s in vmi spec", p.iface.Name)
+}
+
+func (p *PluginBindMechanism) onDefineInterface(domainIface *api.Interface) (*api.Interface, error) {
+	interfaceXML, err := xml.Marshal(domainIface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal interface %s: %v", p.iface.Name, err)
+	}
+	vmiJSON, err := json.Marshal(p.vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VMI: %v", err)
+	}
+
+	conn, err := grpcutil.DialSocketWithTimeout(p.socketPath, pluginConnectTimeoutSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial the network binding plugin %s: %v", p.iface.Binding.Name, err)
+	}
+	defer conn.Close()
+
+	client := bindingV1alpha1.NewNetworkBindingClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	result, err := client.OnDefineInterface(ctx, &bindingV1alpha1.OnDefineInterfaceParams{
+		InterfaceXML:  interfaceXML,
+		Vmi:           vmiJSON,
+		InterfaceName: p.iface.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("network binding plugin %s failed to define interface %s: %v", p.iface.Binding.Name, p.iface.Name, err)
+	}
+
+	decoratedIface := &api.Interface{}
+	if err := xml.Unmarshal(result.GetInterfaceXML(), decoratedIface); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal interface %s returned by network binding plugin %s: %v", p.iface.Name, p.iface.Binding.Name, err)
+	}
+	if decoratedIface.Alias == nil || decoratedIface.Alias.Name != p.iface.Name {
+		return nil, fmt.Errorf("network binding plugin %s changed the alias of interface %s", p.iface.Binding.Name, p.iface.Name)
+	}
+	return decoratedIface, nil
+}
+
+func (p *PluginBindMechanism) loadCachedInterface(pid, name string) (bool, error) {
+	return true, nil
+}
+
+func (p *PluginBindMechanism) setCachedInterface(pid, name string) error {
+	return nil
+}
+
+func (p *PluginBindMechanism) loadCachedVIF(pid, name string) (bool, error) {
+	return true, nil
+}
+
+func (p *PluginBindMechanism) setCachedVIF(pid, name string) error {
+	return nil
+}
+
 func createAndBindTapToBridge(deviceName string, bridgeIfaceName string, queueNumber uint32, launcherPID int, mtu int) error {
 	err := Handler.CreateTapDevice(deviceName, queueNumber, launcherPID, mtu)
 	if err != nil {
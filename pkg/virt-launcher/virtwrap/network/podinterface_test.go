@@ -20,11 +20,14 @@
 package network
 
 import (
+	"context"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"runtime"
 
 	"github.com/coreos/go-iptables/iptables"
@@ -33,11 +36,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc"
 
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
+	bindingV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/network/v1alpha1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -788,6 +793,116 @@ var _ = Describe("Pod Network", func() {
 		})
 	})
 
+	Context("Passt", func() {
+		It("should connect the domain interface to the passt socket", func() {
+			domain := NewDomainWithBridgeInterface()
+			domain.Spec.Devices.Interfaces[0].Driver = &api.InterfaceDriver{Name: "vhost"}
+			vmi := newVMIPasstInterface("testnamespace", "testVmName")
+			vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = "de:ad:00:00:be:af"
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			passt, ok := driver.(*PasstBindMechanism)
+			Expect(ok).To(BeTrue())
+
+			Expect(passt.decorateConfig()).To(Succeed())
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vhostuser"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source).To(Equal(api.InterfaceSource{
+				Type: "unix",
+				Path: "/var/run/kubevirt-private/passt-default.sock",
+				Mode: "client",
+			}))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.Name).To(BeEmpty())
+			Expect(domain.Spec.Devices.Interfaces[0].MAC).To(Equal(&api.MAC{MAC: "de:ad:00:00:be:af"}))
+		})
+		It("should start passt in place of the DHCP server and forward all ports", func() {
+			vmi := newVMIPasstInterface("testnamespace", "testVmName")
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], NewDomainWithBridgeInterface(), primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockNetwork.EXPECT().StartPasst([]string{
+				"--vhost-user",
+				"--socket", "/var/run/kubevirt-private/passt-default.sock",
+				"--interface", primaryPodInterfaceName,
+				"--tcp-ports", "all",
+				"--udp-ports", "all",
+			}).Return(nil)
+			Expect(driver.startDHCP(vmi)).To(Succeed())
+		})
+		It("should only forward the ports of the interface", func() {
+			vmi := newVMIPasstInterface("testnamespace", "testVmName")
+			vmi.Spec.Domain.Devices.Interfaces[0].Ports = []v1.Port{{Port: 80}, {Protocol: "TCP", Port: 443}, {Protocol: "UDP", Port: 53}}
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], NewDomainWithBridgeInterface(), primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockNetwork.EXPECT().StartPasst([]string{
+				"--vhost-user",
+				"--socket", "/var/run/kubevirt-private/passt-default.sock",
+				"--interface", primaryPodInterfaceName,
+				"--tcp-ports", "80,443",
+				"--udp-ports", "53",
+			}).Return(fmt.Errorf("passt failed"))
+			Expect(driver.startDHCP(vmi)).ToNot(Succeed())
+		})
+	})
+
+	Context("Plugin decorateConfig", func() {
+		var socketDir string
+		var server *grpc.Server
+
+		startPlugin := func(onDefineInterface func(*api.Interface)) string {
+			var err error
+			socketDir, err = ioutil.TempDir("", "bindingplugin")
+			Expect(err).ToNot(HaveOccurred())
+			socketPath := filepath.Join(socketDir, "vhostuser.sock")
+			listener, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			server = grpc.NewServer()
+			bindingV1alpha1.RegisterNetworkBindingServer(server, fakeBindingPlugin(onDefineInterface))
+			go server.Serve(listener)
+			return socketPath
+		}
+
+		AfterEach(func() {
+			server.Stop()
+			os.RemoveAll(socketDir)
+		})
+
+		It("should replace the domain interface with the one returned by the plugin", func() {
+			domain := NewDomainWithBridgeInterface()
+			vmi := newVMIPluginInterface("testnamespace", "testVmName")
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			plugin, ok := driver.(*PluginBindMechanism)
+			Expect(ok).To(BeTrue())
+			Expect(plugin.socketPath).To(Equal("/var/run/kubevirt-network-bindings/vhostuser.sock"))
+
+			plugin.socketPath = startPlugin(func(iface *api.Interface) {
+				iface.Type = "vhostuser"
+				iface.Source = api.InterfaceSource{Type: "unix", Path: "/var/run/vhostuser/default.sock", Mode: "server"}
+			})
+
+			Expect(plugin.decorateConfig()).To(Succeed())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vhostuser"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source.Path).To(Equal("/var/run/vhostuser/default.sock"))
+			Expect(domain.Spec.Devices.Interfaces[0].Model.Type).To(Equal("virtio"))
+		})
+		It("should fail when the plugin changes the alias of the interface", func() {
+			domain := NewDomainWithBridgeInterface()
+			vmi := newVMIPluginInterface("testnamespace", "testVmName")
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			plugin := driver.(*PluginBindMechanism)
+
+			plugin.socketPath = startPlugin(func(iface *api.Interface) {
+				iface.Alias = &api.Alias{Name: "other"}
+			})
+
+			Expect(plugin.decorateConfig()).ToNot(Succeed())
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("bridge"))
+		})
+	})
+
 	It("should write interface to cache file", func() {
 		uid := types.UID("test-1234")
 		address1 := &net.IPNet{IP: net.IPv4(1, 2, 3, 4)}
@@ -851,6 +966,35 @@ func newVMIMacvtapInterface(namespace string, vmiName string, ifaceName string)
 	return vmi
 }
 
+func newVMIPasstInterface(namespace string, name string) *v1.VirtualMachineInstance {
+	vmi := newVMI(namespace, name)
+	vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}}}}
+	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+	return vmi
+}
+
+func newVMIPluginInterface(namespace string, name string) *v1.VirtualMachineInstance {
+	vmi := newVMI(namespace, name)
+	vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Binding: &v1.PluginBinding{Name: "vhostuser"}}}
+	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+	return vmi
+}
+
+type fakeBindingPlugin func(*api.Interface)
+
+func (f fakeBindingPlugin) OnDefineInterface(_ context.Context, params *bindingV1alpha1.OnDefineInterfaceParams) (*bindingV1alpha1.OnDefineInterfaceResult, error) {
+	iface := &api.Interface{}
+	if err := xml.Unmarshal(params.GetInterfaceXML(), iface); err != nil {
+		return nil, err
+	}
+	f(iface)
+	interfaceXML, err := xml.Marshal(iface)
+	if err != nil {
+		return nil, err
+	}
+	return &bindingV1alpha1.OnDefineInterfaceResult{InterfaceXML: interfaceXML}, nil
+}
+
 func NewDomainWithBridgeInterface() *api.Domain {
 	domain := &api.Domain{}
 	domain.Spec.Devices.Interfaces = []api.Interface{{
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	utilwait "k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	guestExecCommand       = "guest-exec"
+	guestExecStatusCommand = "guest-exec-status"
+	guestExecPollInterval  = 500 * time.Millisecond
+)
+
+type guestExecRequest struct {
+	Execute   string             `json:"execute"`
+	Arguments guestExecArguments `json:"arguments"`
+}
+
+type guestExecArguments struct {
+	Path          string   `json:"path"`
+	Arg           []string `json:"arg,omitempty"`
+	InputData     string   `json:"input-data,omitempty"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+type guestExecReturn struct {
+	Return struct {
+		Pid int `json:"pid"`
+	} `json:"return"`
+}
+
+type guestExecStatusReturn struct {
+	Return guestExecStatus `json:"return"`
+}
+
+type guestExecStatus struct {
+	Exited       bool   `json:"exited"`
+	ExitCode     int32  `json:"exitcode"`
+	OutData      string `json:"out-data"`
+	ErrData      string `json:"err-data"`
+	OutTruncated bool   `json:"out-truncated"`
+	ErrTruncated bool   `json:"err-truncated"`
+}
+
+// GuestExecResult is the outcome of a command which exited in the guest
+type GuestExecResult struct {
+	ExitCode int32
+	Stdout   string
+	Stderr   string
+}
+
+// GuestExec runs a command through the guest agent of the domain, feeding it stdin if not empty,
+// and waits up to timeout for it to exit. A non-zero exit code is not treated as an error.
+func GuestExec(virConn cli.Connection, domName string, command string, args []string, stdin string, timeout time.Duration) (*GuestExecResult, error) {
+	execRequest := guestExecRequest{
+		Execute: guestExecCommand,
+		Arguments: guestExecArguments{
+			Path:          command,
+			Arg:           args,
+			CaptureOutput: true,
+		},
+	}
+	if stdin != "" {
+		execRequest.Arguments.InputData = base64.StdEncoding.EncodeToString([]byte(stdin))
+	}
+	cmdExec, err := json.Marshal(execRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := virConn.QemuAgentCommand(string(cmdExec), domName)
+	if err != nil {
+		return nil, err
+	}
+	execRes := guestExecReturn{}
+	if err := json.Unmarshal([]byte(output), &execRes); err != nil {
+		return nil, err
+	}
+	if execRes.Return.Pid <= 0 {
+		return nil, fmt.Errorf("invalid pid [%d] returned from the guest agent: %s", execRes.Return.Pid, output)
+	}
+
+	cmdExecStatus := fmt.Sprintf(`{"execute":"%s","arguments":{"pid":%d}}`, guestExecStatusCommand, execRes.Return.Pid)
+	status := guestExecStatusReturn{}
+	err = utilwait.PollImmediate(guestExecPollInterval, timeout, func() (bool, error) {
+		output, err := virConn.QemuAgentCommand(cmdExecStatus, domName)
+		if err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal([]byte(output), &status); err != nil {
+			return false, err
+		}
+		return status.Return.Exited, nil
+	})
+	if err == utilwait.ErrWaitTimeout {
+		return nil, fmt.Errorf("timed out after %v waiting for guest command %s with pid [%d] to exit", timeout, command, execRes.Return.Pid)
+	} else if err != nil {
+		return nil, err
+	}
+
+	stdout, err := base64.StdEncoding.DecodeString(status.Return.OutData)
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := base64.StdEncoding.DecodeString(status.Return.ErrData)
+	if err != nil {
+		return nil, err
+	}
+	if status.Return.OutTruncated || status.Return.ErrTruncated {
+		log.Log.Warningf("Output of guest command %s in domain %s was truncated by the guest agent", command, domName)
+	}
+
+	return &GuestExecResult{
+		ExitCode: status.Return.ExitCode,
+		Stdout:   string(stdout),
+		Stderr:   string(stderr),
+	}, nil
+}
@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest exec", func() {
+	const domName = "default_testvmi"
+
+	var ctrl *gomock.Controller
+	var mockConn *cli.MockConnection
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should pass stdin and collect the output and the exit code", func() {
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"cat","arg":["-"],"input-data":"aGVsbG8=","capture-output":true}}`, domName).Return(`{"return":{"pid":42}}`, nil)
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, domName).Return(`{"return":{"exited":false}}`, nil)
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, domName).Return(`{"return":{"exited":true,"exitcode":3,"out-data":"aGVsbG8=","err-data":"b29wcw=="}}`, nil)
+
+		result, err := GuestExec(mockConn, domName, "cat", []string{"-"}, "hello", 5*time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(&GuestExecResult{
+			ExitCode: 3,
+			Stdout:   "hello",
+			Stderr:   "oops",
+		}))
+	})
+
+	It("should escape the arguments of the command", func() {
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"echo","arg":["\"quoted\""],"capture-output":true}}`, domName).Return(`{"return":{"pid":42}}`, nil)
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, domName).Return(`{"return":{"exited":true,"exitcode":0}}`, nil)
+
+		result, err := GuestExec(mockConn, domName, "echo", []string{`"quoted"`}, "", 5*time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ExitCode).To(BeZero())
+	})
+
+	It("should fail when the command does not exit in time", func() {
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"sleep","arg":["100"],"capture-output":true}}`, domName).Return(`{"return":{"pid":42}}`, nil)
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec-status","arguments":{"pid":42}}`, domName).AnyTimes().Return(`{"return":{"exited":false}}`, nil)
+
+		_, err := GuestExec(mockConn, domName, "sleep", []string{"100"}, "", time.Second)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+	})
+
+	It("should fail when the guest agent returns an invalid pid", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domName).Return(`{"return":{"pid":0}}`, nil)
+
+		_, err := GuestExec(mockConn, domName, "true", nil, "", time.Second)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid pid"))
+	})
+
+	It("should fail when the guest agent is not responding", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domName).Return("", fmt.Errorf("agent not responding"))
+
+		_, err := GuestExec(mockConn, domName, "true", nil, "", time.Second)
+		Expect(err).To(MatchError("agent not responding"))
+	})
+})
@@ -12,7 +12,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/util/net/multus"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
@@ -87,6 +89,9 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) error {
 	injectOperatorMetadata(kv, &daemonSet.ObjectMeta, imageTag, imageRegistry, id, true)
 	injectOperatorMetadata(kv, &daemonSet.Spec.Template.ObjectMeta, imageTag, imageRegistry, id, false)
 	injectPlacementMetadata(kv.Spec.Workloads, &daemonSet.Spec.Template.Spec)
+	if daemonSet.GetName() == "virt-handler" {
+		injectMigrationNetwork(kv, &daemonSet.Spec.Template.ObjectMeta)
+	}
 
 	kvkey, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -135,6 +140,18 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) error {
 	return nil
 }
 
+// injectMigrationNetwork attaches virt-handler pods to the migration network, if one is configured
+func injectMigrationNetwork(kv *v1.KubeVirt, objectMeta *metav1.ObjectMeta) {
+	migrationConfig := kv.Spec.Configuration.MigrationConfiguration
+	if migrationConfig == nil || migrationConfig.Network == nil {
+		return
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = make(map[string]string)
+	}
+	objectMeta.Annotations[multus.NetworksAnnotation] = fmt.Sprintf("%s@%s", *migrationConfig.Network, v1.MigrationInterfaceName)
+}
+
 func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Deployment) error {
 	podDisruptionBudget := components.NewPodDisruptionBudgetForDeployment(deployment)
 
@@ -330,6 +330,27 @@ var _ = Describe("Apply Apps", func() {
 		})
 	})
 
+	Context("on calling injectMigrationNetwork", func() {
+		It("should not attach a network when no migration network is configured", func() {
+			kv := &v1.KubeVirt{}
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{}
+			objectMeta := metav1.ObjectMeta{}
+
+			injectMigrationNetwork(kv, &objectMeta)
+			Expect(objectMeta.Annotations).To(BeEmpty())
+		})
+
+		It("should attach the migration network as the migration interface", func() {
+			network := "migration-net"
+			kv := &v1.KubeVirt{}
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{Network: &network}
+			objectMeta := metav1.ObjectMeta{}
+
+			injectMigrationNetwork(kv, &objectMeta)
+			Expect(objectMeta.Annotations).To(HaveKeyWithValue("k8s.v1.cni.cncf.io/networks", "migration-net@migration0"))
+		})
+	})
+
 	Context("on calling injectPlacementMetadata", func() {
 		var componentConfig *v1.ComponentConfig
 		var nodePlacement *v1.NodePlacement
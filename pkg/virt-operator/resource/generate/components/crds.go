@@ -32,6 +32,10 @@ import (
 	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
 
 	virtv1 "kubevirt.io/client-go/api/v1"
+	clonev1alpha1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1alpha1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	poolv1alpha1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 )
 
@@ -40,15 +44,23 @@ const (
 )
 
 var (
-	VIRTUALMACHINE                   = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCE           = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEPRESET     = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
-	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
-	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
-	PreserveUnknownFieldsFalse       = false
+	VIRTUALMACHINE                    = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCE            = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEPRESET      = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEREPLICASET  = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEMIGRATION   = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
+	KUBEVIRT                          = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
+	VIRTUALMACHINESNAPSHOT            = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTCONTENT     = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE    = "virtualmachinesnapshotschedules." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEINSTANCETYPE        = instancetypev1alpha1.PluralResourceName + "." + instancetypev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLUSTERINSTANCETYPE = instancetypev1alpha1.ClusterPluralResourceName + "." + instancetypev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEPREFERENCE          = instancetypev1alpha1.PluralPreferenceResourceName + "." + instancetypev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLUSTERPREFERENCE   = instancetypev1alpha1.ClusterPluralPreferenceResourceName + "." + instancetypev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEPOOL                = poolv1alpha1.PluralResourceName + "." + poolv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLONE               = clonev1alpha1.PluralResourceName + "." + clonev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEEXPORT              = exportv1alpha1.PluralResourceName + "." + exportv1alpha1.SchemeGroupVersion.Group
+	PreserveUnknownFieldsFalse        = false
 )
 
 func patchValidation(crd *extv1beta1.CustomResourceDefinition) error {
@@ -418,6 +430,291 @@ func NewVirtualMachineRestoreCrd() (*extv1beta1.CustomResourceDefinition, error)
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   snapshotv1.SchemeGroupVersion.Group,
+		Version: snapshotv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+			{Name: "LastSnapshotTime", Type: "date", JSONPath: ".status.lastSnapshotTime"},
+			{Name: "NextSnapshotTime", Type: "date", JSONPath: ".status.nextSnapshotTime"},
+			{Name: "Error", Type: "string", JSONPath: ".status.error.message"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineInstancetypeCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEINSTANCETYPE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1alpha1.SchemeGroupVersion.Group,
+		Version: instancetypev1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetypev1alpha1.PluralResourceName,
+			Singular:   instancetypev1alpha1.SingularResourceName,
+			Kind:       instancetypev1alpha1.VirtualMachineInstancetypeKind,
+			ShortNames: []string{"vminstancetype", "vminstancetypes"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineClusterInstancetypeCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLUSTERINSTANCETYPE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1alpha1.SchemeGroupVersion.Group,
+		Version: instancetypev1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Cluster",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetypev1alpha1.ClusterPluralResourceName,
+			Singular:   instancetypev1alpha1.ClusterSingularResourceName,
+			Kind:       instancetypev1alpha1.VirtualMachineClusterInstancetypeKind,
+			ShortNames: []string{"vmclusterinstancetype", "vmclusterinstancetypes"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachinePreferenceCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEPREFERENCE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1alpha1.SchemeGroupVersion.Group,
+		Version: instancetypev1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetypev1alpha1.PluralPreferenceResourceName,
+			Singular:   instancetypev1alpha1.SingularPreferenceResourceName,
+			Kind:       instancetypev1alpha1.VirtualMachinePreferenceKind,
+			ShortNames: []string{"vmpref", "vmprefs", "vmp", "vmps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineClusterPreferenceCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLUSTERPREFERENCE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1alpha1.SchemeGroupVersion.Group,
+		Version: instancetypev1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Cluster",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetypev1alpha1.ClusterPluralPreferenceResourceName,
+			Singular:   instancetypev1alpha1.ClusterSingularPreferenceResourceName,
+			Kind:       instancetypev1alpha1.VirtualMachineClusterPreferenceKind,
+			ShortNames: []string{"vmcpref", "vmcprefs", "vmcp", "vmcps"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachinePoolCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+	labelSelector := ".status.labelSelector"
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEPOOL
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   poolv1alpha1.SchemeGroupVersion.Group,
+		Version: poolv1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    poolv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     poolv1alpha1.PluralResourceName,
+			Singular:   poolv1alpha1.SingularResourceName,
+			Kind:       poolv1alpha1.VirtualMachinePoolKind,
+			ShortNames: []string{"vmpool", "vmpools"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Desired", Type: "integer", JSONPath: ".spec.replicas",
+				Description: "Number of desired VirtualMachines"},
+			{Name: "Current", Type: "integer", JSONPath: ".status.replicas",
+				Description: "Number of managed and not deleted VirtualMachines"},
+			{Name: "Ready", Type: "integer", JSONPath: ".status.readyReplicas",
+				Description: "Number of managed VirtualMachines which are ready to receive traffic"},
+			{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+		},
+		Subresources: &extv1beta1.CustomResourceSubresources{
+			Scale: &extv1beta1.CustomResourceSubresourceScale{
+				SpecReplicasPath:   ".spec.replicas",
+				StatusReplicasPath: ".status.replicas",
+				LabelSelectorPath:  &labelSelector,
+			},
+			Status: &extv1beta1.CustomResourceSubresourceStatus{},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineCloneCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLONE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   clonev1alpha1.SchemeGroupVersion.Group,
+		Version: clonev1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    clonev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     clonev1alpha1.PluralResourceName,
+			Singular:   clonev1alpha1.SingularResourceName,
+			Kind:       clonev1alpha1.VirtualMachineCloneKind,
+			ShortNames: []string{"vmclone", "vmclones"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+			{Name: "SourceVirtualMachine", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "TargetVirtualMachine", Type: "string", JSONPath: ".status.targetName"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineExportCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEEXPORT
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   exportv1alpha1.SchemeGroupVersion.Group,
+		Version: exportv1alpha1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    exportv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     exportv1alpha1.PluralResourceName,
+			Singular:   exportv1alpha1.SingularResourceName,
+			Kind:       exportv1alpha1.VirtualMachineExportKind,
+			ShortNames: []string{"vmexport", "vmexports"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "SourceKind", Type: "string", JSONPath: ".spec.source.kind"},
+			{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+			{Name: "Expiration", Type: "date", JSONPath: ".status.ttlExpirationTime"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewServiceMonitorCR(namespace string, monitorNamespace string, insecureSkipVerify bool) *promv1.ServiceMonitor {
 	return &promv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
@@ -306,6 +306,9 @@ var CRDsValidation map[string]string = map[string]string{
             imagePullPolicy:
               description: PullPolicy describes a policy for if/when to pull a container image
               type: string
+            logSerialConsole:
+              description: LogSerialConsole is the default for logging the serial console of VMIs which do not set it themselves.
+              type: boolean
             machineType:
               type: string
             memBalloonStatsPeriod:
@@ -327,6 +330,9 @@ var CRDsValidation map[string]string = map[string]string{
                 completionTimeoutPerGiB:
                   format: int64
                   type: integer
+                network:
+                  description: Network is the name of a NetworkAttachmentDefinition virt-handler pods are attached to, migrations go over this network instead of the pod network.
+                  type: string
                 nodeDrainTaintKey:
                   type: string
                 parallelMigrationsPerCluster:
@@ -344,6 +350,40 @@ var CRDsValidation map[string]string = map[string]string{
             network:
               description: NetworkConfiguration holds network options
               properties:
+                binding:
+                  additionalProperties:
+                    description: InterfaceBindingPlugin is a network binding implemented by a sidecar in the virt-launcher pod
+                    properties:
+                      computeResourceOverhead:
+                        description: ComputeResourceOverhead is added to the resources of the compute container of the virt-launcher pod, to request the devices or the additional memory the binding needs.
+                        properties:
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                            type: object
+                        type: object
+                      sidecarImage:
+                        description: SidecarImage is the image of the sidecar which implements the binding. It is added to the virt-launcher pod of every VMI with an interface using the binding.
+                        type: string
+                    required:
+                    - sidecarImage
+                    type: object
+                  description: Binding holds the network binding plugins registered in the cluster, by name. Interfaces refer to them in their binding field.
+                  type: object
                 defaultNetworkInterface:
                   type: string
                 permitBridgeInterfaceOnPodNetwork:
@@ -408,6 +448,8 @@ var CRDsValidation map[string]string = map[string]string{
               items:
                 type: string
               type: array
+            vmStateStorageClass:
+              type: string
           type: object
         customizeComponents:
           properties:
@@ -1498,6 +1540,36 @@ var CRDsValidation map[string]string = map[string]string{
             - spec
             type: object
           type: array
+        instancetype:
+          description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+          properties:
+            kind:
+              description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+              type: string
+            name:
+              description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+              type: string
+            revisionName:
+              description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+              type: string
+          required:
+          - name
+          type: object
+        preference:
+          description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+          properties:
+            kind:
+              description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+              type: string
+            name:
+              description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+              type: string
+            revisionName:
+              description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the preference is applied to the VirtualMachineInstance.
+              type: string
+          required:
+          - name
+          type: object
         runStrategy:
           description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
           type: string
@@ -2068,6 +2140,10 @@ var CRDsValidation map[string]string = map[string]string{
                         isolateEmulatorThread:
                           description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
                           type: boolean
+                        maxSockets:
+                          description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                          format: int32
+                          type: integer
                         model:
                           description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
                           type: string
@@ -2095,6 +2171,9 @@ var CRDsValidation map[string]string = map[string]string{
                         autoattachSerialConsole:
                           description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
                           type: boolean
+                        autoattachVSOCK:
+                          description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
+                          type: boolean
                         blockMultiQueue:
                           description: Whether or not to enable virtio multi-queue for block devices
                           type: boolean
@@ -2244,6 +2323,73 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Interfaces describe network interfaces which are added to the vmi.
                           items:
                             properties:
+                              bandwidth:
+                                description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                                properties:
+                                  name:
+                                    description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                                    type: string
+                                required:
+                                - name
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                                 type: integer
@@ -2293,6 +2439,9 @@ var CRDsValidation map[string]string = map[string]string{
                               name:
                                 description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
                                 type: string
+                              passt:
+                                description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                                type: object
                               pciAddress:
                                 description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
                                 type: string
@@ -2326,12 +2475,22 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        logSerialConsole:
+                          description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
+                          type: boolean
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                           type: boolean
                         rng:
                           description: Whether to have random number generator from host
                           type: object
+                        tpm:
+                          description: Whether to emulate a TPM device.
+                          properties:
+                            persistent:
+                              description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                              type: boolean
+                          type: object
                         useVirtioTransitional:
                           description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
                           type: boolean
@@ -2512,6 +2671,9 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                  type: boolean
                                 secureBoot:
                                   description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
                                   type: boolean
@@ -2553,6 +2715,13 @@ var CRDsValidation map[string]string = map[string]string{
                               description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
                           type: object
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
                       type: object
                     resources:
                       description: Resources describes the Compute Resources required by this vmi.
@@ -2971,6 +3140,9 @@ var CRDsValidation map[string]string = map[string]string{
                             description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
                             type: string
                         type: object
+                      downwardMetrics:
+                        description: DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                        type: object
                       emptyDisk:
                         description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
                         properties:
@@ -3023,6 +3195,18 @@ var CRDsValidation map[string]string = map[string]string{
                         - path
                         - type
                         type: object
+                      memoryDump:
+                        description: MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+                        properties:
+                          claimName:
+                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          readOnly:
+                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
                       name:
                         description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
                         type: string
@@ -3058,6 +3242,24 @@ var CRDsValidation map[string]string = map[string]string{
                             description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
                             type: string
                         type: object
+                      sysprep:
+                        description: 'Sysprep represents a Sysprep answer file for the unattended setup of Windows. The answer file will be added as a CD-ROM to the vmi. More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview'
+                        properties:
+                          configMap:
+                            description: ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secret:
+                            description: Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                        type: object
                     required:
                     - name
                     type: object
@@ -3101,6 +3303,66 @@ var CRDsValidation map[string]string = map[string]string{
         created:
           description: Created indicates if the virtual machine is created in the cluster
           type: boolean
+        interfaceRequests:
+          description: InterfaceRequests indicates a list of interfaces to add or remove from the VMI template and hotplug on an active running VMI.
+          items:
+            properties:
+              addInterfaceOptions:
+                description: AddInterfaceOptions when set indicates an interface should be added. The details within this field specify how to add the interface
+                properties:
+                  name:
+                    description: Name is the name of both the interface and the network which are added
+                    type: string
+                  networkAttachmentDefinitionName:
+                    description: NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition, either as <name> in the namespace of the VMI or as <namespace>/<name>
+                    type: string
+                required:
+                - name
+                - networkAttachmentDefinitionName
+                type: object
+              removeInterfaceOptions:
+                description: RemoveInterfaceOptions when set indicates an interface should be removed. The details within this field specify which interface to remove
+                properties:
+                  name:
+                    description: Name is the name of both the interface and the network which are removed
+                    type: string
+                required:
+                - name
+                type: object
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        memoryDumpRequest:
+          description: MemoryDumpRequest tracks memory dump request phase and info of getting a memory dump to the given pvc
+          nullable: true
+          properties:
+            claimName:
+              description: ClaimName is the name of the pvc that will contain the memory dump
+              type: string
+            endTimestamp:
+              description: EndTimestamp represents the time the memory dump was completed
+              format: date-time
+              type: string
+            fileName:
+              description: FileName represents the name of the output file
+              type: string
+            message:
+              description: Message is a detailed message about failure of the memory dump
+              type: string
+            phase:
+              description: Phase represents the memory dump phase
+              type: string
+            remove:
+              description: Remove represents request of dissociating the memory dump pvc
+              type: boolean
+            startTimestamp:
+              description: StartTimestamp represents the time the memory dump started
+              format: date-time
+              type: string
+          required:
+          - claimName
+          - phase
+          type: object
         ready:
           description: Ready indicates if the virtual machine is running and ready
           type: boolean
@@ -3274,8 +3536,8 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineinstance": `openAPIV3Schema:
-  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone defines the operation of cloning a VM into a new VM
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
@@ -3286,93 +3548,549 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+      description: VirtualMachineCloneSpec is the spec for a VirtualMachineClone resource
       properties:
-        accessCredentials:
-          description: Specifies a set of public keys to inject into the vm guest
+        annotationFilters:
+          description: 'AnnotationFilters selects which annotations of the source are copied to the target. Filters are evaluated in order and may contain "*" wildcards, a leading "!" excludes matching keys. Example use: ["*", "!some/key*"]. If empty, all annotations are copied.'
           items:
-            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
-            properties:
-              sshPublicKey:
-                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
-                properties:
-                  propagationMethod:
-                    description: PropagationMethod represents how the public key is injected into the vm guest.
-                    properties:
-                      configDrive:
-                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
-                        type: object
-                      qemuGuestAgent:
-                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                        properties:
-                          users:
-                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
-                            items:
-                              type: string
-                            type: array
-                            x-kubernetes-list-type: set
-                        required:
-                        - users
-                        type: object
-                    type: object
-                  source:
-                    description: Source represents where the public keys are pulled from
-                    properties:
-                      secret:
-                        description: Secret means that the access credential is pulled from a kubernetes secret
-                        properties:
-                          secretName:
-                            description: SecretName represents the name of the secret in the VMI's namespace
-                            type: string
-                        required:
-                        - secretName
-                        type: object
-                    type: object
-                required:
-                - propagationMethod
-                - source
-                type: object
-              userPassword:
-                description: UserPassword represents the source and method for applying a guest user's password
-                properties:
-                  propagationMethod:
-                    description: propagationMethod represents how the user passwords are injected into the vm guest.
-                    properties:
-                      qemuGuestAgent:
-                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                        type: object
-                    type: object
-                  source:
-                    description: Source represents where the user passwords are pulled from
-                    properties:
-                      secret:
-                        description: Secret means that the access credential is pulled from a kubernetes secret
-                        properties:
-                          secretName:
-                            description: SecretName represents the name of the secret in the VMI's namespace
-                            type: string
-                        required:
-                        - secretName
-                        type: object
-                    type: object
-                required:
-                - propagationMethod
-                - source
-                type: object
-            type: object
+            type: string
           type: array
-          x-kubernetes-list-type: atomic
-        affinity:
-          description: If affinity is specifies, obey all the affinity rules
+        labelFilters:
+          description: LabelFilters selects which labels of the source are copied to the target, using the same syntax as AnnotationFilters.
+          items:
+            type: string
+          type: array
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the value is the new mac address. If this field is not specified, a new MAC address will be generated automatically, as for any interface that is not included in this map.
+          type: object
+        newSMBiosSerial:
+          description: NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will be generated automatically.
+          type: string
+        source:
+          description: 'Source is the object that would be cloned. Currently supported source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot of snapshot.kubevirt.io API group'
           properties:
-            nodeAffinity:
-              description: Describes node affinity scheduling rules for the pod.
-              properties:
-                preferredDuringSchedulingIgnoredDuringExecution:
-                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
-                  items:
-                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
-                    properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        target:
+          description: 'Target is the outcome of the cloning process. Currently supported target types are: VirtualMachine of kubevirt.io API group. If the name is empty, a name is generated for the new VirtualMachine.'
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineCloneStatus is the status for a VirtualMachineClone resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        phase:
+          description: VirtualMachineClonePhase is the current phase of the VirtualMachineClone
+          type: string
+        snapshotName:
+          type: string
+        targetName:
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterinstancetype": `openAPIV3Schema:
+  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype resource.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: Required number of vCPUs to expose to the guest. The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+              type: boolean
+            model:
+              description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+              type: string
+          required:
+          - guest
+          type: object
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+          required:
+          - guest
+          type: object
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterpreference": `openAPIV3Schema:
+  description: VirtualMachineClusterPreference is a cluster scoped version of the VirtualMachinePreference resource.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        cpu:
+          description: CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredCPUTopology:
+              description: PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+              type: string
+          type: object
+        devices:
+          description: Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAutoattachGraphicsDevice:
+              description: PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+              type: boolean
+            preferredAutoattachSerialConsole:
+              description: PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+              type: boolean
+            preferredCdromBus:
+              description: PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+              type: string
+            preferredDiskBus:
+              description: PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+              type: string
+            preferredInterfaceModel:
+              description: PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+              type: string
+            preferredLunBus:
+              description: PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+              type: string
+          type: object
+        firmware:
+          description: Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredUseBios:
+              description: PreferredUseBios optionally enables BIOS
+              type: boolean
+            preferredUseEfi:
+              description: PreferredUseEfi optionally enables EFI
+              type: boolean
+            preferredUseSecureBoot:
+              description: PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones.
+              type: boolean
+          type: object
+        machine:
+          description: Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredMachineType:
+              description: PreferredMachineType optionally defines the preferred machine type to use.
+              type: string
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineexport": `openAPIV3Schema:
+  description: VirtualMachineExport defines the operation of exporting a VM, PVC or VirtualMachineSnapshot
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineExportSpec is the spec for a VirtualMachineExport resource
+      properties:
+        source:
+          description: 'Source is the object that is exported. Currently supported source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot of snapshot.kubevirt.io API group, PersistentVolumeClaim of the core API group'
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        tokenSecretRef:
+          description: TokenSecretRef is the name of a Secret holding the token needed to access the export under the "token" key. If empty, a Secret with a random token is generated.
+          type: string
+        ttlDuration:
+          description: TTLDuration limits the lifetime of the export, the export is deleted once it expires. Defaults to two hours.
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineExportStatus is the status for a VirtualMachineExport resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        links:
+          description: VirtualMachineExportLinks contains the links to the exported objects
+          properties:
+            external:
+              description: External links are reachable from outside the cluster through an Ingress
+              properties:
+                cert:
+                  description: Cert is the PEM encoded CA certificate the exporter's certificate is signed with
+                  type: string
+                manifests:
+                  description: Manifests lists the exported manifests
+                  items:
+                    description: VirtualMachineExportManifest contains the link to an exported manifest
+                    properties:
+                      type:
+                        description: ExportManifestType is the type of an exported manifest
+                        type: string
+                      url:
+                        type: string
+                    required:
+                    - type
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - type
+                  x-kubernetes-list-type: map
+                volumes:
+                  description: Volumes lists the exported volumes
+                  items:
+                    description: VirtualMachineExportVolume contains the links to one exported volume
+                    properties:
+                      formats:
+                        items:
+                          description: VirtualMachineExportVolumeFormat contains the link to a volume in a specific format
+                          properties:
+                            format:
+                              description: ExportVolumeFormat is the format of an exported volume image
+                              type: string
+                            url:
+                              type: string
+                          required:
+                          - format
+                          - url
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - format
+                        x-kubernetes-list-type: map
+                      name:
+                        description: Name is the name of the exported volume
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              required:
+              - cert
+              type: object
+            internal:
+              description: Internal links are reachable from within the cluster
+              properties:
+                cert:
+                  description: Cert is the PEM encoded CA certificate the exporter's certificate is signed with
+                  type: string
+                manifests:
+                  description: Manifests lists the exported manifests
+                  items:
+                    description: VirtualMachineExportManifest contains the link to an exported manifest
+                    properties:
+                      type:
+                        description: ExportManifestType is the type of an exported manifest
+                        type: string
+                      url:
+                        type: string
+                    required:
+                    - type
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - type
+                  x-kubernetes-list-type: map
+                volumes:
+                  description: Volumes lists the exported volumes
+                  items:
+                    description: VirtualMachineExportVolume contains the links to one exported volume
+                    properties:
+                      formats:
+                        items:
+                          description: VirtualMachineExportVolumeFormat contains the link to a volume in a specific format
+                          properties:
+                            format:
+                              description: ExportVolumeFormat is the format of an exported volume image
+                              type: string
+                            url:
+                              type: string
+                          required:
+                          - format
+                          - url
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - format
+                        x-kubernetes-list-type: map
+                      name:
+                        description: Name is the name of the exported volume
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              required:
+              - cert
+              type: object
+          type: object
+        phase:
+          description: VirtualMachineExportPhase is the current phase of the VirtualMachineExport
+          type: string
+        serviceName:
+          description: ServiceName is the name of the Service in front of the exporter
+          type: string
+        tokenSecretRef:
+          description: TokenSecretRef is the name of the Secret holding the export token
+          type: string
+        ttlExpirationTime:
+          description: TTLExpirationTime is the time the export is deleted
+          format: date-time
+          nullable: true
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the exported VirtualMachine, if any
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstance": `openAPIV3Schema:
+  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+      properties:
+        accessCredentials:
+          description: Specifies a set of public keys to inject into the vm guest
+          items:
+            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+            properties:
+              sshPublicKey:
+                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                properties:
+                  propagationMethod:
+                    description: PropagationMethod represents how the public key is injected into the vm guest.
+                    properties:
+                      configDrive:
+                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
+                        type: object
+                      qemuGuestAgent:
+                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                        properties:
+                          users:
+                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: set
+                        required:
+                        - users
+                        type: object
+                    type: object
+                  source:
+                    description: Source represents where the public keys are pulled from
+                    properties:
+                      secret:
+                        description: Secret means that the access credential is pulled from a kubernetes secret
+                        properties:
+                          secretName:
+                            description: SecretName represents the name of the secret in the VMI's namespace
+                            type: string
+                        required:
+                        - secretName
+                        type: object
+                    type: object
+                required:
+                - propagationMethod
+                - source
+                type: object
+              userPassword:
+                description: UserPassword represents the source and method for applying a guest user's password
+                properties:
+                  propagationMethod:
+                    description: propagationMethod represents how the user passwords are injected into the vm guest.
+                    properties:
+                      qemuGuestAgent:
+                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                        type: object
+                    type: object
+                  source:
+                    description: Source represents where the user passwords are pulled from
+                    properties:
+                      secret:
+                        description: Secret means that the access credential is pulled from a kubernetes secret
+                        properties:
+                          secretName:
+                            description: SecretName represents the name of the secret in the VMI's namespace
+                            type: string
+                        required:
+                        - secretName
+                        type: object
+                    type: object
+                required:
+                - propagationMethod
+                - source
+                type: object
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        affinity:
+          description: If affinity is specifies, obey all the affinity rules
+          properties:
+            nodeAffinity:
+              description: Describes node affinity scheduling rules for the pod.
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
+                  items:
+                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                    properties:
                       preference:
                         description: A node selector term, associated with the corresponding weight.
                         properties:
@@ -3842,6 +4560,10 @@ var CRDsValidation map[string]string = map[string]string{
                 isolateEmulatorThread:
                   description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
                   type: boolean
+                maxSockets:
+                  description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                  format: int32
+                  type: integer
                 model:
                   description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
                   type: string
@@ -3869,6 +4591,9 @@ var CRDsValidation map[string]string = map[string]string{
                 autoattachSerialConsole:
                   description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
                   type: boolean
+                autoattachVSOCK:
+                  description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
+                  type: boolean
                 blockMultiQueue:
                   description: Whether or not to enable virtio multi-queue for block devices
                   type: boolean
@@ -4018,6 +4743,73 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Interfaces describe network interfaces which are added to the vmi.
                   items:
                     properties:
+                      bandwidth:
+                        description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                        properties:
+                          name:
+                            description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                            type: string
+                        required:
+                        - name
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                         type: integer
@@ -4067,6 +4859,9 @@ var CRDsValidation map[string]string = map[string]string{
                       name:
                         description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
                         type: string
+                      passt:
+                        description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                        type: object
                       pciAddress:
                         description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
                         type: string
@@ -4100,12 +4895,22 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                logSerialConsole:
+                  description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
+                  type: boolean
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                   type: boolean
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                tpm:
+                  description: Whether to emulate a TPM device.
+                  properties:
+                    persistent:
+                      description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                      type: boolean
+                  type: object
                 useVirtioTransitional:
                   description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
                   type: boolean
@@ -4286,6 +5091,9 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                          type: boolean
                         secureBoot:
                           description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
                           type: boolean
@@ -4304,789 +5112,1723 @@ var CRDsValidation map[string]string = map[string]string{
             machine:
               description: Machine type.
               properties:
-                type:
-                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                type:
+                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                  type: string
+              required:
+              - type
+              type: object
+            memory:
+              description: Memory allow specifying the VMI memory features.
+              properties:
+                guest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                hugepages:
+                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                  properties:
+                    pageSize:
+                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                      type: string
+                  type: object
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
+            resources:
+              description: Resources describes the Compute Resources required by this vmi.
+              properties:
+                limits:
+                  additionalProperties:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                  type: object
+                overcommitGuestOverhead:
+                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
+                  type: boolean
+                requests:
+                  additionalProperties:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                  type: object
+              type: object
+          required:
+          - devices
+          type: object
+        evictionStrategy:
+          description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
+          type: string
+        hostname:
+          description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+          type: string
+        livenessProbe:
+          description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+          properties:
+            failureThreshold:
+              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+              format: int32
+              type: integer
+            httpGet:
+              description: HTTPGet specifies the http request to perform.
+              properties:
+                host:
+                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                  type: string
+                httpHeaders:
+                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                  items:
+                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                    properties:
+                      name:
+                        description: The header field name
+                        type: string
+                      value:
+                        description: The header field value
+                        type: string
+                    required:
+                    - name
+                    - value
+                    type: object
+                  type: array
+                path:
+                  description: Path to access on the HTTP server.
+                  type: string
+                port:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                  x-kubernetes-int-or-string: true
+                scheme:
+                  description: Scheme to use for connecting to the host. Defaults to HTTP.
+                  type: string
+              required:
+              - port
+              type: object
+            initialDelaySeconds:
+              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+              format: int32
+              type: integer
+            periodSeconds:
+              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+              format: int32
+              type: integer
+            successThreshold:
+              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+              format: int32
+              type: integer
+            tcpSocket:
+              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+              properties:
+                host:
+                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                  type: string
+                port:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                  x-kubernetes-int-or-string: true
+              required:
+              - port
+              type: object
+            timeoutSeconds:
+              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+              format: int32
+              type: integer
+          type: object
+        networks:
+          description: List of networks that can be attached to a vm's virtual interface.
+          items:
+            description: Network represents a network type and a resource that should be connected to the vm.
+            properties:
+              multus:
+                description: Represents the multus cni network.
+                properties:
+                  default:
+                    description: Select the default network and add it to the multus-cni.io/default-network annotation.
+                    type: boolean
+                  networkName:
+                    description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                    type: string
+                required:
+                - networkName
+                type: object
+              name:
+                description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                type: string
+              pod:
+                description: Represents the stock pod network interface.
+                properties:
+                  vmNetworkCIDR:
+                    description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                    type: string
+                type: object
+            required:
+            - name
+            type: object
+          type: array
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+          type: object
+        priorityClassName:
+          description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+          type: string
+        readinessProbe:
+          description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+          properties:
+            failureThreshold:
+              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+              format: int32
+              type: integer
+            httpGet:
+              description: HTTPGet specifies the http request to perform.
+              properties:
+                host:
+                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                  type: string
+                httpHeaders:
+                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                  items:
+                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                    properties:
+                      name:
+                        description: The header field name
+                        type: string
+                      value:
+                        description: The header field value
+                        type: string
+                    required:
+                    - name
+                    - value
+                    type: object
+                  type: array
+                path:
+                  description: Path to access on the HTTP server.
+                  type: string
+                port:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                  x-kubernetes-int-or-string: true
+                scheme:
+                  description: Scheme to use for connecting to the host. Defaults to HTTP.
                   type: string
               required:
-              - type
+              - port
               type: object
-            memory:
-              description: Memory allow specifying the VMI memory features.
+            initialDelaySeconds:
+              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+              format: int32
+              type: integer
+            periodSeconds:
+              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+              format: int32
+              type: integer
+            successThreshold:
+              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+              format: int32
+              type: integer
+            tcpSocket:
+              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
               properties:
-                guest:
+                host:
+                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                  type: string
+                port:
                   anyOf:
                   - type: integer
                   - type: string
-                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
-                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
                   x-kubernetes-int-or-string: true
-                hugepages:
-                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
-                  properties:
-                    pageSize:
-                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
-                      type: string
-                  type: object
+              required:
+              - port
               type: object
-            resources:
-              description: Resources describes the Compute Resources required by this vmi.
-              properties:
-                limits:
-                  additionalProperties:
+            timeoutSeconds:
+              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+              format: int32
+              type: integer
+          type: object
+        schedulerName:
+          description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
+          type: string
+        subdomain:
+          description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
+          type: string
+        terminationGracePeriodSeconds:
+          description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
+          format: int64
+          type: integer
+        tolerations:
+          description: If toleration is specified, obey all the toleration rules.
+          items:
+            description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
+            properties:
+              effect:
+                description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                type: string
+              key:
+                description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                type: string
+              operator:
+                description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
+                type: string
+              tolerationSeconds:
+                description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
+                format: int64
+                type: integer
+              value:
+                description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
+                type: string
+            type: object
+          type: array
+        volumes:
+          description: List of volumes that can be mounted by disks belonging to the vmi.
+          items:
+            description: Volume represents a named volume in a vmi.
+            properties:
+              cloudInitConfigDrive:
+                description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                properties:
+                  networkData:
+                    description: NetworkData contains config drive inline cloud-init networkdata.
+                    type: string
+                  networkDataBase64:
+                    description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                    type: string
+                  networkDataSecretRef:
+                    description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                  secretRef:
+                    description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                  userData:
+                    description: UserData contains config drive inline cloud-init userdata.
+                    type: string
+                  userDataBase64:
+                    description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
+                    type: string
+                type: object
+              cloudInitNoCloud:
+                description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                properties:
+                  networkData:
+                    description: NetworkData contains NoCloud inline cloud-init networkdata.
+                    type: string
+                  networkDataBase64:
+                    description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
+                    type: string
+                  networkDataSecretRef:
+                    description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                  secretRef:
+                    description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                  userData:
+                    description: UserData contains NoCloud inline cloud-init userdata.
+                    type: string
+                  userDataBase64:
+                    description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
+                    type: string
+                type: object
+              configMap:
+                description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                properties:
+                  name:
+                    description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                    type: string
+                  optional:
+                    description: Specify whether the ConfigMap or it's keys must be defined
+                    type: boolean
+                  volumeLabel:
+                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                    type: string
+                type: object
+              containerDisk:
+                description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                properties:
+                  image:
+                    description: Image is the name of the image with the embedded disk.
+                    type: string
+                  imagePullPolicy:
+                    description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                    type: string
+                  imagePullSecret:
+                    description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
+                    type: string
+                  path:
+                    description: Path defines the path to disk file in the container
+                    type: string
+                required:
+                - image
+                type: object
+              dataVolume:
+                description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                properties:
+                  name:
+                    description: Name represents the name of the DataVolume in the same namespace
+                    type: string
+                required:
+                - name
+                type: object
+              downwardAPI:
+                description: DownwardAPI represents downward API about the pod that should populate this volume
+                properties:
+                  fields:
+                    description: Fields is a list of downward API volume file
+                    items:
+                      description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                      properties:
+                        fieldRef:
+                          description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                          properties:
+                            apiVersion:
+                              description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
+                              type: string
+                            fieldPath:
+                              description: Path of the field to select in the specified API version.
+                              type: string
+                          required:
+                          - fieldPath
+                          type: object
+                        mode:
+                          description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                          format: int32
+                          type: integer
+                        path:
+                          description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                          type: string
+                        resourceFieldRef:
+                          description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                          properties:
+                            containerName:
+                              description: 'Container name: required for volumes, optional for env vars'
+                              type: string
+                            divisor:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: Specifies the output format of the exposed resources, defaults to "1"
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            resource:
+                              description: 'Required: resource to select'
+                              type: string
+                          required:
+                          - resource
+                          type: object
+                      required:
+                      - path
+                      type: object
+                    type: array
+                  volumeLabel:
+                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                    type: string
+                type: object
+              downwardMetrics:
+                description: DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                type: object
+              emptyDisk:
+                description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                properties:
+                  capacity:
                     anyOf:
                     - type: integer
                     - type: string
+                    description: Capacity of the sparse disk.
                     pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                     x-kubernetes-int-or-string: true
-                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
-                  type: object
-                overcommitGuestOverhead:
-                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
-                  type: boolean
-                requests:
-                  additionalProperties:
+                required:
+                - capacity
+                type: object
+              ephemeral:
+                description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
+                properties:
+                  persistentVolumeClaim:
+                    description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                    properties:
+                      claimName:
+                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                        type: string
+                      readOnly:
+                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                        type: boolean
+                    required:
+                    - claimName
+                    type: object
+                type: object
+              hostDisk:
+                description: HostDisk represents a disk created on the cluster level
+                properties:
+                  capacity:
                     anyOf:
                     - type: integer
                     - type: string
+                    description: Capacity of the sparse disk
                     pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                     x-kubernetes-int-or-string: true
-                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
-                  type: object
-              type: object
-          required:
-          - devices
+                  path:
+                    description: The path to HostDisk image located on the cluster
+                    type: string
+                  shared:
+                    description: Shared indicate whether the path is shared between nodes
+                    type: boolean
+                  type:
+                    description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
+                    type: string
+                required:
+                - path
+                - type
+                type: object
+              memoryDump:
+                description: MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+                properties:
+                  claimName:
+                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                    type: string
+                  readOnly:
+                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                    type: boolean
+                required:
+                - claimName
+                type: object
+              name:
+                description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                type: string
+              persistentVolumeClaim:
+                description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                properties:
+                  claimName:
+                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                    type: string
+                  readOnly:
+                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                    type: boolean
+                required:
+                - claimName
+                type: object
+              secret:
+                description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                properties:
+                  optional:
+                    description: Specify whether the Secret or it's keys must be defined
+                    type: boolean
+                  secretName:
+                    description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                    type: string
+                  volumeLabel:
+                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                    type: string
+                type: object
+              serviceAccount:
+                description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                properties:
+                  serviceAccountName:
+                    description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                    type: string
+                type: object
+              sysprep:
+                description: 'Sysprep represents a Sysprep answer file for the unattended setup of Windows. The answer file will be added as a CD-ROM to the vmi. More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview'
+                properties:
+                  configMap:
+                    description: ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                  secret:
+                    description: Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                    properties:
+                      name:
+                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        type: string
+                    type: object
+                type: object
+            required:
+            - name
+            type: object
+          type: array
+      required:
+      - domain
+      type: object
+    status:
+      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
+      properties:
+        activePods:
+          additionalProperties:
+            type: string
+          description: ActivePods is a mapping of pod UID to node name. It is possible for multiple pods to be running for a single VMI during migration.
+          type: object
+        conditions:
+          description: Conditions are specific points in VirtualMachineInstance's pod runtime.
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        currentCPUTopology:
+          description: CurrentCPUTopology specifies the current CPU topology used by the VM workload. The number of sockets can differ from spec.domain.cpu.sockets while sockets are being hotplugged.
+          properties:
+            cores:
+              description: Cores specifies the number of cores inside the vmi.
+              format: int32
+              type: integer
+            sockets:
+              description: Sockets specifies the number of sockets inside the vmi.
+              format: int32
+              type: integer
+            threads:
+              description: Threads specifies the number of threads inside the vmi.
+              format: int32
+              type: integer
+          type: object
+        evacuationNodeName:
+          description: EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
+          type: string
+        guestOSInfo:
+          description: Guest OS Information
+          properties:
+            id:
+              description: Guest OS Id
+              type: string
+            kernelRelease:
+              description: Guest OS Kernel Release
+              type: string
+            kernelVersion:
+              description: Kernel version of the Guest OS
+              type: string
+            machine:
+              description: Machine type of the Guest OS
+              type: string
+            name:
+              description: Name of the Guest OS
+              type: string
+            prettyName:
+              description: Guest OS Pretty Name
+              type: string
+            version:
+              description: Guest OS Version
+              type: string
+            versionId:
+              description: Version ID of the Guest OS
+              type: string
+          type: object
+        interfaces:
+          description: Interfaces represent the details of available network interfaces.
+          items:
+            properties:
+              bandwidth:
+                description: The bandwidth limits applied to the interface
+                properties:
+                  inbound:
+                    description: Inbound limits the traffic received by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average rate in bytes per second, at least 1Ki.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                  outbound:
+                    description: Outbound limits the traffic sent by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average rate in bytes per second, at least 1Ki.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                type: object
+              interfaceName:
+                description: The interface name inside the Virtual Machine
+                type: string
+              ipAddress:
+                description: IP address of a Virtual Machine interface. It is always the first item of IPs
+                type: string
+              ipAddresses:
+                description: List of all IP addresses of a Virtual Machine interface
+                items:
+                  type: string
+                type: array
+              mac:
+                description: Hardware address of a Virtual Machine interface
+                type: string
+              name:
+                description: 'Name of the interface, corresponds to name of the network assigned to the interface TODO: remove omitempty, when api breaking changes are allowed'
+                type: string
+              podInterfaceName:
+                description: The name of the pod interface of a secondary multus network, set once the interface is reported in the network status of the pod
+                type: string
+            type: object
+          type: array
+        memory:
+          description: Memory shows the status of the guest memory of VMIs with hotpluggable memory.
+          properties:
+            guestAtBoot:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestAtBoot specifies the guest memory the VMI was started with.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            guestCurrent:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestCurrent specifies the guest memory currently plugged into the VMI.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            guestRequested:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestRequested specifies the guest memory requested to be plugged into the VMI. It follows spec.domain.memory.guest as soon as the virt-launcher pod can accommodate it.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
           type: object
-        evictionStrategy:
-          description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
-          type: string
-        hostname:
-          description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+        migrationMethod:
+          description: 'Represents the method using which the vmi can be migrated: live migration or block migration'
           type: string
-        livenessProbe:
-          description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+        migrationState:
+          description: Represents the status of a live migration
           properties:
-            failureThreshold:
-              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
-              format: int32
-              type: integer
-            httpGet:
-              description: HTTPGet specifies the http request to perform.
-              properties:
-                host:
-                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
-                  type: string
-                httpHeaders:
-                  description: Custom headers to set in the request. HTTP allows repeated headers.
-                  items:
-                    description: HTTPHeader describes a custom header to be used in HTTP probes
-                    properties:
-                      name:
-                        description: The header field name
-                        type: string
-                      value:
-                        description: The header field value
-                        type: string
-                    required:
-                    - name
-                    - value
-                    type: object
-                  type: array
-                path:
-                  description: Path to access on the HTTP server.
-                  type: string
-                port:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                  x-kubernetes-int-or-string: true
-                scheme:
-                  description: Scheme to use for connecting to the host. Defaults to HTTP.
-                  type: string
-              required:
-              - port
-              type: object
-            initialDelaySeconds:
-              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-              format: int32
-              type: integer
-            periodSeconds:
-              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
-              format: int32
-              type: integer
-            successThreshold:
-              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-              format: int32
-              type: integer
-            tcpSocket:
-              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
-              properties:
-                host:
-                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
-                  type: string
-                port:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                  x-kubernetes-int-or-string: true
-              required:
-              - port
+            abortRequested:
+              description: Indicates that the migration has been requested to abort
+              type: boolean
+            abortStatus:
+              description: Indicates the final status of the live migration abortion
+              type: string
+            completed:
+              description: Indicates the migration completed
+              type: boolean
+            endTimestamp:
+              description: The time the migration action ended
+              format: date-time
+              nullable: true
+              type: string
+            failed:
+              description: Indicates that the migration failed
+              type: boolean
+            migrationUid:
+              description: The VirtualMachineInstanceMigration object associated with this migration
+              type: string
+            mode:
+              description: Lets us know if the vmi is currently running pre or post copy migration
+              type: string
+            sourceNode:
+              description: The source node that the VMI originated on
+              type: string
+            startTimestamp:
+              description: The time the migration action began
+              format: date-time
+              nullable: true
+              type: string
+            targetDirectMigrationNodePorts:
+              additionalProperties:
+                type: integer
+              description: The list of ports opened for live migration on the destination node
               type: object
-            timeoutSeconds:
-              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-              format: int32
-              type: integer
+            targetNode:
+              description: The target node that the VMI is moving to
+              type: string
+            targetNodeAddress:
+              description: The address of the target node to use for the migration
+              type: string
+            targetNodeDomainDetected:
+              description: The Target Node has seen the Domain Start Event
+              type: boolean
+            targetPod:
+              description: The target pod that the VMI is moving to
+              type: string
           type: object
-        networks:
-          description: List of networks that can be attached to a vm's virtual interface.
+        nodeName:
+          description: NodeName is the name where the VirtualMachineInstance is currently running.
+          type: string
+        phase:
+          description: Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.
+          type: string
+        qosClass:
+          description: 'The Quality of Service (QOS) classification assigned to the virtual machine instance based on resource requirements See PodQOSClass type for available QOS classes More info: https://git.k8s.io/community/contributors/design-proposals/node/resource-qos.md'
+          type: string
+        reason:
+          description: A brief CamelCase message indicating details about why the VMI is in this state. e.g. 'NodeUnresponsive'
+          type: string
+        volumeStatus:
+          description: VolumeStatus contains the statuses of all the volumes
           items:
-            description: Network represents a network type and a resource that should be connected to the vm.
+            description: VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.
             properties:
-              multus:
-                description: Represents the multus cni network.
+              hotplugVolume:
+                description: If the volume is hotplug, this will contain the hotplug status.
                 properties:
-                  default:
-                    description: Select the default network and add it to the multus-cni.io/default-network annotation.
-                    type: boolean
-                  networkName:
-                    description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                  attachPodName:
+                    description: AttachPodName is the name of the pod used to attach the volume to the node.
+                    type: string
+                  attachPodUID:
+                    description: AttachPodUID is the UID of the pod used to attach the volume to the node.
                     type: string
-                required:
-                - networkName
                 type: object
-              name:
-                description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                type: string
-              pod:
-                description: Represents the stock pod network interface.
+              memoryDumpVolume:
+                description: If the volume is memorydump volume, this will contain the memorydump info.
                 properties:
-                  vmNetworkCIDR:
-                    description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                  claimName:
+                    description: ClaimName is the name of the pvc the memory was dumped to
+                    type: string
+                  endTimestamp:
+                    description: EndTimestamp is the time when the memory dump completed
+                    format: date-time
+                    type: string
+                  startTimestamp:
+                    description: StartTimestamp is the time when the memory dump started
+                    format: date-time
+                    type: string
+                  targetFileName:
+                    description: TargetFileName is the name of the memory dump output
                     type: string
                 type: object
+              message:
+                description: Message is a detailed message about the current hotplug volume phase
+                type: string
+              name:
+                description: Name is the name of the volume
+                type: string
+              phase:
+                description: Phase is the phase
+                type: string
+              reason:
+                description: Reason is a brief description of why we are in the current hotplug volume phase
+                type: string
+              target:
+                description: 'Target is the target name used when adding the volume to the VM, eg: vda'
+                type: string
             required:
             - name
+            - target
             type: object
           type: array
-        nodeSelector:
-          additionalProperties:
-            type: string
-          description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
-          type: object
-        priorityClassName:
-          description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+          x-kubernetes-list-type: atomic
+        vsockCID:
+          description: VSOCKCID is the cluster-unique context ID of the vsock device of the VMI. It is allocated by virt-controller and released on deletion of the VMI.
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstancemigration": `openAPIV3Schema:
+  description: VirtualMachineInstanceMigration represents the object tracking a VMI's migration to another host in the cluster
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        vmiName:
+          description: The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
           type: string
-        readinessProbe:
-          description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+      type: object
+    status:
+      description: VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.
+      properties:
+        conditions:
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        phase:
+          description: VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstancepreset": `openAPIV3Schema:
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+      properties:
+        domain:
+          description: Domain is the same object type as contained in VirtualMachineInstanceSpec
           properties:
-            failureThreshold:
-              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
-              format: int32
-              type: integer
-            httpGet:
-              description: HTTPGet specifies the http request to perform.
+            chassis:
+              description: Chassis specifies the chassis info passed to the domain.
               properties:
-                host:
-                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                asset:
                   type: string
-                httpHeaders:
-                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                manufacturer:
+                  type: string
+                serial:
+                  type: string
+                sku:
+                  type: string
+                version:
+                  type: string
+              type: object
+            clock:
+              description: Clock sets the clock and timers of the vmi.
+              properties:
+                timer:
+                  description: Timer specifies whih timers are attached to the vmi.
+                  properties:
+                    hpet:
+                      description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
+                      properties:
+                        present:
+                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                          type: boolean
+                        tickPolicy:
+                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                          type: string
+                      type: object
+                    hyperv:
+                      description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                      properties:
+                        present:
+                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                          type: boolean
+                      type: object
+                    kvm:
+                      description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                      properties:
+                        present:
+                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                          type: boolean
+                      type: object
+                    pit:
+                      description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                      properties:
+                        present:
+                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                          type: boolean
+                        tickPolicy:
+                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                          type: string
+                      type: object
+                    rtc:
+                      description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                      properties:
+                        present:
+                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                          type: boolean
+                        tickPolicy:
+                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                          type: string
+                        track:
+                          description: Track the guest or the wall clock.
+                          type: string
+                      type: object
+                  type: object
+                timezone:
+                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                  type: string
+                utc:
+                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                  properties:
+                    offsetSeconds:
+                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
+                      type: integer
+                  type: object
+              type: object
+            cpu:
+              description: CPU allow specified the detailed CPU topology inside the vmi.
+              properties:
+                cores:
+                  description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
+                  format: int32
+                  type: integer
+                dedicatedCpuPlacement:
+                  description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+                  type: boolean
+                features:
+                  description: Features specifies the CPU features list inside the VMI.
                   items:
-                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                    description: CPUFeature allows specifying a CPU feature.
                     properties:
                       name:
-                        description: The header field name
+                        description: Name of the CPU feature
                         type: string
-                      value:
-                        description: The header field value
+                      policy:
+                        description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
                         type: string
                     required:
                     - name
-                    - value
                     type: object
                   type: array
-                path:
-                  description: Path to access on the HTTP server.
-                  type: string
-                port:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                  x-kubernetes-int-or-string: true
-                scheme:
-                  description: Scheme to use for connecting to the host. Defaults to HTTP.
+                isolateEmulatorThread:
+                  description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+                  type: boolean
+                maxSockets:
+                  description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                  format: int32
+                  type: integer
+                model:
+                  description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
                   type: string
-              required:
-              - port
+                sockets:
+                  description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
+                  format: int32
+                  type: integer
+                threads:
+                  description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
+                  format: int32
+                  type: integer
               type: object
-            initialDelaySeconds:
-              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-              format: int32
-              type: integer
-            periodSeconds:
-              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
-              format: int32
-              type: integer
-            successThreshold:
-              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-              format: int32
-              type: integer
-            tcpSocket:
-              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+            devices:
+              description: Devices allows adding disks, network interfaces, and others
               properties:
-                host:
-                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
-                  type: string
-                port:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                  x-kubernetes-int-or-string: true
-              required:
-              - port
-              type: object
-            timeoutSeconds:
-              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-              format: int32
-              type: integer
-          type: object
-        schedulerName:
-          description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
-          type: string
-        subdomain:
-          description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
-          type: string
-        terminationGracePeriodSeconds:
-          description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
-          format: int64
-          type: integer
-        tolerations:
-          description: If toleration is specified, obey all the toleration rules.
-          items:
-            description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
-            properties:
-              effect:
-                description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
-                type: string
-              key:
-                description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
-                type: string
-              operator:
-                description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
-                type: string
-              tolerationSeconds:
-                description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
-                format: int64
-                type: integer
-              value:
-                description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
-                type: string
-            type: object
-          type: array
-        volumes:
-          description: List of volumes that can be mounted by disks belonging to the vmi.
-          items:
-            description: Volume represents a named volume in a vmi.
-            properties:
-              cloudInitConfigDrive:
-                description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
-                properties:
-                  networkData:
-                    description: NetworkData contains config drive inline cloud-init networkdata.
-                    type: string
-                  networkDataBase64:
-                    description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
-                    type: string
-                  networkDataSecretRef:
-                    description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                autoattachGraphicsDevice:
+                  description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
+                  type: boolean
+                autoattachMemBalloon:
+                  description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
+                  type: boolean
+                autoattachPodInterface:
+                  description: Whether to attach a pod network interface. Defaults to true.
+                  type: boolean
+                autoattachSerialConsole:
+                  description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
+                  type: boolean
+                autoattachVSOCK:
+                  description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
+                  type: boolean
+                blockMultiQueue:
+                  description: Whether or not to enable virtio multi-queue for block devices
+                  type: boolean
+                disableHotplug:
+                  description: DisableHotplug disabled the ability to hotplug disks.
+                  type: boolean
+                disks:
+                  description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
+                  items:
                     properties:
+                      bootOrder:
+                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
+                        type: integer
+                      cache:
+                        description: Cache specifies which kvm disk cache mode should be used.
+                        type: string
+                      cdrom:
+                        description: Attach a volume as a cdrom to the vmi.
+                        properties:
+                          bus:
+                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                            type: string
+                          readonly:
+                            description: ReadOnly. Defaults to true.
+                            type: boolean
+                          tray:
+                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                            type: string
+                        type: object
+                      dedicatedIOThread:
+                        description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
+                        type: boolean
+                      disk:
+                        description: Attach a volume as a disk to the vmi.
+                        properties:
+                          bus:
+                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                            type: string
+                          pciAddress:
+                            description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                            type: string
+                          readonly:
+                            description: ReadOnly. Defaults to false.
+                            type: boolean
+                        type: object
+                      floppy:
+                        description: Attach a volume as a floppy to the vmi.
+                        properties:
+                          readonly:
+                            description: ReadOnly. Defaults to false.
+                            type: boolean
+                          tray:
+                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                            type: string
+                        type: object
+                      io:
+                        description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
+                        type: string
+                      lun:
+                        description: Attach a volume as a LUN to the vmi.
+                        properties:
+                          bus:
+                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                            type: string
+                          readonly:
+                            description: ReadOnly. Defaults to false.
+                            type: boolean
+                        type: object
                       name:
-                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        description: Name is the device name
+                        type: string
+                      serial:
+                        description: Serial provides the ability to specify a serial number for the disk device.
+                        type: string
+                      tag:
+                        description: If specified, disk address and its tag will be provided to the guest via config drive metadata
                         type: string
+                    required:
+                    - name
                     type: object
-                  secretRef:
-                    description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                  type: array
+                filesystems:
+                  description: Filesystems describes filesystem which is connected to the vmi.
+                  items:
                     properties:
                       name:
-                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        description: Name is the device name
                         type: string
+                      virtiofs:
+                        description: Virtiofs is supported
+                        type: object
+                    required:
+                    - name
+                    - virtiofs
                     type: object
-                  userData:
-                    description: UserData contains config drive inline cloud-init userdata.
-                    type: string
-                  userDataBase64:
-                    description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
-                    type: string
-                type: object
-              cloudInitNoCloud:
-                description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
-                properties:
-                  networkData:
-                    description: NetworkData contains NoCloud inline cloud-init networkdata.
-                    type: string
-                  networkDataBase64:
-                    description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
-                    type: string
-                  networkDataSecretRef:
-                    description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                  type: array
+                  x-kubernetes-list-type: atomic
+                gpus:
+                  description: Whether to attach a GPU device to the vmi.
+                  items:
                     properties:
+                      deviceName:
+                        type: string
                       name:
-                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                        description: Name of the GPU device as exposed by a device plugin
                         type: string
+                    required:
+                    - deviceName
+                    - name
                     type: object
-                  secretRef:
-                    description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                  type: array
+                  x-kubernetes-list-type: atomic
+                hostDevices:
+                  description: Whether to attach a host device to the vmi.
+                  items:
                     properties:
+                      deviceName:
+                        description: DeviceName is the resource name of the host device exposed by a device plugin
+                        type: string
                       name:
-                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
                         type: string
+                    required:
+                    - deviceName
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                inputs:
+                  description: Inputs describe input devices
+                  items:
+                    properties:
+                      bus:
+                        description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
+                        type: string
+                      name:
+                        description: Name is the device name
+                        type: string
+                      type:
+                        description: 'Type indicated the type of input device. Supported values: tablet.'
+                        type: string
+                    required:
+                    - name
+                    - type
                     type: object
-                  userData:
-                    description: UserData contains NoCloud inline cloud-init userdata.
-                    type: string
-                  userDataBase64:
-                    description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
-                    type: string
-                type: object
-              configMap:
-                description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
-                properties:
-                  name:
-                    description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                    type: string
-                  optional:
-                    description: Specify whether the ConfigMap or it's keys must be defined
-                    type: boolean
-                  volumeLabel:
-                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                    type: string
-                type: object
-              containerDisk:
-                description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
-                properties:
-                  image:
-                    description: Image is the name of the image with the embedded disk.
-                    type: string
-                  imagePullPolicy:
-                    description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
-                    type: string
-                  imagePullSecret:
-                    description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
-                    type: string
-                  path:
-                    description: Path defines the path to disk file in the container
-                    type: string
-                required:
-                - image
-                type: object
-              dataVolume:
-                description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
-                properties:
-                  name:
-                    description: Name represents the name of the DataVolume in the same namespace
-                    type: string
-                required:
-                - name
-                type: object
-              downwardAPI:
-                description: DownwardAPI represents downward API about the pod that should populate this volume
-                properties:
-                  fields:
-                    description: Fields is a list of downward API volume file
-                    items:
-                      description: DownwardAPIVolumeFile represents information to create the file containing the pod field
-                      properties:
-                        fieldRef:
-                          description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                  type: array
+                interfaces:
+                  description: Interfaces describe network interfaces which are added to the vmi.
+                  items:
+                    properties:
+                      bandwidth:
+                        description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                        properties:
+                          name:
+                            description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      bootOrder:
+                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
+                        type: integer
+                      bridge:
+                        type: object
+                      dhcpOptions:
+                        description: If specified the network interface will pass additional DHCP options to the VMI
+                        properties:
+                          bootFileName:
+                            description: If specified will pass option 67 to interface's DHCP server
+                            type: string
+                          ntpServers:
+                            description: If specified will pass the configured NTP server to the VM via DHCP option 042.
+                            items:
+                              type: string
+                            type: array
+                          privateOptions:
+                            description: 'If specified will pass extra DHCP options for private use, range: 224-254'
+                            items:
+                              description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                              properties:
+                                option:
+                                  description: Option is an Integer value from 224-254 Required.
+                                  type: integer
+                                value:
+                                  description: Value is a String value for the Option provided Required.
+                                  type: string
+                              required:
+                              - option
+                              - value
+                              type: object
+                            type: array
+                          tftpServerName:
+                            description: If specified will pass option 66 to interface's DHCP server
+                            type: string
+                        type: object
+                      macAddress:
+                        description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                        type: string
+                      macvtap:
+                        type: object
+                      masquerade:
+                        type: object
+                      model:
+                        description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
+                        type: string
+                      name:
+                        description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
+                        type: string
+                      passt:
+                        description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                        type: object
+                      pciAddress:
+                        description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                        type: string
+                      ports:
+                        description: List of ports to be forwarded to the virtual machine.
+                        items:
+                          description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
                           properties:
-                            apiVersion:
-                              description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
+                            name:
+                              description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
                               type: string
-                            fieldPath:
-                              description: Path of the field to select in the specified API version.
+                            port:
+                              description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
+                              format: int32
+                              type: integer
+                            protocol:
+                              description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
                               type: string
                           required:
-                          - fieldPath
+                          - port
                           type: object
-                        mode:
-                          description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                        type: array
+                      slirp:
+                        type: object
+                      sriov:
+                        type: object
+                      tag:
+                        description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                logSerialConsole:
+                  description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
+                  type: boolean
+                networkInterfaceMultiqueue:
+                  description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
+                  type: boolean
+                rng:
+                  description: Whether to have random number generator from host
+                  type: object
+                tpm:
+                  description: Whether to emulate a TPM device.
+                  properties:
+                    persistent:
+                      description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                      type: boolean
+                  type: object
+                useVirtioTransitional:
+                  description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
+                  type: boolean
+                watchdog:
+                  description: Watchdog describes a watchdog device which can be added to the vmi.
+                  properties:
+                    i6300esb:
+                      description: i6300esb watchdog device.
+                      properties:
+                        action:
+                          description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
+                          type: string
+                      type: object
+                    name:
+                      description: Name of the watchdog.
+                      type: string
+                  required:
+                  - name
+                  type: object
+              type: object
+            features:
+              description: Features like acpi, apic, hyperv, smm.
+              properties:
+                acpi:
+                  description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                apic:
+                  description: Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                    endOfInterrupt:
+                      description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                      type: boolean
+                  type: object
+                hyperv:
+                  description: Defaults to the machine type setting.
+                  properties:
+                    evmcs:
+                      description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    frequencies:
+                      description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    ipi:
+                      description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    reenlightenment:
+                      description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    relaxed:
+                      description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    reset:
+                      description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    runtime:
+                      description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    spinlocks:
+                      description: Spinlocks allows to configure the spinlock retry attempts.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                        spinlocks:
+                          description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
                           format: int32
                           type: integer
-                        path:
-                          description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                      type: object
+                    synic:
+                      description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    synictimer:
+                      description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    tlbflush:
+                      description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    vapic:
+                      description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                    vendorid:
+                      description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                        vendorid:
+                          description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
                           type: string
-                        resourceFieldRef:
-                          description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
-                          properties:
-                            containerName:
-                              description: 'Container name: required for volumes, optional for env vars'
-                              type: string
-                            divisor:
-                              anyOf:
-                              - type: integer
-                              - type: string
-                              description: Specifies the output format of the exposed resources, defaults to "1"
-                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                              x-kubernetes-int-or-string: true
-                            resource:
-                              description: 'Required: resource to select'
-                              type: string
-                          required:
-                          - resource
-                          type: object
-                      required:
-                      - path
                       type: object
-                    type: array
-                  volumeLabel:
-                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                    type: string
-                type: object
-              emptyDisk:
-                description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
-                properties:
-                  capacity:
-                    anyOf:
-                    - type: integer
-                    - type: string
-                    description: Capacity of the sparse disk.
-                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                    x-kubernetes-int-or-string: true
-                required:
-                - capacity
-                type: object
-              ephemeral:
-                description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
-                properties:
-                  persistentVolumeClaim:
-                    description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                    properties:
-                      claimName:
-                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                        type: string
-                      readOnly:
-                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                        type: boolean
-                    required:
-                    - claimName
-                    type: object
-                type: object
-              hostDisk:
-                description: HostDisk represents a disk created on the cluster level
-                properties:
-                  capacity:
-                    anyOf:
-                    - type: integer
-                    - type: string
-                    description: Capacity of the sparse disk
-                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                    x-kubernetes-int-or-string: true
-                  path:
-                    description: The path to HostDisk image located on the cluster
-                    type: string
-                  shared:
-                    description: Shared indicate whether the path is shared between nodes
-                    type: boolean
-                  type:
-                    description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
-                    type: string
-                required:
-                - path
-                - type
-                type: object
-              name:
-                description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                type: string
-              persistentVolumeClaim:
-                description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                properties:
-                  claimName:
-                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                    type: string
-                  readOnly:
-                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                    type: boolean
-                required:
-                - claimName
-                type: object
-              secret:
-                description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
-                properties:
-                  optional:
-                    description: Specify whether the Secret or it's keys must be defined
-                    type: boolean
-                  secretName:
-                    description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
-                    type: string
-                  volumeLabel:
-                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                    type: string
-                type: object
-              serviceAccount:
-                description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
-                properties:
-                  serviceAccountName:
-                    description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
-                    type: string
-                type: object
-            required:
-            - name
-            type: object
-          type: array
-      required:
-      - domain
-      type: object
-    status:
-      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
-      properties:
-        activePods:
-          additionalProperties:
-            type: string
-          description: ActivePods is a mapping of pod UID to node name. It is possible for multiple pods to be running for a single VMI during migration.
-          type: object
-        conditions:
-          description: Conditions are specific points in VirtualMachineInstance's pod runtime.
-          items:
-            properties:
-              lastProbeTime:
-                format: date-time
-                nullable: true
-                type: string
-              lastTransitionTime:
-                format: date-time
-                nullable: true
-                type: string
-              message:
-                type: string
-              reason:
-                type: string
-              status:
-                type: string
-              type:
-                type: string
-            required:
-            - status
-            - type
-            type: object
-          type: array
-        evacuationNodeName:
-          description: EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
-          type: string
-        guestOSInfo:
-          description: Guest OS Information
-          properties:
-            id:
-              description: Guest OS Id
-              type: string
-            kernelRelease:
-              description: Guest OS Kernel Release
-              type: string
-            kernelVersion:
-              description: Kernel version of the Guest OS
+                    vpindex:
+                      description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                      properties:
+                        enabled:
+                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                          type: boolean
+                      type: object
+                  type: object
+                kvm:
+                  description: Configure how KVM presence is exposed to the guest.
+                  properties:
+                    hidden:
+                      description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
+                      type: boolean
+                  type: object
+                smm:
+                  description: SMM enables/disables System Management Mode. TSEG not yet implemented.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+            firmware:
+              description: Firmware.
+              properties:
+                bootloader:
+                  description: Settings to control the bootloader that is used.
+                  properties:
+                    bios:
+                      description: If set (default), BIOS will be used.
+                      properties:
+                        useSerial:
+                          description: If set, the BIOS output will be transmitted over serial
+                          type: boolean
+                      type: object
+                    efi:
+                      description: If set, EFI will be used instead of BIOS.
+                      properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                          type: boolean
+                        secureBoot:
+                          description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                          type: boolean
+                      type: object
+                  type: object
+                serial:
+                  description: The system-serial-number in SMBIOS
+                  type: string
+                uuid:
+                  description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                  type: string
+              type: object
+            ioThreadsPolicy:
+              description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
               type: string
             machine:
-              description: Machine type of the Guest OS
-              type: string
-            name:
-              description: Name of the Guest OS
-              type: string
-            prettyName:
-              description: Guest OS Pretty Name
-              type: string
-            version:
-              description: Guest OS Version
-              type: string
-            versionId:
-              description: Version ID of the Guest OS
-              type: string
-          type: object
-        interfaces:
-          description: Interfaces represent the details of available network interfaces.
-          items:
-            properties:
-              interfaceName:
-                description: The interface name inside the Virtual Machine
-                type: string
-              ipAddress:
-                description: IP address of a Virtual Machine interface. It is always the first item of IPs
-                type: string
-              ipAddresses:
-                description: List of all IP addresses of a Virtual Machine interface
-                items:
+              description: Machine type.
+              properties:
+                type:
+                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
                   type: string
-                type: array
-              mac:
-                description: Hardware address of a Virtual Machine interface
-                type: string
-              name:
-                description: 'Name of the interface, corresponds to name of the network assigned to the interface TODO: remove omitempty, when api breaking changes are allowed'
-                type: string
-            type: object
-          type: array
-        migrationMethod:
-          description: 'Represents the method using which the vmi can be migrated: live migration or block migration'
-          type: string
-        migrationState:
-          description: Represents the status of a live migration
-          properties:
-            abortRequested:
-              description: Indicates that the migration has been requested to abort
-              type: boolean
-            abortStatus:
-              description: Indicates the final status of the live migration abortion
-              type: string
-            completed:
-              description: Indicates the migration completed
-              type: boolean
-            endTimestamp:
-              description: The time the migration action ended
-              format: date-time
-              nullable: true
-              type: string
-            failed:
-              description: Indicates that the migration failed
-              type: boolean
-            migrationUid:
-              description: The VirtualMachineInstanceMigration object associated with this migration
-              type: string
-            mode:
-              description: Lets us know if the vmi is currently running pre or post copy migration
-              type: string
-            sourceNode:
-              description: The source node that the VMI originated on
-              type: string
-            startTimestamp:
-              description: The time the migration action began
-              format: date-time
-              nullable: true
-              type: string
-            targetDirectMigrationNodePorts:
-              additionalProperties:
-                type: integer
-              description: The list of ports opened for live migration on the destination node
+              required:
+              - type
+              type: object
+            memory:
+              description: Memory allow specifying the VMI memory features.
+              properties:
+                guest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                hugepages:
+                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                  properties:
+                    pageSize:
+                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                      type: string
+                  type: object
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
               type: object
-            targetNode:
-              description: The target node that the VMI is moving to
-              type: string
-            targetNodeAddress:
-              description: The address of the target node to use for the migration
-              type: string
-            targetNodeDomainDetected:
-              description: The Target Node has seen the Domain Start Event
-              type: boolean
-            targetPod:
-              description: The target pod that the VMI is moving to
-              type: string
+            resources:
+              description: Resources describes the Compute Resources required by this vmi.
+              properties:
+                limits:
+                  additionalProperties:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                  type: object
+                overcommitGuestOverhead:
+                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
+                  type: boolean
+                requests:
+                  additionalProperties:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                  type: object
+              type: object
+          required:
+          - devices
           type: object
-        nodeName:
-          description: NodeName is the name where the VirtualMachineInstance is currently running.
-          type: string
-        phase:
-          description: Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.
-          type: string
-        qosClass:
-          description: 'The Quality of Service (QOS) classification assigned to the virtual machine instance based on resource requirements See PodQOSClass type for available QOS classes More info: https://git.k8s.io/community/contributors/design-proposals/node/resource-qos.md'
-          type: string
-        reason:
-          description: A brief CamelCase message indicating details about why the VMI is in this state. e.g. 'NodeUnresponsive'
-          type: string
-        volumeStatus:
-          description: VolumeStatus contains the statuses of all the volumes
-          items:
-            description: VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.
-            properties:
-              hotplugVolume:
-                description: If the volume is hotplug, this will contain the hotplug status.
+        selector:
+          description: Selector is a label query over a set of VMIs. Required.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
                 properties:
-                  attachPodName:
-                    description: AttachPodName is the name of the pod used to attach the volume to the node.
+                  key:
+                    description: key is the label key that the selector applies to.
                     type: string
-                  attachPodUID:
-                    description: AttachPodUID is the UID of the pod used to attach the volume to the node.
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
                     type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
                 type: object
-              message:
-                description: Message is a detailed message about the current hotplug volume phase
-                type: string
-              name:
-                description: Name is the name of the volume
-                type: string
-              phase:
-                description: Phase is the phase
-                type: string
-              reason:
-                description: Reason is a brief description of why we are in the current hotplug volume phase
-                type: string
-              target:
-                description: 'Target is the target name used when adding the volume to the VM, eg: vda'
-                type: string
-            required:
-            - name
-            - target
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
-      type: object
-  required:
-  - spec
-  type: object
-`,
-	"virtualmachineinstancemigration": `openAPIV3Schema:
-  description: VirtualMachineInstanceMigration represents the object tracking a VMI's migration to another host in the cluster
-  properties:
-    apiVersion:
-      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
-      type: string
-    kind:
-      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
-      type: string
-    metadata:
-      type: object
-    spec:
-      properties:
-        vmiName:
-          description: The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
-          type: string
-      type: object
-    status:
-      description: VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.
-      properties:
-        conditions:
-          items:
-            properties:
-              lastProbeTime:
-                format: date-time
-                nullable: true
-                type: string
-              lastTransitionTime:
-                format: date-time
-                nullable: true
-                type: string
-              message:
-                type: string
-              reason:
-                type: string
-              status:
-                type: string
-              type:
+              type: array
+            matchLabels:
+              additionalProperties:
                 type: string
-            required:
-            - status
-            - type
-            type: object
-          type: array
-        phase:
-          description: VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
-          type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - selector
       type: object
-  required:
-  - spec
   type: object
 `,
-	"virtualmachineinstancepreset": `openAPIV3Schema:
+	"virtualmachineinstancereplicaset": `openAPIV3Schema:
+  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
@@ -5099,990 +6841,1990 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
       properties:
-        domain:
-          description: Domain is the same object type as contained in VirtualMachineInstanceSpec
+        paused:
+          description: Indicates that the replica set is paused.
+          type: boolean
+        replicas:
+          description: Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.
+          format: int32
+          type: integer
+        selector:
+          description: Label selector for pods. Existing ReplicaSets whose pods are selected by this will be the ones affected by this deployment.
           properties:
-            chassis:
-              description: Chassis specifies the chassis info passed to the domain.
-              properties:
-                asset:
-                  type: string
-                manufacturer:
-                  type: string
-                serial:
-                  type: string
-                sku:
-                  type: string
-                version:
-                  type: string
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
               type: object
-            clock:
-              description: Clock sets the clock and timers of the vmi.
+          type: object
+        template:
+          description: Template describes the pods that will be created.
+          properties:
+            metadata:
+              nullable: true
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+            spec:
+              description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
               properties:
-                timer:
-                  description: Timer specifies whih timers are attached to the vmi.
+                accessCredentials:
+                  description: Specifies a set of public keys to inject into the vm guest
+                  items:
+                    description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+                    properties:
+                      sshPublicKey:
+                        description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                        properties:
+                          propagationMethod:
+                            description: PropagationMethod represents how the public key is injected into the vm guest.
+                            properties:
+                              configDrive:
+                                description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
+                                type: object
+                              qemuGuestAgent:
+                                description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                properties:
+                                  users:
+                                    description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: set
+                                required:
+                                - users
+                                type: object
+                            type: object
+                          source:
+                            description: Source represents where the public keys are pulled from
+                            properties:
+                              secret:
+                                description: Secret means that the access credential is pulled from a kubernetes secret
+                                properties:
+                                  secretName:
+                                    description: SecretName represents the name of the secret in the VMI's namespace
+                                    type: string
+                                required:
+                                - secretName
+                                type: object
+                            type: object
+                        required:
+                        - propagationMethod
+                        - source
+                        type: object
+                      userPassword:
+                        description: UserPassword represents the source and method for applying a guest user's password
+                        properties:
+                          propagationMethod:
+                            description: propagationMethod represents how the user passwords are injected into the vm guest.
+                            properties:
+                              qemuGuestAgent:
+                                description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                type: object
+                            type: object
+                          source:
+                            description: Source represents where the user passwords are pulled from
+                            properties:
+                              secret:
+                                description: Secret means that the access credential is pulled from a kubernetes secret
+                                properties:
+                                  secretName:
+                                    description: SecretName represents the name of the secret in the VMI's namespace
+                                    type: string
+                                required:
+                                - secretName
+                                type: object
+                            type: object
+                        required:
+                        - propagationMethod
+                        - source
+                        type: object
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                affinity:
+                  description: If affinity is specifies, obey all the affinity rules
                   properties:
-                    hpet:
-                      description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
-                      properties:
-                        present:
-                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                          type: boolean
-                        tickPolicy:
-                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
-                          type: string
-                      type: object
-                    hyperv:
-                      description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
-                      properties:
-                        present:
-                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                          type: boolean
-                      type: object
-                    kvm:
-                      description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                    nodeAffinity:
+                      description: Describes node affinity scheduling rules for the pod.
                       properties:
-                        present:
-                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                          type: boolean
+                        preferredDuringSchedulingIgnoredDuringExecution:
+                          description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
+                          items:
+                            description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                            properties:
+                              preference:
+                                description: A node selector term, associated with the corresponding weight.
+                                properties:
+                                  matchExpressions:
+                                    description: A list of node selector requirements by node's labels.
+                                    items:
+                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                  matchFields:
+                                    description: A list of node selector requirements by node's fields.
+                                    items:
+                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                type: object
+                              weight:
+                                description: Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+                                format: int32
+                                type: integer
+                            required:
+                            - preference
+                            - weight
+                            type: object
+                          type: array
+                        requiredDuringSchedulingIgnoredDuringExecution:
+                          description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.
+                          properties:
+                            nodeSelectorTerms:
+                              description: Required. A list of node selector terms. The terms are ORed.
+                              items:
+                                description: A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                                properties:
+                                  matchExpressions:
+                                    description: A list of node selector requirements by node's labels.
+                                    items:
+                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                  matchFields:
+                                    description: A list of node selector requirements by node's fields.
+                                    items:
+                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                type: object
+                              type: array
+                          required:
+                          - nodeSelectorTerms
+                          type: object
                       type: object
-                    pit:
-                      description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                    podAffinity:
+                      description: Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
                       properties:
-                        present:
-                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                          type: boolean
-                        tickPolicy:
-                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
-                          type: string
+                        preferredDuringSchedulingIgnoredDuringExecution:
+                          description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                          items:
+                            description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                            properties:
+                              podAffinityTerm:
+                                description: Required. A pod affinity term, associated with the corresponding weight.
+                                properties:
+                                  labelSelector:
+                                    description: A label query over a set of resources, in this case pods.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                        items:
+                                          description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaces:
+                                    description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                    items:
+                                      type: string
+                                    type: array
+                                  topologyKey:
+                                    description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
+                              weight:
+                                description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                format: int32
+                                type: integer
+                            required:
+                            - podAffinityTerm
+                            - weight
+                            type: object
+                          type: array
+                        requiredDuringSchedulingIgnoredDuringExecution:
+                          description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                          items:
+                            description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                            properties:
+                              labelSelector:
+                                description: A label query over a set of resources, in this case pods.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                    items:
+                                      description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: key is the label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                          type: string
+                                        values:
+                                          description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
+                                type: object
+                              namespaces:
+                                description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                items:
+                                  type: string
+                                type: array
+                              topologyKey:
+                                description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                type: string
+                            required:
+                            - topologyKey
+                            type: object
+                          type: array
                       type: object
-                    rtc:
-                      description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                    podAntiAffinity:
+                      description: Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
                       properties:
-                        present:
-                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                          type: boolean
-                        tickPolicy:
-                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
-                          type: string
-                        track:
-                          description: Track the guest or the wall clock.
-                          type: string
-                      type: object
-                  type: object
-                timezone:
-                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
-                  type: string
-                utc:
-                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
-                  properties:
-                    offsetSeconds:
-                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
-                      type: integer
-                  type: object
-              type: object
-            cpu:
-              description: CPU allow specified the detailed CPU topology inside the vmi.
-              properties:
-                cores:
-                  description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
-                  format: int32
-                  type: integer
-                dedicatedCpuPlacement:
-                  description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
-                  type: boolean
-                features:
-                  description: Features specifies the CPU features list inside the VMI.
-                  items:
-                    description: CPUFeature allows specifying a CPU feature.
-                    properties:
-                      name:
-                        description: Name of the CPU feature
-                        type: string
-                      policy:
-                        description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
-                        type: string
-                    required:
-                    - name
-                    type: object
-                  type: array
-                isolateEmulatorThread:
-                  description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
-                  type: boolean
-                model:
-                  description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
-                  type: string
-                sockets:
-                  description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
-                  format: int32
-                  type: integer
-                threads:
-                  description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
-                  format: int32
-                  type: integer
-              type: object
-            devices:
-              description: Devices allows adding disks, network interfaces, and others
-              properties:
-                autoattachGraphicsDevice:
-                  description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
-                  type: boolean
-                autoattachMemBalloon:
-                  description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
-                  type: boolean
-                autoattachPodInterface:
-                  description: Whether to attach a pod network interface. Defaults to true.
-                  type: boolean
-                autoattachSerialConsole:
-                  description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
-                  type: boolean
-                blockMultiQueue:
-                  description: Whether or not to enable virtio multi-queue for block devices
-                  type: boolean
-                disableHotplug:
-                  description: DisableHotplug disabled the ability to hotplug disks.
-                  type: boolean
-                disks:
-                  description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
-                  items:
-                    properties:
-                      bootOrder:
-                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
-                        type: integer
-                      cache:
-                        description: Cache specifies which kvm disk cache mode should be used.
+                        preferredDuringSchedulingIgnoredDuringExecution:
+                          description: The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                          items:
+                            description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                            properties:
+                              podAffinityTerm:
+                                description: Required. A pod affinity term, associated with the corresponding weight.
+                                properties:
+                                  labelSelector:
+                                    description: A label query over a set of resources, in this case pods.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                        items:
+                                          description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                  namespaces:
+                                    description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                    items:
+                                      type: string
+                                    type: array
+                                  topologyKey:
+                                    description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
+                              weight:
+                                description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                format: int32
+                                type: integer
+                            required:
+                            - podAffinityTerm
+                            - weight
+                            type: object
+                          type: array
+                        requiredDuringSchedulingIgnoredDuringExecution:
+                          description: If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                          items:
+                            description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                            properties:
+                              labelSelector:
+                                description: A label query over a set of resources, in this case pods.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                    items:
+                                      description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: key is the label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                          type: string
+                                        values:
+                                          description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
+                                type: object
+                              namespaces:
+                                description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                items:
+                                  type: string
+                                type: array
+                              topologyKey:
+                                description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                type: string
+                            required:
+                            - topologyKey
+                            type: object
+                          type: array
+                      type: object
+                  type: object
+                dnsConfig:
+                  description: Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.
+                  properties:
+                    nameservers:
+                      description: A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed.
+                      items:
                         type: string
-                      cdrom:
-                        description: Attach a volume as a cdrom to the vmi.
-                        properties:
-                          bus:
-                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
-                            type: string
-                          readonly:
-                            description: ReadOnly. Defaults to true.
-                            type: boolean
-                          tray:
-                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
-                            type: string
-                        type: object
-                      dedicatedIOThread:
-                        description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
-                        type: boolean
-                      disk:
-                        description: Attach a volume as a disk to the vmi.
-                        properties:
-                          bus:
-                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
-                            type: string
-                          pciAddress:
-                            description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
-                            type: string
-                          readonly:
-                            description: ReadOnly. Defaults to false.
-                            type: boolean
-                        type: object
-                      floppy:
-                        description: Attach a volume as a floppy to the vmi.
+                      type: array
+                    options:
+                      description: A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy.
+                      items:
+                        description: PodDNSConfigOption defines DNS resolver options of a pod.
                         properties:
-                          readonly:
-                            description: ReadOnly. Defaults to false.
-                            type: boolean
-                          tray:
-                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                          name:
+                            description: Required.
                             type: string
-                        type: object
-                      io:
-                        description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
-                        type: string
-                      lun:
-                        description: Attach a volume as a LUN to the vmi.
-                        properties:
-                          bus:
-                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                          value:
                             type: string
-                          readonly:
-                            description: ReadOnly. Defaults to false.
-                            type: boolean
-                        type: object
-                      name:
-                        description: Name is the device name
-                        type: string
-                      serial:
-                        description: Serial provides the ability to specify a serial number for the disk device.
-                        type: string
-                      tag:
-                        description: If specified, disk address and its tag will be provided to the guest via config drive metadata
-                        type: string
-                    required:
-                    - name
-                    type: object
-                  type: array
-                filesystems:
-                  description: Filesystems describes filesystem which is connected to the vmi.
-                  items:
-                    properties:
-                      name:
-                        description: Name is the device name
-                        type: string
-                      virtiofs:
-                        description: Virtiofs is supported
                         type: object
-                    required:
-                    - name
-                    - virtiofs
-                    type: object
-                  type: array
-                  x-kubernetes-list-type: atomic
-                gpus:
-                  description: Whether to attach a GPU device to the vmi.
-                  items:
-                    properties:
-                      deviceName:
-                        type: string
-                      name:
-                        description: Name of the GPU device as exposed by a device plugin
-                        type: string
-                    required:
-                    - deviceName
-                    - name
-                    type: object
-                  type: array
-                  x-kubernetes-list-type: atomic
-                hostDevices:
-                  description: Whether to attach a host device to the vmi.
-                  items:
-                    properties:
-                      deviceName:
-                        description: DeviceName is the resource name of the host device exposed by a device plugin
-                        type: string
-                      name:
-                        type: string
-                    required:
-                    - deviceName
-                    - name
-                    type: object
-                  type: array
-                  x-kubernetes-list-type: atomic
-                inputs:
-                  description: Inputs describe input devices
-                  items:
-                    properties:
-                      bus:
-                        description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
-                        type: string
-                      name:
-                        description: Name is the device name
-                        type: string
-                      type:
-                        description: 'Type indicated the type of input device. Supported values: tablet.'
+                      type: array
+                    searches:
+                      description: A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed.
+                      items:
                         type: string
-                    required:
-                    - name
-                    - type
-                    type: object
-                  type: array
-                interfaces:
-                  description: Interfaces describe network interfaces which are added to the vmi.
-                  items:
-                    properties:
-                      bootOrder:
-                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
-                        type: integer
-                      bridge:
-                        type: object
-                      dhcpOptions:
-                        description: If specified the network interface will pass additional DHCP options to the VMI
-                        properties:
-                          bootFileName:
-                            description: If specified will pass option 67 to interface's DHCP server
-                            type: string
-                          ntpServers:
-                            description: If specified will pass the configured NTP server to the VM via DHCP option 042.
-                            items:
-                              type: string
-                            type: array
-                          privateOptions:
-                            description: 'If specified will pass extra DHCP options for private use, range: 224-254'
-                            items:
-                              description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                      type: array
+                  type: object
+                dnsPolicy:
+                  description: Set DNS policy for the pod. Defaults to "ClusterFirst". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
+                  type: string
+                domain:
+                  description: Specification of the desired behavior of the VirtualMachineInstance on the host.
+                  properties:
+                    chassis:
+                      description: Chassis specifies the chassis info passed to the domain.
+                      properties:
+                        asset:
+                          type: string
+                        manufacturer:
+                          type: string
+                        serial:
+                          type: string
+                        sku:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                    clock:
+                      description: Clock sets the clock and timers of the vmi.
+                      properties:
+                        timer:
+                          description: Timer specifies whih timers are attached to the vmi.
+                          properties:
+                            hpet:
+                              description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
                               properties:
-                                option:
-                                  description: Option is an Integer value from 224-254 Required.
-                                  type: integer
-                                value:
-                                  description: Value is a String value for the Option provided Required.
+                                present:
+                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                                  type: string
+                              type: object
+                            hyperv:
+                              description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                              properties:
+                                present:
+                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                  type: boolean
+                              type: object
+                            kvm:
+                              description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                              properties:
+                                present:
+                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                  type: boolean
+                              type: object
+                            pit:
+                              description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                              properties:
+                                present:
+                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                                  type: string
+                              type: object
+                            rtc:
+                              description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                              properties:
+                                present:
+                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                                  type: string
+                                track:
+                                  description: Track the guest or the wall clock.
                                   type: string
-                              required:
-                              - option
-                              - value
                               type: object
-                            type: array
-                          tftpServerName:
-                            description: If specified will pass option 66 to interface's DHCP server
-                            type: string
-                        type: object
-                      macAddress:
-                        description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
-                        type: string
-                      macvtap:
-                        type: object
-                      masquerade:
-                        type: object
-                      model:
-                        description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
-                        type: string
-                      name:
-                        description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
-                        type: string
-                      pciAddress:
-                        description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
-                        type: string
-                      ports:
-                        description: List of ports to be forwarded to the virtual machine.
-                        items:
-                          description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
+                          type: object
+                        timezone:
+                          description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                          type: string
+                        utc:
+                          description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
                           properties:
-                            name:
-                              description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
-                              type: string
-                            port:
-                              description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
-                              format: int32
+                            offsetSeconds:
+                              description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
                               type: integer
-                            protocol:
-                              description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
-                              type: string
-                          required:
-                          - port
                           type: object
-                        type: array
-                      slirp:
-                        type: object
-                      sriov:
-                        type: object
-                      tag:
-                        description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
-                        type: string
-                    required:
-                    - name
-                    type: object
-                  type: array
-                networkInterfaceMultiqueue:
-                  description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
-                  type: boolean
-                rng:
-                  description: Whether to have random number generator from host
-                  type: object
-                useVirtioTransitional:
-                  description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
-                  type: boolean
-                watchdog:
-                  description: Watchdog describes a watchdog device which can be added to the vmi.
-                  properties:
-                    i6300esb:
-                      description: i6300esb watchdog device.
-                      properties:
-                        action:
-                          description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
-                          type: string
                       type: object
-                    name:
-                      description: Name of the watchdog.
-                      type: string
-                  required:
-                  - name
-                  type: object
-              type: object
-            features:
-              description: Features like acpi, apic, hyperv, smm.
-              properties:
-                acpi:
-                  description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
-                  properties:
-                    enabled:
-                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                      type: boolean
-                  type: object
-                apic:
-                  description: Defaults to the machine type setting.
-                  properties:
-                    enabled:
-                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                      type: boolean
-                    endOfInterrupt:
-                      description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
-                      type: boolean
-                  type: object
-                hyperv:
-                  description: Defaults to the machine type setting.
-                  properties:
-                    evmcs:
-                      description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                    cpu:
+                      description: CPU allow specified the detailed CPU topology inside the vmi.
                       properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        cores:
+                          description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                        dedicatedCpuPlacement:
+                          description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
                           type: boolean
-                      type: object
-                    frequencies:
-                      description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        features:
+                          description: Features specifies the CPU features list inside the VMI.
+                          items:
+                            description: CPUFeature allows specifying a CPU feature.
+                            properties:
+                              name:
+                                description: Name of the CPU feature
+                                type: string
+                              policy:
+                                description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                        isolateEmulatorThread:
+                          description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
                           type: boolean
+                        maxSockets:
+                          description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                          format: int32
+                          type: integer
+                        model:
+                          description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+                          type: string
+                        sockets:
+                          description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                        threads:
+                          description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
                       type: object
-                    ipi:
-                      description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                    devices:
+                      description: Devices allows adding disks, network interfaces, and others
                       properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        autoattachGraphicsDevice:
+                          description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
                           type: boolean
-                      type: object
-                    reenlightenment:
-                      description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        autoattachMemBalloon:
+                          description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
                           type: boolean
-                      type: object
-                    relaxed:
-                      description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        autoattachPodInterface:
+                          description: Whether to attach a pod network interface. Defaults to true.
                           type: boolean
-                      type: object
-                    reset:
-                      description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        autoattachSerialConsole:
+                          description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
                           type: boolean
-                      type: object
-                    runtime:
-                      description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        autoattachVSOCK:
+                          description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
                           type: boolean
-                      type: object
-                    spinlocks:
-                      description: Spinlocks allows to configure the spinlock retry attempts.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        blockMultiQueue:
+                          description: Whether or not to enable virtio multi-queue for block devices
                           type: boolean
-                        spinlocks:
-                          description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
-                          format: int32
-                          type: integer
-                      type: object
-                    synic:
-                      description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        disableHotplug:
+                          description: DisableHotplug disabled the ability to hotplug disks.
+                          type: boolean
+                        disks:
+                          description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
+                          items:
+                            properties:
+                              bootOrder:
+                                description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
+                                type: integer
+                              cache:
+                                description: Cache specifies which kvm disk cache mode should be used.
+                                type: string
+                              cdrom:
+                                description: Attach a volume as a cdrom to the vmi.
+                                properties:
+                                  bus:
+                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                    type: string
+                                  readonly:
+                                    description: ReadOnly. Defaults to true.
+                                    type: boolean
+                                  tray:
+                                    description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                    type: string
+                                type: object
+                              dedicatedIOThread:
+                                description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
+                                type: boolean
+                              disk:
+                                description: Attach a volume as a disk to the vmi.
+                                properties:
+                                  bus:
+                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                    type: string
+                                  pciAddress:
+                                    description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                    type: string
+                                  readonly:
+                                    description: ReadOnly. Defaults to false.
+                                    type: boolean
+                                type: object
+                              floppy:
+                                description: Attach a volume as a floppy to the vmi.
+                                properties:
+                                  readonly:
+                                    description: ReadOnly. Defaults to false.
+                                    type: boolean
+                                  tray:
+                                    description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                    type: string
+                                type: object
+                              io:
+                                description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
+                                type: string
+                              lun:
+                                description: Attach a volume as a LUN to the vmi.
+                                properties:
+                                  bus:
+                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                    type: string
+                                  readonly:
+                                    description: ReadOnly. Defaults to false.
+                                    type: boolean
+                                type: object
+                              name:
+                                description: Name is the device name
+                                type: string
+                              serial:
+                                description: Serial provides the ability to specify a serial number for the disk device.
+                                type: string
+                              tag:
+                                description: If specified, disk address and its tag will be provided to the guest via config drive metadata
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                        filesystems:
+                          description: Filesystems describes filesystem which is connected to the vmi.
+                          items:
+                            properties:
+                              name:
+                                description: Name is the device name
+                                type: string
+                              virtiofs:
+                                description: Virtiofs is supported
+                                type: object
+                            required:
+                            - name
+                            - virtiofs
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        gpus:
+                          description: Whether to attach a GPU device to the vmi.
+                          items:
+                            properties:
+                              deviceName:
+                                type: string
+                              name:
+                                description: Name of the GPU device as exposed by a device plugin
+                                type: string
+                            required:
+                            - deviceName
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        hostDevices:
+                          description: Whether to attach a host device to the vmi.
+                          items:
+                            properties:
+                              deviceName:
+                                description: DeviceName is the resource name of the host device exposed by a device plugin
+                                type: string
+                              name:
+                                type: string
+                            required:
+                            - deviceName
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        inputs:
+                          description: Inputs describe input devices
+                          items:
+                            properties:
+                              bus:
+                                description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
+                                type: string
+                              name:
+                                description: Name is the device name
+                                type: string
+                              type:
+                                description: 'Type indicated the type of input device. Supported values: tablet.'
+                                type: string
+                            required:
+                            - name
+                            - type
+                            type: object
+                          type: array
+                        interfaces:
+                          description: Interfaces describe network interfaces which are added to the vmi.
+                          items:
+                            properties:
+                              bandwidth:
+                                description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                                properties:
+                                  name:
+                                    description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              bootOrder:
+                                description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
+                                type: integer
+                              bridge:
+                                type: object
+                              dhcpOptions:
+                                description: If specified the network interface will pass additional DHCP options to the VMI
+                                properties:
+                                  bootFileName:
+                                    description: If specified will pass option 67 to interface's DHCP server
+                                    type: string
+                                  ntpServers:
+                                    description: If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                    items:
+                                      type: string
+                                    type: array
+                                  privateOptions:
+                                    description: 'If specified will pass extra DHCP options for private use, range: 224-254'
+                                    items:
+                                      description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                                      properties:
+                                        option:
+                                          description: Option is an Integer value from 224-254 Required.
+                                          type: integer
+                                        value:
+                                          description: Value is a String value for the Option provided Required.
+                                          type: string
+                                      required:
+                                      - option
+                                      - value
+                                      type: object
+                                    type: array
+                                  tftpServerName:
+                                    description: If specified will pass option 66 to interface's DHCP server
+                                    type: string
+                                type: object
+                              macAddress:
+                                description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                type: string
+                              macvtap:
+                                type: object
+                              masquerade:
+                                type: object
+                              model:
+                                description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
+                                type: string
+                              name:
+                                description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
+                                type: string
+                              passt:
+                                description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                                type: object
+                              pciAddress:
+                                description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                type: string
+                              ports:
+                                description: List of ports to be forwarded to the virtual machine.
+                                items:
+                                  description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
+                                  properties:
+                                    name:
+                                      description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
+                                      type: string
+                                    port:
+                                      description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
+                                      format: int32
+                                      type: integer
+                                    protocol:
+                                      description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
+                                type: array
+                              slirp:
+                                type: object
+                              sriov:
+                                type: object
+                              tag:
+                                description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                        logSerialConsole:
+                          description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
                           type: boolean
-                      type: object
-                    synictimer:
-                      description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        networkInterfaceMultiqueue:
+                          description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                           type: boolean
-                      type: object
-                    tlbflush:
-                      description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
-                      properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                        rng:
+                          description: Whether to have random number generator from host
+                          type: object
+                        tpm:
+                          description: Whether to emulate a TPM device.
+                          properties:
+                            persistent:
+                              description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                              type: boolean
+                          type: object
+                        useVirtioTransitional:
+                          description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
                           type: boolean
+                        watchdog:
+                          description: Watchdog describes a watchdog device which can be added to the vmi.
+                          properties:
+                            i6300esb:
+                              description: i6300esb watchdog device.
+                              properties:
+                                action:
+                                  description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
+                                  type: string
+                              type: object
+                            name:
+                              description: Name of the watchdog.
+                              type: string
+                          required:
+                          - name
+                          type: object
                       type: object
-                    vapic:
-                      description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                    features:
+                      description: Features like acpi, apic, hyperv, smm.
                       properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                          type: boolean
+                        acpi:
+                          description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
+                          properties:
+                            enabled:
+                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                              type: boolean
+                          type: object
+                        apic:
+                          description: Defaults to the machine type setting.
+                          properties:
+                            enabled:
+                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                              type: boolean
+                            endOfInterrupt:
+                              description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                              type: boolean
+                          type: object
+                        hyperv:
+                          description: Defaults to the machine type setting.
+                          properties:
+                            evmcs:
+                              description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            frequencies:
+                              description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            ipi:
+                              description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            reenlightenment:
+                              description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            relaxed:
+                              description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            reset:
+                              description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            runtime:
+                              description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            spinlocks:
+                              description: Spinlocks allows to configure the spinlock retry attempts.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                                spinlocks:
+                                  description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
+                                  format: int32
+                                  type: integer
+                              type: object
+                            synic:
+                              description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            synictimer:
+                              description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            tlbflush:
+                              description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            vapic:
+                              description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                            vendorid:
+                              description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                                vendorid:
+                                  description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                                  type: string
+                              type: object
+                            vpindex:
+                              description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                  type: boolean
+                              type: object
+                          type: object
+                        kvm:
+                          description: Configure how KVM presence is exposed to the guest.
+                          properties:
+                            hidden:
+                              description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
+                              type: boolean
+                          type: object
+                        smm:
+                          description: SMM enables/disables System Management Mode. TSEG not yet implemented.
+                          properties:
+                            enabled:
+                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                              type: boolean
+                          type: object
                       type: object
-                    vendorid:
-                      description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                    firmware:
+                      description: Firmware.
                       properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                          type: boolean
-                        vendorid:
-                          description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                        bootloader:
+                          description: Settings to control the bootloader that is used.
+                          properties:
+                            bios:
+                              description: If set (default), BIOS will be used.
+                              properties:
+                                useSerial:
+                                  description: If set, the BIOS output will be transmitted over serial
+                                  type: boolean
+                              type: object
+                            efi:
+                              description: If set, EFI will be used instead of BIOS.
+                              properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                  type: boolean
+                                secureBoot:
+                                  description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                                  type: boolean
+                              type: object
+                          type: object
+                        serial:
+                          description: The system-serial-number in SMBIOS
+                          type: string
+                        uuid:
+                          description: UUID reported by the vmi bios. Defaults to a random generated uid.
                           type: string
                       type: object
-                    vpindex:
-                      description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                    ioThreadsPolicy:
+                      description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
+                      type: string
+                    machine:
+                      description: Machine type.
                       properties:
-                        enabled:
-                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                          type: boolean
+                        type:
+                          description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - type
                       type: object
-                  type: object
-                kvm:
-                  description: Configure how KVM presence is exposed to the guest.
-                  properties:
-                    hidden:
-                      description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
-                      type: boolean
-                  type: object
-                smm:
-                  description: SMM enables/disables System Management Mode. TSEG not yet implemented.
-                  properties:
-                    enabled:
-                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                      type: boolean
-                  type: object
-              type: object
-            firmware:
-              description: Firmware.
-              properties:
-                bootloader:
-                  description: Settings to control the bootloader that is used.
-                  properties:
-                    bios:
-                      description: If set (default), BIOS will be used.
+                    memory:
+                      description: Memory allow specifying the VMI memory features.
                       properties:
-                        useSerial:
-                          description: If set, the BIOS output will be transmitted over serial
-                          type: boolean
+                        guest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        hugepages:
+                          description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                          properties:
+                            pageSize:
+                              description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                              type: string
+                          type: object
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
                       type: object
-                    efi:
-                      description: If set, EFI will be used instead of BIOS.
+                    resources:
+                      description: Resources describes the Compute Resources required by this vmi.
                       properties:
-                        secureBoot:
-                          description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                        limits:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                          type: object
+                        overcommitGuestOverhead:
+                          description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
                           type: boolean
+                        requests:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                          type: object
                       type: object
+                  required:
+                  - devices
                   type: object
-                serial:
-                  description: The system-serial-number in SMBIOS
-                  type: string
-                uuid:
-                  description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                evictionStrategy:
+                  description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
                   type: string
-              type: object
-            ioThreadsPolicy:
-              description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
-              type: string
-            machine:
-              description: Machine type.
-              properties:
-                type:
-                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                hostname:
+                  description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
-              required:
-              - type
-              type: object
-            memory:
-              description: Memory allow specifying the VMI memory features.
-              properties:
-                guest:
-                  anyOf:
-                  - type: integer
-                  - type: string
-                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
-                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                  x-kubernetes-int-or-string: true
-                hugepages:
-                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                livenessProbe:
+                  description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
                   properties:
-                    pageSize:
-                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
-                      type: string
-                  type: object
-              type: object
-            resources:
-              description: Resources describes the Compute Resources required by this vmi.
-              properties:
-                limits:
-                  additionalProperties:
-                    anyOf:
-                    - type: integer
-                    - type: string
-                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                    x-kubernetes-int-or-string: true
-                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
-                  type: object
-                overcommitGuestOverhead:
-                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
-                  type: boolean
-                requests:
-                  additionalProperties:
-                    anyOf:
-                    - type: integer
-                    - type: string
-                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                    x-kubernetes-int-or-string: true
-                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                    failureThreshold:
+                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            properties:
+                              name:
+                                description: The header field name
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: Scheme to use for connecting to the host. Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
                   type: object
-              type: object
-          required:
-          - devices
-          type: object
-        selector:
-          description: Selector is a label query over a set of VMIs. Required.
-          properties:
-            matchExpressions:
-              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
-              items:
-                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                properties:
-                  key:
-                    description: key is the label key that the selector applies to.
-                    type: string
-                  operator:
-                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
-                    type: string
-                  values:
-                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
-                    items:
-                      type: string
-                    type: array
-                required:
-                - key
-                - operator
-                type: object
-              type: array
-            matchLabels:
-              additionalProperties:
-                type: string
-              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
-              type: object
-          type: object
-      required:
-      - selector
-      type: object
-  type: object
-`,
-	"virtualmachineinstancereplicaset": `openAPIV3Schema:
-  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
-  properties:
-    apiVersion:
-      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
-      type: string
-    kind:
-      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
-      type: string
-    metadata:
-      type: object
-    spec:
-      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
-      properties:
-        paused:
-          description: Indicates that the replica set is paused.
-          type: boolean
-        replicas:
-          description: Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.
-          format: int32
-          type: integer
-        selector:
-          description: Label selector for pods. Existing ReplicaSets whose pods are selected by this will be the ones affected by this deployment.
-          properties:
-            matchExpressions:
-              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
-              items:
-                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                properties:
-                  key:
-                    description: key is the label key that the selector applies to.
-                    type: string
-                  operator:
-                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                networks:
+                  description: List of networks that can be attached to a vm's virtual interface.
+                  items:
+                    description: Network represents a network type and a resource that should be connected to the vm.
+                    properties:
+                      multus:
+                        description: Represents the multus cni network.
+                        properties:
+                          default:
+                            description: Select the default network and add it to the multus-cni.io/default-network annotation.
+                            type: boolean
+                          networkName:
+                            description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                            type: string
+                        required:
+                        - networkName
+                        type: object
+                      name:
+                        description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                        type: string
+                      pod:
+                        description: Represents the stock pod network interface.
+                        properties:
+                          vmNetworkCIDR:
+                            description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+                nodeSelector:
+                  additionalProperties:
                     type: string
-                  values:
-                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
-                    items:
-                      type: string
-                    type: array
-                required:
-                - key
-                - operator
-                type: object
-              type: array
-            matchLabels:
-              additionalProperties:
-                type: string
-              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
-              type: object
-          type: object
-        template:
-          description: Template describes the pods that will be created.
-          properties:
-            metadata:
-              nullable: true
-              type: object
-              x-kubernetes-preserve-unknown-fields: true
-            spec:
-              description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
-              properties:
-                accessCredentials:
-                  description: Specifies a set of public keys to inject into the vm guest
+                  description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+                  type: object
+                priorityClassName:
+                  description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+                  type: string
+                readinessProbe:
+                  description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                  properties:
+                    failureThreshold:
+                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            properties:
+                              name:
+                                description: The header field name
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: Scheme to use for connecting to the host. Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                  type: object
+                schedulerName:
+                  description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
+                  type: string
+                subdomain:
+                  description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
+                  type: string
+                terminationGracePeriodSeconds:
+                  description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
+                  format: int64
+                  type: integer
+                tolerations:
+                  description: If toleration is specified, obey all the toleration rules.
                   items:
-                    description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+                    description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
                     properties:
-                      sshPublicKey:
-                        description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                      effect:
+                        description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                        type: string
+                      key:
+                        description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                        type: string
+                      operator:
+                        description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
+                        type: string
+                      tolerationSeconds:
+                        description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
+                        format: int64
+                        type: integer
+                      value:
+                        description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
+                        type: string
+                    type: object
+                  type: array
+                volumes:
+                  description: List of volumes that can be mounted by disks belonging to the vmi.
+                  items:
+                    description: Volume represents a named volume in a vmi.
+                    properties:
+                      cloudInitConfigDrive:
+                        description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                        properties:
+                          networkData:
+                            description: NetworkData contains config drive inline cloud-init networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          userData:
+                            description: UserData contains config drive inline cloud-init userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      cloudInitNoCloud:
+                        description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                        properties:
+                          networkData:
+                            description: NetworkData contains NoCloud inline cloud-init networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          userData:
+                            description: UserData contains NoCloud inline cloud-init userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      configMap:
+                        description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                        properties:
+                          name:
+                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                            type: string
+                          optional:
+                            description: Specify whether the ConfigMap or it's keys must be defined
+                            type: boolean
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      containerDisk:
+                        description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                        properties:
+                          image:
+                            description: Image is the name of the image with the embedded disk.
+                            type: string
+                          imagePullPolicy:
+                            description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                            type: string
+                          imagePullSecret:
+                            description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
+                            type: string
+                          path:
+                            description: Path defines the path to disk file in the container
+                            type: string
+                        required:
+                        - image
+                        type: object
+                      dataVolume:
+                        description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                        properties:
+                          name:
+                            description: Name represents the name of the DataVolume in the same namespace
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      downwardAPI:
+                        description: DownwardAPI represents downward API about the pod that should populate this volume
                         properties:
-                          propagationMethod:
-                            description: PropagationMethod represents how the public key is injected into the vm guest.
-                            properties:
-                              configDrive:
-                                description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
-                                type: object
-                              qemuGuestAgent:
-                                description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                                properties:
-                                  users:
-                                    description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
-                                    items:
+                          fields:
+                            description: Fields is a list of downward API volume file
+                            items:
+                              description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                              properties:
+                                fieldRef:
+                                  description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                                  properties:
+                                    apiVersion:
+                                      description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
                                       type: string
-                                    type: array
-                                    x-kubernetes-list-type: set
-                                required:
-                                - users
-                                type: object
-                            type: object
-                          source:
-                            description: Source represents where the public keys are pulled from
-                            properties:
-                              secret:
-                                description: Secret means that the access credential is pulled from a kubernetes secret
-                                properties:
-                                  secretName:
-                                    description: SecretName represents the name of the secret in the VMI's namespace
-                                    type: string
-                                required:
-                                - secretName
-                                type: object
-                            type: object
+                                    fieldPath:
+                                      description: Path of the field to select in the specified API version.
+                                      type: string
+                                  required:
+                                  - fieldPath
+                                  type: object
+                                mode:
+                                  description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                                  format: int32
+                                  type: integer
+                                path:
+                                  description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                                  type: string
+                                resourceFieldRef:
+                                  description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                                  properties:
+                                    containerName:
+                                      description: 'Container name: required for volumes, optional for env vars'
+                                      type: string
+                                    divisor:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: Specifies the output format of the exposed resources, defaults to "1"
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    resource:
+                                      description: 'Required: resource to select'
+                                      type: string
+                                  required:
+                                  - resource
+                                  type: object
+                              required:
+                              - path
+                              type: object
+                            type: array
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      downwardMetrics:
+                        description: DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                        type: object
+                      emptyDisk:
+                        description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                        properties:
+                          capacity:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Capacity of the sparse disk.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
                         required:
-                        - propagationMethod
-                        - source
+                        - capacity
                         type: object
-                      userPassword:
-                        description: UserPassword represents the source and method for applying a guest user's password
+                      ephemeral:
+                        description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
                         properties:
-                          propagationMethod:
-                            description: propagationMethod represents how the user passwords are injected into the vm guest.
-                            properties:
-                              qemuGuestAgent:
-                                description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                                type: object
-                            type: object
-                          source:
-                            description: Source represents where the user passwords are pulled from
+                          persistentVolumeClaim:
+                            description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
                             properties:
-                              secret:
-                                description: Secret means that the access credential is pulled from a kubernetes secret
-                                properties:
-                                  secretName:
-                                    description: SecretName represents the name of the secret in the VMI's namespace
-                                    type: string
-                                required:
-                                - secretName
-                                type: object
+                              claimName:
+                                description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                type: string
+                              readOnly:
+                                description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                type: boolean
+                            required:
+                            - claimName
                             type: object
+                        type: object
+                      hostDisk:
+                        description: HostDisk represents a disk created on the cluster level
+                        properties:
+                          capacity:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Capacity of the sparse disk
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          path:
+                            description: The path to HostDisk image located on the cluster
+                            type: string
+                          shared:
+                            description: Shared indicate whether the path is shared between nodes
+                            type: boolean
+                          type:
+                            description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
+                            type: string
                         required:
-                        - propagationMethod
-                        - source
+                        - path
+                        - type
                         type: object
-                    type: object
-                  type: array
-                  x-kubernetes-list-type: atomic
-                affinity:
-                  description: If affinity is specifies, obey all the affinity rules
-                  properties:
-                    nodeAffinity:
-                      description: Describes node affinity scheduling rules for the pod.
-                      properties:
-                        preferredDuringSchedulingIgnoredDuringExecution:
-                          description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
-                          items:
-                            description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
-                            properties:
-                              preference:
-                                description: A node selector term, associated with the corresponding weight.
-                                properties:
-                                  matchExpressions:
-                                    description: A list of node selector requirements by node's labels.
-                                    items:
-                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                      properties:
-                                        key:
-                                          description: The label key that the selector applies to.
-                                          type: string
-                                        operator:
-                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                          type: string
-                                        values:
-                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                  matchFields:
-                                    description: A list of node selector requirements by node's fields.
-                                    items:
-                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                      properties:
-                                        key:
-                                          description: The label key that the selector applies to.
-                                          type: string
-                                        operator:
-                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                          type: string
-                                        values:
-                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                type: object
-                              weight:
-                                description: Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
-                                format: int32
-                                type: integer
-                            required:
-                            - preference
-                            - weight
+                      memoryDump:
+                        description: MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+                        properties:
+                          claimName:
+                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          readOnly:
+                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                      name:
+                        description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                        type: string
+                      persistentVolumeClaim:
+                        description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                        properties:
+                          claimName:
+                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          readOnly:
+                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                      secret:
+                        description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                        properties:
+                          optional:
+                            description: Specify whether the Secret or it's keys must be defined
+                            type: boolean
+                          secretName:
+                            description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                            type: string
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      serviceAccount:
+                        description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                        properties:
+                          serviceAccountName:
+                            description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                            type: string
+                        type: object
+                      sysprep:
+                        description: 'Sysprep represents a Sysprep answer file for the unattended setup of Windows. The answer file will be added as a CD-ROM to the vmi. More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview'
+                        properties:
+                          configMap:
+                            description: ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
                             type: object
-                          type: array
-                        requiredDuringSchedulingIgnoredDuringExecution:
-                          description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.
-                          properties:
-                            nodeSelectorTerms:
-                              description: Required. A list of node selector terms. The terms are ORed.
-                              items:
-                                description: A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
-                                properties:
-                                  matchExpressions:
-                                    description: A list of node selector requirements by node's labels.
-                                    items:
-                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                      properties:
-                                        key:
-                                          description: The label key that the selector applies to.
-                                          type: string
-                                        operator:
-                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                          type: string
-                                        values:
-                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                  matchFields:
-                                    description: A list of node selector requirements by node's fields.
-                                    items:
-                                      description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                      properties:
-                                        key:
-                                          description: The label key that the selector applies to.
-                                          type: string
-                                        operator:
-                                          description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                          type: string
-                                        values:
-                                          description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                type: object
-                              type: array
-                          required:
-                          - nodeSelectorTerms
-                          type: object
-                      type: object
-                    podAffinity:
-                      description: Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
-                      properties:
-                        preferredDuringSchedulingIgnoredDuringExecution:
-                          description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
-                          items:
-                            description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                          secret:
+                            description: Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
                             properties:
-                              podAffinityTerm:
-                                description: Required. A pod affinity term, associated with the corresponding weight.
-                                properties:
-                                  labelSelector:
-                                    description: A label query over a set of resources, in this case pods.
-                                    properties:
-                                      matchExpressions:
-                                        description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
-                                        items:
-                                          description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                          properties:
-                                            key:
-                                              description: key is the label key that the selector applies to.
-                                              type: string
-                                            operator:
-                                              description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
-                                              type: string
-                                            values:
-                                              description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
-                                              items:
-                                                type: string
-                                              type: array
-                                          required:
-                                          - key
-                                          - operator
-                                          type: object
-                                        type: array
-                                      matchLabels:
-                                        additionalProperties:
-                                          type: string
-                                        description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                        type: object
-                                    type: object
-                                  namespaces:
-                                    description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
-                                    items:
-                                      type: string
-                                    type: array
-                                  topologyKey:
-                                    description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+              required:
+              - domain
+              type: object
+          type: object
+      required:
+      - selector
+      - template
+      type: object
+    status:
+      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
+      nullable: true
+      properties:
+        conditions:
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        labelSelector:
+          description: Canonical form of the label selector for HPA which consumes it through the scale subresource.
+          type: string
+        readyReplicas:
+          description: The number of ready replicas for this replica set.
+          format: int32
+          type: integer
+        replicas:
+          description: Total number of non-terminated pods targeted by this deployment (their labels match the selector).
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstancetype": `openAPIV3Schema:
+  description: VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration that can be used by multiple VirtualMachine resources.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: Required number of vCPUs to expose to the guest. The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+              type: boolean
+            model:
+              description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+              type: string
+          required:
+          - guest
+          type: object
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+          required:
+          - guest
+          type: object
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinepool": `openAPIV3Schema:
+  description: VirtualMachinePool resource contains a VirtualMachine configuration that can be used to replicate multiple VirtualMachine resources.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachinePoolSpec is a description of a VirtualMachinePool.
+      properties:
+        paused:
+          description: Indicates that the pool is paused.
+          type: boolean
+        replicas:
+          description: Number of desired VirtualMachines. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.
+          format: int32
+          type: integer
+        scaleInStrategy:
+          description: ScaleInStrategy specifies how the pool selects and removes VirtualMachines when scaling in.
+          properties:
+            selectionPolicy:
+              description: 'SelectionPolicy defines the order in which VirtualMachines are removed. One of: LeastReady, Oldest. Defaults to LeastReady.'
+              type: string
+            volumePolicy:
+              description: 'VolumePolicy defines whether the DataVolumes of removed VirtualMachines are deleted or retained. One of: Delete, Retain. Defaults to Delete.'
+              type: string
+          type: object
+        selector:
+          description: Label selector for VirtualMachines. Existing VirtualMachines selected by this selector are the ones affected by this pool.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        virtualMachineTemplate:
+          description: Template describes the VirtualMachines that will be created. The names of the VirtualMachines are suffixed with their unique index within the pool, and the names of their DataVolumeTemplates are prefixed with the name of the VirtualMachine.
+          properties:
+            metadata:
+              nullable: true
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+            spec:
+              description: VirtualMachineSpec contains the VirtualMachine specification.
+              properties:
+                dataVolumeTemplates:
+                  description: dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference. DataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.
+                  items:
+                    nullable: true
+                    properties:
+                      apiVersion:
+                        description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+                        type: string
+                      kind:
+                        description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+                        type: string
+                      metadata:
+                        nullable: true
+                        type: object
+                        x-kubernetes-preserve-unknown-fields: true
+                      spec:
+                        description: DataVolumeSpec contains the DataVolume specification.
+                        properties:
+                          contentType:
+                            description: 'DataVolumeContentType options: "kubevirt", "archive"'
+                            enum:
+                            - kubevirt
+                            - archive
+                            type: string
+                          pvc:
+                            description: PVC is the PVC specification
+                            properties:
+                              accessModes:
+                                description: 'AccessModes contains the desired access modes the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                                items:
+                                  type: string
+                                type: array
+                              dataSource:
+                                description: 'This field can be used to specify either: * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot) * An existing PVC (PersistentVolumeClaim) * An existing custom resource that implements data population (Alpha) In order to use custom resource types that implement data population, the AnyVolumeDataSource feature gate must be enabled. If the provisioner or an external controller can support the specified data source, it will create a new volume based on the contents of the specified data source.'
+                                properties:
+                                  apiGroup:
+                                    description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+                                    type: string
+                                  kind:
+                                    description: Kind is the type of resource being referenced
+                                    type: string
+                                  name:
+                                    description: Name is the name of resource being referenced
                                     type: string
                                 required:
-                                - topologyKey
+                                - kind
+                                - name
                                 type: object
-                              weight:
-                                description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
-                                format: int32
-                                type: integer
-                            required:
-                            - podAffinityTerm
-                            - weight
-                            type: object
-                          type: array
-                        requiredDuringSchedulingIgnoredDuringExecution:
-                          description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
-                          items:
-                            description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
-                            properties:
-                              labelSelector:
-                                description: A label query over a set of resources, in this case pods.
+                              resources:
+                                description: 'Resources represents the minimum resources the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                                properties:
+                                  limits:
+                                    additionalProperties:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    description: 'Limits describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                                    type: object
+                                  requests:
+                                    additionalProperties:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    description: 'Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                                    type: object
+                                type: object
+                              selector:
+                                description: A label query over volumes to consider for binding.
                                 properties:
                                   matchExpressions:
                                     description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
@@ -6111,1276 +8853,1873 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
                                     type: object
                                 type: object
-                              namespaces:
-                                description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
-                                items:
-                                  type: string
-                                type: array
-                              topologyKey:
-                                description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                              storageClassName:
+                                description: 'Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                                type: string
+                              volumeMode:
+                                description: volumeMode defines what type of volume is required by the claim. Value of Filesystem is implied when not included in claim spec.
+                                type: string
+                              volumeName:
+                                description: VolumeName is the binding reference to the PersistentVolume backing this claim.
                                 type: string
-                            required:
-                            - topologyKey
                             type: object
-                          type: array
-                      type: object
-                    podAntiAffinity:
-                      description: Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
-                      properties:
-                        preferredDuringSchedulingIgnoredDuringExecution:
-                          description: The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
-                          items:
-                            description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                          source:
+                            description: Source is the src of the data for the requested DataVolume
                             properties:
-                              podAffinityTerm:
-                                description: Required. A pod affinity term, associated with the corresponding weight.
+                              blank:
+                                description: DataVolumeBlankImage provides the parameters to create a new raw blank image for the PVC
+                                type: object
+                              http:
+                                description: DataVolumeSourceHTTP can be either an http or https endpoint, with an optional basic auth user name and password, and an optional configmap containing additional CAs
                                 properties:
-                                  labelSelector:
-                                    description: A label query over a set of resources, in this case pods.
-                                    properties:
-                                      matchExpressions:
-                                        description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
-                                        items:
-                                          description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                          properties:
-                                            key:
-                                              description: key is the label key that the selector applies to.
-                                              type: string
-                                            operator:
-                                              description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
-                                              type: string
-                                            values:
-                                              description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
-                                              items:
-                                                type: string
-                                              type: array
-                                          required:
-                                          - key
-                                          - operator
-                                          type: object
-                                        type: array
-                                      matchLabels:
-                                        additionalProperties:
-                                          type: string
-                                        description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                        type: object
-                                    type: object
-                                  namespaces:
-                                    description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
-                                    items:
-                                      type: string
-                                    type: array
-                                  topologyKey:
-                                    description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                  certConfigMap:
+                                    description: CertConfigMap is a configmap reference, containing a Certificate Authority(CA) public key, and a base64 encoded pem certificate
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef A Secret reference, the secret should contain accessKeyId (user name) base64 encoded, and secretKey (password) also base64 encoded
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the http(s) endpoint
                                     type: string
                                 required:
-                                - topologyKey
+                                - url
                                 type: object
-                              weight:
-                                description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
-                                format: int32
-                                type: integer
-                            required:
-                            - podAffinityTerm
-                            - weight
-                            type: object
-                          type: array
-                        requiredDuringSchedulingIgnoredDuringExecution:
-                          description: If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
-                          items:
-                            description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
-                            properties:
-                              labelSelector:
-                                description: A label query over a set of resources, in this case pods.
+                              imageio:
+                                description: DataVolumeSourceImageIO provides the parameters to create a Data Volume from an imageio source
                                 properties:
-                                  matchExpressions:
-                                    description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
-                                    items:
-                                      description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                                      properties:
-                                        key:
-                                          description: key is the label key that the selector applies to.
-                                          type: string
-                                        operator:
-                                          description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
-                                          type: string
-                                        values:
-                                          description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                  matchLabels:
-                                    additionalProperties:
-                                      type: string
-                                    description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                    type: object
+                                  certConfigMap:
+                                    description: CertConfigMap provides a reference to the CA cert
+                                    type: string
+                                  diskId:
+                                    description: DiskID provides id of a disk to be imported
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the ovirt-engine
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the ovirt-engine
+                                    type: string
+                                required:
+                                - diskId
+                                - url
+                                type: object
+                              pvc:
+                                description: DataVolumeSourcePVC provides the parameters to create a Data Volume from an existing PVC
+                                properties:
+                                  name:
+                                    description: The name of the source PVC
+                                    type: string
+                                  namespace:
+                                    description: The namespace of the source PVC
+                                    type: string
+                                required:
+                                - name
+                                - namespace
+                                type: object
+                              registry:
+                                description: DataVolumeSourceRegistry provides the parameters to create a Data Volume from an registry source
+                                properties:
+                                  certConfigMap:
+                                    description: CertConfigMap provides a reference to the Registry certs
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the Registry source
+                                    type: string
+                                  url:
+                                    description: URL is the url of the Docker registry source
+                                    type: string
+                                required:
+                                - url
+                                type: object
+                              s3:
+                                description: DataVolumeSourceS3 provides the parameters to create a Data Volume from an S3 source
+                                properties:
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the S3 source
+                                    type: string
+                                  url:
+                                    description: URL is the url of the S3 source
+                                    type: string
+                                required:
+                                - url
+                                type: object
+                              upload:
+                                description: DataVolumeSourceUpload provides the parameters to create a Data Volume by uploading the source
+                                type: object
+                              vddk:
+                                description: DataVolumeSourceVDDK provides the parameters to create a Data Volume from a Vmware source
+                                properties:
+                                  backingFile:
+                                    description: BackingFile is the path to the virtual hard disk to migrate from vCenter/ESXi
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides a reference to a secret containing the username and password needed to access the vCenter or ESXi host
+                                    type: string
+                                  thumbprint:
+                                    description: Thumbprint is the certificate thumbprint of the vCenter or ESXi host
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the vCenter or ESXi host with the VM to migrate
+                                    type: string
+                                  uuid:
+                                    description: UUID is the UUID of the virtual machine that the backing file is attached to in vCenter/ESXi
+                                    type: string
                                 type: object
-                              namespaces:
-                                description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
-                                items:
-                                  type: string
-                                type: array
-                              topologyKey:
-                                description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
-                                type: string
-                            required:
-                            - topologyKey
                             type: object
-                          type: array
-                      type: object
-                  type: object
-                dnsConfig:
-                  description: Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.
-                  properties:
-                    nameservers:
-                      description: A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed.
-                      items:
-                        type: string
-                      type: array
-                    options:
-                      description: A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy.
-                      items:
-                        description: PodDNSConfigOption defines DNS resolver options of a pod.
-                        properties:
-                          name:
-                            description: Required.
-                            type: string
-                          value:
-                            type: string
+                        required:
+                        - pvc
+                        - source
                         type: object
-                      type: array
-                    searches:
-                      description: A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed.
-                      items:
-                        type: string
-                      type: array
+                      status:
+                        description: DataVolumeTemplateDummyStatus is here simply for backwards compatibility with a previous API.
+                        nullable: true
+                        type: object
+                    required:
+                    - spec
+                    type: object
+                  type: array
+                instancetype:
+                  description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+                  properties:
+                    kind:
+                      description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+                      type: string
+                    name:
+                      description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+                      type: string
+                    revisionName:
+                      description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                      type: string
+                  required:
+                  - name
                   type: object
-                dnsPolicy:
-                  description: Set DNS policy for the pod. Defaults to "ClusterFirst". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
+                preference:
+                  description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+                  properties:
+                    kind:
+                      description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+                      type: string
+                    name:
+                      description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+                      type: string
+                    revisionName:
+                      description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the preference is applied to the VirtualMachineInstance.
+                      type: string
+                  required:
+                  - name
+                  type: object
+                runStrategy:
+                  description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
                   type: string
-                domain:
-                  description: Specification of the desired behavior of the VirtualMachineInstance on the host.
+                running:
+                  description: Running controls whether the associatied VirtualMachineInstance is created or not Mutually exclusive with RunStrategy
+                  type: boolean
+                template:
+                  description: Template is the direct specification of VirtualMachineInstance
                   properties:
-                    chassis:
-                      description: Chassis specifies the chassis info passed to the domain.
-                      properties:
-                        asset:
-                          type: string
-                        manufacturer:
-                          type: string
-                        serial:
-                          type: string
-                        sku:
-                          type: string
-                        version:
-                          type: string
+                    metadata:
+                      nullable: true
                       type: object
-                    clock:
-                      description: Clock sets the clock and timers of the vmi.
+                      x-kubernetes-preserve-unknown-fields: true
+                    spec:
+                      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
                       properties:
-                        timer:
-                          description: Timer specifies whih timers are attached to the vmi.
+                        accessCredentials:
+                          description: Specifies a set of public keys to inject into the vm guest
+                          items:
+                            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+                            properties:
+                              sshPublicKey:
+                                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                                properties:
+                                  propagationMethod:
+                                    description: PropagationMethod represents how the public key is injected into the vm guest.
+                                    properties:
+                                      configDrive:
+                                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
+                                        type: object
+                                      qemuGuestAgent:
+                                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                        properties:
+                                          users:
+                                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: set
+                                        required:
+                                        - users
+                                        type: object
+                                    type: object
+                                  source:
+                                    description: Source represents where the public keys are pulled from
+                                    properties:
+                                      secret:
+                                        description: Secret means that the access credential is pulled from a kubernetes secret
+                                        properties:
+                                          secretName:
+                                            description: SecretName represents the name of the secret in the VMI's namespace
+                                            type: string
+                                        required:
+                                        - secretName
+                                        type: object
+                                    type: object
+                                required:
+                                - propagationMethod
+                                - source
+                                type: object
+                              userPassword:
+                                description: UserPassword represents the source and method for applying a guest user's password
+                                properties:
+                                  propagationMethod:
+                                    description: propagationMethod represents how the user passwords are injected into the vm guest.
+                                    properties:
+                                      qemuGuestAgent:
+                                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                        type: object
+                                    type: object
+                                  source:
+                                    description: Source represents where the user passwords are pulled from
+                                    properties:
+                                      secret:
+                                        description: Secret means that the access credential is pulled from a kubernetes secret
+                                        properties:
+                                          secretName:
+                                            description: SecretName represents the name of the secret in the VMI's namespace
+                                            type: string
+                                        required:
+                                        - secretName
+                                        type: object
+                                    type: object
+                                required:
+                                - propagationMethod
+                                - source
+                                type: object
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        affinity:
+                          description: If affinity is specifies, obey all the affinity rules
                           properties:
-                            hpet:
-                              description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
-                              properties:
-                                present:
-                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                                  type: boolean
-                                tickPolicy:
-                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
-                                  type: string
-                              type: object
-                            hyperv:
-                              description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
-                              properties:
-                                present:
-                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                                  type: boolean
-                              type: object
-                            kvm:
-                              description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                            nodeAffinity:
+                              description: Describes node affinity scheduling rules for the pod.
                               properties:
-                                present:
-                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                                  type: boolean
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                                    properties:
+                                      preference:
+                                        description: A node selector term, associated with the corresponding weight.
+                                        properties:
+                                          matchExpressions:
+                                            description: A list of node selector requirements by node's labels.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchFields:
+                                            description: A list of node selector requirements by node's fields.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                        type: object
+                                      weight:
+                                        description: Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - preference
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.
+                                  properties:
+                                    nodeSelectorTerms:
+                                      description: Required. A list of node selector terms. The terms are ORed.
+                                      items:
+                                        description: A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                                        properties:
+                                          matchExpressions:
+                                            description: A list of node selector requirements by node's labels.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchFields:
+                                            description: A list of node selector requirements by node's fields.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                        type: object
+                                      type: array
+                                  required:
+                                  - nodeSelectorTerms
+                                  type: object
                               type: object
-                            pit:
-                              description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                            podAffinity:
+                              description: Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
                               properties:
-                                present:
-                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                                  type: boolean
-                                tickPolicy:
-                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
-                                  type: string
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                                    properties:
+                                      podAffinityTerm:
+                                        description: Required. A pod affinity term, associated with the corresponding weight.
+                                        properties:
+                                          labelSelector:
+                                            description: A label query over a set of resources, in this case pods.
+                                            properties:
+                                              matchExpressions:
+                                                description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                                items:
+                                                  description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                                  properties:
+                                                    key:
+                                                      description: key is the label key that the selector applies to.
+                                                      type: string
+                                                    operator:
+                                                      description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                      type: string
+                                                    values:
+                                                      description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                  required:
+                                                  - key
+                                                  - operator
+                                                  type: object
+                                                type: array
+                                              matchLabels:
+                                                additionalProperties:
+                                                  type: string
+                                                description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                type: object
+                                            type: object
+                                          namespaces:
+                                            description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                            items:
+                                              type: string
+                                            type: array
+                                          topologyKey:
+                                            description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                            type: string
+                                        required:
+                                        - topologyKey
+                                        type: object
+                                      weight:
+                                        description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - podAffinityTerm
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                                  items:
+                                    description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources, in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  type: array
                               type: object
-                            rtc:
-                              description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                            podAntiAffinity:
+                              description: Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
                               properties:
-                                present:
-                                  description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
-                                  type: boolean
-                                tickPolicy:
-                                  description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
-                                  type: string
-                                track:
-                                  description: Track the guest or the wall clock.
-                                  type: string
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                                    properties:
+                                      podAffinityTerm:
+                                        description: Required. A pod affinity term, associated with the corresponding weight.
+                                        properties:
+                                          labelSelector:
+                                            description: A label query over a set of resources, in this case pods.
+                                            properties:
+                                              matchExpressions:
+                                                description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                                items:
+                                                  description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                                  properties:
+                                                    key:
+                                                      description: key is the label key that the selector applies to.
+                                                      type: string
+                                                    operator:
+                                                      description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                      type: string
+                                                    values:
+                                                      description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                  required:
+                                                  - key
+                                                  - operator
+                                                  type: object
+                                                type: array
+                                              matchLabels:
+                                                additionalProperties:
+                                                  type: string
+                                                description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                type: object
+                                            type: object
+                                          namespaces:
+                                            description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                            items:
+                                              type: string
+                                            type: array
+                                          topologyKey:
+                                            description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                            type: string
+                                        required:
+                                        - topologyKey
+                                        type: object
+                                      weight:
+                                        description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - podAffinityTerm
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                                  items:
+                                    description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources, in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  type: array
                               type: object
                           type: object
-                        timezone:
-                          description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
-                          type: string
-                        utc:
-                          description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                        dnsConfig:
+                          description: Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.
                           properties:
-                            offsetSeconds:
-                              description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
-                              type: integer
-                          type: object
-                      type: object
-                    cpu:
-                      description: CPU allow specified the detailed CPU topology inside the vmi.
-                      properties:
-                        cores:
-                          description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                        dedicatedCpuPlacement:
-                          description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
-                          type: boolean
-                        features:
-                          description: Features specifies the CPU features list inside the VMI.
-                          items:
-                            description: CPUFeature allows specifying a CPU feature.
-                            properties:
-                              name:
-                                description: Name of the CPU feature
-                                type: string
-                              policy:
-                                description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
-                                type: string
-                            required:
-                            - name
-                            type: object
-                          type: array
-                        isolateEmulatorThread:
-                          description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
-                          type: boolean
-                        model:
-                          description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
-                          type: string
-                        sockets:
-                          description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                        threads:
-                          description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                      type: object
-                    devices:
-                      description: Devices allows adding disks, network interfaces, and others
-                      properties:
-                        autoattachGraphicsDevice:
-                          description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
-                          type: boolean
-                        autoattachMemBalloon:
-                          description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
-                          type: boolean
-                        autoattachPodInterface:
-                          description: Whether to attach a pod network interface. Defaults to true.
-                          type: boolean
-                        autoattachSerialConsole:
-                          description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
-                          type: boolean
-                        blockMultiQueue:
-                          description: Whether or not to enable virtio multi-queue for block devices
-                          type: boolean
-                        disableHotplug:
-                          description: DisableHotplug disabled the ability to hotplug disks.
-                          type: boolean
-                        disks:
-                          description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
-                          items:
-                            properties:
-                              bootOrder:
-                                description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
-                                type: integer
-                              cache:
-                                description: Cache specifies which kvm disk cache mode should be used.
+                            nameservers:
+                              description: A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed.
+                              items:
                                 type: string
-                              cdrom:
-                                description: Attach a volume as a cdrom to the vmi.
-                                properties:
-                                  bus:
-                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
-                                    type: string
-                                  readonly:
-                                    description: ReadOnly. Defaults to true.
-                                    type: boolean
-                                  tray:
-                                    description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
-                                    type: string
-                                type: object
-                              dedicatedIOThread:
-                                description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
-                                type: boolean
-                              disk:
-                                description: Attach a volume as a disk to the vmi.
-                                properties:
-                                  bus:
-                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
-                                    type: string
-                                  pciAddress:
-                                    description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
-                                    type: string
-                                  readonly:
-                                    description: ReadOnly. Defaults to false.
-                                    type: boolean
-                                type: object
-                              floppy:
-                                description: Attach a volume as a floppy to the vmi.
+                              type: array
+                            options:
+                              description: A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy.
+                              items:
+                                description: PodDNSConfigOption defines DNS resolver options of a pod.
                                 properties:
-                                  readonly:
-                                    description: ReadOnly. Defaults to false.
-                                    type: boolean
-                                  tray:
-                                    description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                  name:
+                                    description: Required.
                                     type: string
-                                type: object
-                              io:
-                                description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
-                                type: string
-                              lun:
-                                description: Attach a volume as a LUN to the vmi.
-                                properties:
-                                  bus:
-                                    description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                  value:
                                     type: string
-                                  readonly:
-                                    description: ReadOnly. Defaults to false.
-                                    type: boolean
-                                type: object
-                              name:
-                                description: Name is the device name
-                                type: string
-                              serial:
-                                description: Serial provides the ability to specify a serial number for the disk device.
-                                type: string
-                              tag:
-                                description: If specified, disk address and its tag will be provided to the guest via config drive metadata
-                                type: string
-                            required:
-                            - name
-                            type: object
-                          type: array
-                        filesystems:
-                          description: Filesystems describes filesystem which is connected to the vmi.
-                          items:
-                            properties:
-                              name:
-                                description: Name is the device name
-                                type: string
-                              virtiofs:
-                                description: Virtiofs is supported
                                 type: object
-                            required:
-                            - name
-                            - virtiofs
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        gpus:
-                          description: Whether to attach a GPU device to the vmi.
-                          items:
-                            properties:
-                              deviceName:
-                                type: string
-                              name:
-                                description: Name of the GPU device as exposed by a device plugin
-                                type: string
-                            required:
-                            - deviceName
-                            - name
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        hostDevices:
-                          description: Whether to attach a host device to the vmi.
-                          items:
-                            properties:
-                              deviceName:
-                                description: DeviceName is the resource name of the host device exposed by a device plugin
-                                type: string
-                              name:
-                                type: string
-                            required:
-                            - deviceName
-                            - name
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        inputs:
-                          description: Inputs describe input devices
-                          items:
-                            properties:
-                              bus:
-                                description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
-                                type: string
-                              name:
-                                description: Name is the device name
-                                type: string
-                              type:
-                                description: 'Type indicated the type of input device. Supported values: tablet.'
+                              type: array
+                            searches:
+                              description: A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed.
+                              items:
                                 type: string
-                            required:
-                            - name
-                            - type
-                            type: object
-                          type: array
-                        interfaces:
-                          description: Interfaces describe network interfaces which are added to the vmi.
-                          items:
-                            properties:
-                              bootOrder:
-                                description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
-                                type: integer
-                              bridge:
-                                type: object
-                              dhcpOptions:
-                                description: If specified the network interface will pass additional DHCP options to the VMI
-                                properties:
-                                  bootFileName:
-                                    description: If specified will pass option 67 to interface's DHCP server
-                                    type: string
-                                  ntpServers:
-                                    description: If specified will pass the configured NTP server to the VM via DHCP option 042.
-                                    items:
+                              type: array
+                          type: object
+                        dnsPolicy:
+                          description: Set DNS policy for the pod. Defaults to "ClusterFirst". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
+                          type: string
+                        domain:
+                          description: Specification of the desired behavior of the VirtualMachineInstance on the host.
+                          properties:
+                            chassis:
+                              description: Chassis specifies the chassis info passed to the domain.
+                              properties:
+                                asset:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                serial:
+                                  type: string
+                                sku:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                            clock:
+                              description: Clock sets the clock and timers of the vmi.
+                              properties:
+                                timer:
+                                  description: Timer specifies whih timers are attached to the vmi.
+                                  properties:
+                                    hpet:
+                                      description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                                          type: string
+                                      type: object
+                                    hyperv:
+                                      description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    kvm:
+                                      description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    pit:
+                                      description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                                          type: string
+                                      type: object
+                                    rtc:
+                                      description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                                          type: string
+                                        track:
+                                          description: Track the guest or the wall clock.
+                                          type: string
+                                      type: object
+                                  type: object
+                                timezone:
+                                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                                  type: string
+                                utc:
+                                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                                  properties:
+                                    offsetSeconds:
+                                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
+                                      type: integer
+                                  type: object
+                              type: object
+                            cpu:
+                              description: CPU allow specified the detailed CPU topology inside the vmi.
+                              properties:
+                                cores:
+                                  description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                                dedicatedCpuPlacement:
+                                  description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+                                  type: boolean
+                                features:
+                                  description: Features specifies the CPU features list inside the VMI.
+                                  items:
+                                    description: CPUFeature allows specifying a CPU feature.
+                                    properties:
+                                      name:
+                                        description: Name of the CPU feature
+                                        type: string
+                                      policy:
+                                        description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                isolateEmulatorThread:
+                                  description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+                                  type: boolean
+                                maxSockets:
+                                  description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                                  format: int32
+                                  type: integer
+                                model:
+                                  description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+                                  type: string
+                                sockets:
+                                  description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                                threads:
+                                  description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                              type: object
+                            devices:
+                              description: Devices allows adding disks, network interfaces, and others
+                              properties:
+                                autoattachGraphicsDevice:
+                                  description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
+                                  type: boolean
+                                autoattachMemBalloon:
+                                  description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
+                                  type: boolean
+                                autoattachPodInterface:
+                                  description: Whether to attach a pod network interface. Defaults to true.
+                                  type: boolean
+                                autoattachSerialConsole:
+                                  description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
+                                  type: boolean
+                                autoattachVSOCK:
+                                  description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
+                                  type: boolean
+                                blockMultiQueue:
+                                  description: Whether or not to enable virtio multi-queue for block devices
+                                  type: boolean
+                                disableHotplug:
+                                  description: DisableHotplug disabled the ability to hotplug disks.
+                                  type: boolean
+                                disks:
+                                  description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
+                                  items:
+                                    properties:
+                                      bootOrder:
+                                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
+                                        type: integer
+                                      cache:
+                                        description: Cache specifies which kvm disk cache mode should be used.
+                                        type: string
+                                      cdrom:
+                                        description: Attach a volume as a cdrom to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to true.
+                                            type: boolean
+                                          tray:
+                                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                            type: string
+                                        type: object
+                                      dedicatedIOThread:
+                                        description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
+                                        type: boolean
+                                      disk:
+                                        description: Attach a volume as a disk to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          pciAddress:
+                                            description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                        type: object
+                                      floppy:
+                                        description: Attach a volume as a floppy to the vmi.
+                                        properties:
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                          tray:
+                                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                            type: string
+                                        type: object
+                                      io:
+                                        description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
+                                        type: string
+                                      lun:
+                                        description: Attach a volume as a LUN to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                        type: object
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      serial:
+                                        description: Serial provides the ability to specify a serial number for the disk device.
+                                        type: string
+                                      tag:
+                                        description: If specified, disk address and its tag will be provided to the guest via config drive metadata
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                filesystems:
+                                  description: Filesystems describes filesystem which is connected to the vmi.
+                                  items:
+                                    properties:
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      virtiofs:
+                                        description: Virtiofs is supported
+                                        type: object
+                                    required:
+                                    - name
+                                    - virtiofs
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                gpus:
+                                  description: Whether to attach a GPU device to the vmi.
+                                  items:
+                                    properties:
+                                      deviceName:
+                                        type: string
+                                      name:
+                                        description: Name of the GPU device as exposed by a device plugin
+                                        type: string
+                                    required:
+                                    - deviceName
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                hostDevices:
+                                  description: Whether to attach a host device to the vmi.
+                                  items:
+                                    properties:
+                                      deviceName:
+                                        description: DeviceName is the resource name of the host device exposed by a device plugin
+                                        type: string
+                                      name:
+                                        type: string
+                                    required:
+                                    - deviceName
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                inputs:
+                                  description: Inputs describe input devices
+                                  items:
+                                    properties:
+                                      bus:
+                                        description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
+                                        type: string
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      type:
+                                        description: 'Type indicated the type of input device. Supported values: tablet.'
+                                        type: string
+                                    required:
+                                    - name
+                                    - type
+                                    type: object
+                                  type: array
+                                interfaces:
+                                  description: Interfaces describe network interfaces which are added to the vmi.
+                                  items:
+                                    properties:
+                                      bandwidth:
+                                        description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average rate in bytes per second, at least 1Ki.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic sent by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average rate in bytes per second, at least 1Ki.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
+                                      binding:
+                                        description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                                        properties:
+                                          name:
+                                            description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      bootOrder:
+                                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
+                                        type: integer
+                                      bridge:
+                                        type: object
+                                      dhcpOptions:
+                                        description: If specified the network interface will pass additional DHCP options to the VMI
+                                        properties:
+                                          bootFileName:
+                                            description: If specified will pass option 67 to interface's DHCP server
+                                            type: string
+                                          ntpServers:
+                                            description: If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                            items:
+                                              type: string
+                                            type: array
+                                          privateOptions:
+                                            description: 'If specified will pass extra DHCP options for private use, range: 224-254'
+                                            items:
+                                              description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                                              properties:
+                                                option:
+                                                  description: Option is an Integer value from 224-254 Required.
+                                                  type: integer
+                                                value:
+                                                  description: Value is a String value for the Option provided Required.
+                                                  type: string
+                                              required:
+                                              - option
+                                              - value
+                                              type: object
+                                            type: array
+                                          tftpServerName:
+                                            description: If specified will pass option 66 to interface's DHCP server
+                                            type: string
+                                        type: object
+                                      macAddress:
+                                        description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                        type: string
+                                      macvtap:
+                                        type: object
+                                      masquerade:
+                                        type: object
+                                      model:
+                                        description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
+                                        type: string
+                                      name:
+                                        description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
+                                        type: string
+                                      passt:
+                                        description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                                        type: object
+                                      pciAddress:
+                                        description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                        type: string
+                                      ports:
+                                        description: List of ports to be forwarded to the virtual machine.
+                                        items:
+                                          description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
+                                          properties:
+                                            name:
+                                              description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
+                                              type: string
+                                            port:
+                                              description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
+                                              format: int32
+                                              type: integer
+                                            protocol:
+                                              description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
+                                              type: string
+                                          required:
+                                          - port
+                                          type: object
+                                        type: array
+                                      slirp:
+                                        type: object
+                                      sriov:
+                                        type: object
+                                      tag:
+                                        description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                logSerialConsole:
+                                  description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
+                                  type: boolean
+                                networkInterfaceMultiqueue:
+                                  description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
+                                  type: boolean
+                                rng:
+                                  description: Whether to have random number generator from host
+                                  type: object
+                                tpm:
+                                  description: Whether to emulate a TPM device.
+                                  properties:
+                                    persistent:
+                                      description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                      type: boolean
+                                  type: object
+                                useVirtioTransitional:
+                                  description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
+                                  type: boolean
+                                watchdog:
+                                  description: Watchdog describes a watchdog device which can be added to the vmi.
+                                  properties:
+                                    i6300esb:
+                                      description: i6300esb watchdog device.
+                                      properties:
+                                        action:
+                                          description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
+                                          type: string
+                                      type: object
+                                    name:
+                                      description: Name of the watchdog.
                                       type: string
-                                    type: array
-                                  privateOptions:
-                                    description: 'If specified will pass extra DHCP options for private use, range: 224-254'
-                                    items:
-                                      description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                                  required:
+                                  - name
+                                  type: object
+                              type: object
+                            features:
+                              description: Features like acpi, apic, hyperv, smm.
+                              properties:
+                                acpi:
+                                  description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                  type: object
+                                apic:
+                                  description: Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                    endOfInterrupt:
+                                      description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                                      type: boolean
+                                  type: object
+                                hyperv:
+                                  description: Defaults to the machine type setting.
+                                  properties:
+                                    evmcs:
+                                      description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    frequencies:
+                                      description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    ipi:
+                                      description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    reenlightenment:
+                                      description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    relaxed:
+                                      description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    reset:
+                                      description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    runtime:
+                                      description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
                                       properties:
-                                        option:
-                                          description: Option is an Integer value from 224-254 Required.
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    spinlocks:
+                                      description: Spinlocks allows to configure the spinlock retry attempts.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                        spinlocks:
+                                          description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
+                                          format: int32
                                           type: integer
-                                        value:
-                                          description: Value is a String value for the Option provided Required.
+                                      type: object
+                                    synic:
+                                      description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    synictimer:
+                                      description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    tlbflush:
+                                      description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    vapic:
+                                      description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    vendorid:
+                                      description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                        vendorid:
+                                          description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
                                           type: string
-                                      required:
-                                      - option
-                                      - value
                                       type: object
-                                    type: array
-                                  tftpServerName:
-                                    description: If specified will pass option 66 to interface's DHCP server
-                                    type: string
-                                type: object
-                              macAddress:
-                                description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
-                                type: string
-                              macvtap:
-                                type: object
-                              masquerade:
-                                type: object
-                              model:
-                                description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
-                                type: string
-                              name:
-                                description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
-                                type: string
-                              pciAddress:
-                                description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
-                                type: string
-                              ports:
-                                description: List of ports to be forwarded to the virtual machine.
-                                items:
-                                  description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
+                                    vpindex:
+                                      description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                  type: object
+                                kvm:
+                                  description: Configure how KVM presence is exposed to the guest.
                                   properties:
-                                    name:
-                                      description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
-                                      type: string
-                                    port:
-                                      description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
-                                      format: int32
-                                      type: integer
-                                    protocol:
-                                      description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
-                                      type: string
-                                  required:
-                                  - port
+                                    hidden:
+                                      description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
+                                      type: boolean
+                                  type: object
+                                smm:
+                                  description: SMM enables/disables System Management Mode. TSEG not yet implemented.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
                                   type: object
-                                type: array
-                              slirp:
-                                type: object
-                              sriov:
-                                type: object
-                              tag:
-                                description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
-                                type: string
-                            required:
-                            - name
-                            type: object
-                          type: array
-                        networkInterfaceMultiqueue:
-                          description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
-                          type: boolean
-                        rng:
-                          description: Whether to have random number generator from host
-                          type: object
-                        useVirtioTransitional:
-                          description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
-                          type: boolean
-                        watchdog:
-                          description: Watchdog describes a watchdog device which can be added to the vmi.
-                          properties:
-                            i6300esb:
-                              description: i6300esb watchdog device.
-                              properties:
-                                action:
-                                  description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
-                                  type: string
-                              type: object
-                            name:
-                              description: Name of the watchdog.
-                              type: string
-                          required:
-                          - name
-                          type: object
-                      type: object
-                    features:
-                      description: Features like acpi, apic, hyperv, smm.
-                      properties:
-                        acpi:
-                          description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
-                          properties:
-                            enabled:
-                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                              type: boolean
-                          type: object
-                        apic:
-                          description: Defaults to the machine type setting.
-                          properties:
-                            enabled:
-                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                              type: boolean
-                            endOfInterrupt:
-                              description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
-                              type: boolean
-                          type: object
-                        hyperv:
-                          description: Defaults to the machine type setting.
-                          properties:
-                            evmcs:
-                              description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            frequencies:
-                              description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            ipi:
-                              description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            reenlightenment:
-                              description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            relaxed:
-                              description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            reset:
-                              description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            runtime:
-                              description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            spinlocks:
-                              description: Spinlocks allows to configure the spinlock retry attempts.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                                spinlocks:
-                                  description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
-                                  format: int32
-                                  type: integer
-                              type: object
-                            synic:
-                              description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            synictimer:
-                              description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            tlbflush:
-                              description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                              type: object
-                            vapic:
-                              description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
                               type: object
-                            vendorid:
-                              description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                            firmware:
+                              description: Firmware.
                               properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
-                                vendorid:
-                                  description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                                bootloader:
+                                  description: Settings to control the bootloader that is used.
+                                  properties:
+                                    bios:
+                                      description: If set (default), BIOS will be used.
+                                      properties:
+                                        useSerial:
+                                          description: If set, the BIOS output will be transmitted over serial
+                                          type: boolean
+                                      type: object
+                                    efi:
+                                      description: If set, EFI will be used instead of BIOS.
+                                      properties:
+                                        persistent:
+                                          description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                          type: boolean
+                                        secureBoot:
+                                          description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                                          type: boolean
+                                      type: object
+                                  type: object
+                                serial:
+                                  description: The system-serial-number in SMBIOS
+                                  type: string
+                                uuid:
+                                  description: UUID reported by the vmi bios. Defaults to a random generated uid.
                                   type: string
                               type: object
-                            vpindex:
-                              description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                            ioThreadsPolicy:
+                              description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
+                              type: string
+                            machine:
+                              description: Machine type.
                               properties:
-                                enabled:
-                                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                                  type: boolean
+                                type:
+                                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                                  type: string
+                              required:
+                              - type
                               type: object
-                          type: object
-                        kvm:
-                          description: Configure how KVM presence is exposed to the guest.
-                          properties:
-                            hidden:
-                              description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
-                              type: boolean
-                          type: object
-                        smm:
-                          description: SMM enables/disables System Management Mode. TSEG not yet implemented.
-                          properties:
-                            enabled:
-                              description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
-                              type: boolean
-                          type: object
-                      type: object
-                    firmware:
-                      description: Firmware.
-                      properties:
-                        bootloader:
-                          description: Settings to control the bootloader that is used.
-                          properties:
-                            bios:
-                              description: If set (default), BIOS will be used.
+                            memory:
+                              description: Memory allow specifying the VMI memory features.
                               properties:
-                                useSerial:
-                                  description: If set, the BIOS output will be transmitted over serial
-                                  type: boolean
+                                guest:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                hugepages:
+                                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                                  properties:
+                                    pageSize:
+                                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                                      type: string
+                                  type: object
+                                maxGuest:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
                               type: object
-                            efi:
-                              description: If set, EFI will be used instead of BIOS.
+                            resources:
+                              description: Resources describes the Compute Resources required by this vmi.
                               properties:
-                                secureBoot:
-                                  description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                                limits:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                                  type: object
+                                overcommitGuestOverhead:
+                                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
                                   type: boolean
+                                requests:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                                  type: object
                               type: object
+                          required:
+                          - devices
                           type: object
-                        serial:
-                          description: The system-serial-number in SMBIOS
-                          type: string
-                        uuid:
-                          description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                        evictionStrategy:
+                          description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
                           type: string
-                      type: object
-                    ioThreadsPolicy:
-                      description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
-                      type: string
-                    machine:
-                      description: Machine type.
-                      properties:
-                        type:
-                          description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                        hostname:
+                          description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                           type: string
-                      required:
-                      - type
-                      type: object
-                    memory:
-                      description: Memory allow specifying the VMI memory features.
-                      properties:
-                        guest:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
-                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                          x-kubernetes-int-or-string: true
-                        hugepages:
-                          description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                        livenessProbe:
+                          description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
                           properties:
-                            pageSize:
-                              description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
-                              type: string
-                          type: object
-                      type: object
-                    resources:
-                      description: Resources describes the Compute Resources required by this vmi.
-                      properties:
-                        limits:
-                          additionalProperties:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
-                          type: object
-                        overcommitGuestOverhead:
-                          description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
-                          type: boolean
-                        requests:
-                          additionalProperties:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
-                          type: object
-                      type: object
-                  required:
-                  - devices
-                  type: object
-                evictionStrategy:
-                  description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
-                  type: string
-                hostname:
-                  description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
-                  type: string
-                livenessProbe:
-                  description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                  properties:
-                    failureThreshold:
-                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
-                      properties:
-                        host:
-                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
-                          type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP allows repeated headers.
-                          items:
-                            description: HTTPHeader describes a custom header to be used in HTTP probes
-                            properties:
-                              name:
-                                description: The header field name
-                                type: string
-                              value:
-                                description: The header field value
-                                type: string
-                            required:
-                            - name
-                            - value
-                            type: object
-                          type: array
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: Scheme to use for connecting to the host. Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
-                      type: object
-                    initialDelaySeconds:
-                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    successThreshold:
-                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    tcpSocket:
-                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
-                      properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                      required:
-                      - port
-                      type: object
-                    timeoutSeconds:
-                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                  type: object
-                networks:
-                  description: List of networks that can be attached to a vm's virtual interface.
-                  items:
-                    description: Network represents a network type and a resource that should be connected to the vm.
-                    properties:
-                      multus:
-                        description: Represents the multus cni network.
-                        properties:
-                          default:
-                            description: Select the default network and add it to the multus-cni.io/default-network annotation.
-                            type: boolean
-                          networkName:
-                            description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
-                            type: string
-                        required:
-                        - networkName
-                        type: object
-                      name:
-                        description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                        type: string
-                      pod:
-                        description: Represents the stock pod network interface.
-                        properties:
-                          vmNetworkCIDR:
-                            description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
-                            type: string
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  type: array
-                nodeSelector:
-                  additionalProperties:
-                    type: string
-                  description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
-                  type: object
-                priorityClassName:
-                  description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
-                  type: string
-                readinessProbe:
-                  description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                  properties:
-                    failureThreshold:
-                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
-                      properties:
-                        host:
-                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
-                          type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                            failureThreshold:
+                              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            httpGet:
+                              description: HTTPGet specifies the http request to perform.
+                              properties:
+                                host:
+                                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                                  type: string
+                                httpHeaders:
+                                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                                  items:
+                                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                                    properties:
+                                      name:
+                                        description: The header field name
+                                        type: string
+                                      value:
+                                        description: The header field value
+                                        type: string
+                                    required:
+                                    - name
+                                    - value
+                                    type: object
+                                  type: array
+                                path:
+                                  description: Path to access on the HTTP server.
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                                scheme:
+                                  description: Scheme to use for connecting to the host. Defaults to HTTP.
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            initialDelaySeconds:
+                              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                            periodSeconds:
+                              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            successThreshold:
+                              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            tcpSocket:
+                              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                              properties:
+                                host:
+                                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                              required:
+                              - port
+                              type: object
+                            timeoutSeconds:
+                              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                          type: object
+                        networks:
+                          description: List of networks that can be attached to a vm's virtual interface.
                           items:
-                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            description: Network represents a network type and a resource that should be connected to the vm.
                             properties:
+                              multus:
+                                description: Represents the multus cni network.
+                                properties:
+                                  default:
+                                    description: Select the default network and add it to the multus-cni.io/default-network annotation.
+                                    type: boolean
+                                  networkName:
+                                    description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                                    type: string
+                                required:
+                                - networkName
+                                type: object
                               name:
-                                description: The header field name
-                                type: string
-                              value:
-                                description: The header field value
+                                description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
                                 type: string
+                              pod:
+                                description: Represents the stock pod network interface.
+                                properties:
+                                  vmNetworkCIDR:
+                                    description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                                    type: string
+                                type: object
                             required:
                             - name
-                            - value
                             type: object
                           type: array
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: Scheme to use for connecting to the host. Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
-                      type: object
-                    initialDelaySeconds:
-                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    successThreshold:
-                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    tcpSocket:
-                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
-                      properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                      required:
-                      - port
-                      type: object
-                    timeoutSeconds:
-                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                  type: object
-                schedulerName:
-                  description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
-                  type: string
-                subdomain:
-                  description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
-                  type: string
-                terminationGracePeriodSeconds:
-                  description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
-                  format: int64
-                  type: integer
-                tolerations:
-                  description: If toleration is specified, obey all the toleration rules.
-                  items:
-                    description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
-                    properties:
-                      effect:
-                        description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
-                        type: string
-                      key:
-                        description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
-                        type: string
-                      operator:
-                        description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
-                        type: string
-                      tolerationSeconds:
-                        description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
-                        format: int64
-                        type: integer
-                      value:
-                        description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
-                        type: string
-                    type: object
-                  type: array
-                volumes:
-                  description: List of volumes that can be mounted by disks belonging to the vmi.
-                  items:
-                    description: Volume represents a named volume in a vmi.
-                    properties:
-                      cloudInitConfigDrive:
-                        description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
-                        properties:
-                          networkData:
-                            description: NetworkData contains config drive inline cloud-init networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
-                            type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
-                            properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                                type: string
-                            type: object
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret that contains config drive userdata.
-                            properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                                type: string
-                            type: object
-                          userData:
-                            description: UserData contains config drive inline cloud-init userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
-                            type: string
-                        type: object
-                      cloudInitNoCloud:
-                        description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
-                        properties:
-                          networkData:
-                            description: NetworkData contains NoCloud inline cloud-init networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
-                            type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
-                            properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                                type: string
-                            type: object
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
-                            properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                                type: string
-                            type: object
-                          userData:
-                            description: UserData contains NoCloud inline cloud-init userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
-                            type: string
-                        type: object
-                      configMap:
-                        description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
-                        properties:
-                          name:
-                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                            type: string
-                          optional:
-                            description: Specify whether the ConfigMap or it's keys must be defined
-                            type: boolean
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      containerDisk:
-                        description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
-                        properties:
-                          image:
-                            description: Image is the name of the image with the embedded disk.
-                            type: string
-                          imagePullPolicy:
-                            description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
-                            type: string
-                          imagePullSecret:
-                            description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
-                            type: string
-                          path:
-                            description: Path defines the path to disk file in the container
-                            type: string
-                        required:
-                        - image
-                        type: object
-                      dataVolume:
-                        description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
-                        properties:
-                          name:
-                            description: Name represents the name of the DataVolume in the same namespace
+                        nodeSelector:
+                          additionalProperties:
                             type: string
-                        required:
-                        - name
-                        type: object
-                      downwardAPI:
-                        description: DownwardAPI represents downward API about the pod that should populate this volume
-                        properties:
-                          fields:
-                            description: Fields is a list of downward API volume file
-                            items:
-                              description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                          description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+                          type: object
+                        priorityClassName:
+                          description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+                          type: string
+                        readinessProbe:
+                          description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          properties:
+                            failureThreshold:
+                              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            httpGet:
+                              description: HTTPGet specifies the http request to perform.
                               properties:
-                                fieldRef:
-                                  description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
-                                  properties:
-                                    apiVersion:
-                                      description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
-                                      type: string
-                                    fieldPath:
-                                      description: Path of the field to select in the specified API version.
-                                      type: string
-                                  required:
-                                  - fieldPath
-                                  type: object
-                                mode:
-                                  description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
-                                  format: int32
-                                  type: integer
+                                host:
+                                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                                  type: string
+                                httpHeaders:
+                                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                                  items:
+                                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                                    properties:
+                                      name:
+                                        description: The header field name
+                                        type: string
+                                      value:
+                                        description: The header field value
+                                        type: string
+                                    required:
+                                    - name
+                                    - value
+                                    type: object
+                                  type: array
                                 path:
-                                  description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                                  description: Path to access on the HTTP server.
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                                scheme:
+                                  description: Scheme to use for connecting to the host. Defaults to HTTP.
                                   type: string
-                                resourceFieldRef:
-                                  description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
-                                  properties:
-                                    containerName:
-                                      description: 'Container name: required for volumes, optional for env vars'
-                                      type: string
-                                    divisor:
-                                      anyOf:
-                                      - type: integer
-                                      - type: string
-                                      description: Specifies the output format of the exposed resources, defaults to "1"
-                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                                      x-kubernetes-int-or-string: true
-                                    resource:
-                                      description: 'Required: resource to select'
-                                      type: string
-                                  required:
-                                  - resource
-                                  type: object
                               required:
-                              - path
+                              - port
                               type: object
-                            type: array
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      emptyDisk:
-                        description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk.
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                        required:
-                        - capacity
-                        type: object
-                      ephemeral:
-                        description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
-                        properties:
-                          persistentVolumeClaim:
-                            description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            initialDelaySeconds:
+                              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                            periodSeconds:
+                              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            successThreshold:
+                              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            tcpSocket:
+                              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                              properties:
+                                host:
+                                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                              required:
+                              - port
+                              type: object
+                            timeoutSeconds:
+                              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                          type: object
+                        schedulerName:
+                          description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
+                          type: string
+                        subdomain:
+                          description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
+                          type: string
+                        terminationGracePeriodSeconds:
+                          description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
+                          format: int64
+                          type: integer
+                        tolerations:
+                          description: If toleration is specified, obey all the toleration rules.
+                          items:
+                            description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
                             properties:
-                              claimName:
-                                description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                              effect:
+                                description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                                type: string
+                              key:
+                                description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                                type: string
+                              operator:
+                                description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
+                                type: string
+                              tolerationSeconds:
+                                description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
+                                format: int64
+                                type: integer
+                              value:
+                                description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
                                 type: string
-                              readOnly:
-                                description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                                type: boolean
-                            required:
-                            - claimName
                             type: object
-                        type: object
-                      hostDisk:
-                        description: HostDisk represents a disk created on the cluster level
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          path:
-                            description: The path to HostDisk image located on the cluster
-                            type: string
-                          shared:
-                            description: Shared indicate whether the path is shared between nodes
-                            type: boolean
-                          type:
-                            description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
-                            type: string
-                        required:
-                        - path
-                        - type
-                        type: object
-                      name:
-                        description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                        type: string
-                      persistentVolumeClaim:
-                        description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                        properties:
-                          claimName:
-                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                            type: string
-                          readOnly:
-                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                            type: boolean
-                        required:
-                        - claimName
-                        type: object
-                      secret:
-                        description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
-                        properties:
-                          optional:
-                            description: Specify whether the Secret or it's keys must be defined
-                            type: boolean
-                          secretName:
-                            description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
-                            type: string
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      serviceAccount:
-                        description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
-                        properties:
-                          serviceAccountName:
-                            description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
-                            type: string
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  type: array
+                          type: array
+                        volumes:
+                          description: List of volumes that can be mounted by disks belonging to the vmi.
+                          items:
+                            description: Volume represents a named volume in a vmi.
+                            properties:
+                              cloudInitConfigDrive:
+                                description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                                properties:
+                                  networkData:
+                                    description: NetworkData contains config drive inline cloud-init networkdata.
+                                    type: string
+                                  networkDataBase64:
+                                    description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                                    type: string
+                                  networkDataSecretRef:
+                                    description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secretRef:
+                                    description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  userData:
+                                    description: UserData contains config drive inline cloud-init userdata.
+                                    type: string
+                                  userDataBase64:
+                                    description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
+                                    type: string
+                                type: object
+                              cloudInitNoCloud:
+                                description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                                properties:
+                                  networkData:
+                                    description: NetworkData contains NoCloud inline cloud-init networkdata.
+                                    type: string
+                                  networkDataBase64:
+                                    description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
+                                    type: string
+                                  networkDataSecretRef:
+                                    description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secretRef:
+                                    description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  userData:
+                                    description: UserData contains NoCloud inline cloud-init userdata.
+                                    type: string
+                                  userDataBase64:
+                                    description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
+                                    type: string
+                                type: object
+                              configMap:
+                                description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                    type: string
+                                  optional:
+                                    description: Specify whether the ConfigMap or it's keys must be defined
+                                    type: boolean
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              containerDisk:
+                                description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                                properties:
+                                  image:
+                                    description: Image is the name of the image with the embedded disk.
+                                    type: string
+                                  imagePullPolicy:
+                                    description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                                    type: string
+                                  imagePullSecret:
+                                    description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
+                                    type: string
+                                  path:
+                                    description: Path defines the path to disk file in the container
+                                    type: string
+                                required:
+                                - image
+                                type: object
+                              dataVolume:
+                                description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                                properties:
+                                  name:
+                                    description: Name represents the name of the DataVolume in the same namespace
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              downwardAPI:
+                                description: DownwardAPI represents downward API about the pod that should populate this volume
+                                properties:
+                                  fields:
+                                    description: Fields is a list of downward API volume file
+                                    items:
+                                      description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                                      properties:
+                                        fieldRef:
+                                          description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                                          properties:
+                                            apiVersion:
+                                              description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
+                                              type: string
+                                            fieldPath:
+                                              description: Path of the field to select in the specified API version.
+                                              type: string
+                                          required:
+                                          - fieldPath
+                                          type: object
+                                        mode:
+                                          description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                                          format: int32
+                                          type: integer
+                                        path:
+                                          description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                                          type: string
+                                        resourceFieldRef:
+                                          description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                                          properties:
+                                            containerName:
+                                              description: 'Container name: required for volumes, optional for env vars'
+                                              type: string
+                                            divisor:
+                                              anyOf:
+                                              - type: integer
+                                              - type: string
+                                              description: Specifies the output format of the exposed resources, defaults to "1"
+                                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                              x-kubernetes-int-or-string: true
+                                            resource:
+                                              description: 'Required: resource to select'
+                                              type: string
+                                          required:
+                                          - resource
+                                          type: object
+                                      required:
+                                      - path
+                                      type: object
+                                    type: array
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              downwardMetrics:
+                                description: DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                                type: object
+                              emptyDisk:
+                                description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                                properties:
+                                  capacity:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Capacity of the sparse disk.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - capacity
+                                type: object
+                              ephemeral:
+                                description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
+                                properties:
+                                  persistentVolumeClaim:
+                                    description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    properties:
+                                      claimName:
+                                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                        type: string
+                                      readOnly:
+                                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                        type: boolean
+                                    required:
+                                    - claimName
+                                    type: object
+                                type: object
+                              hostDisk:
+                                description: HostDisk represents a disk created on the cluster level
+                                properties:
+                                  capacity:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Capacity of the sparse disk
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  path:
+                                    description: The path to HostDisk image located on the cluster
+                                    type: string
+                                  shared:
+                                    description: Shared indicate whether the path is shared between nodes
+                                    type: boolean
+                                  type:
+                                    description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
+                                    type: string
+                                required:
+                                - path
+                                - type
+                                type: object
+                              memoryDump:
+                                description: MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+                                properties:
+                                  claimName:
+                                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    type: string
+                                  readOnly:
+                                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                    type: boolean
+                                required:
+                                - claimName
+                                type: object
+                              name:
+                                description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                                type: string
+                              persistentVolumeClaim:
+                                description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                properties:
+                                  claimName:
+                                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    type: string
+                                  readOnly:
+                                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                    type: boolean
+                                required:
+                                - claimName
+                                type: object
+                              secret:
+                                description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                                properties:
+                                  optional:
+                                    description: Specify whether the Secret or it's keys must be defined
+                                    type: boolean
+                                  secretName:
+                                    description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                                    type: string
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              serviceAccount:
+                                description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                                properties:
+                                  serviceAccountName:
+                                    description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                                    type: string
+                                type: object
+                              sysprep:
+                                description: 'Sysprep represents a Sysprep answer file for the unattended setup of Windows. The answer file will be added as a CD-ROM to the vmi. More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview'
+                                properties:
+                                  configMap:
+                                    description: ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secret:
+                                    description: Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                type: object
+                            required:
+                            - name
+                            type: object
+                          type: array
+                      required:
+                      - domain
+                      type: object
+                  type: object
               required:
-              - domain
+              - template
               type: object
           type: object
       required:
       - selector
-      - template
+      - virtualMachineTemplate
       type: object
     status:
-      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
-      nullable: true
+      description: VirtualMachinePoolStatus represents the observed state of a VirtualMachinePool.
       properties:
         conditions:
           items:
+            description: VirtualMachinePoolCondition represents the state of a VirtualMachinePool at a certain point.
             properties:
               lastProbeTime:
                 format: date-time
@@ -7407,17 +10746,85 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes it through the scale subresource.
           type: string
         readyReplicas:
-          description: The number of ready replicas for this replica set.
+          description: The number of ready VirtualMachines for this pool.
           format: int32
           type: integer
         replicas:
-          description: Total number of non-terminated pods targeted by this deployment (their labels match the selector).
+          description: Total number of non-terminated VirtualMachines targeted by this pool (their labels match the selector).
           format: int32
           type: integer
       type: object
   required:
   - spec
   type: object
+`,
+	"virtualmachinepreference": `openAPIV3Schema:
+  description: VirtualMachinePreference resource contains optional preferences related to the VirtualMachine.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        cpu:
+          description: CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredCPUTopology:
+              description: PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+              type: string
+          type: object
+        devices:
+          description: Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAutoattachGraphicsDevice:
+              description: PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+              type: boolean
+            preferredAutoattachSerialConsole:
+              description: PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+              type: boolean
+            preferredCdromBus:
+              description: PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+              type: string
+            preferredDiskBus:
+              description: PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+              type: string
+            preferredInterfaceModel:
+              description: PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+              type: string
+            preferredLunBus:
+              description: PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+              type: string
+          type: object
+        firmware:
+          description: Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredUseBios:
+              description: PreferredUseBios optionally enables BIOS
+              type: boolean
+            preferredUseEfi:
+              description: PreferredUseEfi optionally enables EFI
+              type: boolean
+            preferredUseSecureBoot:
+              description: PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones.
+              type: boolean
+          type: object
+        machine:
+          description: Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredMachineType:
+              description: PreferredMachineType optionally defines the preferred machine type to use.
+              type: string
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinerestore": `openAPIV3Schema:
   description: VirtualMachineRestore defines the operation of restoring a VM
@@ -7434,7 +10841,7 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
         target:
-          description: initially only VirtualMachine type supported
+          description: initially only VirtualMachine type supported, a VirtualMachine that does not exist yet is created from the snapshot
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
@@ -7594,6 +11001,12 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        indications:
+          items:
+            description: Indication is a way to indicate the state of the vm when taking the snapshot
+            type: string
+          type: array
+          x-kubernetes-list-type: set
         readyToUse:
           type: boolean
         sourceUID:
@@ -7862,6 +11275,36 @@ var CRDsValidation map[string]string = map[string]string{
                         - spec
                         type: object
                       type: array
+                    instancetype:
+                      description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+                      properties:
+                        kind:
+                          description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+                          type: string
+                        name:
+                          description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+                          type: string
+                        revisionName:
+                          description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - name
+                      type: object
+                    preference:
+                      description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+                      properties:
+                        kind:
+                          description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+                          type: string
+                        name:
+                          description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+                          type: string
+                        revisionName:
+                          description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the preference is applied to the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - name
+                      type: object
                     runStrategy:
                       description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
                       type: string
@@ -8432,6 +11875,10 @@ var CRDsValidation map[string]string = map[string]string{
                                     isolateEmulatorThread:
                                       description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
                                       type: boolean
+                                    maxSockets:
+                                      description: MaxSockets specifies the maximum number of sockets the vmi can have. Sockets can be hotplugged into a running vmi up to this value. Must be a value greater or equal to Sockets.
+                                      format: int32
+                                      type: integer
                                     model:
                                       description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
                                       type: string
@@ -8459,6 +11906,9 @@ var CRDsValidation map[string]string = map[string]string{
                                     autoattachSerialConsole:
                                       description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
                                       type: boolean
+                                    autoattachVSOCK:
+                                      description: Whether to attach the VSOCK CID to the VM or not. VSOCK access will be available if set to true. Requires the VSOCK feature gate. Defaults to false.
+                                      type: boolean
                                     blockMultiQueue:
                                       description: Whether or not to enable virtio multi-queue for block devices
                                       type: boolean
@@ -8608,6 +12058,73 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Interfaces describe network interfaces which are added to the vmi.
                                       items:
                                         properties:
+                                          bandwidth:
+                                            description: Bandwidth limits the traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. It can be changed on a running VMI.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average rate in bytes per second, at least 1Ki.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average rate in bytes per second, at least 1Ki.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak rate in bytes per second at which bursts are sent, at least the average rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
+                                          binding:
+                                            description: Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest. Mutually exclusive with the other binding methods.
+                                            properties:
+                                              name:
+                                                description: Name of the binding plugin, as registered in the network configuration of KubeVirt.
+                                                type: string
+                                            required:
+                                            - name
+                                            type: object
                                           bootOrder:
                                             description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                                             type: integer
@@ -8657,6 +12174,9 @@ var CRDsValidation map[string]string = map[string]string{
                                           name:
                                             description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
                                             type: string
+                                          passt:
+                                            description: InterfacePasst connects the interface to the pod network through the passt user-space network stack, the guest gets the IP addresses of the pod.
+                                            type: object
                                           pciAddress:
                                             description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
                                             type: string
@@ -8690,12 +12210,22 @@ var CRDsValidation map[string]string = map[string]string{
                                         - name
                                         type: object
                                       type: array
+                                    logSerialConsole:
+                                      description: Whether to log the auto-attached default serial console or not. The serial console output is written to a log file which is streamed by the guest-console-log container of the pod. Not relevant if autoattachSerialConsole is disabled. Defaults to the cluster wide logSerialConsole setting.
+                                      type: boolean
                                     networkInterfaceMultiqueue:
                                       description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                                       type: boolean
                                     rng:
                                       description: Whether to have random number generator from host
                                       type: object
+                                    tpm:
+                                      description: Whether to emulate a TPM device.
+                                      properties:
+                                        persistent:
+                                          description: If set to true, Persistent will persist the state of the TPM device across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                          type: boolean
+                                      type: object
                                     useVirtioTransitional:
                                       description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
                                       type: boolean
@@ -8876,6 +12406,9 @@ var CRDsValidation map[string]string = map[string]string{
                                         efi:
                                           description: If set, EFI will be used instead of BIOS.
                                           properties:
+                                            persistent:
+                                              description: If set to true, Persistent will persist the EFI NVRAM across reboots in a PersistentVolumeClaim owned by the VirtualMachine. Requires the VMPersistentState feature gate. Defaults to false
+                                              type: boolean
                                             secureBoot:
                                               description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
                                               type: boolean
@@ -8917,6 +12450,13 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
                                           type: string
                                       type: object
+                                    maxGuest:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS. The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value. Requires Guest to be set.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
                                   type: object
                                 resources:
                                   description: Resources describes the Compute Resources required by this vmi.
@@ -9335,6 +12875,9 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
                                         type: string
                                     type: object
+                                  downwardMetrics:
+                                    description: DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                                    type: object
                                   emptyDisk:
                                     description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
                                     properties:
@@ -9387,6 +12930,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     - path
                                     - type
                                     type: object
+                                  memoryDump:
+                                    description: MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+                                    properties:
+                                      claimName:
+                                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                        type: string
+                                      readOnly:
+                                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                        type: boolean
+                                    required:
+                                    - claimName
+                                    type: object
                                   name:
                                     description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
                                     type: string
@@ -9422,6 +12977,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
                                         type: string
                                     type: object
+                                  sysprep:
+                                    description: 'Sysprep represents a Sysprep answer file for the unattended setup of Windows. The answer file will be added as a CD-ROM to the vmi. More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview'
+                                    properties:
+                                      configMap:
+                                        description: ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                                        properties:
+                                          name:
+                                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                            type: string
+                                        type: object
+                                      secret:
+                                        description: Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+                                        properties:
+                                          name:
+                                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                            type: string
+                                        type: object
+                                    type: object
                                 required:
                                 - name
                                 type: object
@@ -9465,6 +13038,66 @@ var CRDsValidation map[string]string = map[string]string{
                     created:
                       description: Created indicates if the virtual machine is created in the cluster
                       type: boolean
+                    interfaceRequests:
+                      description: InterfaceRequests indicates a list of interfaces to add or remove from the VMI template and hotplug on an active running VMI.
+                      items:
+                        properties:
+                          addInterfaceOptions:
+                            description: AddInterfaceOptions when set indicates an interface should be added. The details within this field specify how to add the interface
+                            properties:
+                              name:
+                                description: Name is the name of both the interface and the network which are added
+                                type: string
+                              networkAttachmentDefinitionName:
+                                description: NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition, either as <name> in the namespace of the VMI or as <namespace>/<name>
+                                type: string
+                            required:
+                            - name
+                            - networkAttachmentDefinitionName
+                            type: object
+                          removeInterfaceOptions:
+                            description: RemoveInterfaceOptions when set indicates an interface should be removed. The details within this field specify which interface to remove
+                            properties:
+                              name:
+                                description: Name is the name of both the interface and the network which are removed
+                                type: string
+                            required:
+                            - name
+                            type: object
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    memoryDumpRequest:
+                      description: MemoryDumpRequest tracks memory dump request phase and info of getting a memory dump to the given pvc
+                      nullable: true
+                      properties:
+                        claimName:
+                          description: ClaimName is the name of the pvc that will contain the memory dump
+                          type: string
+                        endTimestamp:
+                          description: EndTimestamp represents the time the memory dump was completed
+                          format: date-time
+                          type: string
+                        fileName:
+                          description: FileName represents the name of the output file
+                          type: string
+                        message:
+                          description: Message is a detailed message about failure of the memory dump
+                          type: string
+                        phase:
+                          description: Phase represents the memory dump phase
+                          type: string
+                        remove:
+                          description: Remove represents request of dissociating the memory dump pvc
+                          type: boolean
+                        startTimestamp:
+                          description: StartTimestamp represents the time the memory dump started
+                          format: date-time
+                          type: string
+                      required:
+                      - claimName
+                      - phase
+                      type: object
                     ready:
                       description: Ready indicates if the virtual machine is running and ready
                       type: boolean
@@ -9796,5 +13429,124 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule periodically snapshots a set of VMs
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is set on every VirtualMachineSnapshot created by the schedule
+          type: string
+        disabled:
+          description: Disabled pauses creating new snapshots, retention is still enforced
+          type: boolean
+        retention:
+          description: VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule are pruned
+          properties:
+            maxAge:
+              description: MaxAge is the age after which a snapshot is deleted
+              type: string
+            maxCount:
+              description: MaxCount is the number of snapshots kept per VirtualMachine
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: Schedule in cron format ("minute hour day-of-month month day-of-week"), evaluated in UTC
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines in the namespace of the schedule
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastSnapshotTime:
+          format: date-time
+          nullable: true
+          type: string
+        nextSnapshotTime:
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
@@ -315,7 +315,11 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewVirtualMachineInstanceCrd, components.NewPresetCrd, components.NewReplicaSetCrd,
 		components.NewVirtualMachineCrd, components.NewVirtualMachineInstanceMigrationCrd,
 		components.NewVirtualMachineSnapshotCrd, components.NewVirtualMachineSnapshotContentCrd,
-		components.NewVirtualMachineRestoreCrd,
+		components.NewVirtualMachineRestoreCrd, components.NewVirtualMachineSnapshotScheduleCrd,
+		components.NewVirtualMachineInstancetypeCrd, components.NewVirtualMachineClusterInstancetypeCrd,
+		components.NewVirtualMachinePreferenceCrd, components.NewVirtualMachineClusterPreferenceCrd,
+		components.NewVirtualMachinePoolCrd, components.NewVirtualMachineCloneCrd,
+		components.NewVirtualMachineExportCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
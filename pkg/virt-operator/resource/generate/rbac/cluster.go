@@ -123,6 +123,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachineinstances/console",
 					"virtualmachineinstances/vnc",
+					"virtualmachineinstances/portforward",
+					"virtualmachines/portforward",
 				},
 				Verbs: []string{
 					"get",
@@ -135,8 +137,14 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachineinstances/pause",
 					"virtualmachineinstances/unpause",
+					"virtualmachineinstances/freeze",
+					"virtualmachineinstances/unfreeze",
+					"virtualmachineinstances/softreboot",
+					"virtualmachineinstances/guestexec",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
+					"virtualmachineinstances/addinterface",
+					"virtualmachineinstances/removeinterface",
 				},
 				Verbs: []string{
 					"get",
@@ -151,6 +159,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/start",
 					"virtualmachines/stop",
 					"virtualmachines/restart",
+					"virtualmachines/memorydump",
+					"virtualmachines/removememorydump",
+					"virtualmachines/addinterface",
+					"virtualmachines/removeinterface",
 				},
 				Verbs: []string{
 					"update",
@@ -179,6 +191,64 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstancetypes",
+					"virtualmachinepreferences",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclusterinstancetypes",
+					"virtualmachineclusterpreferences",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"pool.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepools",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"clone.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclones",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"export.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineexports",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -209,6 +279,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachineinstances/console",
 					"virtualmachineinstances/vnc",
+					"virtualmachineinstances/portforward",
+					"virtualmachines/portforward",
 				},
 				Verbs: []string{
 					"get",
@@ -221,8 +293,14 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachineinstances/pause",
 					"virtualmachineinstances/unpause",
+					"virtualmachineinstances/freeze",
+					"virtualmachineinstances/unfreeze",
+					"virtualmachineinstances/softreboot",
+					"virtualmachineinstances/guestexec",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
+					"virtualmachineinstances/addinterface",
+					"virtualmachineinstances/removeinterface",
 				},
 				Verbs: []string{
 					"get",
@@ -237,6 +315,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/start",
 					"virtualmachines/stop",
 					"virtualmachines/restart",
+					"virtualmachines/memorydump",
+					"virtualmachines/removememorydump",
+					"virtualmachines/addinterface",
+					"virtualmachines/removeinterface",
 				},
 				Verbs: []string{
 					"update",
@@ -265,11 +347,69 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstancetypes",
+					"virtualmachinepreferences",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclusterinstancetypes",
+					"virtualmachineclusterpreferences",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"pool.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepools",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"clone.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclones",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					"export.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineexports",
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -311,6 +451,54 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstancetypes",
+					"virtualmachineclusterinstancetypes",
+					"virtualmachinepreferences",
+					"virtualmachineclusterpreferences",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"pool.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepools",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"clone.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclones",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"export.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineexports",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
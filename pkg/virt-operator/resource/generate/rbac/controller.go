@@ -87,6 +87,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "update", "create",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"secrets", "services",
+				},
+				Verbs: []string{
+					"get", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -131,6 +142,31 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "create", "update", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"apps",
+				},
+				Resources: []string{
+					"controllerrevisions",
+				},
+				Verbs: []string{
+					"create", "get",
+				},
+			},
+			{
+				APIGroups: []string{
+					"instancetype.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstancetypes",
+					"virtualmachineclusterinstancetypes",
+					"virtualmachinepreferences",
+					"virtualmachineclusterpreferences",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"snapshot.kubevirt.io",
@@ -142,6 +178,40 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"*",
 				},
 			},
+			{
+				APIGroups: []string{
+					"pool.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepools",
+					"virtualmachinepools/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"clone.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineclones",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"export.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineexports",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					"kubevirt.io",
@@ -160,6 +230,10 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
+					"virtualmachineinstances/addinterface",
+					"virtualmachineinstances/removeinterface",
+					"virtualmachineinstances/freeze",
+					"virtualmachineinstances/unfreeze",
 				},
 				Verbs: []string{
 					"get",
@@ -254,6 +328,19 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"networking.k8s.io",
+				},
+				Resources: []string{
+					"ingresses",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
 		},
 	}
 }
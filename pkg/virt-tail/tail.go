@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package virttail
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+const pollInterval = 500 * time.Millisecond
+
+// Tailer follows a log file which is rotated by renaming it and creating a new one,
+// or which is truncated in place, and copies everything written to it to out
+type Tailer struct {
+	path string
+	out  io.Writer
+	file *os.File
+}
+
+// NewTailer returns a Tailer copying the log file at path to out
+func NewTailer(path string, out io.Writer) *Tailer {
+	return &Tailer{path: path, out: out}
+}
+
+// open opens the log file if it exists, it is created by virtlogd once the guest starts
+func (t *Tailer) open() error {
+	file, err := os.Open(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	t.file = file
+	return nil
+}
+
+// drain copies everything written to the open file since the last call
+func (t *Tailer) drain() error {
+	if t.file == nil {
+		if err := t.open(); err != nil || t.file == nil {
+			return err
+		}
+	}
+	if _, err := io.Copy(t.out, t.file); err != nil {
+		return err
+	}
+
+	current, err := t.file.Stat()
+	if err != nil {
+		return err
+	}
+	offset, err := t.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if current.Size() < offset {
+		// truncated in place, start over
+		_, err = t.file.Seek(0, io.SeekStart)
+		return err
+	}
+
+	latest, err := os.Stat(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !os.SameFile(current, latest) {
+		// rotated, the old file has been drained already
+		t.file.Close()
+		t.file = nil
+		return t.open()
+	}
+	return nil
+}
+
+// Run follows the log file until stop is closed
+func (t *Tailer) Run(stop <-chan struct{}) error {
+	defer func() {
+		if t.file != nil {
+			t.file.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.drain(); err != nil {
+			return err
+		}
+		select {
+		case <-stop:
+			// print what the guest wrote while the pod was shutting down
+			return t.drain()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package virttail
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tailer", func() {
+	var dir, logFile string
+	var out *bytes.Buffer
+	var tailer *Tailer
+
+	appendLog := func(content string) {
+		file, err := os.OpenFile(logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+		_, err = file.WriteString(content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "virt-tail")
+		Expect(err).ToNot(HaveOccurred())
+		logFile = filepath.Join(dir, "serial0")
+		out = &bytes.Buffer{}
+		tailer = NewTailer(logFile, out)
+	})
+
+	AfterEach(func() {
+		if tailer.file != nil {
+			tailer.file.Close()
+		}
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should wait for the log file to be created", func() {
+		Expect(tailer.drain()).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+
+		appendLog("booting\n")
+		Expect(tailer.drain()).To(Succeed())
+		Expect(out.String()).To(Equal("booting\n"))
+	})
+
+	It("should copy what is appended to the log file", func() {
+		appendLog("first\n")
+		Expect(tailer.drain()).To(Succeed())
+		appendLog("second\n")
+		Expect(tailer.drain()).To(Succeed())
+		Expect(tailer.drain()).To(Succeed())
+		Expect(out.String()).To(Equal("first\nsecond\n"))
+	})
+
+	It("should follow the log file after a rotation", func() {
+		appendLog("before\n")
+		Expect(tailer.drain()).To(Succeed())
+
+		appendLog("late\n")
+		Expect(os.Rename(logFile, logFile+".1")).To(Succeed())
+		appendLog("after\n")
+		Expect(tailer.drain()).To(Succeed())
+		Expect(tailer.drain()).To(Succeed())
+
+		Expect(out.String()).To(Equal("before\nlate\nafter\n"))
+	})
+
+	It("should start over after the log file was truncated", func() {
+		appendLog("a long line before the truncation\n")
+		Expect(tailer.drain()).To(Succeed())
+
+		Expect(os.Truncate(logFile, 0)).To(Succeed())
+		appendLog("short\n")
+		Expect(tailer.drain()).To(Succeed())
+		Expect(tailer.drain()).To(Succeed())
+
+		Expect(out.String()).To(Equal("a long line before the truncation\nshort\n"))
+	})
+
+	It("should copy what is left in the log file on shutdown", func() {
+		appendLog("running\n")
+		stop := make(chan struct{})
+		close(stop)
+
+		Expect(tailer.Run(stop)).To(Succeed())
+		Expect(out.String()).To(Equal("running\n"))
+	})
+
+	It("should stop when asked to", func() {
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- tailer.Run(stop)
+		}()
+
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package guestexec
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUESTEXEC = "guest-exec"
+
+	stdinFlag   = "stdin"
+	timeoutFlag = "timeout"
+)
+
+var (
+	stdin   bool
+	timeout time.Duration
+)
+
+// ExitError is returned when the command in the guest exited with a non-zero exit code,
+// virtctl exits with the same code.
+type ExitError struct {
+	ExitCode int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.ExitCode)
+}
+
+// NewGuestExecCommand returns a cobra.Command for running a command in the guest of a VirtualMachineInstance
+func NewGuestExecCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guest-exec (VMI) -- COMMAND [args...]",
+		Short: "Run a command in the guest of a running VMI via the guest agent",
+		Long: `Runs a command in the guest of a running VirtualMachineInstance through the QEMU guest agent and prints its output.
+No network connection to the guest is required. The command is not run in a shell and is not interactive.
+virtctl exits with the exit code of the command.`,
+		Args:    validateArgs,
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig}
+			return c.run(cmd, args)
+		},
+	}
+	cmd.Flags().BoolVarP(&stdin, stdinFlag, "i", false, "Pass the standard input of virtctl to the command.")
+	cmd.Flags().DurationVar(&timeout, timeoutFlag, time.Duration(v1.DefaultGuestExecTimeoutSeconds)*time.Second,
+		fmt.Sprintf("The time the command is given to exit, at most %ds.", v1.MaxGuestExecTimeoutSeconds))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Print the kernel version of the guest of 'myvmi':
+  {{ProgramName}} guest-exec myvmi -- uname -r
+
+  # Pass a script on stdin and give it up to two minutes to finish:
+  {{ProgramName}} guest-exec myvmi --stdin --timeout=2m -- /bin/sh < diagnose.sh`
+	return usage
+}
+
+func validateArgs(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		fmt.Printf("fatal: Number of input parameters is incorrect, %s accepts the VMI name followed by the command and its args, received %d arg(s)\n\n", COMMAND_GUESTEXEC, len(args))
+		cmd.Help()
+		return fmt.Errorf("argument validation failed")
+	}
+	if dash := cmd.ArgsLenAtDash(); dash != -1 && dash != 1 {
+		return fmt.Errorf("expected exactly one VMI name before --, got %d", dash)
+	}
+	return nil
+}
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	if timeout < time.Second || timeout > time.Duration(v1.MaxGuestExecTimeoutSeconds)*time.Second {
+		return fmt.Errorf("--%s must be between 1s and %ds", timeoutFlag, v1.MaxGuestExecTimeoutSeconds)
+	}
+	timeoutSeconds := int32(timeout.Seconds())
+
+	request := &v1.VirtualMachineInstanceGuestExecRequest{
+		Command:        args[1],
+		Args:           args[2:],
+		TimeoutSeconds: &timeoutSeconds,
+	}
+	if stdin {
+		input, err := ioutil.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return fmt.Errorf("error reading stdin: %v", err)
+		}
+		request.Stdin = string(input)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(vmiName, request)
+	if err != nil {
+		return fmt.Errorf("error running command in VirtualMachineInstance %s: %v", vmiName, err)
+	}
+
+	io.WriteString(cmd.OutOrStdout(), result.Stdout)
+	io.WriteString(cmd.ErrOrStderr(), result.Stderr)
+
+	if result.ExitCode != 0 {
+		return &ExitError{ExitCode: int(result.ExitCode)}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package guestexec_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGuestExec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GuestExec Suite")
+}
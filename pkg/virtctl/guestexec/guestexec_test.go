@@ -0,0 +1,101 @@
+package guestexec_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/tests"
+)
+
+const (
+	commandName     = "guest-exec"
+	targetNamespace = "default"
+	vmiName         = "testvmi"
+)
+
+var _ = Describe("GuestExec", func() {
+
+	var (
+		ctrl         *gomock.Controller
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(targetNamespace).Return(vmiInterface).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	timeoutSeconds := func(seconds int32) *int32 {
+		return &seconds
+	}
+
+	It("should run the command and print its output", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, &v1.VirtualMachineInstanceGuestExecRequest{
+			Command:        "ls",
+			Args:           []string{"-l", "/tmp"},
+			TimeoutSeconds: timeoutSeconds(v1.DefaultGuestExecTimeoutSeconds),
+		}).Return(&v1.VirtualMachineInstanceGuestExecResult{Stdout: "out", Stderr: "err"}, nil)
+
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd := tests.NewVirtctlCommand(commandName, vmiName, "--", "ls", "-l", "/tmp")
+		cmd.SetOut(stdout)
+		cmd.SetErr(stderr)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(stdout.String()).To(Equal("out"))
+		Expect(stderr.String()).To(Equal("err"))
+	})
+
+	It("should pass stdin and the timeout to the command", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, &v1.VirtualMachineInstanceGuestExecRequest{
+			Command:        "/bin/sh",
+			Args:           []string{},
+			Stdin:          "echo hello",
+			TimeoutSeconds: timeoutSeconds(120),
+		}).Return(&v1.VirtualMachineInstanceGuestExecResult{Stdout: "hello\n"}, nil)
+
+		cmd := tests.NewVirtctlCommand(commandName, vmiName, "--stdin", "--timeout=2m", "--", "/bin/sh")
+		cmd.SetIn(strings.NewReader("echo hello"))
+		cmd.SetOut(&bytes.Buffer{})
+		Expect(cmd.Execute()).To(Succeed())
+	})
+
+	It("should return the exit code of a failed command", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, gomock.Any()).Return(&v1.VirtualMachineInstanceGuestExecResult{ExitCode: 2}, nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, vmiName, "--", "false")
+		Expect(cmd()).To(Equal(&guestexec.ExitError{ExitCode: 2}))
+	})
+
+	It("should fail when the subresource fails", func() {
+		vmiInterface.EXPECT().GuestExec(vmiName, gomock.Any()).Return(nil, fmt.Errorf("VMI does not have guest agent connected"))
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, vmiName, "--", "ls")
+		Expect(cmd()).To(MatchError(ContainSubstring("VMI does not have guest agent connected")))
+	})
+
+	DescribeTable("should reject invalid arguments", func(expected string, args ...string) {
+		cmd := tests.NewRepeatableVirtctlCommand(append([]string{commandName}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("without a command", "argument validation failed", vmiName),
+		Entry("with more than one name before --", "expected exactly one VMI name", vmiName, "other", "--", "ls"),
+		Entry("with a too long timeout", "--timeout must be between", vmiName, "--timeout=10m", "--", "ls"),
+		Entry("with a too short timeout", "--timeout must be between", vmiName, "--timeout=0s", "--", "ls"),
+	)
+})
@@ -0,0 +1,202 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package memorydump
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirttypes "kubevirt.io/kubevirt/pkg/util/types"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_MEMORYDUMP = "memory-dump"
+	ACTION_GET         = "get"
+	ACTION_REMOVE      = "remove"
+
+	claimNameFlag    = "claim-name"
+	createClaimFlag  = "create-claim"
+	storageClassFlag = "storage-class"
+	accessModeFlag   = "access-mode"
+)
+
+var (
+	claimName    string
+	createClaim  bool
+	storageClass string
+	accessMode   string
+)
+
+// NewMemoryDumpCommand returns a cobra.Command for dumping the memory of a VirtualMachine into a PVC
+func NewMemoryDumpCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memory-dump get|remove (VM)",
+		Short: "Dump the memory of a running VM to a given PVC",
+		Long: `Dumps the memory of a running VirtualMachine into a PersistentVolumeClaim or removes the association with it.
+First argument is the action, possible actions are get and remove.
+Second argument is the name of the VirtualMachine.`,
+		Args:    templates.ExactArgs(COMMAND_MEMORYDUMP, 2),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig}
+			return c.run(args)
+		},
+	}
+	cmd.Flags().StringVar(&claimName, claimNameFlag, "", "The PVC the memory is dumped into, defaults to the PVC of the previous memory dump.")
+	cmd.Flags().BoolVar(&createClaim, createClaimFlag, false, "Create the PVC with the size required for the memory dump.")
+	cmd.Flags().StringVar(&storageClass, storageClassFlag, "", "The storage class of the created PVC.")
+	cmd.Flags().StringVar(&accessMode, accessModeFlag, "", "The access mode of the created PVC.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Dump the memory of a running VirtualMachine called 'myvm' into an existing PVC called 'memoryvolume':
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume
+
+  # Create a PVC called 'memoryvolume' and dump the memory of 'myvm' into it:
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --storage-class=local
+
+  # Dump the memory of 'myvm' again, into the PVC of the previous memory dump:
+  {{ProgramName}} memory-dump get myvm
+
+  # Remove the association of 'myvm' with the memory dump PVC:
+  {{ProgramName}} memory-dump remove myvm`
+	return usage
+}
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *command) run(args []string) error {
+	action, vmName := args[0], args[1]
+
+	switch action {
+	case ACTION_GET:
+		if createClaim && claimName == "" {
+			return fmt.Errorf("--%s is required with --%s", claimNameFlag, createClaimFlag)
+		}
+	case ACTION_REMOVE:
+		if claimName != "" || createClaim {
+			return fmt.Errorf("--%s and --%s are not allowed with %s", claimNameFlag, createClaimFlag, action)
+		}
+	default:
+		return fmt.Errorf("invalid action %s, expected one of %s or %s", action, ACTION_GET, ACTION_REMOVE)
+	}
+	if !createClaim && (storageClass != "" || accessMode != "") {
+		return fmt.Errorf("--%s and --%s are only allowed with --%s", storageClassFlag, accessModeFlag, createClaimFlag)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	if action == ACTION_REMOVE {
+		if err := virtClient.VirtualMachine(namespace).RemoveMemoryDump(vmName); err != nil {
+			return fmt.Errorf("error removing memory dump association of VirtualMachine %s: %v", vmName, err)
+		}
+		fmt.Printf("Successfully submitted memory dump removal request of VM %s\n", vmName)
+		return nil
+	}
+
+	return getMemoryDump(virtClient, namespace, vmName)
+}
+
+func getMemoryDump(virtClient kubecli.KubevirtClient, namespace, vmName string) error {
+	claim := claimName
+	if claim == "" {
+		vm, err := virtClient.VirtualMachine(namespace).Get(vmName, &metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting VirtualMachine %s: %v", vmName, err)
+		}
+		if vm.Status.MemoryDumpRequest == nil {
+			return fmt.Errorf("--%s is required, VirtualMachine %s has no previous memory dump", claimNameFlag, vmName)
+		}
+		claim = vm.Status.MemoryDumpRequest.ClaimName
+	}
+
+	if createClaim {
+		if err := createMemoryDumpClaim(virtClient, namespace, vmName, claim); err != nil {
+			return err
+		}
+	}
+
+	err := virtClient.VirtualMachine(namespace).MemoryDump(vmName, &v1.VirtualMachineMemoryDumpRequest{ClaimName: claim})
+	if err != nil {
+		return fmt.Errorf("error dumping the memory of VirtualMachine %s: %v", vmName, err)
+	}
+	fmt.Printf("Successfully submitted memory dump request of VM %s\n", vmName)
+	return nil
+}
+
+func createMemoryDumpClaim(virtClient kubecli.KubevirtClient, namespace, vmName, claim string) error {
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(vmName, &metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachineInstance %s: %v", vmName, err)
+	}
+
+	volumeMode := k8sv1.PersistentVolumeFilesystem
+	pvc := &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claim,
+			Namespace: namespace,
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			VolumeMode: &volumeMode,
+			Resources: k8sv1.ResourceRequirements{
+				Requests: k8sv1.ResourceList{
+					k8sv1.ResourceStorage: kubevirttypes.GetMemoryDumpSize(vmi),
+				},
+			},
+		},
+	}
+	if storageClass != "" {
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	if accessMode != "" {
+		mode := k8sv1.PersistentVolumeAccessMode(accessMode)
+		if mode != k8sv1.ReadWriteOnce && mode != k8sv1.ReadWriteMany {
+			return fmt.Errorf("invalid access mode %s, expected one of %s or %s", accessMode, k8sv1.ReadWriteOnce, k8sv1.ReadWriteMany)
+		}
+		pvc.Spec.AccessModes = []k8sv1.PersistentVolumeAccessMode{mode}
+	}
+
+	_, err = virtClient.CoreV1().PersistentVolumeClaims(namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating PVC %s: %v", claim, err)
+	}
+	fmt.Printf("PVC %s/%s created\n", namespace, claim)
+	return nil
+}
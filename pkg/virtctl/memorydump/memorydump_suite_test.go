@@ -0,0 +1,13 @@
+package memorydump_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryDump(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "MemoryDump Suite")
+}
@@ -0,0 +1,114 @@
+package memorydump_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8sclient "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/tests"
+)
+
+const (
+	commandName     = "memory-dump"
+	targetNamespace = "default"
+	vmName          = "testvm"
+	claimName       = "testclaim"
+)
+
+var _ = Describe("MemoryDump", func() {
+
+	var (
+		ctrl         *gomock.Controller
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		kubeClient   *fakek8sclient.Clientset
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubeClient = fakek8sclient.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(targetNamespace).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(targetNamespace).Return(vmiInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should request a memory dump into the given PVC", func() {
+		vmInterface.EXPECT().MemoryDump(vmName, &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName}).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "get", vmName, "--claim-name", claimName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should request a memory dump into the PVC of the previous memory dump", func() {
+		vm := &v1.VirtualMachine{}
+		vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpCompleted}
+		vmInterface.EXPECT().Get(vmName, gomock.Any()).Return(vm, nil)
+		vmInterface.EXPECT().MemoryDump(vmName, &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName}).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "get", vmName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail without a PVC if there was no previous memory dump", func() {
+		vmInterface.EXPECT().Get(vmName, gomock.Any()).Return(&v1.VirtualMachine{}, nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "get", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("--claim-name is required")))
+	})
+
+	It("should create a PVC large enough for the memory dump", func() {
+		vmi := v1.NewMinimalVMI(vmName)
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+			k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+		}
+		vmiInterface.EXPECT().Get(vmName, gomock.Any()).Return(vmi, nil)
+		vmInterface.EXPECT().MemoryDump(vmName, &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName}).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "get", vmName, "--claim-name", claimName,
+			"--create-claim", "--storage-class", "local", "--access-mode", "ReadWriteOnce")
+		Expect(cmd()).To(Succeed())
+
+		pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(targetNamespace).Get(context.Background(), claimName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*pvc.Spec.VolumeMode).To(Equal(k8sv1.PersistentVolumeFilesystem))
+		Expect(*pvc.Spec.StorageClassName).To(Equal("local"))
+		Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+		size := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+		Expect(size.Cmp(resource.MustParse("1Gi"))).To(Equal(1))
+	})
+
+	It("should remove the memory dump association", func() {
+		vmInterface.EXPECT().RemoveMemoryDump(vmName).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "remove", vmName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	DescribeTable("should fail with invalid arguments", func(expectedErr string, args ...string) {
+		cmd := tests.NewRepeatableVirtctlCommand(append([]string{commandName}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with an unknown action", "invalid action", "dump", vmName),
+		Entry("with create-claim but no claim name", "--claim-name is required", "get", vmName, "--create-claim"),
+		Entry("with a storage class but without create-claim", "only allowed with --create-claim", "get", vmName, "--claim-name", claimName, "--storage-class", "local"),
+		Entry("with a claim name on remove", "not allowed with remove", "remove", vmName, "--claim-name", claimName),
+		Entry("with a missing VM name", "argument validation failed", "get"),
+	)
+})
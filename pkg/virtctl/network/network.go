@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_ADDINTERFACE    = "addinterface"
+	COMMAND_REMOVEINTERFACE = "removeinterface"
+
+	networkAttachmentDefinitionNameFlag = "network-attachment-definition-name"
+	nameFlag                            = "name"
+	persistFlag                         = "persist"
+)
+
+var (
+	networkAttachmentDefinitionName string
+	name                            string
+	persist                         bool
+)
+
+// NewAddInterfaceCommand returns a cobra.Command for hotplugging an interface of a secondary network into a running VMI
+func NewAddInterfaceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addinterface VMI",
+		Short:   "add a network interface to a running VM",
+		Args:    templates.ExactArgs(COMMAND_ADDINTERFACE, 1),
+		Example: usageAddInterface(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{command: COMMAND_ADDINTERFACE, clientConfig: clientConfig}
+			return c.run(args)
+		},
+	}
+	cmd.Flags().StringVar(&networkAttachmentDefinitionName, networkAttachmentDefinitionNameFlag, "", "The NetworkAttachmentDefinition of the secondary network the interface is connected to, as name or namespace/name.")
+	cmd.MarkFlagRequired(networkAttachmentDefinitionNameFlag)
+	cmd.Flags().StringVar(&name, nameFlag, "", "The name of the interface and its network in the VM.")
+	cmd.MarkFlagRequired(nameFlag)
+	cmd.Flags().BoolVar(&persist, persistFlag, false, "If set, the interface is also added to the VM, so that it is kept across restarts.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+// NewRemoveInterfaceCommand returns a cobra.Command for unplugging an interface of a secondary network from a running VMI
+func NewRemoveInterfaceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "removeinterface VMI",
+		Short:   "remove a network interface from a running VM",
+		Args:    templates.ExactArgs(COMMAND_REMOVEINTERFACE, 1),
+		Example: usageRemoveInterface(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{command: COMMAND_REMOVEINTERFACE, clientConfig: clientConfig}
+			return c.run(args)
+		},
+	}
+	cmd.Flags().StringVar(&name, nameFlag, "", "The name of the interface and its network in the VM.")
+	cmd.MarkFlagRequired(nameFlag)
+	cmd.Flags().BoolVar(&persist, persistFlag, false, "If set, the interface is also removed from the VM, so that it stays removed across restarts.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageAddInterface() string {
+	usage := `  # Add an interface connected to the network 'red-net' to the running VMI 'myvmi':
+  {{ProgramName}} addinterface myvmi --network-attachment-definition-name=red-net --name=red
+
+  # Add the interface to the VM 'myvm' as well, so that it is kept across restarts:
+  {{ProgramName}} addinterface myvm --network-attachment-definition-name=red-net --name=red --persist`
+	return usage
+}
+
+func usageRemoveInterface() string {
+	usage := `  # Remove the interface 'red' from the running VMI 'myvmi':
+  {{ProgramName}} removeinterface myvmi --name=red
+
+  # Remove the interface from the VM 'myvm' as well, so that it stays removed across restarts:
+  {{ProgramName}} removeinterface myvm --name=red --persist`
+	return usage
+}
+
+type command struct {
+	command      string
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *command) run(args []string) error {
+	vmiName := args[0]
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	switch c.command {
+	case COMMAND_ADDINTERFACE:
+		options := &v1.AddInterfaceOptions{
+			NetworkAttachmentDefinitionName: networkAttachmentDefinitionName,
+			Name:                            name,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).AddInterface(vmiName, options)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).AddInterface(vmiName, options)
+		}
+		if err != nil {
+			return fmt.Errorf("error adding interface %s to %s: %v", name, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted add interface request to VM %s for interface %s\n", vmiName, name)
+	case COMMAND_REMOVEINTERFACE:
+		options := &v1.RemoveInterfaceOptions{
+			Name: name,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).RemoveInterface(vmiName, options)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).RemoveInterface(vmiName, options)
+		}
+		if err != nil {
+			return fmt.Errorf("error removing interface %s from %s: %v", name, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted remove interface request to VM %s for interface %s\n", vmiName, name)
+	}
+	return nil
+}
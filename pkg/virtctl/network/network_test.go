@@ -0,0 +1,86 @@
+package network_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/tests"
+)
+
+const (
+	targetNamespace = "default"
+	vmName          = "testvm"
+	ifaceName       = "red"
+	nadName         = "red-net"
+)
+
+var _ = Describe("Interface hotplug", func() {
+
+	var (
+		ctrl         *gomock.Controller
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(targetNamespace).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(targetNamespace).Return(vmiInterface).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	addOptions := &v1.AddInterfaceOptions{NetworkAttachmentDefinitionName: nadName, Name: ifaceName}
+	removeOptions := &v1.RemoveInterfaceOptions{Name: ifaceName}
+
+	It("should add an interface to the VMI", func() {
+		vmiInterface.EXPECT().AddInterface(vmName, addOptions).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand("addinterface", vmName, "--network-attachment-definition-name", nadName, "--name", ifaceName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should add an interface to the VM with --persist", func() {
+		vmInterface.EXPECT().AddInterface(vmName, addOptions).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand("addinterface", vmName, "--network-attachment-definition-name", nadName, "--name", ifaceName, "--persist")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should remove an interface from the VMI", func() {
+		vmiInterface.EXPECT().RemoveInterface(vmName, removeOptions).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand("removeinterface", vmName, "--name", ifaceName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should remove an interface from the VM with --persist", func() {
+		vmInterface.EXPECT().RemoveInterface(vmName, removeOptions).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand("removeinterface", vmName, "--name", ifaceName, "--persist")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail without the name of the interface", func() {
+		cmd := tests.NewRepeatableVirtctlCommand("removeinterface", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "name" not set`)))
+	})
+
+	It("should report errors of the request", func() {
+		vmiInterface.EXPECT().AddInterface(vmName, addOptions).Return(fmt.Errorf("error"))
+
+		cmd := tests.NewRepeatableVirtctlCommand("addinterface", vmName, "--network-attachment-definition-name", nadName, "--name", ifaceName)
+		Expect(cmd()).To(MatchError(ContainSubstring("error adding interface red to testvm")))
+	})
+})
@@ -0,0 +1,305 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package portforward
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_PORTFORWARD = "port-forward"
+
+	addressFlag = "address"
+	stdioFlag   = "stdio"
+
+	KindVMI = "vmi"
+	KindVM  = "vm"
+)
+
+var (
+	address string
+	stdio   bool
+)
+
+// NewCommand returns a cobra.Command for forwarding local ports to a VirtualMachineInstance
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "port-forward [kind/]name [protocol/]localPort[:targetPort]...",
+		Short: "Forward local ports to a virtual machine or virtual machine instance.",
+		Long: `Forwards local ports to a running virtual machine instance, without creating a Service.
+The kind of the target is either vmi (default) or vm, the protocol is either tcp (default) or udp.
+If the target port is omitted it is the same as the local port.`,
+		Args:    validateArgs,
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig}
+			return c.run(cmd, args)
+		},
+	}
+	cmd.Flags().StringVar(&address, addressFlag, "127.0.0.1", "The address to listen on for local connections.")
+	cmd.Flags().BoolVar(&stdio, stdioFlag, false,
+		"Forward a single tcp port from the standard input and output instead of listening, for example as ssh ProxyCommand.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Forward the local port 8080 to port 80 of the vmi 'myvmi':
+  {{ProgramName}} port-forward myvmi 8080:80
+
+  # Forward the local port 5353 to the udp port 53 of the vm 'myvm':
+  {{ProgramName}} port-forward vm/myvm udp/5353:53
+
+  # Use port-forward as ssh ProxyCommand:
+  ssh -o 'ProxyCommand={{ProgramName}} port-forward --stdio=true vm/myvm 22' user@myvm`
+	return usage
+}
+
+func validateArgs(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("argument validation failed, %s expects the target and at least one port", COMMAND_PORTFORWARD)
+	}
+	return nil
+}
+
+// ParseTarget splits a [kind/]name target into its kind and name.
+func ParseTarget(target string) (kind string, name string, err error) {
+	kind = KindVMI
+	name = target
+	if parts := strings.SplitN(target, "/", 2); len(parts) == 2 {
+		kind, name = parts[0], parts[1]
+	}
+
+	switch kind {
+	case KindVMI, "vmis", "virtualmachineinstance", "virtualmachineinstances":
+		kind = KindVMI
+	case KindVM, "vms", "virtualmachine", "virtualmachines":
+		kind = KindVM
+	default:
+		return "", "", fmt.Errorf("unsupported target kind %q, expected %s or %s", kind, KindVMI, KindVM)
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("target name must not be empty")
+	}
+	return kind, name, nil
+}
+
+type forwardedPort struct {
+	local    int
+	remote   int
+	protocol string
+}
+
+func parsePort(arg string) (forwardedPort, error) {
+	port := forwardedPort{protocol: "tcp"}
+
+	if parts := strings.SplitN(arg, "/", 2); len(parts) == 2 {
+		port.protocol = parts[0]
+		arg = parts[1]
+	}
+	if port.protocol != "tcp" && port.protocol != "udp" {
+		return port, fmt.Errorf("unsupported protocol %q, expected tcp or udp", port.protocol)
+	}
+
+	local, remote := arg, arg
+	if parts := strings.SplitN(arg, ":", 2); len(parts) == 2 {
+		local, remote = parts[0], parts[1]
+	}
+
+	var err error
+	if port.local, err = parsePortNumber(local); err != nil {
+		return port, err
+	}
+	if port.remote, err = parsePortNumber(remote); err != nil {
+		return port, err
+	}
+	return port, nil
+}
+
+func parsePortNumber(arg string) (int, error) {
+	port, err := strconv.Atoi(arg)
+	if err != nil || port < 0 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", arg)
+	}
+	return port, nil
+}
+
+// portForwardableResource is implemented by the VirtualMachineInstance and VirtualMachine clients
+type portForwardableResource interface {
+	PortForward(name string, port int, protocol string) (kubecli.StreamInterface, error)
+}
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	kind, name, err := ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	ports := []forwardedPort{}
+	for _, arg := range args[1:] {
+		port, err := parsePort(arg)
+		if err != nil {
+			return err
+		}
+		if port.remote == 0 {
+			return fmt.Errorf("invalid target port in %q", arg)
+		}
+		ports = append(ports, port)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	var resource portForwardableResource
+	if kind == KindVM {
+		resource = virtClient.VirtualMachine(namespace)
+	} else {
+		resource = virtClient.VirtualMachineInstance(namespace)
+	}
+	forwarder := portForwarder{name: name, resource: resource}
+
+	if stdio {
+		if len(ports) != 1 || ports[0].protocol != "tcp" {
+			return fmt.Errorf("--%s requires exactly one tcp port", stdioFlag)
+		}
+		return forwarder.forwardStdio(cmd, ports[0])
+	}
+
+	for _, port := range ports {
+		if err := forwarder.startForwarding(port); err != nil {
+			return err
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+	return nil
+}
+
+type portForwarder struct {
+	name     string
+	resource portForwardableResource
+}
+
+func (p *portForwarder) forwardStdio(cmd *cobra.Command, port forwardedPort) error {
+	stream, err := p.resource.PortForward(p.name, port.remote, port.protocol)
+	if err != nil {
+		return fmt.Errorf("can't access %s: %v", p.name, err)
+	}
+	return stream.Stream(kubecli.StreamOptions{
+		In:  cmd.InOrStdin(),
+		Out: cmd.OutOrStdout(),
+	})
+}
+
+func (p *portForwarder) startForwarding(port forwardedPort) error {
+	listenAddress := net.JoinHostPort(address, strconv.Itoa(port.local))
+	if port.protocol == "udp" {
+		return p.startForwardingUDP(listenAddress, port)
+	}
+	return p.startForwardingTCP(listenAddress, port)
+}
+
+func (p *portForwarder) startForwardingTCP(listenAddress string, port forwardedPort) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return fmt.Errorf("can't listen on %s: %v", listenAddress, err)
+	}
+	fmt.Printf("Forwarding from %s to %s:%d\n", listener.Addr(), p.name, port.remote)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				glog.Errorf("error accepting connection on %s: %v", listener.Addr(), err)
+				return
+			}
+			go p.handleConnection(conn, port)
+		}
+	}()
+	return nil
+}
+
+func (p *portForwarder) handleConnection(conn net.Conn, port forwardedPort) {
+	defer conn.Close()
+
+	glog.Infof("opening new tcp tunnel to %d", port.remote)
+	stream, err := p.resource.PortForward(p.name, port.remote, port.protocol)
+	if err != nil {
+		glog.Errorf("can't access %s: %v", p.name, err)
+		return
+	}
+
+	if err := stream.Stream(kubecli.StreamOptions{In: conn, Out: conn}); err != nil {
+		glog.Errorf("error forwarding to %d: %v", port.remote, err)
+	}
+}
+
+func (p *portForwarder) startForwardingUDP(listenAddress string, port forwardedPort) error {
+	udpAddress, err := net.ResolveUDPAddr("udp", listenAddress)
+	if err != nil {
+		return fmt.Errorf("can't resolve %s: %v", listenAddress, err)
+	}
+	conn, err := net.ListenUDP("udp", udpAddress)
+	if err != nil {
+		return fmt.Errorf("can't listen on %s: %v", listenAddress, err)
+	}
+	fmt.Printf("Forwarding from %s to %s:%d\n", conn.LocalAddr(), p.name, port.remote)
+
+	stream, err := p.resource.PortForward(p.name, port.remote, port.protocol)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("can't access %s: %v", p.name, err)
+	}
+
+	// All datagrams share one stream, replies go to the client which sent the last datagram.
+	client := &udpClient{conn: conn}
+	go func() {
+		defer conn.Close()
+		if err := stream.Stream(kubecli.StreamOptions{In: client, Out: client}); err != nil {
+			glog.Errorf("error forwarding to %d: %v", port.remote, err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,105 @@
+package portforward_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/tests"
+)
+
+const (
+	commandName     = "port-forward"
+	targetNamespace = "default"
+)
+
+// echoStream writes everything it reads from In back to Out
+type echoStream struct{}
+
+func (e *echoStream) Stream(options kubecli.StreamOptions) error {
+	_, err := io.Copy(options.Out, options.In)
+	return err
+}
+
+var _ = Describe("PortForward", func() {
+
+	var (
+		ctrl         *gomock.Controller
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		vmInterface  *kubecli.MockVirtualMachineInterface
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(targetNamespace).Return(vmiInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(targetNamespace).Return(vmInterface).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	runStdio := func(target string) string {
+		out := &bytes.Buffer{}
+		cmd := tests.NewVirtctlCommand(commandName, "--stdio=true", target, "22")
+		cmd.SetIn(strings.NewReader("SSH-2.0-OpenSSH"))
+		cmd.SetOut(out)
+		Expect(cmd.Execute()).To(Succeed())
+		return out.String()
+	}
+
+	It("should forward stdio to a VirtualMachine", func() {
+		vmInterface.EXPECT().PortForward("testvm", 22, "tcp").Return(&echoStream{}, nil)
+		Expect(runStdio("vm/testvm")).To(Equal("SSH-2.0-OpenSSH"))
+	})
+
+	It("should forward stdio to a VirtualMachineInstance by default", func() {
+		vmiInterface.EXPECT().PortForward("testvmi", 22, "tcp").Return(&echoStream{}, nil)
+		Expect(runStdio("testvmi")).To(Equal("SSH-2.0-OpenSSH"))
+	})
+
+	It("should fail when the subresource fails", func() {
+		vmInterface.EXPECT().PortForward("testvm", 22, "tcp").Return(nil, fmt.Errorf("VMI is paused"))
+
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "--stdio=true", "vm/testvm", "22")
+		Expect(cmd()).To(MatchError(ContainSubstring("VMI is paused")))
+	})
+
+	DescribeTable("should reject invalid arguments", func(expected string, args ...string) {
+		cmd := tests.NewRepeatableVirtctlCommand(append([]string{commandName}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("without a port", "argument validation failed", "vm/testvm"),
+		Entry("with an unsupported kind", "unsupported target kind", "pod/testvm", "22"),
+		Entry("with an unsupported protocol", "unsupported protocol", "vm/testvm", "sctp/22"),
+		Entry("with an invalid port", "invalid port", "vm/testvm", "ssh"),
+		Entry("with a too large port", "invalid port", "vm/testvm", "8080:65536"),
+		Entry("without a target port", "invalid target port", "vm/testvm", "8080:0"),
+		Entry("with stdio and several ports", "requires exactly one tcp port", "--stdio=true", "vm/testvm", "22", "80"),
+		Entry("with stdio and udp", "requires exactly one tcp port", "--stdio=true", "vm/testvm", "udp/53"),
+	)
+
+	DescribeTable("should parse the target", func(target, kind, name string) {
+		parsedKind, parsedName, err := portforward.ParseTarget(target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsedKind).To(Equal(kind))
+		Expect(parsedName).To(Equal(name))
+	},
+		Entry("without kind", "testvmi", portforward.KindVMI, "testvmi"),
+		Entry("with vmi", "vmi/testvmi", portforward.KindVMI, "testvmi"),
+		Entry("with vm", "vm/testvm", portforward.KindVM, "testvm"),
+		Entry("with the full resource name", "virtualmachine/testvm", portforward.KindVM, "testvm"),
+	)
+})
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package portforward
+
+import (
+	"net"
+	"sync"
+)
+
+// udpClient reads datagrams from a local udp socket and writes the
+// replies back to the address of the last datagram.
+type udpClient struct {
+	conn *net.UDPConn
+	lock sync.Mutex
+	addr *net.UDPAddr
+}
+
+func (u *udpClient) Read(p []byte) (int, error) {
+	n, addr, err := u.conn.ReadFromUDP(p)
+	if err != nil {
+		return n, err
+	}
+	u.lock.Lock()
+	u.addr = addr
+	u.lock.Unlock()
+	return n, nil
+}
+
+func (u *udpClient) Write(p []byte) (int, error) {
+	u.lock.Lock()
+	addr := u.addr
+	u.lock.Unlock()
+	if addr == nil {
+		// Nobody to reply to yet, drop the datagram
+		return len(p), nil
+	}
+	return u.conn.WriteToUDP(p, addr)
+}
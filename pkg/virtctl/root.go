@@ -3,6 +3,7 @@ package virtctl
 import (
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -12,11 +13,17 @@ import (
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/kubevirt/pkg/virtctl/console"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
+	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
+	"kubevirt.io/kubevirt/pkg/virtctl/network"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
 	"kubevirt.io/kubevirt/pkg/virtctl/vm"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc"
 )
 
@@ -71,6 +78,7 @@ func NewVirtctlCommand() *cobra.Command {
 		vm.NewStartCommand(clientConfig),
 		vm.NewStopCommand(clientConfig),
 		vm.NewRestartCommand(clientConfig),
+		vm.NewSoftRebootCommand(clientConfig),
 		vm.NewMigrateCommand(clientConfig),
 		vm.NewRenameCommand(clientConfig),
 		vm.NewGuestOsInfoCommand(clientConfig),
@@ -81,6 +89,14 @@ func NewVirtctlCommand() *cobra.Command {
 		expose.NewExposeCommand(clientConfig),
 		version.VersionCommand(clientConfig),
 		imageupload.NewImageUploadCommand(clientConfig),
+		vmexport.NewVirtualMachineExportCommand(clientConfig),
+		memorydump.NewMemoryDumpCommand(clientConfig),
+		network.NewAddInterfaceCommand(clientConfig),
+		network.NewRemoveInterfaceCommand(clientConfig),
+		guestexec.NewGuestExecCommand(clientConfig),
+		portforward.NewCommand(clientConfig),
+		ssh.NewCommand(clientConfig),
+		ssh.NewSCPCommand(clientConfig),
 		optionsCmd,
 	)
 	return rootCmd
@@ -102,6 +118,13 @@ func GetProgramName(binary string) string {
 func Execute() {
 	log.InitializeLogging(programName)
 	if err := NewVirtctlCommand().Execute(); err != nil {
+		if exitErr, ok := err.(*guestexec.ExitError); ok {
+			os.Exit(exitErr.ExitCode)
+		}
+		// ssh and scp already reported the error, keep their exit code
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
 		fmt.Println(strings.TrimSpace(err.Error()))
 		os.Exit(1)
 	}
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package ssh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SCP = "scp"
+
+	recursiveFlag = "recursive"
+)
+
+// NewSCPCommand returns a cobra.Command for copying files from and to a VirtualMachine or VirtualMachineInstance
+func NewSCPCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := &SCP{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "scp [username@]kind/name:source destination | source [username@]kind/name:destination",
+		Short: "Copy files from and to a virtual machine instance.",
+		Long: `Copies files from and to a running virtual machine instance with the local scp client.
+The connection is tunneled through "port-forward --stdio", no Service is required.
+The kind of the target is either vmi or vm.`,
+		Example: scpUsage(),
+		Args:    templates.ExactArgs(COMMAND_SCP, 2),
+		RunE:    c.Run,
+	}
+	addCommonFlags(cmd.Flags(), &c.options)
+	cmd.Flags().BoolVarP(&c.recursive, recursiveFlag, "r", false, "Copy directories recursively.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func scpUsage() string {
+	usage := `  # Copy a file to the home directory of user 'fedora' in the vm 'myvm':
+  {{ProgramName}} scp myfile.bin fedora@vm/myvm:myfile.bin
+
+  # Copy a directory from the vmi 'myvmi':
+  {{ProgramName}} scp --recursive fedora@vmi/myvmi:/var/log ./logs`
+	return usage
+}
+
+type SCP struct {
+	clientConfig clientcmd.ClientConfig
+	options      SSHOptions
+	recursive    bool
+}
+
+type remotePath struct {
+	username string
+	kind     string
+	name     string
+	path     string
+}
+
+func (o *SCP) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	source, destination := args[0], args[1]
+	sourceRemote, sourceIsRemote := parseRemotePath(source)
+	destinationRemote, destinationIsRemote := parseRemotePath(destination)
+
+	var remote *remotePath
+	switch {
+	case sourceIsRemote && destinationIsRemote:
+		return fmt.Errorf("copying between two virtual machines is not supported")
+	case sourceIsRemote:
+		remote = sourceRemote
+	case destinationIsRemote:
+		remote = destinationRemote
+	default:
+		return fmt.Errorf("either the source or the destination has to be of the form [username@]kind/name:path")
+	}
+
+	remoteArg := fmt.Sprintf("%s:%s", buildHost(o.options.Username, remote.username, namespace, remote.kind, remote.name), remote.path)
+	if sourceIsRemote {
+		source = remoteArg
+	} else {
+		destination = remoteArg
+	}
+
+	scpArgs := buildClientArgs(namespace, remote.kind, remote.name, &o.options)
+	if o.recursive {
+		scpArgs = append(scpArgs, "-r")
+	}
+	scpArgs = append(scpArgs, source, destination)
+	return RunLocalClient(cmd, "scp", scpArgs)
+}
+
+// parseRemotePath parses [username@]kind/name:path, anything else is a local path
+func parseRemotePath(arg string) (*remotePath, bool) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+	username, kind, name, err := parseTarget(parts[0])
+	if err != nil {
+		return nil, false
+	}
+	return &remotePath{username: username, kind: kind, name: name, path: parts[1]}, true
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package ssh
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SSH = "ssh"
+
+	usernameFlag         = "username"
+	identityFilePathFlag = "identity-file"
+	portFlag             = "port"
+	localSSHOptsFlag     = "local-ssh-opts"
+	commandFlag          = "command"
+)
+
+// SSHOptions are the options shared by ssh and scp
+type SSHOptions struct {
+	Username         string
+	IdentityFilePath string
+	SSHPort          int
+	LocalSSHOpts     []string
+}
+
+func addCommonFlags(flags *pflag.FlagSet, options *SSHOptions) {
+	flags.StringVarP(&options.Username, usernameFlag, "l", "",
+		"The user to log in as, overrides the username given in the target.")
+	flags.StringVarP(&options.IdentityFilePath, identityFilePathFlag, "i", "",
+		"The identity file to authenticate with, passed to the local client.")
+	flags.IntVarP(&options.SSHPort, portFlag, "p", 22, "The port ssh is listening on in the guest.")
+	flags.StringArrayVarP(&options.LocalSSHOpts, localSSHOptsFlag, "t", []string{},
+		"Additional options passed to the local client, can be repeated: -t \"-o StrictHostKeyChecking=no\".")
+}
+
+// RunLocalClient runs the local ssh or scp binary, tests replace it
+var RunLocalClient = func(cmd *cobra.Command, binary string, args []string) error {
+	client := exec.Command(binary, args...)
+	client.Stdin = cmd.InOrStdin()
+	client.Stdout = cmd.OutOrStdout()
+	client.Stderr = cmd.ErrOrStderr()
+	return client.Run()
+}
+
+// NewCommand returns a cobra.Command for opening an ssh connection to a VirtualMachine or VirtualMachineInstance
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := &SSH{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "ssh [username@]kind/name",
+		Short: "Open an SSH connection to a virtual machine instance.",
+		Long: `Opens an SSH connection to a running virtual machine instance with the local ssh client.
+The connection is tunneled through "port-forward --stdio", no Service is required.
+The kind of the target is either vmi or vm.`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_SSH, 1),
+		RunE:    c.Run,
+	}
+	addCommonFlags(cmd.Flags(), &c.options)
+	cmd.Flags().StringVarP(&c.command, commandFlag, "c", "", "A command to run in the guest instead of an interactive shell.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Connect to the vm 'myvm' as user 'fedora':
+  {{ProgramName}} ssh fedora@vm/myvm
+
+  # Run a command on the vmi 'myvmi' with a specific identity file:
+  {{ProgramName}} ssh -i ~/.ssh/id_ed25519 --command 'uname -a' fedora@vmi/myvmi`
+	return usage
+}
+
+type SSH struct {
+	clientConfig clientcmd.ClientConfig
+	options      SSHOptions
+	command      string
+}
+
+func (o *SSH) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	username, kind, name, err := parseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	sshArgs := buildClientArgs(namespace, kind, name, &o.options)
+	sshArgs = append(sshArgs, buildHost(o.options.Username, username, namespace, kind, name))
+	if o.command != "" {
+		sshArgs = append(sshArgs, "--", o.command)
+	}
+	return RunLocalClient(cmd, "ssh", sshArgs)
+}
+
+// parseTarget splits a [username@]kind/name target
+func parseTarget(target string) (username string, kind string, name string, err error) {
+	if parts := strings.SplitN(target, "@", 2); len(parts) == 2 {
+		username, target = parts[0], parts[1]
+	}
+	if !strings.Contains(target, "/") {
+		return "", "", "", fmt.Errorf("target %q must be of the form [username@]kind/name", target)
+	}
+	kind, name, err = portforward.ParseTarget(target)
+	return username, kind, name, err
+}
+
+// buildClientArgs returns the arguments for the local client which tunnel the connection through port-forward
+func buildClientArgs(namespace, kind, name string, options *SSHOptions) []string {
+	proxyCommand := fmt.Sprintf("ProxyCommand=%s port-forward --stdio=true --namespace %s %s/%s %d",
+		os.Args[0], namespace, kind, name, options.SSHPort)
+	args := []string{"-o", proxyCommand}
+	if options.IdentityFilePath != "" {
+		args = append(args, "-i", options.IdentityFilePath)
+	}
+	return append(args, options.LocalSSHOpts...)
+}
+
+// buildHost returns the host name passed to the local client. It is only used to look up
+// the known host key, the connection itself goes through the ProxyCommand.
+func buildHost(usernameOverride, username, namespace, kind, name string) string {
+	host := fmt.Sprintf("%s.%s.%s", kind, name, namespace)
+	if usernameOverride != "" {
+		username = usernameOverride
+	}
+	if username != "" {
+		host = username + "@" + host
+	}
+	return host
+}
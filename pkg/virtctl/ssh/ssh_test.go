@@ -0,0 +1,93 @@
+package ssh_test
+
+import (
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
+	"kubevirt.io/kubevirt/tests"
+)
+
+var _ = Describe("SSH", func() {
+
+	var (
+		originalRunLocalClient = ssh.RunLocalClient
+		binary                 string
+		clientArgs             []string
+	)
+
+	proxyCommand := func(target string, port int) string {
+		return fmt.Sprintf("ProxyCommand=%s port-forward --stdio=true --namespace default %s %d", os.Args[0], target, port)
+	}
+
+	BeforeEach(func() {
+		binary, clientArgs = "", nil
+		ssh.RunLocalClient = func(cmd *cobra.Command, name string, args []string) error {
+			binary, clientArgs = name, args
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		ssh.RunLocalClient = originalRunLocalClient
+	})
+
+	Context("ssh", func() {
+
+		It("should tunnel the local ssh client through port-forward", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("ssh", "fedora@vm/testvm")
+			Expect(cmd()).To(Succeed())
+			Expect(binary).To(Equal("ssh"))
+			Expect(clientArgs).To(Equal([]string{"-o", proxyCommand("vm/testvm", 22), "fedora@vm.testvm.default"}))
+		})
+
+		It("should pass the options to the local ssh client", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("ssh", "-l", "root", "-p", "2222", "-i", "id_rsa",
+				"-t", "-oStrictHostKeyChecking=no", "--command", "uname -a", "fedora@vmi/testvmi")
+			Expect(cmd()).To(Succeed())
+			Expect(clientArgs).To(Equal([]string{
+				"-o", proxyCommand("vmi/testvmi", 2222), "-i", "id_rsa", "-oStrictHostKeyChecking=no",
+				"root@vmi.testvmi.default", "--", "uname -a",
+			}))
+		})
+
+		DescribeTable("should reject invalid targets", func(target, expected string) {
+			cmd := tests.NewRepeatableVirtctlCommand("ssh", target)
+			Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+			Expect(binary).To(BeEmpty())
+		},
+			Entry("without kind", "fedora@testvm", "must be of the form"),
+			Entry("with an unsupported kind", "fedora@pod/testvm", "unsupported target kind"),
+		)
+	})
+
+	Context("scp", func() {
+
+		It("should copy a local file to the guest", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("scp", "file.txt", "fedora@vm/testvm:/tmp/file.txt")
+			Expect(cmd()).To(Succeed())
+			Expect(binary).To(Equal("scp"))
+			Expect(clientArgs).To(Equal([]string{"-o", proxyCommand("vm/testvm", 22), "file.txt", "fedora@vm.testvm.default:/tmp/file.txt"}))
+		})
+
+		It("should recursively copy a directory from the guest", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("scp", "-r", "vmi/testvmi:/var/log", "logs")
+			Expect(cmd()).To(Succeed())
+			Expect(clientArgs).To(Equal([]string{"-o", proxyCommand("vmi/testvmi", 22), "-r", "vmi.testvmi.default:/var/log", "logs"}))
+		})
+
+		DescribeTable("should reject invalid paths", func(source, destination, expected string) {
+			cmd := tests.NewRepeatableVirtctlCommand("scp", source, destination)
+			Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+			Expect(binary).To(BeEmpty())
+		},
+			Entry("with two local paths", "file.txt", "dir/file.txt", "either the source or the destination"),
+			Entry("with two remote paths", "vm/testvm:file.txt", "vm/other:file.txt", "between two virtual machines"),
+		)
+	})
+})
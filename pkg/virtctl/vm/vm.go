@@ -37,6 +37,7 @@ const (
 	COMMAND_START       = "start"
 	COMMAND_STOP        = "stop"
 	COMMAND_RESTART     = "restart"
+	COMMAND_SOFT_REBOOT = "soft-reboot"
 	COMMAND_MIGRATE     = "migrate"
 	COMMAND_RENAME      = "rename"
 	COMMAND_GUESTOSINFO = "guestosinfo"
@@ -96,6 +97,22 @@ func NewRestartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	return cmd
 }
 
+func NewSoftRebootCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "soft-reboot (VMI)",
+		Short:   "Soft reboot a virtual machine instance.",
+		Long:    "Asks the guest to reboot through the guest agent, or ACPI if the agent is not connected. The virtual machine instance and its pod are kept.",
+		Example: usage(COMMAND_SOFT_REBOOT),
+		Args:    templates.ExactArgs("soft-reboot", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_SOFT_REBOOT, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
 func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate (VM)",
@@ -232,6 +249,13 @@ func (o *Command) Run(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("Error restarting VirtualMachine %v", err)
 		}
+	case COMMAND_SOFT_REBOOT:
+		err = virtClient.VirtualMachineInstance(namespace).SoftReboot(vmiName)
+		if err != nil {
+			return fmt.Errorf("Error soft rebooting VirtualMachineInstance %v", err)
+		}
+		fmt.Printf("VMI %s was scheduled to %s\n", vmiName, o.command)
+		return nil
 	case COMMAND_MIGRATE:
 		err = virtClient.VirtualMachine(namespace).Migrate(vmiName)
 		if err != nil {
@@ -140,6 +140,21 @@ var _ = Describe("VirtualMachine", func() {
 
 	})
 
+	Context("with soft reboot VMI cmd", func() {
+		It("should soft reboot vmi", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().SoftReboot(vmName).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("soft-reboot", vmName)
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should fail without a VMI name", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("soft-reboot")
+			Expect(cmd()).NotTo(BeNil())
+		})
+	})
+
 	Context("with migrate VM cmd", func() {
 		It("should migrate vm", func() {
 			vm := kubecli.NewMinimalVM(vmName)
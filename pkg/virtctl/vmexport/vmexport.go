@@ -0,0 +1,466 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package vmexport
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	pb "gopkg.in/cheggaaa/pb.v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VMEXPORT = "vmexport"
+	ACTION_CREATE    = "create"
+	ACTION_DELETE    = "delete"
+	ACTION_DOWNLOAD  = "download"
+
+	exportReadyWaitInterval = 2 * time.Second
+)
+
+var (
+	vmName       string
+	pvcName      string
+	snapshotName string
+	ttl          time.Duration
+	outputFile   string
+	volumeName   string
+	format       string
+	manifest     bool
+	insecure     bool
+	keepVme      bool
+
+	exportWaitSecs uint
+)
+
+// HTTPClientCreator is a function that creates http clients
+type HTTPClientCreator func(bool) *http.Client
+
+var httpClientCreatorFunc HTTPClientCreator
+
+// SetHTTPClientCreator allows overriding the default http client
+// useful for unit tests
+func SetHTTPClientCreator(f HTTPClientCreator) {
+	httpClientCreatorFunc = f
+}
+
+// SetDefaultHTTPClientCreator sets the http client creator back to default
+func SetDefaultHTTPClientCreator() {
+	httpClientCreatorFunc = getHTTPClient
+}
+
+func init() {
+	SetDefaultHTTPClientCreator()
+}
+
+// NewVirtualMachineExportCommand returns a cobra.Command for handling VirtualMachineExports
+func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vmexport create|delete|download (VMEXPORT)",
+		Short: "Export a VM, PVC or VirtualMachineSnapshot volume out of the cluster.",
+		Long: `Creates, deletes or downloads from a VirtualMachineExport.
+First argument is the action, possible actions are create, delete and download.
+Second argument is the name of the VirtualMachineExport.`,
+		Args:    templates.ExactArgs(COMMAND_VMEXPORT, 2),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig}
+			return c.run(args)
+		},
+	}
+	cmd.Flags().StringVar(&vmName, "vm", "", "Export the volumes of the named VirtualMachine.")
+	cmd.Flags().StringVar(&pvcName, "pvc", "", "Export the named PersistentVolumeClaim.")
+	cmd.Flags().StringVar(&snapshotName, "snapshot", "", "Export the volumes of the named VirtualMachineSnapshot.")
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "Lifetime of the VirtualMachineExport (ex. 30m, 2h), defaults to the cluster default.")
+	cmd.Flags().StringVar(&outputFile, "output", "", "Local file the downloaded volume or manifest is written to.")
+	cmd.Flags().StringVar(&volumeName, "volume", "", "Name of the volume to download, required if the export contains more than one volume.")
+	cmd.Flags().StringVar(&format, "format", string(exportv1.KubeVirtGz), "Format of the downloaded volume, one of raw, gzip or qcow2.")
+	cmd.Flags().BoolVar(&manifest, "manifest", false, "Download the VirtualMachine manifest instead of a volume.")
+	cmd.Flags().BoolVar(&insecure, "insecure", false, "Allow insecure server connections when using HTTPS.")
+	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "Don't delete a VirtualMachineExport created by download once it is done.")
+	cmd.Flags().UintVar(&exportWaitSecs, "wait-secs", 300, "Seconds to wait for the VirtualMachineExport to become ready.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Create a VirtualMachineExport of the volumes of a VirtualMachine:
+  {{ProgramName}} vmexport create myexport --vm=myvm
+
+  # Create a VirtualMachineExport of a PersistentVolumeClaim which expires after 30 minutes:
+  {{ProgramName}} vmexport create myexport --pvc=mypvc --ttl=30m
+
+  # Download a volume of an existing VirtualMachineExport as a gzip compressed raw image:
+  {{ProgramName}} vmexport download myexport --volume=rootdisk --output=disk.img.gz
+
+  # Export a VirtualMachineSnapshot volume as qcow2, the VirtualMachineExport is deleted once downloaded:
+  {{ProgramName}} vmexport download myexport --snapshot=mysnapshot --format=qcow2 --output=disk.qcow2
+
+  # Download the manifest of an exported VirtualMachine:
+  {{ProgramName}} vmexport download myexport --manifest --output=vm.yaml
+
+  # Delete a VirtualMachineExport:
+  {{ProgramName}} vmexport delete myexport`
+	return usage
+}
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func (c *command) run(args []string) error {
+	action, name := args[0], args[1]
+
+	source, err := exportSource()
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case ACTION_CREATE:
+		if source == nil {
+			return fmt.Errorf("one of --vm, --pvc or --snapshot is required")
+		}
+	case ACTION_DELETE:
+		if source != nil {
+			return fmt.Errorf("--vm, --pvc and --snapshot are not allowed with %s", action)
+		}
+	case ACTION_DOWNLOAD:
+		if outputFile == "" {
+			return fmt.Errorf("--output is required")
+		}
+	default:
+		return fmt.Errorf("invalid action %s, expected one of %s, %s or %s", action, ACTION_CREATE, ACTION_DELETE, ACTION_DOWNLOAD)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	switch action {
+	case ACTION_CREATE:
+		if err := createExport(virtClient, namespace, name, source); err != nil {
+			return err
+		}
+		fmt.Printf("VirtualMachineExport %s/%s created\n", namespace, name)
+	case ACTION_DELETE:
+		err := virtClient.VirtualMachineExport(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil {
+			return fmt.Errorf("error deleting VirtualMachineExport %s/%s: %v", namespace, name, err)
+		}
+		fmt.Printf("VirtualMachineExport %s/%s deleted\n", namespace, name)
+	case ACTION_DOWNLOAD:
+		return downloadExport(virtClient, namespace, name, source)
+	}
+
+	return nil
+}
+
+func exportSource() (*corev1.TypedLocalObjectReference, error) {
+	var sources []*corev1.TypedLocalObjectReference
+
+	if vmName != "" {
+		apiGroup := v1.GroupName
+		sources = append(sources, &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: vmName})
+	}
+	if pvcName != "" {
+		sources = append(sources, &corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: pvcName})
+	}
+	if snapshotName != "" {
+		apiGroup := snapshotv1.SchemeGroupVersion.Group
+		sources = append(sources, &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachineSnapshot", Name: snapshotName})
+	}
+
+	switch len(sources) {
+	case 0:
+		return nil, nil
+	case 1:
+		return sources[0], nil
+	default:
+		return nil, fmt.Errorf("only one of --vm, --pvc or --snapshot may be set")
+	}
+}
+
+func createExport(client kubecli.KubevirtClient, namespace, name string, source *corev1.TypedLocalObjectReference) error {
+	vmExport := &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: exportv1.VirtualMachineExportSpec{
+			Source: *source,
+		},
+	}
+	if ttl > 0 {
+		vmExport.Spec.TTLDuration = &metav1.Duration{Duration: ttl}
+	}
+
+	_, err := client.VirtualMachineExport(namespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineExport %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+func downloadExport(client kubecli.KubevirtClient, namespace, name string, source *corev1.TypedLocalObjectReference) error {
+	if source != nil {
+		_, err := client.VirtualMachineExport(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			return fmt.Errorf("VirtualMachineExport %s/%s already exists, omit --vm, --pvc and --snapshot to download from it", namespace, name)
+		}
+		if err := createExport(client, namespace, name, source); err != nil {
+			return err
+		}
+		fmt.Printf("VirtualMachineExport %s/%s created\n", namespace, name)
+
+		if !keepVme {
+			defer func() {
+				err := client.VirtualMachineExport(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+				if err != nil && !k8serrors.IsNotFound(err) {
+					fmt.Printf("Failed to delete VirtualMachineExport %s/%s: %v\n", namespace, name, err)
+					return
+				}
+				fmt.Printf("VirtualMachineExport %s/%s deleted\n", namespace, name)
+			}()
+		}
+	}
+
+	vmExport, err := waitExportReady(client, namespace, name, exportReadyWaitInterval, time.Duration(exportWaitSecs)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	token, err := getExportToken(client, vmExport)
+	if err != nil {
+		return err
+	}
+
+	body, size, err := openExportStream(client, vmExport, token)
+	if err != nil {
+		return err
+	}
+	defer util.CloseIOAndCheckErr(body, nil)
+
+	// #nosec G304 No risk for path injection as this function executes with
+	// the same privileges as those of virtctl user who supplies outputFile
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer util.CloseIOAndCheckErr(file, nil)
+
+	fmt.Printf("Downloading to %s\n", outputFile)
+
+	bar := pb.New64(size).SetUnits(pb.U_BYTES)
+	bar.Start()
+	_, err = io.Copy(file, bar.NewProxyReader(body))
+	bar.Finish()
+	if err != nil {
+		return fmt.Errorf("error downloading export: %v", err)
+	}
+
+	fmt.Printf("Download of %s completed successfully\n", outputFile)
+	return nil
+}
+
+func waitExportReady(client kubecli.KubevirtClient, namespace, name string, interval, timeout time.Duration) (*exportv1.VirtualMachineExport, error) {
+	var vmExport *exportv1.VirtualMachineExport
+	loggedStatus := false
+
+	err := wait.PollImmediate(interval, timeout, func() (bool, error) {
+		var err error
+		vmExport, err = client.VirtualMachineExport(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if vmExport.Status == nil {
+			return false, nil
+		}
+
+		if vmExport.Status.Phase == exportv1.Skipped {
+			return false, fmt.Errorf("source of VirtualMachineExport %s/%s does not exist or has no volumes", namespace, name)
+		}
+
+		done := vmExport.Status.Phase == exportv1.Ready && vmExport.Status.Links != nil
+		if !done && !loggedStatus {
+			fmt.Printf("Waiting for VirtualMachineExport %s/%s to be ready...\n", namespace, name)
+			loggedStatus = true
+		}
+
+		return done, nil
+	})
+
+	return vmExport, err
+}
+
+func getExportToken(client kubecli.KubevirtClient, vmExport *exportv1.VirtualMachineExport) (string, error) {
+	if vmExport.Status.TokenSecretRef == nil {
+		return "", fmt.Errorf("VirtualMachineExport %s/%s has no token secret", vmExport.Namespace, vmExport.Name)
+	}
+
+	secret, err := client.CoreV1().Secrets(vmExport.Namespace).Get(context.Background(), *vmExport.Status.TokenSecretRef, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := secret.Data[exportv1.TokenKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no %s key", secret.Namespace, secret.Name, exportv1.TokenKey)
+	}
+	return string(token), nil
+}
+
+func getExportURL(link *exportv1.VirtualMachineExportLink) (string, error) {
+	if manifest {
+		for _, m := range link.Manifests {
+			if m.Type == exportv1.AllManifests {
+				return m.Url, nil
+			}
+		}
+		return "", fmt.Errorf("export does not contain a manifest")
+	}
+
+	var volume *exportv1.VirtualMachineExportVolume
+	if volumeName == "" {
+		if len(link.Volumes) != 1 {
+			return "", fmt.Errorf("export contains %d volumes, select one with --volume", len(link.Volumes))
+		}
+		volume = &link.Volumes[0]
+	} else {
+		for i := range link.Volumes {
+			if link.Volumes[i].Name == volumeName {
+				volume = &link.Volumes[i]
+				break
+			}
+		}
+		if volume == nil {
+			return "", fmt.Errorf("export does not contain volume %s", volumeName)
+		}
+	}
+
+	for _, f := range volume.Formats {
+		if string(f.Format) == format {
+			return f.Url, nil
+		}
+	}
+	return "", fmt.Errorf("format %s is not available for volume %s", format, volume.Name)
+}
+
+// openExportStream downloads through the external link if the export is exposed,
+// otherwise through the API server service proxy
+func openExportStream(client kubecli.KubevirtClient, vmExport *exportv1.VirtualMachineExport, token string) (io.ReadCloser, int64, error) {
+	links := vmExport.Status.Links
+
+	if links.External != nil {
+		exportURL, err := getExportURL(links.External)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		req, err := http.NewRequest(http.MethodGet, exportURL, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		req.Header.Add(exportv1.TokenHeader, token)
+
+		resp, err := httpClientCreatorFunc(insecure).Do(req)
+		if err != nil {
+			return nil, 0, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, 0, err
+			}
+			return nil, 0, fmt.Errorf("unexpected return value %d, %s", resp.StatusCode, string(body))
+		}
+
+		size := resp.ContentLength
+		if size < 0 {
+			size = 0
+		}
+		return resp.Body, size, nil
+	}
+
+	if links.Internal == nil {
+		return nil, 0, fmt.Errorf("VirtualMachineExport %s/%s has no links", vmExport.Namespace, vmExport.Name)
+	}
+
+	exportURL, err := getExportURL(links.Internal)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	u, err := url.Parse(exportURL)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	params := map[string]string{exportv1.TokenHeader: token}
+	body, err := client.CoreV1().Services(vmExport.Namespace).ProxyGet("https", vmExport.Status.ServiceName, "443", u.Path, params).Stream(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, 0, nil
+}
+
+func getHTTPClient(insecure bool) *http.Client {
+	client := &http.Client{}
+
+	if insecure {
+		// #nosec cause: InsecureSkipVerify: true resolution: this method explicitly ask for insecure http client
+		client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	return client
+}
@@ -0,0 +1,13 @@
+package vmexport_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestVMExport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "VMExport Suite")
+}
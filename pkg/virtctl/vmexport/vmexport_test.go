@@ -0,0 +1,288 @@
+package vmexport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakek8sclient "k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+	"kubevirt.io/kubevirt/tests"
+)
+
+const (
+	commandName     = "vmexport"
+	targetNamespace = "default"
+	exportName      = "test-export"
+	tokenSecretName = "export-token-test-export"
+	serviceName     = "virt-export-test-export"
+	exportToken     = "secret-token"
+	volumeContent   = "disk content"
+)
+
+// fakeResponseWrapper serves a canned body for a service proxy request
+type fakeResponseWrapper struct {
+	body []byte
+}
+
+func (f *fakeResponseWrapper) DoRaw(context.Context) ([]byte, error) {
+	return f.body, nil
+}
+
+func (f *fakeResponseWrapper) Stream(context.Context) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(f.body)), nil
+}
+
+var _ = Describe("VMExport", func() {
+
+	var (
+		ctrl           *gomock.Controller
+		kubeClient     *fakek8sclient.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		tempDir        string
+		outputFile     string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "vmexport")
+		Expect(err).ToNot(HaveOccurred())
+		outputFile = filepath.Join(tempDir, "disk.img.gz")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		vmexport.SetDefaultHTTPClientCreator()
+		os.RemoveAll(tempDir)
+	})
+
+	testInit := func(kubevirtObjects ...runtime.Object) {
+		kubeClient = fakek8sclient.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tokenSecretName,
+				Namespace: targetNamespace,
+			},
+			Data: map[string][]byte{
+				exportv1.TokenKey: []byte(exportToken),
+			},
+		})
+		kubevirtClient = kubevirtfake.NewSimpleClientset(kubevirtObjects...)
+
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineExport(targetNamespace).
+			Return(kubevirtClient.ExportV1alpha1().VirtualMachineExports(targetNamespace)).AnyTimes()
+	}
+
+	newLink := func(base string) *exportv1.VirtualMachineExportLink {
+		return &exportv1.VirtualMachineExportLink{
+			Volumes: []exportv1.VirtualMachineExportVolume{
+				{
+					Name: "rootdisk",
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.KubeVirtRaw, Url: base + "/volumes/rootdisk/disk.img"},
+						{Format: exportv1.KubeVirtGz, Url: base + "/volumes/rootdisk/disk.img.gz"},
+					},
+				},
+			},
+			Manifests: []exportv1.VirtualMachineExportManifest{
+				{Type: exportv1.AllManifests, Url: base + "/manifests/all"},
+			},
+		}
+	}
+
+	newReadyExport := func() *exportv1.VirtualMachineExport {
+		secretName := tokenSecretName
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      exportName,
+				Namespace: targetNamespace,
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: corev1.TypedLocalObjectReference{
+					Kind: "PersistentVolumeClaim",
+					Name: "test-pvc",
+				},
+			},
+			Status: &exportv1.VirtualMachineExportStatus{
+				Phase:          exportv1.Ready,
+				ServiceName:    serviceName,
+				TokenSecretRef: &secretName,
+				Links: &exportv1.VirtualMachineExportLinks{
+					Internal: newLink("https://" + serviceName + "." + targetNamespace + ".svc"),
+				},
+			},
+		}
+	}
+
+	expectProxyGet := func(path string) {
+		kubeClient.Fake.PrependProxyReactor("services", func(action testing.Action) (bool, restclient.ResponseWrapper, error) {
+			proxy, ok := action.(testing.ProxyGetAction)
+			Expect(ok).To(BeTrue())
+			Expect(proxy.GetName()).To(Equal(serviceName))
+			Expect(proxy.GetScheme()).To(Equal("https"))
+			Expect(proxy.GetPort()).To(Equal("443"))
+			Expect(proxy.GetPath()).To(Equal(path))
+			Expect(proxy.GetParams()).To(HaveKeyWithValue(exportv1.TokenHeader, exportToken))
+			return true, &fakeResponseWrapper{body: []byte(volumeContent)}, nil
+		})
+	}
+
+	expectOutput := func(content string) {
+		data, err := ioutil.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(content))
+	}
+
+	Context("create", func() {
+		DescribeTable("should create a VirtualMachineExport for", func(flag, kind string) {
+			testInit()
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "create", exportName, flag, "source", "--ttl", "1h")
+			Expect(cmd()).To(Succeed())
+
+			vmExport, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(targetNamespace).Get(context.Background(), exportName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmExport.Spec.Source.Kind).To(Equal(kind))
+			Expect(vmExport.Spec.Source.Name).To(Equal("source"))
+			Expect(vmExport.Spec.TTLDuration.Duration.String()).To(Equal("1h0m0s"))
+		},
+			Entry("a VirtualMachine", "--vm", "VirtualMachine"),
+			Entry("a PersistentVolumeClaim", "--pvc", "PersistentVolumeClaim"),
+			Entry("a VirtualMachineSnapshot", "--snapshot", "VirtualMachineSnapshot"),
+		)
+
+		It("should fail without a source", func() {
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "create", exportName)
+			Expect(cmd()).To(MatchError("one of --vm, --pvc or --snapshot is required"))
+		})
+
+		It("should fail with more than one source", func() {
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "create", exportName, "--vm", "myvm", "--pvc", "mypvc")
+			Expect(cmd()).To(MatchError("only one of --vm, --pvc or --snapshot may be set"))
+		})
+	})
+
+	It("should delete a VirtualMachineExport", func() {
+		testInit(newReadyExport())
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "delete", exportName)
+		Expect(cmd()).To(Succeed())
+
+		exports, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(targetNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports.Items).To(BeEmpty())
+	})
+
+	It("should reject an unknown action", func() {
+		cmd := tests.NewRepeatableVirtctlCommand(commandName, "upload", exportName)
+		Expect(cmd()).To(MatchError(ContainSubstring("invalid action upload")))
+	})
+
+	Context("download", func() {
+		It("should require an output file", func() {
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName)
+			Expect(cmd()).To(MatchError("--output is required"))
+		})
+
+		It("should download through the service proxy", func() {
+			testInit(newReadyExport())
+			expectProxyGet("/volumes/rootdisk/disk.img.gz")
+
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName, "--output", outputFile)
+			Expect(cmd()).To(Succeed())
+			expectOutput(volumeContent)
+		})
+
+		It("should download the manifest", func() {
+			testInit(newReadyExport())
+			expectProxyGet("/manifests/all")
+
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName, "--manifest", "--output", outputFile)
+			Expect(cmd()).To(Succeed())
+			expectOutput(volumeContent)
+		})
+
+		It("should download through the external link", func() {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.URL.Path).To(Equal("/volumes/rootdisk/disk.img"))
+				Expect(r.Header.Get(exportv1.TokenHeader)).To(Equal(exportToken))
+				w.Write([]byte(volumeContent))
+			}))
+			defer server.Close()
+			vmexport.SetHTTPClientCreator(func(bool) *http.Client {
+				return server.Client()
+			})
+
+			vmExport := newReadyExport()
+			vmExport.Status.Links.External = newLink(server.URL)
+			testInit(vmExport)
+
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName, "--format", "raw", "--output", outputFile)
+			Expect(cmd()).To(Succeed())
+			expectOutput(volumeContent)
+		})
+
+		It("should create and delete the VirtualMachineExport when a source is given", func() {
+			testInit()
+			expectProxyGet("/volumes/rootdisk/disk.img.gz")
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachineexports", func(action testing.Action) (bool, runtime.Object, error) {
+				create, ok := action.(testing.CreateAction)
+				Expect(ok).To(BeTrue())
+				vmExport := create.GetObject().(*exportv1.VirtualMachineExport)
+				Expect(vmExport.Spec.Source.Kind).To(Equal("PersistentVolumeClaim"))
+
+				ready := newReadyExport()
+				ready.Spec = vmExport.Spec
+				err := kubevirtClient.Tracker().Create(exportv1.SchemeGroupVersion.WithResource("virtualmachineexports"), ready, targetNamespace)
+				return true, ready, err
+			})
+
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName, "--pvc", "test-pvc", "--output", outputFile)
+			Expect(cmd()).To(Succeed())
+			expectOutput(volumeContent)
+
+			exports, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(targetNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exports.Items).To(BeEmpty())
+		})
+
+		DescribeTable("should fail to select", func(args []string, message string) {
+			testInit(newReadyExport())
+			cmd := tests.NewRepeatableVirtctlCommand(append([]string{commandName, "download", exportName, "--output", outputFile}, args...)...)
+			Expect(cmd()).To(MatchError(message))
+		},
+			Entry("an unknown volume", []string{"--volume", "datadisk"}, "export does not contain volume datadisk"),
+			Entry("an unavailable format", []string{"--format", "qcow2"}, "format qcow2 is not available for volume rootdisk"),
+		)
+
+		It("should fail if the source does not exist", func() {
+			vmExport := newReadyExport()
+			vmExport.Status.Phase = exportv1.Skipped
+			testInit(vmExport)
+
+			cmd := tests.NewRepeatableVirtctlCommand(commandName, "download", exportName, "--output", outputFile)
+			Expect(cmd()).To(MatchError(ContainSubstring("does not exist")))
+		})
+	})
+})
@@ -69,6 +69,22 @@ func (in *AccessCredentialSecretSource) DeepCopy() *AccessCredentialSecretSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddInterfaceOptions) DeepCopyInto(out *AddInterfaceOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddInterfaceOptions.
+func (in *AddInterfaceOptions) DeepCopy() *AddInterfaceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddInterfaceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 	*out = *in
@@ -132,6 +148,33 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bootloader) DeepCopyInto(out *Bootloader) {
 	*out = *in
@@ -216,6 +259,22 @@ func (in *CPUFeature) DeepCopy() *CPUFeature {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUTopology) DeepCopyInto(out *CPUTopology) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUTopology.
+func (in *CPUTopology) DeepCopy() *CPUTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -643,6 +702,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LogSerialConsole != nil {
+		in, out := &in.LogSerialConsole, &out.LogSerialConsole
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AutoattachMemBalloon != nil {
 		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
 		*out = new(bool)
@@ -680,6 +744,16 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = make([]HostDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.TPM != nil {
+		in, out := &in.TPM, &out.TPM
+		*out = new(TPMDevice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoattachVSOCK != nil {
+		in, out := &in.AutoattachVSOCK, &out.AutoattachVSOCK
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -772,6 +846,30 @@ func (in *DiskTarget) DeepCopy() *DiskTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMemoryDumpInfo.
+func (in *DomainMemoryDumpInfo) DeepCopy() *DomainMemoryDumpInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryDumpInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -849,6 +947,22 @@ func (in *DownwardAPIVolumeSource) DeepCopy() *DownwardAPIVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownwardMetricsVolumeSource) DeepCopyInto(out *DownwardMetricsVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownwardMetricsVolumeSource.
+func (in *DownwardMetricsVolumeSource) DeepCopy() *DownwardMetricsVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DownwardMetricsVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EFI) DeepCopyInto(out *EFI) {
 	*out = *in
@@ -857,6 +971,11 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1210,6 +1329,27 @@ func (in *FloppyTarget) DeepCopy() *FloppyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
+	*out = *in
+	if in.UnfreezeTimeout != nil {
+		in, out := &in.UnfreezeTimeout, &out.UnfreezeTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeUnfreezeTimeout.
+func (in *FreezeUnfreezeTimeout) DeepCopy() *FreezeUnfreezeTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeUnfreezeTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPU) DeepCopyInto(out *GPU) {
 	*out = *in
@@ -1422,6 +1562,22 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeMatcher) DeepCopyInto(out *InstancetypeMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeMatcher.
+func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -1441,6 +1597,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(PluginBinding)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1454,6 +1620,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -1482,6 +1674,11 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 		*out = new(InterfaceMacvtap)
 		**out = **in
 	}
+	if in.Passt != nil {
+		in, out := &in.Passt, &out.Passt
+		*out = new(InterfacePasst)
+		**out = **in
+	}
 	return
 }
 
@@ -1495,6 +1692,27 @@ func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
+	*out = *in
+	if in.ComputeResourceOverhead != nil {
+		in, out := &in.ComputeResourceOverhead, &out.ComputeResourceOverhead
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPlugin.
+func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
 	*out = *in
@@ -1543,6 +1761,22 @@ func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfacePasst) DeepCopyInto(out *InterfacePasst) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfacePasst.
+func (in *InterfacePasst) DeepCopy() *InterfacePasst {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfacePasst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
 	*out = *in
@@ -1711,6 +1945,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(PermittedHostDevices)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogSerialConsole != nil {
+		in, out := &in.LogSerialConsole, &out.LogSerialConsole
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1924,6 +2163,11 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MaxGuest != nil {
+		in, out := &in.MaxGuest, &out.MaxGuest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -1937,6 +2181,54 @@ func (in *Memory) DeepCopy() *Memory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDumpVolumeSource) DeepCopyInto(out *MemoryDumpVolumeSource) {
+	*out = *in
+	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDumpVolumeSource.
+func (in *MemoryDumpVolumeSource) DeepCopy() *MemoryDumpVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDumpVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
+	*out = *in
+	if in.GuestAtBoot != nil {
+		in, out := &in.GuestAtBoot, &out.GuestAtBoot
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GuestCurrent != nil {
+		in, out := &in.GuestCurrent, &out.GuestCurrent
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GuestRequested != nil {
+		in, out := &in.GuestRequested, &out.GuestRequested
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
+func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -1985,6 +2277,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2044,6 +2341,13 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = make(map[string]InterfaceBindingPlugin, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -2181,6 +2485,22 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginBinding) DeepCopyInto(out *PluginBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginBinding.
+func (in *PluginBinding) DeepCopy() *PluginBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodNetwork) DeepCopyInto(out *PodNetwork) {
 	*out = *in
@@ -2213,6 +2533,22 @@ func (in *Port) DeepCopy() *Port {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferenceMatcher) DeepCopyInto(out *PreferenceMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferenceMatcher.
+func (in *PreferenceMatcher) DeepCopy() *PreferenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Probe) DeepCopyInto(out *Probe) {
 	*out = *in
@@ -2288,6 +2624,22 @@ func (in *RTCTimer) DeepCopy() *RTCTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoveInterfaceOptions) DeepCopyInto(out *RemoveInterfaceOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoveInterfaceOptions.
+func (in *RemoveInterfaceOptions) DeepCopy() *RemoveInterfaceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoveInterfaceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoveVolumeOptions) DeepCopyInto(out *RemoveVolumeOptions) {
 	*out = *in
@@ -2512,6 +2864,53 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepSource.
+func (in *SysprepSource) DeepCopy() *SysprepSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMDevice) DeepCopyInto(out *TPMDevice) {
+	*out = *in
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMDevice.
+func (in *TPMDevice) DeepCopy() *TPMDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -2821,6 +3220,48 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecRequest) DeepCopyInto(out *VirtualMachineInstanceGuestExecRequest) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecRequest.
+func (in *VirtualMachineInstanceGuestExecRequest) DeepCopy() *VirtualMachineInstanceGuestExecRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyInto(out *VirtualMachineInstanceGuestExecResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecResult.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopy() *VirtualMachineInstanceGuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -3074,6 +3515,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3426,6 +3872,21 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CurrentCPUTopology != nil {
+		in, out := &in.CurrentCPUTopology, &out.CurrentCPUTopology
+		*out = new(CPUTopology)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VSOCKCID != nil {
+		in, out := &in.VSOCKCID, &out.VSOCKCID
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -3457,6 +3918,32 @@ func (in *VirtualMachineInstanceTemplateSpec) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInterfaceRequest) DeepCopyInto(out *VirtualMachineInterfaceRequest) {
+	*out = *in
+	if in.AddInterfaceOptions != nil {
+		in, out := &in.AddInterfaceOptions, &out.AddInterfaceOptions
+		*out = new(AddInterfaceOptions)
+		**out = **in
+	}
+	if in.RemoveInterfaceOptions != nil {
+		in, out := &in.RemoveInterfaceOptions, &out.RemoveInterfaceOptions
+		*out = new(RemoveInterfaceOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInterfaceRequest.
+func (in *VirtualMachineInterfaceRequest) DeepCopy() *VirtualMachineInterfaceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInterfaceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -3490,6 +3977,35 @@ func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMemoryDumpRequest) DeepCopyInto(out *VirtualMachineMemoryDumpRequest) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.FileName != nil {
+		in, out := &in.FileName, &out.FileName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMemoryDumpRequest.
+func (in *VirtualMachineMemoryDumpRequest) DeepCopy() *VirtualMachineMemoryDumpRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMemoryDumpRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -3503,6 +4019,16 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(VirtualMachineRunStrategy)
 		**out = **in
 	}
+	if in.Instancetype != nil {
+		in, out := &in.Instancetype, &out.Instancetype
+		*out = new(InstancetypeMatcher)
+		**out = **in
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(PreferenceMatcher)
+		**out = **in
+	}
 	if in.Template != nil {
 		in, out := &in.Template, &out.Template
 		*out = new(VirtualMachineInstanceTemplateSpec)
@@ -3585,11 +4111,23 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InterfaceRequests != nil {
+		in, out := &in.InterfaceRequests, &out.InterfaceRequests
+		*out = make([]VirtualMachineInterfaceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.VolumeSnapshotStatuses != nil {
 		in, out := &in.VolumeSnapshotStatuses, &out.VolumeSnapshotStatuses
 		*out = make([]VolumeSnapshotStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.MemoryDumpRequest != nil {
+		in, out := &in.MemoryDumpRequest, &out.MemoryDumpRequest
+		*out = new(VirtualMachineMemoryDumpRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3685,6 +4223,16 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(CloudInitConfigDriveSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(SysprepSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DownwardMetrics != nil {
+		in, out := &in.DownwardMetrics, &out.DownwardMetrics
+		*out = new(DownwardMetricsVolumeSource)
+		**out = **in
+	}
 	if in.ContainerDisk != nil {
 		in, out := &in.ContainerDisk, &out.ContainerDisk
 		*out = new(ContainerDiskSource)
@@ -3725,6 +4273,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(ServiceAccountVolumeSource)
 		**out = **in
 	}
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(MemoryDumpVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -3746,6 +4299,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(HotplugVolumeStatus)
 		**out = **in
 	}
+	if in.MemoryDumpVolume != nil {
+		in, out := &in.MemoryDumpVolume, &out.MemoryDumpVolume
+		*out = new(DomainMemoryDumpInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -139,6 +139,26 @@ type CloudInitNoCloudSource struct {
 	NetworkData string `json:"networkData,omitempty"`
 }
 
+// Represents a Sysprep answer file source.
+// Only one of its members may be specified.
+//
+// +k8s:openapi-gen=true
+type SysprepSource struct {
+	// Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+	// + optional
+	Secret *v1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.
+	// + optional
+	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+}
+
+// DownwardMetricsVolumeSource adds a disk with host and guest metrics to the vmi,
+// it is refreshed by virt-handler while the vmi is running.
+//
+// +k8s:openapi-gen=true
+type DownwardMetricsVolumeSource struct {
+}
+
 // Represents a cloud-init config drive user data source.
 // More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
 //
@@ -242,6 +262,12 @@ type EFI struct {
 	// Defaults to true
 	// +optional
 	SecureBoot *bool `json:"secureBoot,omitempty"`
+	// If set to true, Persistent will persist the EFI NVRAM across reboots
+	// in a PersistentVolumeClaim owned by the VirtualMachine.
+	// Requires the VMPersistentState feature gate.
+	// Defaults to false
+	// +optional
+	Persistent *bool `json:"persistent,omitempty"`
 }
 
 //
@@ -271,6 +297,11 @@ type CPU struct {
 	// Sockets specifies the number of sockets inside the vmi.
 	// Must be a value greater or equal 1.
 	Sockets uint32 `json:"sockets,omitempty"`
+	// MaxSockets specifies the maximum number of sockets the vmi can have.
+	// Sockets can be hotplugged into a running vmi up to this value.
+	// Must be a value greater or equal to Sockets.
+	// +optional
+	MaxSockets uint32 `json:"maxSockets,omitempty"`
 	// Threads specifies the number of threads inside the vmi.
 	// Must be a value greater or equal 1.
 	Threads uint32 `json:"threads,omitempty"`
@@ -294,6 +325,18 @@ type CPU struct {
 	IsolateEmulatorThread bool `json:"isolateEmulatorThread,omitempty"`
 }
 
+// CPUTopology describes the number of sockets, cores and threads of a vmi.
+//
+// +k8s:openapi-gen=true
+type CPUTopology struct {
+	// Cores specifies the number of cores inside the vmi.
+	Cores uint32 `json:"cores,omitempty"`
+	// Sockets specifies the number of sockets inside the vmi.
+	Sockets uint32 `json:"sockets,omitempty"`
+	// Threads specifies the number of threads inside the vmi.
+	Threads uint32 `json:"threads,omitempty"`
+}
+
 // CPUFeature allows specifying a CPU feature.
 //
 // +k8s:openapi-gen=true
@@ -323,6 +366,11 @@ type Memory struct {
 	// Defaults to the requested memory in the resources section if not specified.
 	// + optional
 	Guest *resource.Quantity `json:"guest,omitempty"`
+	// MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS.
+	// The difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value.
+	// Requires Guest to be set.
+	// +optional
+	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
 }
 
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
@@ -378,6 +426,12 @@ type Devices struct {
 	// Whether to attach the default serial console or not.
 	// Serial console access will not be available if set to false. Defaults to true.
 	AutoattachSerialConsole *bool `json:"autoattachSerialConsole,omitempty"`
+	// Whether to log the auto-attached default serial console or not.
+	// The serial console output is written to a log file which is streamed by the guest-console-log container of the pod.
+	// Not relevant if autoattachSerialConsole is disabled.
+	// Defaults to the cluster wide logSerialConsole setting.
+	// +optional
+	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
 	// Whether to attach the Memory balloon device with default period.
 	// Period can be adjusted in virt-config.
 	// Defaults to true.
@@ -404,6 +458,26 @@ type Devices struct {
 	// +optional
 	// +listType=atomic
 	HostDevices []HostDevice `json:"hostDevices,omitempty"`
+	// Whether to emulate a TPM device.
+	// +optional
+	TPM *TPMDevice `json:"tpm,omitempty"`
+	// Whether to attach the VSOCK CID to the VM or not.
+	// VSOCK access will be available if set to true.
+	// Requires the VSOCK feature gate.
+	// Defaults to false.
+	// +optional
+	AutoattachVSOCK *bool `json:"autoattachVSOCK,omitempty"`
+}
+
+//
+// +k8s:openapi-gen=true
+type TPMDevice struct {
+	// If set to true, Persistent will persist the state of the TPM device across reboots
+	// in a PersistentVolumeClaim owned by the VirtualMachine.
+	// Requires the VMPersistentState feature gate.
+	// Defaults to false
+	// +optional
+	Persistent *bool `json:"persistent,omitempty"`
 }
 
 //
@@ -599,6 +673,15 @@ type VolumeSource struct {
 	// More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
 	// +optional
 	CloudInitConfigDrive *CloudInitConfigDriveSource `json:"cloudInitConfigDrive,omitempty"`
+	// Sysprep represents a Sysprep answer file for the unattended setup of Windows.
+	// The answer file will be added as a CD-ROM to the vmi.
+	// More info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview
+	// +optional
+	Sysprep *SysprepSource `json:"sysprep,omitempty"`
+	// DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics.
+	// The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+	// +optional
+	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// ContainerDisk references a docker image, embedding a qcow or raw disk.
 	// More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html
 	// +optional
@@ -630,6 +713,18 @@ type VolumeSource struct {
 	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
 	// +optional
 	ServiceAccount *ServiceAccountVolumeSource `json:"serviceAccount,omitempty"`
+	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
+	// +optional
+	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+}
+
+// MemoryDumpVolumeSource represents a reference to a PersistentVolumeClaim
+// the memory of the vmi is dumped to.
+//
+// +k8s:openapi-gen=true
+type MemoryDumpVolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	v1.PersistentVolumeClaimVolumeSource `json:",inline"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -1078,6 +1173,50 @@ type Interface struct {
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest.
+	// Mutually exclusive with the other binding methods.
+	// +optional
+	Binding *PluginBinding `json:"binding,omitempty"`
+	// Bandwidth limits the traffic of the interface.
+	// Only supported with the bridge, masquerade and macvtap bindings.
+	// It can be changed on a running VMI.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceBandwidth limits the traffic of an interface in both directions.
+//
+// +k8s:openapi-gen=true
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit limits the traffic of an interface in one direction.
+// The values are rounded down to KiB.
+//
+// +k8s:openapi-gen=true
+type BandwidthLimit struct {
+	// Average rate in bytes per second, at least 1Ki.
+	Average resource.Quantity `json:"average"`
+	// Peak rate in bytes per second at which bursts are sent, at least the average rate.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
+}
+
+// PluginBinding refers to a network binding plugin registered in the cluster.
+//
+// +k8s:openapi-gen=true
+type PluginBinding struct {
+	// Name of the binding plugin, as registered in the network configuration of KubeVirt.
+	Name string `json:"name"`
 }
 
 // Extra DHCP options to use in the interface.
@@ -1120,6 +1259,7 @@ type InterfaceBindingMethod struct {
 	Masquerade *InterfaceMasquerade `json:"masquerade,omitempty"`
 	SRIOV      *InterfaceSRIOV      `json:"sriov,omitempty"`
 	Macvtap    *InterfaceMacvtap    `json:"macvtap,omitempty"`
+	Passt      *InterfacePasst      `json:"passt,omitempty"`
 }
 
 //
@@ -1142,6 +1282,12 @@ type InterfaceSRIOV struct{}
 // +k8s:openapi-gen=true
 type InterfaceMacvtap struct{}
 
+// InterfacePasst connects the interface to the pod network through the passt user-space network stack,
+// the guest gets the IP addresses of the pod.
+//
+// +k8s:openapi-gen=true
+type InterfacePasst struct{}
+
 // Port repesents a port to expose from the virtual machine.
 // Default protocol TCP.
 // The port field is mandatory
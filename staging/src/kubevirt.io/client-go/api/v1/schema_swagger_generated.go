@@ -56,6 +56,20 @@ func (CloudInitNoCloudSource) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SysprepSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "Represents a Sysprep answer file source.\nOnly one of its members may be specified.\n\n+k8s:openapi-gen=true",
+		"secret":    "Secret references a k8s Secret that contains the Sysprep answer file named autounattend.xml or unattend.xml.\n+ optional",
+		"configMap": "ConfigMap references a ConfigMap that contains the Sysprep answer file named autounattend.xml or unattend.xml.\n+ optional",
+	}
+}
+
+func (DownwardMetricsVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "DownwardMetricsVolumeSource adds a disk with host and guest metrics to the vmi,\nit is refreshed by virt-handler while the vmi is running.\n\n+k8s:openapi-gen=true",
+	}
+}
+
 func (CloudInitConfigDriveSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "Represents a cloud-init config drive user data source.\nMore info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html\n\n+k8s:openapi-gen=true",
@@ -109,6 +123,7 @@ func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":           "If set, EFI will be used instead of BIOS.\n\n+k8s:openapi-gen=true",
 		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent": "If set to true, Persistent will persist the EFI NVRAM across reboots\nin a PersistentVolumeClaim owned by the VirtualMachine.\nRequires the VMPersistentState feature gate.\nDefaults to false\n+optional",
 	}
 }
 
@@ -126,6 +141,7 @@ func (CPU) SwaggerDoc() map[string]string {
 		"":                      "CPU allows specifying the CPU topology.\n\n+k8s:openapi-gen=true",
 		"cores":                 "Cores specifies the number of cores inside the vmi.\nMust be a value greater or equal 1.",
 		"sockets":               "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
+		"maxSockets":            "MaxSockets specifies the maximum number of sockets the vmi can have.\nSockets can be hotplugged into a running vmi up to this value.\nMust be a value greater or equal to Sockets.\n+optional",
 		"threads":               "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
 		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\nDefaults to host-model.\n+optional",
 		"features":              "Features specifies the CPU features list inside the VMI.\n+optional",
@@ -134,6 +150,15 @@ func (CPU) SwaggerDoc() map[string]string {
 	}
 }
 
+func (CPUTopology) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "CPUTopology describes the number of sockets, cores and threads of a vmi.\n\n+k8s:openapi-gen=true",
+		"cores":   "Cores specifies the number of cores inside the vmi.",
+		"sockets": "Sockets specifies the number of sockets inside the vmi.",
+		"threads": "Threads specifies the number of threads inside the vmi.",
+	}
+}
+
 func (CPUFeature) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "CPUFeature allows specifying a CPU feature.\n\n+k8s:openapi-gen=true",
@@ -147,6 +172,7 @@ func (Memory) SwaggerDoc() map[string]string {
 		"":          "Memory allows specifying the VirtualMachineInstance memory features.\n\n+k8s:openapi-gen=true",
 		"hugepages": "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
 		"guest":     "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
+		"maxGuest":  "MaxGuest allows to specify the maximum amount of memory which can be visible inside the Guest OS.\nThe difference to Guest is backed by a virtio-mem device, so memory can be hotplugged into a running vmi up to this value.\nRequires Guest to be set.\n+optional",
 	}
 }
 
@@ -185,6 +211,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
 		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
 		"autoattachSerialConsole":    "Whether to attach the default serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
+		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nThe serial console output is written to a log file which is streamed by the guest-console-log container of the pod.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to the cluster wide logSerialConsole setting.\n+optional",
 		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
 		"rng":                        "Whether to have random number generator from host\n+optional",
 		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices\n+optional",
@@ -192,6 +219,15 @@ func (Devices) SwaggerDoc() map[string]string {
 		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
 		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
 		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
+		"tpm":                        "Whether to emulate a TPM device.\n+optional",
+		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true.\nRequires the VSOCK feature gate.\nDefaults to false.\n+optional",
+	}
+}
+
+func (TPMDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "+k8s:openapi-gen=true",
+		"persistent": "If set to true, Persistent will persist the state of the TPM device across reboots\nin a PersistentVolumeClaim owned by the VirtualMachine.\nRequires the VMPersistentState feature gate.\nDefaults to false\n+optional",
 	}
 }
 
@@ -303,6 +339,8 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
 		"cloudInitNoCloud":      "CloudInitNoCloud represents a cloud-init NoCloud user-data source.\nThe NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html\n+optional",
 		"cloudInitConfigDrive":  "CloudInitConfigDrive represents a cloud-init Config Drive user-data source.\nThe Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html\n+optional",
+		"sysprep":               "Sysprep represents a Sysprep answer file for the unattended setup of Windows.\nThe answer file will be added as a CD-ROM to the vmi.\nMore info: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview\n+optional",
+		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest metrics.\nThe disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.\n+optional",
 		"containerDisk":         "ContainerDisk references a docker image, embedding a qcow or raw disk.\nMore info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html\n+optional",
 		"ephemeral":             "Ephemeral is a special volume source that \"wraps\" specified source and provides copy-on-write image on top of it.\n+optional",
 		"emptyDisk":             "EmptyDisk represents a temporary disk which shares the vmis lifecycle.\nMore info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html\n+optional",
@@ -311,6 +349,13 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"secret":                "SecretVolumeSource represents a reference to a secret data in the same namespace.\nMore info: https://kubernetes.io/docs/concepts/configuration/secret/\n+optional",
 		"downwardAPI":           "DownwardAPI represents downward API about the pod that should populate this volume\n+optional",
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
+		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi\n+optional",
+	}
+}
+
+func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MemoryDumpVolumeSource represents a reference to a PersistentVolumeClaim\nthe memory of the vmi is dumped to.\n\n+k8s:openapi-gen=true",
 	}
 }
 
@@ -526,6 +571,32 @@ func (Interface) SwaggerDoc() map[string]string {
 		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"binding":     "Binding specifies a network binding plugin registered in the cluster, which connects the interface to the guest.\nMutually exclusive with the other binding methods.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic of the interface.\nOnly supported with the bridge, masquerade and macvtap bindings.\nIt can be changed on a running VMI.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth limits the traffic of an interface in both directions.\n\n+k8s:openapi-gen=true",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit limits the traffic of an interface in one direction.\nThe values are rounded down to KiB.\n\n+k8s:openapi-gen=true",
+		"average": "Average rate in bytes per second, at least 1Ki.",
+		"peak":    "Peak rate in bytes per second at which bursts are sent, at least the average rate.\n+optional",
+		"burst":   "Burst is the amount of bytes which can be sent at the peak rate, at least 1Ki.\n+optional",
+	}
+}
+
+func (PluginBinding) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "PluginBinding refers to a network binding plugin registered in the cluster.\n\n+k8s:openapi-gen=true",
+		"name": "Name of the binding plugin, as registered in the network configuration of KubeVirt.",
 	}
 }
 
@@ -583,6 +654,12 @@ func (InterfaceMacvtap) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InterfacePasst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "InterfacePasst connects the interface to the pod network through the passt user-space network stack,\nthe guest gets the IP addresses of the pod.\n\n+k8s:openapi-gen=true",
+	}
+}
+
 func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port repesents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory\n\n+k8s:openapi-gen=true",
@@ -197,6 +197,36 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeStatus []VolumeStatus `json:"volumeStatus,omitempty"`
+
+	// CurrentCPUTopology specifies the current CPU topology used by the VM workload.
+	// The number of sockets can differ from spec.domain.cpu.sockets while sockets are being hotplugged.
+	// +optional
+	CurrentCPUTopology *CPUTopology `json:"currentCPUTopology,omitempty"`
+
+	// Memory shows the status of the guest memory of VMIs with hotpluggable memory.
+	// +optional
+	Memory *MemoryStatus `json:"memory,omitempty"`
+
+	// VSOCKCID is the cluster-unique context ID of the vsock device of the VMI.
+	// It is allocated by virt-controller and released on deletion of the VMI.
+	// +optional
+	VSOCKCID *uint32 `json:"vsockCID,omitempty"`
+}
+
+// MemoryStatus reports the guest memory of a VMI with hotpluggable memory.
+//
+// +k8s:openapi-gen=true
+type MemoryStatus struct {
+	// GuestAtBoot specifies the guest memory the VMI was started with.
+	// +optional
+	GuestAtBoot *resource.Quantity `json:"guestAtBoot,omitempty"`
+	// GuestCurrent specifies the guest memory currently plugged into the VMI.
+	// +optional
+	GuestCurrent *resource.Quantity `json:"guestCurrent,omitempty"`
+	// GuestRequested specifies the guest memory requested to be plugged into the VMI.
+	// It follows spec.domain.memory.guest as soon as the virt-launcher pod can accommodate it.
+	// +optional
+	GuestRequested *resource.Quantity `json:"guestRequested,omitempty"`
 }
 
 // VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.
@@ -214,6 +244,21 @@ type VolumeStatus struct {
 	Message string `json:"message,omitempty"`
 	// If the volume is hotplug, this will contain the hotplug status.
 	HotplugVolume *HotplugVolumeStatus `json:"hotplugVolume,omitempty"`
+	// If the volume is memorydump volume, this will contain the memorydump info.
+	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
+}
+
+// DomainMemoryDumpInfo represents the memory dump information
+// +k8s:openapi-gen=true
+type DomainMemoryDumpInfo struct {
+	// StartTimestamp is the time when the memory dump started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time when the memory dump completed
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// ClaimName is the name of the pvc the memory was dumped to
+	ClaimName string `json:"claimName,omitempty"`
+	// TargetFileName is the name of the memory dump output
+	TargetFileName string `json:"targetFileName,omitempty"`
 }
 
 // HotplugVolumeStatus represents the hotplug status of the volume
@@ -244,6 +289,12 @@ const (
 	HotplugVolumeDetaching VolumePhase = "Detaching"
 	// HotplugVolumeUnMounted means the volume has been unmounted from the virt-launcer pod.
 	HotplugVolumeUnMounted VolumePhase = "UnMountedFromPod"
+	// MemoryDumpVolumeInProgress means the memory dump to the volume is in progress.
+	MemoryDumpVolumeInProgress VolumePhase = "MemoryDumpInProgress"
+	// MemoryDumpVolumeCompleted means the memory dump to the volume has completed.
+	MemoryDumpVolumeCompleted VolumePhase = "MemoryDumpCompleted"
+	// MemoryDumpVolumeFailed means the memory dump to the volume failed.
+	MemoryDumpVolumeFailed VolumePhase = "MemoryDumpFailed"
 )
 
 func (v *VirtualMachineInstance) IsScheduling() bool {
@@ -422,6 +473,11 @@ type VirtualMachineInstanceNetworkInterface struct {
 	IPs []string `json:"ipAddresses,omitempty"`
 	// The interface name inside the Virtual Machine
 	InterfaceName string `json:"interfaceName,omitempty"`
+	// The name of the pod interface of a secondary multus network, set once
+	// the interface is reported in the network status of the pod
+	PodInterfaceName string `json:"podInterfaceName,omitempty"`
+	// The bandwidth limits applied to the interface
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -1054,6 +1110,14 @@ type VirtualMachineSpec struct {
 	// mutually exclusive with Running
 	RunStrategy *VirtualMachineRunStrategy `json:"runStrategy,omitempty" optional:"true"`
 
+	// InstancetypeMatcher references a instancetype that is used to fill fields in Template
+	// +optional
+	Instancetype *InstancetypeMatcher `json:"instancetype,omitempty"`
+
+	// PreferenceMatcher references a set of preference that is used to fill fields in Template
+	// +optional
+	Preference *PreferenceMatcher `json:"preference,omitempty"`
+
 	// Template is the direct specification of VirtualMachineInstance
 	Template *VirtualMachineInstanceTemplateSpec `json:"template"`
 
@@ -1062,6 +1126,50 @@ type VirtualMachineSpec struct {
 	DataVolumeTemplates []DataVolumeTemplateSpec `json:"dataVolumeTemplates,omitempty"`
 }
 
+// InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.
+//
+// +k8s:openapi-gen=true
+type InstancetypeMatcher struct {
+	// Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+	Name string `json:"name"`
+
+	// Kind specifies which instancetype resource is referenced.
+	// Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype".
+	// If not specified, "VirtualMachineClusterInstancetype" is used by default.
+	//
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// RevisionName specifies a ControllerRevision containing a specific copy of the
+	// VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially
+	// captured the first time the instancetype is applied to the VirtualMachineInstance.
+	//
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+}
+
+// PreferenceMatcher references a set of preference that is used to fill fields in the VMI template.
+//
+// +k8s:openapi-gen=true
+type PreferenceMatcher struct {
+	// Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+	Name string `json:"name"`
+
+	// Kind specifies which preference resource is referenced.
+	// Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+	// If not specified, "VirtualMachineClusterPreference" is used by default.
+	//
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// RevisionName specifies a ControllerRevision containing a specific copy of the
+	// VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is
+	// initially captured the first time the preference is applied to the VirtualMachineInstance.
+	//
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+}
+
 // StateChangeRequestType represents the existing state change requests that are possible
 //
 // +k8s:openapi-gen=true
@@ -1095,9 +1203,19 @@ type VirtualMachineStatus struct {
 	// +listType=atomic
 	VolumeRequests []VirtualMachineVolumeRequest `json:"volumeRequests,omitempty" optional:"true"`
 
+	// InterfaceRequests indicates a list of interfaces to add or remove from the VMI template and
+	// hotplug on an active running VMI.
+	// +listType=atomic
+	InterfaceRequests []VirtualMachineInterfaceRequest `json:"interfaceRequests,omitempty" optional:"true"`
+
 	// VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is
 	// supported by each volume.
 	VolumeSnapshotStatuses []VolumeSnapshotStatus `json:"volumeSnapshotStatuses,omitempty" optional:"true"`
+
+	// MemoryDumpRequest tracks memory dump request phase and info of getting a memory
+	// dump to the given pvc
+	// +nullable
+	MemoryDumpRequest *VirtualMachineMemoryDumpRequest `json:"memoryDumpRequest,omitempty" optional:"true"`
 }
 
 // +k8s:openapi-gen=true
@@ -1120,6 +1238,59 @@ type VirtualMachineVolumeRequest struct {
 	RemoveVolumeOptions *RemoveVolumeOptions `json:"removeVolumeOptions,omitempty" optional:"true"`
 }
 
+// +k8s:openapi-gen=true
+type VirtualMachineInterfaceRequest struct {
+	// AddInterfaceOptions when set indicates an interface should be added. The details
+	// within this field specify how to add the interface
+	AddInterfaceOptions *AddInterfaceOptions `json:"addInterfaceOptions,omitempty" optional:"true"`
+	// RemoveInterfaceOptions when set indicates an interface should be removed. The details
+	// within this field specify which interface to remove
+	RemoveInterfaceOptions *RemoveInterfaceOptions `json:"removeInterfaceOptions,omitempty" optional:"true"`
+}
+
+// VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
+// +k8s:openapi-gen=true
+type VirtualMachineMemoryDumpRequest struct {
+	// ClaimName is the name of the pvc that will contain the memory dump
+	ClaimName string `json:"claimName"`
+	// Phase represents the memory dump phase
+	Phase MemoryDumpPhase `json:"phase"`
+	// Remove represents request of dissociating the memory dump pvc
+	// +optional
+	Remove bool `json:"remove,omitempty"`
+	// StartTimestamp represents the time the memory dump started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp represents the time the memory dump was completed
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// FileName represents the name of the output file
+	// +optional
+	FileName *string `json:"fileName,omitempty"`
+	// Message is a detailed message about failure of the memory dump
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MemoryDumpPhase represents the phase of a memory dump request
+// +k8s:openapi-gen=true
+type MemoryDumpPhase string
+
+const (
+	// MemoryDumpAssociating means the memory dump pvc is being associated with the VirtualMachine
+	MemoryDumpAssociating MemoryDumpPhase = "Associating"
+	// MemoryDumpInProgress means the memory dump is in progress
+	MemoryDumpInProgress MemoryDumpPhase = "InProgress"
+	// MemoryDumpUnmounting means the memory dump completed and the pvc is being unmounted
+	MemoryDumpUnmounting MemoryDumpPhase = "Unmounting"
+	// MemoryDumpCompleted means the memory dump completed and the pvc was unmounted
+	MemoryDumpCompleted MemoryDumpPhase = "Completed"
+	// MemoryDumpDissociating means the memory dump pvc is being dissociated from the VirtualMachine
+	MemoryDumpDissociating MemoryDumpPhase = "Dissociating"
+	// MemoryDumpFailed means the memory dump failed
+	MemoryDumpFailed MemoryDumpPhase = "Failed"
+)
+
 // +k8s:openapi-gen=true
 type VirtualMachineStateChangeRequest struct {
 	// Indicates the type of action that is requested. e.g. Start or Stop
@@ -1163,6 +1334,10 @@ const (
 
 	// This condition indicates that the VM was renamed
 	RenameConditionType VirtualMachineConditionType = "RenameOperation"
+
+	// VirtualMachineRestartRequired is added in a virtual machine when changes to its template
+	// can't be applied to the running vmi and only take effect after a restart.
+	VirtualMachineRestartRequired VirtualMachineConditionType = "RestartRequired"
 )
 
 //
@@ -1554,6 +1729,62 @@ type RemoveVolumeOptions struct {
 	Name string `json:"name"`
 }
 
+// AddInterfaceOptions is provided when dynamically hot plugging a network interface
+// +k8s:openapi-gen=true
+type AddInterfaceOptions struct {
+	// NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition, either as
+	// <name> in the namespace of the VMI or as <namespace>/<name>
+	NetworkAttachmentDefinitionName string `json:"networkAttachmentDefinitionName"`
+	// Name is the name of both the interface and the network which are added
+	Name string `json:"name"`
+}
+
+// RemoveInterfaceOptions is provided when dynamically hot unplugging a network interface
+// +k8s:openapi-gen=true
+type RemoveInterfaceOptions struct {
+	// Name is the name of both the interface and the network which are removed
+	Name string `json:"name"`
+}
+
+// FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
+// +k8s:openapi-gen=true
+type FreezeUnfreezeTimeout struct {
+	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
+}
+
+const (
+	// DefaultGuestExecTimeoutSeconds is used when a guest exec request does not specify a timeout
+	DefaultGuestExecTimeoutSeconds int32 = 30
+	// MaxGuestExecTimeoutSeconds is the longest a guest exec request may wait for its command
+	MaxGuestExecTimeoutSeconds int32 = 300
+)
+
+// VirtualMachineInstanceGuestExecRequest represents a command which should be run in the guest by the guest agent
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceGuestExecRequest struct {
+	// Command is the path or name of the executable inside the guest
+	Command string `json:"command"`
+	// Args are passed to the command
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// Stdin is written to the standard input of the command
+	// +optional
+	Stdin string `json:"stdin,omitempty"`
+	// TimeoutSeconds is the time the command is given to exit before the request fails.
+	// Defaults to 30 seconds and may not exceed 300 seconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtualMachineInstanceGuestExecResult holds the outcome of a command run in the guest
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceGuestExecResult struct {
+	ExitCode int32  `json:"exitCode"`
+	Stdout   string `json:"stdout,omitempty"`
+	Stderr   string `json:"stderr,omitempty"`
+}
+
 // KubeVirtConfiguration holds all kubevirt configurations
 // +k8s:openapi-gen=true
 type KubeVirtConfiguration struct {
@@ -1571,6 +1802,9 @@ type KubeVirtConfiguration struct {
 	SupportedGuestAgentVersions []string                `json:"supportedGuestAgentVersions,omitempty"`
 	MemBalloonStatsPeriod       *uint32                 `json:"memBalloonStatsPeriod,omitempty"`
 	PermittedHostDevices        *PermittedHostDevices   `json:"permittedHostDevices,omitempty"`
+	VMStateStorageClass         string                  `json:"vmStateStorageClass,omitempty"`
+	// LogSerialConsole is the default for logging the serial console of VMIs which do not set it themselves.
+	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
 }
 
 //
@@ -1595,8 +1829,14 @@ type MigrationConfiguration struct {
 	ProgressTimeout                   *int64             `json:"progressTimeout,omitempty"`
 	UnsafeMigrationOverride           *bool              `json:"unsafeMigrationOverride,omitempty"`
 	AllowPostCopy                     *bool              `json:"allowPostCopy,omitempty"`
+	// Network is the name of a NetworkAttachmentDefinition virt-handler pods are attached to,
+	// migrations go over this network instead of the pod network.
+	Network *string `json:"network,omitempty"`
 }
 
+// MigrationInterfaceName is the name of the interface of virt-handler pods on the migration network
+const MigrationInterfaceName = "migration0"
+
 // DeveloperConfiguration holds developer options
 // +k8s:openapi-gen=true
 type DeveloperConfiguration struct {
@@ -1652,4 +1892,20 @@ type NetworkConfiguration struct {
 	NetworkInterface                  string `json:"defaultNetworkInterface,omitempty"`
 	PermitSlirpInterface              *bool  `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool  `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
+	// Binding holds the network binding plugins registered in the cluster, by name.
+	// Interfaces refer to them in their binding field.
+	// +optional
+	Binding map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+}
+
+// InterfaceBindingPlugin is a network binding implemented by a sidecar in the virt-launcher pod
+// +k8s:openapi-gen=true
+type InterfaceBindingPlugin struct {
+	// SidecarImage is the image of the sidecar which implements the binding.
+	// It is added to the virt-launcher pod of every VMI with an interface using the binding.
+	SidecarImage string `json:"sidecarImage"`
+	// ComputeResourceOverhead is added to the resources of the compute container of the virt-launcher pod,
+	// to request the devices or the additional memory the binding needs.
+	// +optional
+	ComputeResourceOverhead *k8sv1.ResourceRequirements `json:"computeResourceOverhead,omitempty"`
 }
@@ -54,18 +54,41 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"evacuationNodeName": "EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want\nto evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.\n+optional",
 		"activePods":         "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":       "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
+		"currentCPUTopology": "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nThe number of sockets can differ from spec.domain.cpu.sockets while sockets are being hotplugged.\n+optional",
+		"memory":             "Memory shows the status of the guest memory of VMIs with hotpluggable memory.\n+optional",
+		"vsockCID":           "VSOCKCID is the cluster-unique context ID of the vsock device of the VMI.\nIt is allocated by virt-controller and released on deletion of the VMI.\n+optional",
+	}
+}
+
+func (MemoryStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "MemoryStatus reports the guest memory of a VMI with hotpluggable memory.\n\n+k8s:openapi-gen=true",
+		"guestAtBoot":    "GuestAtBoot specifies the guest memory the VMI was started with.\n+optional",
+		"guestCurrent":   "GuestCurrent specifies the guest memory currently plugged into the VMI.\n+optional",
+		"guestRequested": "GuestRequested specifies the guest memory requested to be plugged into the VMI.\nIt follows spec.domain.memory.guest as soon as the virt-launcher pod can accommodate it.\n+optional",
 	}
 }
 
 func (VolumeStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.\n+k8s:openapi-gen=true",
-		"name":          "Name is the name of the volume",
-		"target":        "Target is the target name used when adding the volume to the VM, eg: vda",
-		"phase":         "Phase is the phase",
-		"reason":        "Reason is a brief description of why we are in the current hotplug volume phase",
-		"message":       "Message is a detailed message about the current hotplug volume phase",
-		"hotplugVolume": "If the volume is hotplug, this will contain the hotplug status.",
+		"":                 "VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.\n+k8s:openapi-gen=true",
+		"name":             "Name is the name of the volume",
+		"target":           "Target is the target name used when adding the volume to the VM, eg: vda",
+		"phase":            "Phase is the phase",
+		"reason":           "Reason is a brief description of why we are in the current hotplug volume phase",
+		"message":          "Message is a detailed message about the current hotplug volume phase",
+		"hotplugVolume":    "If the volume is hotplug, this will contain the hotplug status.",
+		"memoryDumpVolume": "If the volume is memorydump volume, this will contain the memorydump info.",
+	}
+}
+
+func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "DomainMemoryDumpInfo represents the memory dump information\n+k8s:openapi-gen=true",
+		"startTimestamp": "StartTimestamp is the time when the memory dump started",
+		"endTimestamp":   "EndTimestamp is the time when the memory dump completed",
+		"claimName":      "ClaimName is the name of the pvc the memory was dumped to",
+		"targetFileName": "TargetFileName is the name of the memory dump output",
 	}
 }
 
@@ -95,12 +118,14 @@ func (VirtualMachineInstanceMigrationCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"ipAddress":     "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
-		"mac":           "Hardware address of a Virtual Machine interface",
-		"name":          "Name of the interface, corresponds to name of the network assigned to the interface",
-		"ipAddresses":   "List of all IP addresses of a Virtual Machine interface",
-		"interfaceName": "The interface name inside the Virtual Machine",
+		"":                 "+k8s:openapi-gen=true",
+		"ipAddress":        "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
+		"mac":              "Hardware address of a Virtual Machine interface",
+		"name":             "Name of the interface, corresponds to name of the network assigned to the interface",
+		"ipAddresses":      "List of all IP addresses of a Virtual Machine interface",
+		"interfaceName":    "The interface name inside the Virtual Machine",
+		"podInterfaceName": "The name of the pod interface of a secondary multus network, set once\nthe interface is reported in the network status of the pod",
+		"bandwidth":        "The bandwidth limits applied to the interface",
 	}
 }
 
@@ -272,11 +297,31 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"":                    "VirtualMachineSpec describes how the proper VirtualMachine\nshould look like\n\n+k8s:openapi-gen=true",
 		"running":             "Running controls whether the associatied VirtualMachineInstance is created or not\nMutually exclusive with RunStrategy",
 		"runStrategy":         "Running state indicates the requested running state of the VirtualMachineInstance\nmutually exclusive with Running",
+		"instancetype":        "InstancetypeMatcher references a instancetype that is used to fill fields in Template\n+optional",
+		"preference":          "PreferenceMatcher references a set of preference that is used to fill fields in Template\n+optional",
 		"template":            "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates": "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 	}
 }
 
+func (InstancetypeMatcher) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.\n\n+k8s:openapi-gen=true",
+		"name":         "Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype",
+		"kind":         "Kind specifies which instancetype resource is referenced.\nAllowed values are: \"VirtualMachineInstancetype\" and \"VirtualMachineClusterInstancetype\".\nIf not specified, \"VirtualMachineClusterInstancetype\" is used by default.\n\n+optional",
+		"revisionName": "RevisionName specifies a ControllerRevision containing a specific copy of the\nVirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially\ncaptured the first time the instancetype is applied to the VirtualMachineInstance.\n\n+optional",
+	}
+}
+
+func (PreferenceMatcher) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "PreferenceMatcher references a set of preference that is used to fill fields in the VMI template.\n\n+k8s:openapi-gen=true",
+		"name":         "Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference",
+		"kind":         "Kind specifies which preference resource is referenced.\nAllowed values are: \"VirtualMachinePreference\" and \"VirtualMachineClusterPreference\".\nIf not specified, \"VirtualMachineClusterPreference\" is used by default.\n\n+optional",
+		"revisionName": "RevisionName specifies a ControllerRevision containing a specific copy of the\nVirtualMachinePreference or VirtualMachineClusterPreference to be used. This is\ninitially captured the first time the preference is applied to the VirtualMachineInstance.\n\n+optional",
+	}
+}
+
 func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                       "VirtualMachineStatus represents the status returned by the\ncontroller to describe how the VirtualMachine is doing\n\n+k8s:openapi-gen=true",
@@ -286,7 +331,9 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"conditions":             "Hold the state information of the VirtualMachine and its VirtualMachineInstance",
 		"stateChangeRequests":    "StateChangeRequests indicates a list of actions that should be taken on a VMI\ne.g. stop a specific VMI then start a new one.",
 		"volumeRequests":         "VolumeRequests indicates a list of volumes add or remove from the VMI template and\nhotplug on an active running VMI.\n+listType=atomic",
+		"interfaceRequests":      "InterfaceRequests indicates a list of interfaces to add or remove from the VMI template and\nhotplug on an active running VMI.\n+listType=atomic",
 		"volumeSnapshotStatuses": "VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is\nsupported by each volume.",
+		"memoryDumpRequest":      "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable",
 	}
 }
 
@@ -307,6 +354,27 @@ func (VirtualMachineVolumeRequest) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInterfaceRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "+k8s:openapi-gen=true",
+		"addInterfaceOptions":    "AddInterfaceOptions when set indicates an interface should be added. The details\nwithin this field specify how to add the interface",
+		"removeInterfaceOptions": "RemoveInterfaceOptions when set indicates an interface should be removed. The details\nwithin this field specify which interface to remove",
+	}
+}
+
+func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info\n+k8s:openapi-gen=true",
+		"claimName":      "ClaimName is the name of the pvc that will contain the memory dump",
+		"phase":          "Phase represents the memory dump phase",
+		"remove":         "Remove represents request of dissociating the memory dump pvc\n+optional",
+		"startTimestamp": "StartTimestamp represents the time the memory dump started\n+optional",
+		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
+		"fileName":       "FileName represents the name of the output file\n+optional",
+		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+	}
+}
+
 func (VirtualMachineStateChangeRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "+k8s:openapi-gen=true",
@@ -482,9 +550,47 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (AddInterfaceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "AddInterfaceOptions is provided when dynamically hot plugging a network interface\n+k8s:openapi-gen=true",
+		"networkAttachmentDefinitionName": "NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition, either as\n<name> in the namespace of the VMI or as <namespace>/<name>",
+		"name":                            "Name is the name of both the interface and the network which are added",
+	}
+}
+
+func (RemoveInterfaceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "RemoveInterfaceOptions is provided when dynamically hot unplugging a network interface\n+k8s:openapi-gen=true",
+		"name": "Name is the name of both the interface and the network which are removed",
+	}
+}
+
+func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command\n+k8s:openapi-gen=true",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestExecRequest represents a command which should be run in the guest by the guest agent\n+k8s:openapi-gen=true",
+		"command":        "Command is the path or name of the executable inside the guest",
+		"args":           "Args are passed to the command\n+optional\n+listType=atomic",
+		"stdin":          "Stdin is written to the standard input of the command\n+optional",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is given to exit before the request fails.\nDefaults to 30 seconds and may not exceed 300 seconds.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestExecResult holds the outcome of a command run in the guest\n+k8s:openapi-gen=true",
+	}
+}
+
 func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "KubeVirtConfiguration holds all kubevirt configurations\n+k8s:openapi-gen=true",
+		"":                 "KubeVirtConfiguration holds all kubevirt configurations\n+k8s:openapi-gen=true",
+		"logSerialConsole": "LogSerialConsole is the default for logging the serial console of VMIs which do not set it themselves.",
 	}
 }
 
@@ -496,7 +602,8 @@ func (SMBiosConfiguration) SwaggerDoc() map[string]string {
 
 func (MigrationConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "MigrationConfiguration holds migration options\n+k8s:openapi-gen=true",
+		"":        "MigrationConfiguration holds migration options\n+k8s:openapi-gen=true",
+		"network": "Network is the name of a NetworkAttachmentDefinition virt-handler pods are attached to,\nmigrations go over this network instead of the pod network.",
 	}
 }
 
@@ -535,6 +642,15 @@ func (MediatedHostDevice) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options\n+k8s:openapi-gen=true",
+		"":        "NetworkConfiguration holds network options\n+k8s:openapi-gen=true",
+		"binding": "Binding holds the network binding plugins registered in the cluster, by name.\nInterfaces refer to them in their binding field.\n+optional",
+	}
+}
+
+func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "InterfaceBindingPlugin is a network binding implemented by a sidecar in the virt-launcher pod\n+k8s:openapi-gen=true",
+		"sidecarImage":            "SidecarImage is the image of the sidecar which implements the binding.\nIt is added to the virt-launcher pod of every VMI with an interface using the binding.",
+		"computeResourceOverhead": "ComputeResourceOverhead is added to the resources of the compute container of the virt-launcher pod,\nto request the devices or the additional memory the binding needs.\n+optional",
 	}
 }
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SingularResourceName is the singular name of the clone resource
+	SingularResourceName = "virtualmachineclone"
+	// PluralResourceName is the plural name of the clone resource
+	PluralResourceName = "virtualmachineclones"
+
+	// VirtualMachineCloneKind is the kind of the clone resource
+	VirtualMachineCloneKind = "VirtualMachineClone"
+)
+
+// VirtualMachineClone defines the operation of cloning a VM into a new VM
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineCloneSpec `json:"spec"`
+	// +optional
+	Status VirtualMachineCloneStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineCloneSpec is the spec for a VirtualMachineClone resource
+type VirtualMachineCloneSpec struct {
+	// Source is the object that would be cloned. Currently supported source types are:
+	// VirtualMachine of kubevirt.io API group,
+	// VirtualMachineSnapshot of snapshot.kubevirt.io API group
+	Source *corev1.TypedLocalObjectReference `json:"source"`
+
+	// Target is the outcome of the cloning process.
+	// Currently supported target types are: VirtualMachine of kubevirt.io API group.
+	// If the name is empty, a name is generated for the new VirtualMachine.
+	// +optional
+	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
+
+	// AnnotationFilters selects which annotations of the source are copied to the target.
+	// Filters are evaluated in order and may contain "*" wildcards, a leading "!" excludes
+	// matching keys. Example use: ["*", "!some/key*"]. If empty, all annotations are copied.
+	// +optional
+	AnnotationFilters []string `json:"annotationFilters,omitempty"`
+
+	// LabelFilters selects which labels of the source are copied to the target,
+	// using the same syntax as AnnotationFilters.
+	// +optional
+	LabelFilters []string `json:"labelFilters,omitempty"`
+
+	// NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
+	// value is the new mac address. If this field is not specified, a new MAC address will
+	// be generated automatically, as for any interface that is not included in this map.
+	// +optional
+	NewMacAddresses map[string]string `json:"newMacAddresses,omitempty"`
+
+	// NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
+	// be generated automatically.
+	// +optional
+	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+}
+
+// VirtualMachineClonePhase is the current phase of the VirtualMachineClone
+type VirtualMachineClonePhase string
+
+const (
+	// PhaseUnset means the clone has not been processed yet
+	PhaseUnset VirtualMachineClonePhase = ""
+	// SnapshotInProgress means the source VirtualMachine is being snapshotted
+	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
+	// RestoreInProgress means the volumes of the target are being restored from the snapshot
+	RestoreInProgress VirtualMachineClonePhase = "RestoreInProgress"
+	// CreatingTargetVM means the target VirtualMachine is being created
+	CreatingTargetVM VirtualMachineClonePhase = "CreatingTargetVM"
+	// Succeeded means the target VirtualMachine was created successfully
+	Succeeded VirtualMachineClonePhase = "Succeeded"
+	// Failed means the clone failed and will not be retried
+	Failed VirtualMachineClonePhase = "Failed"
+	// PhaseUnknown means the phase of the clone could not be determined
+	PhaseUnknown VirtualMachineClonePhase = "Unknown"
+)
+
+// VirtualMachineCloneStatus is the status for a VirtualMachineClone resource
+type VirtualMachineCloneStatus struct {
+	// +optional
+	SnapshotName *string `json:"snapshotName,omitempty"`
+
+	// +optional
+	TargetName *string `json:"targetName,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	Phase VirtualMachineClonePhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionReady is the "ready" condition type
+	ConditionReady ConditionType = "Ready"
+
+	// ConditionProgressing is the "progressing" condition type
+	ConditionProgressing ConditionType = "Progressing"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineCloneList is a list of VirtualMachineClone resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineCloneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineClone `json:"items"`
+}
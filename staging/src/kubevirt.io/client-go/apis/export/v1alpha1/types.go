@@ -0,0 +1,226 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SingularResourceName is the singular name of the export resource
+	SingularResourceName = "virtualmachineexport"
+	// PluralResourceName is the plural name of the export resource
+	PluralResourceName = "virtualmachineexports"
+
+	// VirtualMachineExportKind is the kind of the export resource
+	VirtualMachineExportKind = "VirtualMachineExport"
+
+	// TokenKey is the key in the token Secret holding the export token
+	TokenKey = "token"
+	// TokenHeader is the http header used to pass the export token
+	TokenHeader = "x-kubevirt-export-token"
+)
+
+// VirtualMachineExport defines the operation of exporting a VM, PVC or VirtualMachineSnapshot
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineExportSpec `json:"spec"`
+	// +optional
+	Status *VirtualMachineExportStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineExportSpec is the spec for a VirtualMachineExport resource
+type VirtualMachineExportSpec struct {
+	// Source is the object that is exported. Currently supported source types are:
+	// VirtualMachine of kubevirt.io API group,
+	// VirtualMachineSnapshot of snapshot.kubevirt.io API group,
+	// PersistentVolumeClaim of the core API group
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// TokenSecretRef is the name of a Secret holding the token needed to access the export
+	// under the "token" key. If empty, a Secret with a random token is generated.
+	// +optional
+	TokenSecretRef *string `json:"tokenSecretRef,omitempty"`
+
+	// TTLDuration limits the lifetime of the export, the export is deleted once it expires.
+	// Defaults to two hours.
+	// +optional
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+}
+
+// VirtualMachineExportPhase is the current phase of the VirtualMachineExport
+type VirtualMachineExportPhase string
+
+const (
+	// Pending means the export is waiting for its source to become available
+	Pending VirtualMachineExportPhase = "Pending"
+	// Ready means the exporter is serving the source
+	Ready VirtualMachineExportPhase = "Ready"
+	// Skipped means the source does not exist or has no exportable volumes
+	Skipped VirtualMachineExportPhase = "Skipped"
+)
+
+// VirtualMachineExportStatus is the status for a VirtualMachineExport resource
+type VirtualMachineExportStatus struct {
+	// +optional
+	Phase VirtualMachineExportPhase `json:"phase,omitempty"`
+
+	// +optional
+	Links *VirtualMachineExportLinks `json:"links,omitempty"`
+
+	// TokenSecretRef is the name of the Secret holding the export token
+	// +optional
+	TokenSecretRef *string `json:"tokenSecretRef,omitempty"`
+
+	// TTLExpirationTime is the time the export is deleted
+	// +optional
+	// +nullable
+	TTLExpirationTime *metav1.Time `json:"ttlExpirationTime,omitempty"`
+
+	// ServiceName is the name of the Service in front of the exporter
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// VirtualMachineName is the name of the exported VirtualMachine, if any
+	// +optional
+	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineExportLinks contains the links to the exported objects
+type VirtualMachineExportLinks struct {
+	// Internal links are reachable from within the cluster
+	// +optional
+	Internal *VirtualMachineExportLink `json:"internal,omitempty"`
+
+	// External links are reachable from outside the cluster through an Ingress
+	// +optional
+	External *VirtualMachineExportLink `json:"external,omitempty"`
+}
+
+// VirtualMachineExportLink contains the links for one way of reaching the export
+type VirtualMachineExportLink struct {
+	// Cert is the PEM encoded CA certificate the exporter's certificate is signed with
+	Cert string `json:"cert"`
+
+	// Volumes lists the exported volumes
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VirtualMachineExportVolume `json:"volumes,omitempty"`
+
+	// Manifests lists the exported manifests
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Manifests []VirtualMachineExportManifest `json:"manifests,omitempty"`
+}
+
+// VirtualMachineExportVolume contains the links to one exported volume
+type VirtualMachineExportVolume struct {
+	// Name is the name of the exported volume
+	Name string `json:"name"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=format
+	Formats []VirtualMachineExportVolumeFormat `json:"formats,omitempty"`
+}
+
+// ExportVolumeFormat is the format of an exported volume image
+type ExportVolumeFormat string
+
+const (
+	// KubeVirtRaw is the raw disk image
+	KubeVirtRaw ExportVolumeFormat = "raw"
+	// KubeVirtGz is the gzip compressed raw disk image
+	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtQcow2 is the disk image converted to qcow2
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
+)
+
+// VirtualMachineExportVolumeFormat contains the link to a volume in a specific format
+type VirtualMachineExportVolumeFormat struct {
+	Format ExportVolumeFormat `json:"format"`
+	Url    string             `json:"url"`
+}
+
+// ExportManifestType is the type of an exported manifest
+type ExportManifestType string
+
+const (
+	// AllManifests contains the definition of the exported VirtualMachine
+	AllManifests ExportManifestType = "all"
+)
+
+// VirtualMachineExportManifest contains the link to an exported manifest
+type VirtualMachineExportManifest struct {
+	Type ExportManifestType `json:"type"`
+	Url  string             `json:"url"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionReady is the "ready" condition type
+	ConditionReady ConditionType = "Ready"
+
+	// ConditionVolumesReady is the condition type reporting whether all exported volumes are available
+	ConditionVolumesReady ConditionType = "VolumesReady"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineExportList is a list of VirtualMachineExport resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineExport `json:"items"`
+}
@@ -0,0 +1,303 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+const (
+	// SingularResourceName is the singular name of the namespaced instancetype resource
+	SingularResourceName = "virtualmachineinstancetype"
+	// PluralResourceName is the plural name of the namespaced instancetype resource
+	PluralResourceName = "virtualmachineinstancetypes"
+	// ClusterSingularResourceName is the singular name of the cluster instancetype resource
+	ClusterSingularResourceName = "virtualmachineclusterinstancetype"
+	// ClusterPluralResourceName is the plural name of the cluster instancetype resource
+	ClusterPluralResourceName = "virtualmachineclusterinstancetypes"
+
+	// SingularPreferenceResourceName is the singular name of the namespaced preference resource
+	SingularPreferenceResourceName = "virtualmachinepreference"
+	// PluralPreferenceResourceName is the plural name of the namespaced preference resource
+	PluralPreferenceResourceName = "virtualmachinepreferences"
+	// ClusterSingularPreferenceResourceName is the singular name of the cluster preference resource
+	ClusterSingularPreferenceResourceName = "virtualmachineclusterpreference"
+	// ClusterPluralPreferenceResourceName is the plural name of the cluster preference resource
+	ClusterPluralPreferenceResourceName = "virtualmachineclusterpreferences"
+
+	// VirtualMachineInstancetypeKind is the kind of the namespaced instancetype resource
+	VirtualMachineInstancetypeKind = "VirtualMachineInstancetype"
+	// VirtualMachineClusterInstancetypeKind is the kind of the cluster instancetype resource
+	VirtualMachineClusterInstancetypeKind = "VirtualMachineClusterInstancetype"
+	// VirtualMachinePreferenceKind is the kind of the namespaced preference resource
+	VirtualMachinePreferenceKind = "VirtualMachinePreference"
+	// VirtualMachineClusterPreferenceKind is the kind of the cluster preference resource
+	VirtualMachineClusterPreferenceKind = "VirtualMachineClusterPreference"
+)
+
+// VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration
+// that can be used by multiple VirtualMachine resources.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstancetype struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the instancetype
+	Spec VirtualMachineInstancetypeSpec `json:"spec"`
+}
+
+// VirtualMachineInstancetypeList is a list of VirtualMachineInstancetype resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstancetypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstancetype `json:"items"`
+}
+
+// VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype resource.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterInstancetype struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the instancetype
+	Spec VirtualMachineInstancetypeSpec `json:"spec"`
+}
+
+// VirtualMachineClusterInstancetypeList is a list of VirtualMachineClusterInstancetype resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterInstancetypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClusterInstancetype `json:"items"`
+}
+
+// VirtualMachineInstancetypeSpec is a description of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype.
+//
+// CPU and Memory are required attributes with both requiring that their Guest attribute is defined, ensuring a number of vCPUs and amount of RAM is always provided by each instancetype.
+type VirtualMachineInstancetypeSpec struct {
+	// Required CPU related attributes of the instancetype.
+	CPU CPUInstancetype `json:"cpu"`
+
+	// Required Memory related attributes of the instancetype.
+	Memory MemoryInstancetype `json:"memory"`
+
+	// Optionally defines the IOThreadsPolicy to be used by the instancetype.
+	//
+	// +optional
+	IOThreadsPolicy *v1.IOThreadsPolicy `json:"ioThreadsPolicy,omitempty"`
+}
+
+// CPUInstancetype contains the CPU related configuration of a given VirtualMachineInstancetypeSpec.
+//
+// Guest is a required attribute and defines the number of vCPUs to be exposed to the guest by the instancetype.
+type CPUInstancetype struct {
+	// Required number of vCPUs to expose to the guest.
+	//
+	// The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+	Guest uint32 `json:"guest"`
+
+	// Model specifies the CPU model inside the VMI.
+	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+	// and "host-model" to get CPU closest to the node one.
+	// Defaults to host-model.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+	// with enough dedicated pCPUs and pin the vCPUs to it.
+	// +optional
+	DedicatedCPUPlacement bool `json:"dedicatedCPUPlacement,omitempty"`
+
+	// IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+	// the emulator thread on it.
+	// +optional
+	IsolateEmulatorThread bool `json:"isolateEmulatorThread,omitempty"`
+}
+
+// MemoryInstancetype contains the Memory related configuration of a given VirtualMachineInstancetypeSpec.
+//
+// Guest is a required attribute and defines the amount of RAM to be exposed to the guest by the instancetype.
+type MemoryInstancetype struct {
+	// Required amount of memory which is visible inside the guest OS.
+	Guest resource.Quantity `json:"guest"`
+
+	// Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+	// +optional
+	Hugepages *v1.Hugepages `json:"hugepages,omitempty"`
+}
+
+// VirtualMachinePreference resource contains optional preferences related to the VirtualMachine.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePreference struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the preferences
+	Spec VirtualMachinePreferenceSpec `json:"spec"`
+}
+
+// VirtualMachinePreferenceList is a list of VirtualMachinePreference resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePreferenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachinePreference `json:"items"`
+}
+
+// VirtualMachineClusterPreference is a cluster scoped version of the VirtualMachinePreference resource.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterPreference struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the preferences
+	Spec VirtualMachinePreferenceSpec `json:"spec"`
+}
+
+// VirtualMachineClusterPreferenceList is a list of VirtualMachineClusterPreference resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterPreferenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClusterPreference `json:"items"`
+}
+
+// VirtualMachinePreferenceSpec is a description of the VirtualMachinePreference or VirtualMachineClusterPreference.
+//
+// Preferences are only applied to fields that are left unset in the VirtualMachine template.
+type VirtualMachinePreferenceSpec struct {
+	// CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	CPU *CPUPreferences `json:"cpu,omitempty"`
+
+	// Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Devices *DevicePreferences `json:"devices,omitempty"`
+
+	// Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Firmware *FirmwarePreferences `json:"firmware,omitempty"`
+
+	// Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Machine *MachinePreferences `json:"machine,omitempty"`
+}
+
+// PreferredCPUTopology defines how the guest vCPUs of an instancetype are laid out.
+type PreferredCPUTopology string
+
+const (
+	// PreferSockets exposes the guest vCPUs of the instancetype as sockets
+	PreferSockets PreferredCPUTopology = "preferSockets"
+	// PreferCores exposes the guest vCPUs of the instancetype as cores
+	PreferCores PreferredCPUTopology = "preferCores"
+	// PreferThreads exposes the guest vCPUs of the instancetype as threads
+	PreferThreads PreferredCPUTopology = "preferThreads"
+)
+
+// CPUPreferences contains various optional CPU preferences.
+type CPUPreferences struct {
+	// PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+	//
+	// +optional
+	PreferredCPUTopology PreferredCPUTopology `json:"preferredCPUTopology,omitempty"`
+}
+
+// DevicePreferences contains various optional Device preferences.
+type DevicePreferences struct {
+	// PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+	//
+	// +optional
+	PreferredAutoattachGraphicsDevice *bool `json:"preferredAutoattachGraphicsDevice,omitempty"`
+
+	// PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+	//
+	// +optional
+	PreferredAutoattachSerialConsole *bool `json:"preferredAutoattachSerialConsole,omitempty"`
+
+	// PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+	//
+	// +optional
+	PreferredDiskBus string `json:"preferredDiskBus,omitempty"`
+
+	// PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+	//
+	// +optional
+	PreferredLunBus string `json:"preferredLunBus,omitempty"`
+
+	// PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+	//
+	// +optional
+	PreferredCdromBus string `json:"preferredCdromBus,omitempty"`
+
+	// PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+	//
+	// +optional
+	PreferredInterfaceModel string `json:"preferredInterfaceModel,omitempty"`
+}
+
+// FirmwarePreferences contains various optional defaults for Firmware.
+type FirmwarePreferences struct {
+	// PreferredUseBios optionally enables BIOS
+	//
+	// +optional
+	PreferredUseBios *bool `json:"preferredUseBios,omitempty"`
+
+	// PreferredUseEfi optionally enables EFI
+	//
+	// +optional
+	PreferredUseEfi *bool `json:"preferredUseEfi,omitempty"`
+
+	// PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones.
+	//
+	// +optional
+	PreferredUseSecureBoot *bool `json:"preferredUseSecureBoot,omitempty"`
+}
+
+// MachinePreferences contains various optional defaults for Machine.
+type MachinePreferences struct {
+	// PreferredMachineType optionally defines the preferred machine type to use.
+	//
+	// +optional
+	PreferredMachineType string `json:"preferredMachineType,omitempty"`
+}
@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+const (
+	// SingularResourceName is the singular name of the pool resource
+	SingularResourceName = "virtualmachinepool"
+	// PluralResourceName is the plural name of the pool resource
+	PluralResourceName = "virtualmachinepools"
+
+	// VirtualMachinePoolKind is the kind of the pool resource
+	VirtualMachinePoolKind = "VirtualMachinePool"
+)
+
+// VirtualMachinePool resource contains a VirtualMachine configuration
+// that can be used to replicate multiple VirtualMachine resources.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachinePoolSpec `json:"spec"`
+	// +optional
+	Status VirtualMachinePoolStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineTemplateSpec describes the VirtualMachines created by a VirtualMachinePool.
+type VirtualMachineTemplateSpec struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +nullable
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty"`
+	// VirtualMachineSpec contains the VirtualMachine specification.
+	Spec v1.VirtualMachineSpec `json:"spec,omitempty" valid:"required"`
+}
+
+// VirtualMachinePoolSpec is a description of a VirtualMachinePool.
+type VirtualMachinePoolSpec struct {
+	// Number of desired VirtualMachines. This is a pointer to distinguish between explicit
+	// zero and not specified. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for VirtualMachines. Existing VirtualMachines selected by this
+	// selector are the ones affected by this pool.
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Template describes the VirtualMachines that will be created. The names of the
+	// VirtualMachines are suffixed with their unique index within the pool, and the
+	// names of their DataVolumeTemplates are prefixed with the name of the VirtualMachine.
+	VirtualMachineTemplate *VirtualMachineTemplateSpec `json:"virtualMachineTemplate"`
+
+	// Indicates that the pool is paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// ScaleInStrategy specifies how the pool selects and removes VirtualMachines when scaling in.
+	// +optional
+	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
+}
+
+// VirtualMachinePoolSelectionPolicy defines which VirtualMachines are removed first when a pool scales in.
+type VirtualMachinePoolSelectionPolicy string
+
+const (
+	// VirtualMachinePoolSelectionPolicyLeastReady removes VirtualMachines which are not ready first,
+	// followed by the VirtualMachines with the highest index.
+	VirtualMachinePoolSelectionPolicyLeastReady VirtualMachinePoolSelectionPolicy = "LeastReady"
+	// VirtualMachinePoolSelectionPolicyOldest removes the VirtualMachines with the oldest creation timestamp first.
+	VirtualMachinePoolSelectionPolicyOldest VirtualMachinePoolSelectionPolicy = "Oldest"
+)
+
+// VirtualMachinePoolVolumePolicy defines what happens to the DataVolumes of a VirtualMachine
+// removed by the pool while scaling in.
+type VirtualMachinePoolVolumePolicy string
+
+const (
+	// VirtualMachinePoolVolumePolicyDelete deletes the DataVolumes together with the VirtualMachine.
+	VirtualMachinePoolVolumePolicyDelete VirtualMachinePoolVolumePolicy = "Delete"
+	// VirtualMachinePoolVolumePolicyRetain keeps the DataVolumes of the VirtualMachine. They are
+	// picked up again once a VirtualMachine with the same index is created by the pool.
+	VirtualMachinePoolVolumePolicyRetain VirtualMachinePoolVolumePolicy = "Retain"
+)
+
+// VirtualMachinePoolScaleInStrategy specifies how VirtualMachines are removed when a pool scales in.
+type VirtualMachinePoolScaleInStrategy struct {
+	// SelectionPolicy defines the order in which VirtualMachines are removed.
+	// One of: LeastReady, Oldest. Defaults to LeastReady.
+	// +optional
+	SelectionPolicy *VirtualMachinePoolSelectionPolicy `json:"selectionPolicy,omitempty"`
+
+	// VolumePolicy defines whether the DataVolumes of removed VirtualMachines are deleted or retained.
+	// One of: Delete, Retain. Defaults to Delete.
+	// +optional
+	VolumePolicy *VirtualMachinePoolVolumePolicy `json:"volumePolicy,omitempty"`
+}
+
+// VirtualMachinePoolStatus represents the observed state of a VirtualMachinePool.
+type VirtualMachinePoolStatus struct {
+	// Total number of non-terminated VirtualMachines targeted by this pool (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// The number of ready VirtualMachines for this pool.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// +optional
+	Conditions []VirtualMachinePoolCondition `json:"conditions,omitempty"`
+}
+
+// VirtualMachinePoolConditionType represents the type of a VirtualMachinePool condition.
+type VirtualMachinePoolConditionType string
+
+const (
+	// VirtualMachinePoolReplicaFailure is added in a pool when one of its VirtualMachines
+	// fails to be created or deleted.
+	VirtualMachinePoolReplicaFailure VirtualMachinePoolConditionType = "ReplicaFailure"
+
+	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
+	// After this condition was added, it is safe to remove or add VirtualMachines by hand and adjust
+	// the pool manually.
+	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+)
+
+// VirtualMachinePoolCondition represents the state of a VirtualMachinePool at a certain point.
+type VirtualMachinePoolCondition struct {
+	Type   VirtualMachinePoolConditionType `json:"type"`
+	Status k8sv1.ConditionStatus           `json:"status"`
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachinePoolList is a list of VirtualMachinePool resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []VirtualMachinePool `json:"items"`
+}
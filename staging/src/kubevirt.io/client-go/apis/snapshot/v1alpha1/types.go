@@ -82,8 +82,28 @@ type VirtualMachineSnapshotStatus struct {
 
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// +optional
+	// +listType=set
+	Indications []Indication `json:"indications,omitempty"`
 }
 
+// Indication is a way to indicate the state of the vm when taking the snapshot
+type Indication string
+
+const (
+	// VMSnapshotOnlineSnapshotIndication means the vm was running during the snapshot
+	VMSnapshotOnlineSnapshotIndication Indication = "Online"
+
+	// VMSnapshotGuestAgentIndication means the guest agent was connected and
+	// the guest filesystems were frozen during the snapshot
+	VMSnapshotGuestAgentIndication Indication = "GuestAgent"
+
+	// VMSnapshotNoGuestAgentIndication means the vm was running without a
+	// connected guest agent, so the snapshot is only crash consistent
+	VMSnapshotNoGuestAgentIndication Indication = "NoGuestAgent"
+)
+
 // Error is the last error encountered during the snapshot/restore
 type Error struct {
 	// +optional
@@ -241,7 +261,8 @@ type VirtualMachineRestore struct {
 
 // VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreSpec struct {
-	// initially only VirtualMachine type supported
+	// initially only VirtualMachine type supported,
+	// a VirtualMachine that does not exist yet is created from the snapshot
 	Target corev1.TypedLocalObjectReference `json:"target"`
 
 	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
@@ -285,3 +306,73 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule periodically snapshots a set of VMs
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule in cron format ("minute hour day-of-month month day-of-week"), evaluated in UTC
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines in the namespace of the schedule
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Disabled pauses creating new snapshots, retention is still enforced
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+
+	// DeletionPolicy is set on every VirtualMachineSnapshot created by the schedule
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule are pruned
+type VirtualMachineSnapshotScheduleRetention struct {
+	// MaxAge is the age after which a snapshot is deleted
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// MaxCount is the number of snapshots kept per VirtualMachine
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+	// +nullable
+	LastSnapshotTime *metav1.Time `json:"lastSnapshotTime,omitempty"`
+
+	// +optional
+	// +nullable
+	NextSnapshotTime *metav1.Time `json:"nextSnapshotTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
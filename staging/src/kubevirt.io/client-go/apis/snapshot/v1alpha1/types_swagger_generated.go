@@ -25,6 +25,7 @@ func (VirtualMachineSnapshotStatus) SwaggerDoc() map[string]string {
 		"readyToUse":                        "+optional",
 		"error":                             "+optional",
 		"conditions":                        "+optional",
+		"indications":                       "+optional\n+listType=set",
 	}
 }
 
@@ -121,7 +122,7 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target": "initially only VirtualMachine type supported",
+		"target": "initially only VirtualMachine type supported,\na VirtualMachine that does not exist yet is created from the snapshot",
 	}
 }
 
@@ -148,3 +149,45 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically snapshots a set of VMs\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":       "Schedule in cron format (\"minute hour day-of-month month day-of-week\"), evaluated in UTC",
+		"selector":       "Selector selects the VirtualMachines in the namespace of the schedule",
+		"disabled":       "Disabled pauses creating new snapshots, retention is still enforced\n+optional",
+		"retention":      "+optional",
+		"deletionPolicy": "DeletionPolicy is set on every VirtualMachineSnapshot created by the schedule\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule are pruned",
+		"maxAge":   "MaxAge is the age after which a snapshot is deleted\n+optional",
+		"maxCount": "MaxCount is the number of snapshots kept per VirtualMachine\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastSnapshotTime": "+optional\n+nullable",
+		"nextSnapshotTime": "+optional\n+nullable",
+		"error":            "+optional",
+		"conditions":       "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -25,6 +25,10 @@ import (
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
+	clonev1alpha1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1alpha1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	instancetypev1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	poolv1alpha1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 )
 
@@ -32,6 +36,10 @@ var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
+	clonev1alpha1.AddToScheme,
+	exportv1alpha1.AddToScheme,
+	instancetypev1alpha1.AddToScheme,
+	poolv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 }
 
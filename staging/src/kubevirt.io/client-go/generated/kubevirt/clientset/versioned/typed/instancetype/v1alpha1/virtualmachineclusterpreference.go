@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+
+	v1alpha1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	scheme "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/scheme"
+)
+
+// VirtualMachineClusterPreferencesGetter has a method to return a VirtualMachineClusterPreferenceInterface.
+// A group's client should implement this interface.
+type VirtualMachineClusterPreferencesGetter interface {
+	VirtualMachineClusterPreferences() VirtualMachineClusterPreferenceInterface
+}
+
+// VirtualMachineClusterPreferenceInterface has methods to work with VirtualMachineClusterPreference resources.
+type VirtualMachineClusterPreferenceInterface interface {
+	Create(ctx context.Context, virtualMachineClusterPreference *v1alpha1.VirtualMachineClusterPreference, opts v1.CreateOptions) (*v1alpha1.VirtualMachineClusterPreference, error)
+	Update(ctx context.Context, virtualMachineClusterPreference *v1alpha1.VirtualMachineClusterPreference, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineClusterPreference, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineClusterPreference, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineClusterPreferenceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineClusterPreference, err error)
+	VirtualMachineClusterPreferenceExpansion
+}
+
+// virtualMachineClusterPreferences implements VirtualMachineClusterPreferenceInterface
+type virtualMachineClusterPreferences struct {
+	client rest.Interface
+}
+
+// newVirtualMachineClusterPreferences returns a VirtualMachineClusterPreferences
+func newVirtualMachineClusterPreferences(c *InstancetypeV1alpha1Client) *virtualMachineClusterPreferences {
+	return &virtualMachineClusterPreferences{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the virtualMachineClusterPreference, and returns the corresponding virtualMachineClusterPreference object, and an error if there is any.
+func (c *virtualMachineClusterPreferences) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineClusterPreference, err error) {
+	result = &v1alpha1.VirtualMachineClusterPreference{}
+	err = c.client.Get().
+		Resource("virtualmachineclusterpreferences").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineClusterPreferences that match those selectors.
+func (c *virtualMachineClusterPreferences) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineClusterPreferenceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VirtualMachineClusterPreferenceList{}
+	err = c.client.Get().
+		Resource("virtualmachineclusterpreferences").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineClusterPreferences.
+func (c *virtualMachineClusterPreferences) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("virtualmachineclusterpreferences").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineClusterPreference and creates it.  Returns the server's representation of the virtualMachineClusterPreference, and an error, if there is any.
+func (c *virtualMachineClusterPreferences) Create(ctx context.Context, virtualMachineClusterPreference *v1alpha1.VirtualMachineClusterPreference, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineClusterPreference, err error) {
+	result = &v1alpha1.VirtualMachineClusterPreference{}
+	err = c.client.Post().
+		Resource("virtualmachineclusterpreferences").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineClusterPreference).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineClusterPreference and updates it. Returns the server's representation of the virtualMachineClusterPreference, and an error, if there is any.
+func (c *virtualMachineClusterPreferences) Update(ctx context.Context, virtualMachineClusterPreference *v1alpha1.VirtualMachineClusterPreference, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineClusterPreference, err error) {
+	result = &v1alpha1.VirtualMachineClusterPreference{}
+	err = c.client.Put().
+		Resource("virtualmachineclusterpreferences").
+		Name(virtualMachineClusterPreference.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineClusterPreference).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineClusterPreference and deletes it. Returns an error if one occurs.
+func (c *virtualMachineClusterPreferences) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("virtualmachineclusterpreferences").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineClusterPreferences) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("virtualmachineclusterpreferences").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineClusterPreference.
+func (c *virtualMachineClusterPreferences) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineClusterPreference, err error) {
+	result = &v1alpha1.VirtualMachineClusterPreference{}
+	err = c.client.Patch(pt).
+		Resource("virtualmachineclusterpreferences").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
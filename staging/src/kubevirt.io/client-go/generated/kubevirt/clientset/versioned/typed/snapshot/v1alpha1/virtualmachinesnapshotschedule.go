@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+
+	v1alpha1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	scheme "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/scheme"
+)
+
+// VirtualMachineSnapshotSchedulesGetter has a method to return a VirtualMachineSnapshotScheduleInterface.
+// A group's client should implement this interface.
+type VirtualMachineSnapshotSchedulesGetter interface {
+	VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface
+}
+
+// VirtualMachineSnapshotScheduleInterface has methods to work with VirtualMachineSnapshotSchedule resources.
+type VirtualMachineSnapshotScheduleInterface interface {
+	Create(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.CreateOptions) (*v1alpha1.VirtualMachineSnapshotSchedule, error)
+	Update(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineSnapshotSchedule, error)
+	UpdateStatus(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineSnapshotSchedule, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineSnapshotSchedule, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineSnapshotScheduleList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error)
+	VirtualMachineSnapshotScheduleExpansion
+}
+
+// virtualMachineSnapshotSchedules implements VirtualMachineSnapshotScheduleInterface
+type virtualMachineSnapshotSchedules struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineSnapshotSchedules returns a VirtualMachineSnapshotSchedules
+func newVirtualMachineSnapshotSchedules(c *SnapshotV1alpha1Client, namespace string) *virtualMachineSnapshotSchedules {
+	return &virtualMachineSnapshotSchedules{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineSnapshotSchedule, and returns the corresponding virtualMachineSnapshotSchedule object, and an error if there is any.
+func (c *virtualMachineSnapshotSchedules) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	result = &v1alpha1.VirtualMachineSnapshotSchedule{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSnapshotSchedules that match those selectors.
+func (c *virtualMachineSnapshotSchedules) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineSnapshotScheduleList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VirtualMachineSnapshotScheduleList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSnapshotSchedules.
+func (c *virtualMachineSnapshotSchedules) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineSnapshotSchedule and creates it.  Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *virtualMachineSnapshotSchedules) Create(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	result = &v1alpha1.VirtualMachineSnapshotSchedule{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineSnapshotSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineSnapshotSchedule and updates it. Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *virtualMachineSnapshotSchedules) Update(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	result = &v1alpha1.VirtualMachineSnapshotSchedule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		Name(virtualMachineSnapshotSchedule.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineSnapshotSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineSnapshotSchedules) UpdateStatus(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	result = &v1alpha1.VirtualMachineSnapshotSchedule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		Name(virtualMachineSnapshotSchedule.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineSnapshotSchedule).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineSnapshotSchedule and deletes it. Returns an error if one occurs.
+func (c *virtualMachineSnapshotSchedules) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineSnapshotSchedules) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineSnapshotSchedule.
+func (c *virtualMachineSnapshotSchedules) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	result = &v1alpha1.VirtualMachineSnapshotSchedule{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinesnapshotschedules").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -4,6 +4,8 @@
 package kubecli
 
 import (
+	time "time"
+
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1"
 	v10 "k8s.io/api/autoscaling/v1"
@@ -61,7 +63,11 @@ import (
 	versioned "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned"
 	versioned0 "kubevirt.io/client-go/generated/external-snapshotter/clientset/versioned"
 	versioned1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned"
-	v1alpha16 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
+	v1alpha16 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/clone/v1alpha1"
+	v1alpha17 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
+	v1alpha18 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
+	v1alpha19 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
+	v1alpha20 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	versioned2 "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
 	versioned3 "kubevirt.io/client-go/generated/prometheus-operator/clientset/versioned"
 )
@@ -147,9 +153,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineInstancePreset(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstancePreset", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha16.VirtualMachineSnapshotInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha20.VirtualMachineSnapshotInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshot", namespace)
-	ret0, _ := ret[0].(v1alpha16.VirtualMachineSnapshotInterface)
+	ret0, _ := ret[0].(v1alpha20.VirtualMachineSnapshotInterface)
 	return ret0
 }
 
@@ -157,9 +163,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshot(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshot", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha16.VirtualMachineSnapshotContentInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha20.VirtualMachineSnapshotContentInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotContent", namespace)
-	ret0, _ := ret[0].(v1alpha16.VirtualMachineSnapshotContentInterface)
+	ret0, _ := ret[0].(v1alpha20.VirtualMachineSnapshotContentInterface)
 	return ret0
 }
 
@@ -167,9 +173,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotContent(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotContent", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha16.VirtualMachineRestoreInterface {
+func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha20.VirtualMachineRestoreInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineRestore", namespace)
-	ret0, _ := ret[0].(v1alpha16.VirtualMachineRestoreInterface)
+	ret0, _ := ret[0].(v1alpha20.VirtualMachineRestoreInterface)
 	return ret0
 }
 
@@ -177,6 +183,86 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1alpha20.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha20.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineInstancetype(namespace string) v1alpha18.VirtualMachineInstancetypeInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineInstancetype", namespace)
+	ret0, _ := ret[0].(v1alpha18.VirtualMachineInstancetypeInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineInstancetype(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstancetype", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClusterInstancetype() v1alpha18.VirtualMachineClusterInstancetypeInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClusterInstancetype")
+	ret0, _ := ret[0].(v1alpha18.VirtualMachineClusterInstancetypeInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterInstancetype() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterInstancetype")
+}
+
+func (_m *MockKubevirtClient) VirtualMachinePreference(namespace string) v1alpha18.VirtualMachinePreferenceInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachinePreference", namespace)
+	ret0, _ := ret[0].(v1alpha18.VirtualMachinePreferenceInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachinePreference(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachinePreference", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClusterPreference() v1alpha18.VirtualMachineClusterPreferenceInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClusterPreference")
+	ret0, _ := ret[0].(v1alpha18.VirtualMachineClusterPreferenceInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterPreference() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterPreference")
+}
+
+func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha19.VirtualMachinePoolInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachinePool", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachinePoolInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachinePool(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachinePool", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClone(namespace string) v1alpha16.VirtualMachineCloneInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClone", namespace)
+	ret0, _ := ret[0].(v1alpha16.VirtualMachineCloneInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClone(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClone", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1alpha17.VirtualMachineExportInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
+	ret0, _ := ret[0].(v1alpha17.VirtualMachineExportInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineExport(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineExport", arg0)
+}
+
 func (_m *MockKubevirtClient) ServerVersion() *ServerVersion {
 	ret := _m.ctrl.Call(_m, "ServerVersion")
 	ret0, _ := ret[0].(*ServerVersion)
@@ -881,6 +967,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNC(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNC", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) PortForward(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PortForward", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Pause(name string) error {
 	ret := _m.ctrl.Call(_m, "Pause", name)
 	ret0, _ := ret[0].(error)
@@ -901,6 +998,36 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Unpause(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Unpause", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) Freeze(name string, unfreezeTimeout time.Duration) error {
+	ret := _m.ctrl.Call(_m, "Freeze", name, unfreezeTimeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Freeze(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Freeze", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) Unfreeze(name string) error {
+	ret := _m.ctrl.Call(_m, "Unfreeze", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Unfreeze(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Unfreeze", arg0)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) SoftReboot(name string) error {
+	ret := _m.ctrl.Call(_m, "SoftReboot", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SoftReboot(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SoftReboot", arg0)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) GuestOsInfo(name string) (v117.VirtualMachineInstanceGuestAgentInfo, error) {
 	ret := _m.ctrl.Call(_m, "GuestOsInfo", name)
 	ret0, _ := ret[0].(v117.VirtualMachineInstanceGuestAgentInfo)
@@ -934,6 +1061,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestExec(name string, request *v117.VirtualMachineInstanceGuestExecRequest) (*v117.VirtualMachineInstanceGuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", name, request)
+	ret0, _ := ret[0].(*v117.VirtualMachineInstanceGuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestExec(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(name string, addVolumeOptions *v117.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -954,6 +1092,26 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) AddInterface(name string, addInterfaceOptions *v117.AddInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddInterface", name, addInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) RemoveInterface(name string, removeInterfaceOptions *v117.RemoveInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveInterface", name, removeInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveInterface", arg0, arg1)
+}
+
 // Mock of ReplicaSetInterface interface
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -1373,6 +1531,57 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveVolume(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInterface) AddInterface(name string, addInterfaceOptions *v117.AddInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddInterface", name, addInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) AddInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) RemoveInterface(name string, removeInterfaceOptions *v117.RemoveInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveInterface", name, removeInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) MemoryDump(name string, memoryDumpRequest *v117.VirtualMachineMemoryDumpRequest) error {
+	ret := _m.ctrl.Call(_m, "MemoryDump", name, memoryDumpRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) MemoryDump(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MemoryDump", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) RemoveMemoryDump(name string) error {
+	ret := _m.ctrl.Call(_m, "RemoveMemoryDump", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveMemoryDump(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveMemoryDump", arg0)
+}
+
+func (_m *MockVirtualMachineInterface) PortForward(name string, port int, protocol string) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) PortForward(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PortForward", arg0, arg1, arg2)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -176,6 +177,23 @@ var _ = Describe("Kubevirt VirtualMachine Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should connect a port-forward stream to a VirtualMachine", func() {
+		var upgrader websocket.Upgrader
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", subVMIPath+"/portforward/22/tcp"),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err := client.VirtualMachine(k8sv1.NamespaceDefault).PortForward("testvm", 22, "tcp")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should migrate a VirtualMachine", func() {
 		server.AppendHandlers(ghttp.CombineHandlers(
 			ghttp.VerifyRequest("PUT", subVMIPath+"/migrate"),
@@ -201,6 +219,34 @@ var _ = Describe("Kubevirt VirtualMachine Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should request a memory dump of a VM", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", subVMIPath+"/memorydump"),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			),
+		)
+
+		err := client.VirtualMachine(k8sv1.NamespaceDefault).MemoryDump("testvm", &virtv1.VirtualMachineMemoryDumpRequest{ClaimName: "testclaim"})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should remove the memory dump of a VM", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", subVMIPath+"/removememorydump"),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			),
+		)
+
+		err := client.VirtualMachine(k8sv1.NamespaceDefault).RemoveMemoryDump("testvm")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	AfterEach(func() {
 		server.Close()
 	})
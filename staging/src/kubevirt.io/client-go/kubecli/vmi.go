@@ -127,6 +127,10 @@ func roundTripperFromConfig(config *rest.Config, callback RoundTripCallback) (ht
 }
 
 func RequestFromConfig(config *rest.Config, vmi string, namespace string, resource string) (*http.Request, error) {
+	return requestFromConfig(config, "virtualmachineinstances", vmi, namespace, resource)
+}
+
+func requestFromConfig(config *rest.Config, resource string, name string, namespace string, subresource string) (*http.Request, error) {
 
 	u, err := url.Parse(config.Host)
 	if err != nil {
@@ -142,7 +146,7 @@ func RequestFromConfig(config *rest.Config, vmi string, namespace string, resour
 		return nil, fmt.Errorf("Unsupported Protocol %s", u.Scheme)
 	}
 
-	u.Path = fmt.Sprintf("/apis/subresources.kubevirt.io/%s/namespaces/%s/virtualmachineinstances/%s/%s", v1.ApiStorageVersion, namespace, vmi, resource)
+	u.Path = fmt.Sprintf("/apis/subresources.kubevirt.io/%s/namespaces/%s/%s/%s/%s", v1.ApiStorageVersion, namespace, resource, name, subresource)
 	req := &http.Request{
 		Method: http.MethodGet,
 		URL:    u,
@@ -181,6 +185,14 @@ func (v *vmis) VNC(name string) (StreamInterface, error) {
 	return v.asyncSubresourceHelper(name, "vnc")
 }
 
+func (v *vmis) PortForward(name string, port int, protocol string) (StreamInterface, error) {
+	return v.asyncSubresourceHelper(name, buildPortForwardResourcePath(port, protocol))
+}
+
+func buildPortForwardResourcePath(port int, protocol string) string {
+	return fmt.Sprintf("portforward/%d/%s", port, protocol)
+}
+
 type connectionStruct struct {
 	con StreamInterface
 	err error
@@ -247,6 +259,10 @@ func (a *AsyncSubresourceError) GetStatusCode() int {
 }
 
 func (v *vmis) asyncSubresourceHelper(name string, resource string) (StreamInterface, error) {
+	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, resource)
+}
+
+func asyncSubresourceHelper(config *rest.Config, resource, namespace, name string, subresource string) (StreamInterface, error) {
 
 	done := make(chan struct{})
 
@@ -255,13 +271,13 @@ func (v *vmis) asyncSubresourceHelper(name string, resource string) (StreamInter
 		Done:       done,
 	}
 	// Create a round tripper with all necessary kubernetes security details
-	wrappedRoundTripper, err := roundTripperFromConfig(v.config, aws.WebsocketCallback)
+	wrappedRoundTripper, err := roundTripperFromConfig(config, aws.WebsocketCallback)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create round tripper for remote execution: %v", err)
 	}
 
 	// Create a request out of config and the query parameters
-	req, err := RequestFromConfig(v.config, name, v.namespace, resource)
+	req, err := requestFromConfig(config, resource, name, namespace, subresource)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create request for remote execution: %v", err)
 	}
@@ -318,6 +334,31 @@ func (v *vmis) Unpause(name string) error {
 	return v.restClient.Put().RequestURI(uri).Do(context.Background()).Error()
 }
 
+func (v *vmis) Freeze(name string, unfreezeTimeout time.Duration) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "freeze")
+
+	JSON, err := json.Marshal(&v1.FreezeUnfreezeTimeout{
+		UnfreezeTimeout: &k8smetav1.Duration{
+			Duration: unfreezeTimeout,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().RequestURI(uri).Body([]byte(JSON)).Do(context.Background()).Error()
+}
+
+func (v *vmis) Unfreeze(name string) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "unfreeze")
+	return v.restClient.Put().RequestURI(uri).Do(context.Background()).Error()
+}
+
+func (v *vmis) SoftReboot(name string) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "softreboot")
+	return v.restClient.Put().RequestURI(uri).Do(context.Background()).Error()
+}
+
 func (v *vmis) Get(name string, options *k8smetav1.GetOptions) (vmi *v1.VirtualMachineInstance, err error) {
 	vmi = &v1.VirtualMachineInstance{}
 	err = v.restClient.Get().
@@ -474,6 +515,33 @@ func (v *vmis) FilesystemList(name string) (v1.VirtualMachineInstanceFileSystemL
 	return fsList, err
 }
 
+func (v *vmis) GuestExec(name string, request *v1.VirtualMachineInstanceGuestExecRequest) (*v1.VirtualMachineInstanceGuestExecResult, error) {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestexec")
+
+	JSON, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// the command may legitimately run for the whole requested timeout
+	timeoutSeconds := v1.DefaultGuestExecTimeoutSeconds
+	if request.TimeoutSeconds != nil {
+		timeoutSeconds = *request.TimeoutSeconds
+	}
+	timeout := time.Duration(timeoutSeconds)*time.Second + 30*time.Second
+
+	body, err := v.restClient.Put().RequestURI(uri).SetHeader("Content-Type", "application/json").Body(JSON).Timeout(timeout).DoRaw(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.VirtualMachineInstanceGuestExecResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (v *vmis) AddVolume(name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "addvolume")
 
@@ -497,3 +565,27 @@ func (v *vmis) RemoveVolume(name string, removeVolumeOptions *v1.RemoveVolumeOpt
 
 	return v.restClient.Put().RequestURI(uri).Body([]byte(JSON)).Do(context.Background()).Error()
 }
+
+func (v *vmis) AddInterface(name string, addInterfaceOptions *v1.AddInterfaceOptions) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "addinterface")
+
+	JSON, err := json.Marshal(addInterfaceOptions)
+
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().RequestURI(uri).Body([]byte(JSON)).Do(context.Background()).Error()
+}
+
+func (v *vmis) RemoveInterface(name string, removeInterfaceOptions *v1.RemoveInterfaceOptions) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "removeinterface")
+
+	JSON, err := json.Marshal(removeInterfaceOptions)
+
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().RequestURI(uri).Body([]byte(JSON)).Do(context.Background()).Error()
+}
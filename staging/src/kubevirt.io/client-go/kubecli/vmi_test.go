@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
@@ -157,6 +158,20 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("should connect a port-forward stream to a VM", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", subVMPath+"/portforward/22/tcp"),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).PortForward("testvm", 22, "tcp")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should exchange data with the VM", func() {
 		vncPath := subVMPath + "/vnc"
 
@@ -241,6 +256,40 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should freeze a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/freeze"),
+			ghttp.VerifyBody([]byte(`{"unfreezeTimeout":"1m0s"}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Freeze("testvm", time.Minute)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should unfreeze a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/unfreeze"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Unfreeze("testvm")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should soft reboot a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/softreboot"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).SoftReboot("testvm")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should fetch GuestOSInfo from VirtualMachineInstance via subresource", func() {
 		osInfo := v1.VirtualMachineInstanceGuestAgentInfo{
 			GAVersion: "4.1.1",
@@ -292,6 +341,30 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(fetchedInfo).To(Equal(fileSystemList), "fetched info should be the same as passed in")
 	})
 
+	It("should run a command in the VirtualMachineInstance via subresource", func() {
+		result := v1.VirtualMachineInstanceGuestExecResult{
+			ExitCode: 1,
+			Stdout:   "out",
+			Stderr:   "err",
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/guestexec"),
+			ghttp.VerifyJSONRepresenting(v1.VirtualMachineInstanceGuestExecRequest{
+				Command: "ls",
+				Args:    []string{"-l"},
+			}),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, result),
+		))
+		fetchedResult, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).GuestExec("testvm", &v1.VirtualMachineInstanceGuestExecRequest{
+			Command: "ls",
+			Args:    []string{"-l"},
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*fetchedResult).To(Equal(result))
+	})
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -144,6 +144,12 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 			table.Entry("[test_id:5243]given a vmsnapshot", "virtualmachinesnapshots"),
 			table.Entry("[test_id:5244]given a vmsnapshotcontent", "virtualmachinesnapshotcontents"),
 			table.Entry("[test_id:5245]given a vmsrestore", "virtualmachinerestores"),
+			table.Entry("given a vmsnapshotschedule", "virtualmachinesnapshotschedules"),
+			table.Entry("given a vminstancetype", "virtualmachineinstancetypes"),
+			table.Entry("given a vmpreference", "virtualmachinepreferences"),
+			table.Entry("given a vmpool", "virtualmachinepools"),
+			table.Entry("given a vmclone", "virtualmachineclones"),
+			table.Entry("given a vmexport", "virtualmachineexports"),
 		)
 
 		table.DescribeTable("should verify permissions on subresources are correct for view, edit, and admin", func(resource string, subresource string) {
@@ -297,6 +303,12 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				table.Entry("[test_id:5246]given a vmsnapshot", "virtualmachinesnapshots"),
 				table.Entry("[test_id:5247]given a vmsnapshotcontent", "virtualmachinesnapshotcontents"),
 				table.Entry("[test_id:5248]given a vmsrestore", "virtualmachinerestores"),
+				table.Entry("given a vmsnapshotschedule", "virtualmachinesnapshotschedules"),
+				table.Entry("given a vminstancetype", "virtualmachineinstancetypes"),
+				table.Entry("given a vmpreference", "virtualmachinepreferences"),
+				table.Entry("given a vmpool", "virtualmachinepools"),
+				table.Entry("given a vmclone", "virtualmachineclones"),
+				table.Entry("given a vmexport", "virtualmachineexports"),
 			)
 
 			table.DescribeTable("should verify permissions on resources are correct for subresources", func(resource string, action string) {
@@ -304,6 +316,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 			},
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/pause", "update"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/unpause", "update"),
+				table.Entry("given a vmi", "virtualmachineinstances/freeze", "update"),
+				table.Entry("given a vmi", "virtualmachineinstances/unfreeze", "update"),
+				table.Entry("given a vm", "virtualmachines/memorydump", "update"),
+				table.Entry("given a vm", "virtualmachines/removememorydump", "update"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/console", "get"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/vnc", "get"),
 			)
@@ -340,6 +356,12 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				table.Entry("[test_id:5249]given a vmsnapshot", "virtualmachinesnapshots"),
 				table.Entry("[test_id:5250]given a vmsnapshotcontent", "virtualmachinesnapshotcontents"),
 				table.Entry("[test_id:5251]given a vmsrestore", "virtualmachinerestores"),
+				table.Entry("given a vmsnapshotschedule", "virtualmachinesnapshotschedules"),
+				table.Entry("given a vminstancetype", "virtualmachineinstancetypes"),
+				table.Entry("given a vmpreference", "virtualmachinepreferences"),
+				table.Entry("given a vmpool", "virtualmachinepools"),
+				table.Entry("given a vmclone", "virtualmachineclones"),
+				table.Entry("given a vmexport", "virtualmachineexports"),
 			)
 
 			table.DescribeTable("should verify permissions on resources are correct for subresources", func(resource string, action string) {
@@ -347,6 +369,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 			},
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/pause", "update"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/unpause", "update"),
+				table.Entry("given a vmi", "virtualmachineinstances/freeze", "update"),
+				table.Entry("given a vmi", "virtualmachineinstances/unfreeze", "update"),
+				table.Entry("given a vm", "virtualmachines/memorydump", "update"),
+				table.Entry("given a vm", "virtualmachines/removememorydump", "update"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/console", "get"),
 				table.Entry("[test_id:2921]given a vmi", "virtualmachineinstances/vnc", "get"),
 			)
@@ -90,7 +90,12 @@ var _ = Describe("[Serial]Infrastructure", func() {
 		It("[test_id:5177]Should have structural schema", func() {
 			ourCRDs := []string{crds.VIRTUALMACHINE, crds.VIRTUALMACHINEINSTANCE, crds.VIRTUALMACHINEINSTANCEPRESET,
 				crds.VIRTUALMACHINEINSTANCEREPLICASET, crds.VIRTUALMACHINEINSTANCEMIGRATION, crds.KUBEVIRT,
-				crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT,
+				crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT, crds.VIRTUALMACHINESNAPSHOTSCHEDULE,
+				crds.VIRTUALMACHINEINSTANCETYPE, crds.VIRTUALMACHINECLUSTERINSTANCETYPE,
+				crds.VIRTUALMACHINEPREFERENCE, crds.VIRTUALMACHINECLUSTERPREFERENCE,
+				crds.VIRTUALMACHINEPOOL,
+				crds.VIRTUALMACHINECLONE,
+				crds.VIRTUALMACHINEEXPORT,
 			}
 
 			for _, name := range ourCRDs {
@@ -568,6 +568,84 @@ var _ = Describe("[Serial]VirtualMachineRestore Tests", func() {
 				Expect(errors.IsNotFound(err)).To(BeTrue())
 			})
 
+			It("should restore a vm that boots from a datavolumetemplate to a new vm", func() {
+				vm, vmi = createAndStartVM(tests.NewRandomVMWithDataVolumeAndUserDataInStorageClass(
+					tests.GetUrl(tests.CirrosHttpUrl),
+					tests.NamespaceTestDefault,
+					"#!/bin/bash\necho 'hello'\n",
+					snapshotStorageClass,
+				))
+
+				originalDVName := vm.Spec.DataVolumeTemplates[0].Name
+
+				By("Stopping VM")
+				vm = tests.StopVirtualMachine(vm)
+
+				By("creating snapshot")
+				snapshot = createSnapshot(vm)
+
+				By("Restoring to a new VM")
+				newVMName := vm.Name + "-restored"
+				restore = createRestoreDef(vm, snapshot.Name)
+				restore.Spec.Target.Name = newVMName
+
+				restore, err = virtClient.VirtualMachineRestore(vm.Namespace).Create(context.Background(), restore, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				var newVM *v1.VirtualMachine
+				Eventually(func() error {
+					newVM, err = virtClient.VirtualMachine(vm.Namespace).Get(newVMName, &metav1.GetOptions{})
+					return err
+				}, 180*time.Second, time.Second).Should(Succeed())
+				defer deleteVM(newVM)
+
+				restore = waitRestoreComplete(restore, newVM)
+				Expect(restore.Status.Restores).To(HaveLen(1))
+				Expect(restore.Status.DeletedDataVolumes).To(BeEmpty())
+
+				newVM, err = virtClient.VirtualMachine(vm.Namespace).Get(newVMName, &metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+				Expect(newVM.Spec.DataVolumeTemplates[0].Name).ToNot(Equal(originalDVName))
+
+				By("Verifying the source VM is untouched")
+				_, err = virtClient.CdiClient().CdiV1alpha1().DataVolumes(vm.Namespace).Get(context.Background(), originalDVName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Starting the new VM")
+				newVM = tests.StartVirtualMachine(newVM)
+				vmi, err = virtClient.VirtualMachineInstance(newVM.Namespace).Get(newVM.Name, &metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(libnet.WithIPv6(console.LoginToCirros)(vmi)).To(Succeed())
+			})
+
+			It("should reject restore to a vm that is not the snapshot source", func() {
+				vm = tests.NewRandomVMWithDataVolumeAndUserDataInStorageClass(
+					tests.GetUrl(tests.CirrosHttpUrl),
+					tests.NamespaceTestDefault,
+					"#!/bin/bash\necho 'hello'\n",
+					snapshotStorageClass,
+				)
+				vm, err = virtClient.VirtualMachine(vm.Namespace).Create(vm)
+				Expect(err).ToNot(HaveOccurred())
+
+				By("creating snapshot")
+				snapshot = createSnapshot(vm)
+
+				otherVMI := tests.NewRandomVMIWithEphemeralDisk(cd.ContainerDiskFor(cd.ContainerDiskCirros))
+				otherVM := tests.NewRandomVirtualMachine(otherVMI, false)
+				otherVM, err = virtClient.VirtualMachine(vm.Namespace).Create(otherVM)
+				Expect(err).ToNot(HaveOccurred())
+				defer deleteVM(otherVM)
+
+				r := createRestoreDef(vm, snapshot.Name)
+				r.Spec.Target.Name = otherVM.Name
+
+				_, err = virtClient.VirtualMachineRestore(vm.Namespace).Create(context.Background(), r, metav1.CreateOptions{})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("restoring to a new VirtualMachine requires an unused name"))
+			})
+
 			It("[test_id:5261]should restore a vm that boots from a datavolume (not template)", func() {
 				vm = tests.NewRandomVMWithDataVolumeAndUserDataInStorageClass(
 					tests.GetUrl(tests.CirrosHttpUrl),